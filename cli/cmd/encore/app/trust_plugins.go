@@ -0,0 +1,54 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"encr.dev/cli/cmd/encore/cmdutil"
+	"encr.dev/pkg/appfile"
+	"encr.dev/v2/parser/plugin/external"
+)
+
+var trustPluginsCmd = &cobra.Command{
+	Use:   "trust-plugins",
+	Short: "Allow the parser plugins configured in encore.app to run",
+	Long: `Allow the parser plugins configured in encore.app to run.
+
+Plugins run commands on this machine whenever the app is parsed,
+so they only run once you've reviewed and trusted them.
+They need to be trusted again whenever their configuration changes,
+or the contents of an executable within the app they run.`,
+	Args: cobra.NoArgs,
+
+	DisableFlagsInUseLine: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		appRoot, _ := cmdutil.AppRoot()
+		trustPlugins(appRoot)
+	},
+}
+
+func init() {
+	appCmd.AddCommand(trustPluginsCmd)
+}
+
+func trustPlugins(appRoot string) {
+	f, err := appfile.ParseFile(filepath.Join(appRoot, appfile.Name))
+	if err != nil {
+		cmdutil.Fatal(err)
+	}
+	if len(f.Plugins) == 0 {
+		cmdutil.Fatal("the app does not configure any plugins")
+	}
+
+	if err := external.Trust(appRoot, f.Plugins); err != nil {
+		cmdutil.Fatal("could not trust plugins: ", err)
+	}
+
+	fmt.Println("Trusted the plugins of the app, which run these commands when parsing it:")
+	for _, p := range f.Plugins {
+		fmt.Printf("  %s: %s\n", p.Name, strings.Join(p.Command, " "))
+	}
+}
@@ -0,0 +1,149 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"encr.dev/cli/cmd/encore/cmdutil"
+	"encr.dev/cli/cmd/encore/root"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the local cache",
+	Long: `Manage the local cache used by 'encore run'.
+
+The cache contents are persisted per infrastructure namespace,
+so switching namespaces also switches the cache contents.`,
+}
+
+var nsName string
+
+var flushCmd = &cobra.Command{
+	Use:   "flush [--namespace=<name>]",
+	Short: "Remove all keys from the local cache",
+	Args:  cobra.NoArgs,
+
+	DisableFlagsInUseLine: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		appRoot, _ := cmdutil.AppRoot()
+		daemon := cmdutil.ConnectDaemon(ctx)
+		_, err := daemon.CacheFlush(ctx, &daemonpb.CacheFlushRequest{
+			AppRoot:   appRoot,
+			Namespace: nonZeroPtr(nsName),
+		})
+		if err != nil {
+			cmdutil.Fatal(err)
+		}
+		_, _ = fmt.Fprintln(os.Stderr, "flushed cache")
+	},
+}
+
+func init() {
+	var output string
+	dumpCmd := &cobra.Command{
+		Use:   "dump [--output=<file>] [--namespace=<name>]",
+		Short: "Write a snapshot of the local cache as JSON",
+		Long: `Write a snapshot of the local cache as JSON.
+
+The snapshot is written to stdout unless --output is given,
+and can be loaded again with 'encore cache restore'.`,
+		Args: cobra.NoArgs,
+
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			appRoot, _ := cmdutil.AppRoot()
+			daemon := cmdutil.ConnectDaemon(ctx)
+			resp, err := daemon.CacheDump(ctx, &daemonpb.CacheDumpRequest{
+				AppRoot:   appRoot,
+				Namespace: nonZeroPtr(nsName),
+			})
+			if err != nil {
+				cmdutil.Fatal(err)
+			}
+
+			if output == "" || output == "-" {
+				_, _ = os.Stdout.Write(resp.Snapshot)
+				_, _ = fmt.Fprintln(os.Stdout)
+				return
+			}
+			if err := os.WriteFile(output, resp.Snapshot, 0644); err != nil {
+				cmdutil.Fatal(err)
+			}
+			_, _ = fmt.Fprintf(os.Stderr, "wrote cache snapshot to %s\n", output)
+		},
+	}
+
+	dumpCmd.Flags().StringVarP(&output, "output", "o", "", "File to write the snapshot to (defaults to stdout)")
+	dumpCmd.Flags().StringVarP(&nsName, "namespace", "n", "", "Namespace to use (defaults to active namespace)")
+	cacheCmd.AddCommand(dumpCmd)
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore <file> [--namespace=<name>]",
+	Short: "Replace the local cache with a snapshot",
+	Long: `Replace the local cache with a snapshot created by 'encore cache dump'.
+
+Use '-' to read the snapshot from stdin.
+Keys that have expired since the snapshot was taken are not restored.`,
+	Args: cobra.ExactArgs(1),
+
+	DisableFlagsInUseLine: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		var (
+			data []byte
+			err  error
+		)
+		if args[0] == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(args[0])
+		}
+		if err != nil {
+			cmdutil.Fatal(err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		appRoot, _ := cmdutil.AppRoot()
+		daemon := cmdutil.ConnectDaemon(ctx)
+		_, err = daemon.CacheRestore(ctx, &daemonpb.CacheRestoreRequest{
+			AppRoot:   appRoot,
+			Namespace: nonZeroPtr(nsName),
+			Snapshot:  data,
+		})
+		if err != nil {
+			cmdutil.Fatal(err)
+		}
+		_, _ = fmt.Fprintln(os.Stderr, "restored cache")
+	},
+}
+
+func init() {
+	flushCmd.Flags().StringVarP(&nsName, "namespace", "n", "", "Namespace to use (defaults to active namespace)")
+	restoreCmd.Flags().StringVarP(&nsName, "namespace", "n", "", "Namespace to use (defaults to active namespace)")
+	cacheCmd.AddCommand(flushCmd)
+	cacheCmd.AddCommand(restoreCmd)
+	root.Cmd.AddCommand(cacheCmd)
+}
+
+func nonZeroPtr[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
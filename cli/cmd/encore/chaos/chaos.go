@@ -0,0 +1,180 @@
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"encr.dev/cli/cmd/encore/cmdutil"
+	"encr.dev/cli/cmd/encore/root"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+var chaosCmd = &cobra.Command{
+	Use:   "chaos",
+	Short: "Inject latency and errors into a locally running app",
+	Long: `Inject latency and errors into the operations of an app
+running locally with 'encore run', or tested with 'encore test'.
+
+Rules can target service-to-service API calls, SQL database queries,
+cache operations, bucket operations and Pub/Sub publishes, scoped by
+service, endpoint or resource. Changes apply to running apps right away.
+Injected faults are shown as events in traces.
+
+Rules apply to 'encore run' unless --test is given. Rules for tests are
+kept separately, and only apply when running 'encore test --chaos'.
+
+Fault injection is only supported for Go apps, and is never active
+outside of local development and tests.`,
+}
+
+// forTests is whether to manage the rules for tests instead of 'encore run'.
+var forTests bool
+
+// chaosEnv returns the environment the rules are managed for.
+func chaosEnv() string {
+	if forTests {
+		return "test"
+	}
+	return "run"
+}
+
+func init() {
+	var (
+		rule    daemonpb.ChaosRule
+		latency time.Duration
+	)
+	addCmd := &cobra.Command{
+		Use:   "add [--kind=<kind>] [--service=<name>] [--endpoint=<name>] [--resource=<name>] [--latency=<duration>] [--error-rate=<fraction>]",
+		Short: "Add a fault injection rule",
+		Long: `Add a fault injection rule.
+
+The rule applies to the operations matching all of the given filters,
+and when several rules match an operation the first one added applies.
+--kind is one of api, sqldb, cache, bucket or pubsub.
+--service is the service being called for api rules, and the service
+performing the operation otherwise. --resource is the name of the database,
+cache cluster, bucket or topic.
+
+For example, to make a fifth of the calls to the users service fail
+after a delay of 200ms:
+
+    encore chaos add --kind=api --service=users --latency=200ms --error-rate=0.2`,
+		Args: cobra.NoArgs,
+
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			rule.LatencyMs = latency.Milliseconds()
+			appRoot, _ := cmdutil.AppRoot()
+			daemon := cmdutil.ConnectDaemon(ctx)
+			added, err := daemon.ChaosAddRule(ctx, &daemonpb.ChaosAddRuleRequest{
+				AppRoot: appRoot,
+				Rule:    &rule,
+				Env:     chaosEnv(),
+			})
+			if err != nil {
+				cmdutil.Fatal(err)
+			}
+			_, _ = fmt.Fprintf(os.Stderr, "added chaos rule %s\n", added.Id)
+		},
+	}
+	addCmd.Flags().StringVar(&rule.Kind, "kind", "", "Kind of operations to target (api, sqldb, cache, bucket or pubsub)")
+	addCmd.Flags().StringVar(&rule.Service, "service", "", "Service to target")
+	addCmd.Flags().StringVar(&rule.Endpoint, "endpoint", "", "Endpoint to target (api rules only)")
+	addCmd.Flags().StringVar(&rule.Resource, "resource", "", "Database, cache cluster, bucket or topic to target")
+	addCmd.Flags().DurationVar(&latency, "latency", 0, "Latency to add to each operation")
+	addCmd.Flags().Float64Var(&rule.ErrorRate, "error-rate", 0, "Fraction of operations to fail, between 0 and 1")
+	_ = addCmd.RegisterFlagCompletionFunc("kind", cmdutil.AutoCompleteFromStaticList(
+		"api\tService-to-service API calls",
+		"sqldb\tSQL database queries and transactions",
+		"cache\tCache operations",
+		"bucket\tObject storage operations",
+		"pubsub\tPub/Sub publishes",
+	))
+	chaosCmd.AddCommand(addCmd)
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the fault injection rules",
+	Args:  cobra.NoArgs,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		appRoot, _ := cmdutil.AppRoot()
+		daemon := cmdutil.ConnectDaemon(ctx)
+		resp, err := daemon.ChaosRules(ctx, &daemonpb.ChaosRulesRequest{AppRoot: appRoot, Env: chaosEnv()})
+		if err != nil {
+			cmdutil.Fatal(err)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.StripEscape)
+		_, _ = fmt.Fprint(w, "ID\tKIND\tSERVICE\tENDPOINT\tRESOURCE\tLATENCY\tERROR RATE\n")
+		for _, r := range resp.Rules {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.Id, orAny(r.Kind), orAny(r.Service),
+				orAny(r.Endpoint), orAny(r.Resource), time.Duration(r.LatencyMs)*time.Millisecond,
+				strconv.FormatFloat(r.ErrorRate*100, 'f', -1, 64)+"%")
+		}
+		_ = w.Flush()
+	},
+}
+
+var removeCmd = &cobra.Command{
+	Use:   "remove <id>...",
+	Short: "Remove fault injection rules",
+	Args:  cobra.MinimumNArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		removeRules(args)
+		_, _ = fmt.Fprintf(os.Stderr, "removed chaos rules %s\n", strings.Join(args, ", "))
+	},
+}
+
+var clearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove all fault injection rules",
+	Args:  cobra.NoArgs,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		removeRules(nil)
+		_, _ = fmt.Fprintln(os.Stderr, "removed all chaos rules")
+	},
+}
+
+func removeRules(ids []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	appRoot, _ := cmdutil.AppRoot()
+	daemon := cmdutil.ConnectDaemon(ctx)
+	_, err := daemon.ChaosRemoveRules(ctx, &daemonpb.ChaosRemoveRulesRequest{AppRoot: appRoot, Ids: ids, Env: chaosEnv()})
+	if err != nil {
+		cmdutil.Fatal(err)
+	}
+}
+
+func orAny(s string) string {
+	if s == "" {
+		return "*"
+	}
+	return s
+}
+
+func init() {
+	chaosCmd.PersistentFlags().BoolVar(&forTests, "test", false, "Manage the rules for 'encore test --chaos' instead of 'encore run'")
+	chaosCmd.AddCommand(listCmd)
+	chaosCmd.AddCommand(removeCmd)
+	chaosCmd.AddCommand(clearCmd)
+	root.Cmd.AddCommand(chaosCmd)
+}
@@ -13,13 +13,28 @@ import (
 )
 
 var (
-	codegenDebug    bool
-	checkParseTests bool
+	codegenDebug        bool
+	checkParseTests     bool
+	checkMigrationsBase string
+	checkAPICompatBase  string
 )
 
 var checkCmd = &cobra.Command{
 	Use:   "check",
 	Short: "Checks your application for compile-time errors using Encore's compiler.",
+	Long: `Checks your application for compile-time errors using Encore's compiler.
+
+It also checks the database migrations added or modified since the git revision
+given by --migrations-base for operations that are unsafe to run against a
+production database, such as locking a table while creating an index or dropping
+a column still used by queries. Add a '-- encore-lint-ignore: <rule>' comment
+before a statement to allow it.
+
+With --api-compat=<git-ref> it also compares the app's API with the API at the
+given git revision, and fails if any change would break existing clients,
+such as removing an endpoint or field, changing a field's type, adding a
+required request field, changing an endpoint's path, or changing the schema
+of a Pub/Sub message.`,
 
 	DisableFlagsInUseLine: true,
 	Run: func(cmd *cobra.Command, args []string) {
@@ -32,6 +47,8 @@ func init() {
 	rootCmd.AddCommand(checkCmd)
 	checkCmd.Flags().BoolVar(&codegenDebug, "codegen-debug", false, "Dump generated code (for debugging Encore's code generation)")
 	checkCmd.Flags().BoolVar(&checkParseTests, "tests", false, "Parse tests as well")
+	checkCmd.Flags().StringVar(&checkMigrationsBase, "migrations-base", "HEAD", "Git revision to check new database migrations against")
+	checkCmd.Flags().StringVar(&checkAPICompatBase, "api-compat", "", "Git revision to check the API for breaking changes against")
 }
 
 func runChecks(appRoot, relPath string) {
@@ -46,11 +63,13 @@ func runChecks(appRoot, relPath string) {
 
 	daemon := setupDaemon(ctx)
 	stream, err := daemon.Check(ctx, &daemonpb.CheckRequest{
-		AppRoot:      appRoot,
-		WorkingDir:   relPath,
-		CodegenDebug: codegenDebug,
-		ParseTests:   checkParseTests,
-		Environ:      os.Environ(),
+		AppRoot:        appRoot,
+		WorkingDir:     relPath,
+		CodegenDebug:   codegenDebug,
+		ParseTests:     checkParseTests,
+		Environ:        os.Environ(),
+		MigrationsBase: checkMigrationsBase,
+		ApiCompatBase:  checkAPICompatBase,
 	})
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "fatal: ", err)
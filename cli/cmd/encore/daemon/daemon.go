@@ -43,6 +43,7 @@ import (
 	"encr.dev/cli/daemon/mcp"
 	"encr.dev/cli/daemon/namespace"
 	"encr.dev/cli/daemon/objects"
+	"encr.dev/cli/daemon/redis"
 	"encr.dev/cli/daemon/run"
 	"encr.dev/cli/daemon/secret"
 	"encr.dev/cli/daemon/sqldb"
@@ -110,6 +111,7 @@ type Daemon struct {
 	NS            *namespace.Manager
 	ClusterMgr    *sqldb.ClusterManager
 	ObjectsMgr    *objects.ClusterManager
+	RedisMgr      *redis.ClusterManager
 	MCPMgr        *mcp.Manager
 	PublicBuckets *objects.PublicBucketServer
 	Trace         trace2.Store
@@ -155,6 +157,8 @@ func (d *Daemon) init(ctx context.Context) {
 	d.ClusterMgr = sqldb.NewClusterManager(sqldbDriver, d.Apps, d.NS, d.Secret)
 	d.ObjectsMgr = objects.NewClusterManager(d.NS)
 	d.PublicBuckets = objects.NewPublicBucketServer("http://"+d.ObjectStorage.ClientAddr(), d.ObjectsMgr.PersistentStoreFallback)
+	d.RedisMgr = redis.NewClusterManager()
+	d.closeOnExit(d.RedisMgr)
 
 	traceStore := sqlite.New(d.EncoreDB)
 	go traceStore.CleanEvery(ctx, 1*time.Minute, 500, 100, 10000)
@@ -167,6 +171,7 @@ func (d *Daemon) init(ctx context.Context) {
 		Secret:        d.Secret,
 		ClusterMgr:    d.ClusterMgr,
 		ObjectsMgr:    d.ObjectsMgr,
+		RedisMgr:      d.RedisMgr,
 		PublicBuckets: d.PublicBuckets,
 	}
 	d.MCPMgr = mcp.NewManager(
@@ -182,8 +187,9 @@ func (d *Daemon) init(ctx context.Context) {
 	d.NS.RegisterDeletionHandler(d.ClusterMgr)
 	d.NS.RegisterDeletionHandler(d.RunMgr)
 	d.NS.RegisterDeletionHandler(d.ObjectsMgr)
+	d.NS.RegisterDeletionHandler(d.RedisMgr)
 
-	d.Server = daemon.New(d.Apps, d.RunMgr, d.ClusterMgr, d.Secret, d.NS, d.MCPMgr)
+	d.Server = daemon.New(d.Apps, d.RunMgr, d.ClusterMgr, d.Secret, d.NS, d.MCPMgr, d.Trace)
 }
 
 func (d *Daemon) serve() {
@@ -48,9 +48,17 @@ func getDBRole() daemonpb.DBRole {
 	}
 }
 
+var dbResetSkipSeeds bool
+
 var dbResetCmd = &cobra.Command{
 	Use:   "reset <database-names...|--all>",
 	Short: "Resets the databases with the given names. Use --all to reset all databases.",
+	Long: `Resets the databases with the given names, recreating them from their migrations.
+Use --all to reset all databases.
+
+After the migrations, the seed data declared for each database is inserted
+into the local databases. Use --skip-seeds to leave the databases empty.
+`,
 
 	Run: func(command *cobra.Command, args []string) {
 		appRoot, _ := determineAppRoot()
@@ -73,6 +81,8 @@ var dbResetCmd = &cobra.Command{
 			DatabaseNames: dbNames,
 			ClusterType:   dbClusterType(),
 			Namespace:     nonZeroPtr(nsName),
+			SkipSeeds:     dbResetSkipSeeds,
+			Environ:       os.Environ(),
 		})
 		if err != nil {
 			fatal("reset databases: ", err)
@@ -298,6 +308,7 @@ func init() {
 	dbResetCmd.Flags().BoolVar(&resetAll, "all", false, "Reset all services in the application")
 	dbResetCmd.Flags().BoolVarP(&testDB, "test", "t", false, "Reset databases in the test cluster instead")
 	dbResetCmd.Flags().BoolVar(&shadowDB, "shadow", false, "Reset databases in the shadow cluster instead")
+	dbResetCmd.Flags().BoolVar(&dbResetSkipSeeds, "skip-seeds", false, "Don't insert the databases' seed data")
 	dbCmd.AddCommand(dbResetCmd)
 
 	dbShellCmd.Flags().StringVarP(&nsName, "namespace", "n", "", "Namespace to use (defaults to active namespace)")
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+var (
+	dbDiffName   string
+	dbDiffDryRun bool
+)
+
+var dbDiffCmd = &cobra.Command{
+	Use:   "diff [<db-name>] [--name=<description>] [--dry-run]",
+	Short: "Generates a migration from changes made to the local database",
+	Long: `Compares the schema of a local database with the schema produced by
+applying all its migrations, and generates a new numbered migration
+(.up.sql and .down.sql) capturing the differences.
+
+This is useful after prototyping schema changes directly in the database,
+for example using 'encore db shell'. The new migration is marked as applied,
+since the database already has the changes.
+
+Use --dry-run to print the migration without writing it.
+`,
+	Args: cobra.MaximumNArgs(1),
+
+	DisableFlagsInUseLine: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		appRoot, relPath := determineAppRoot()
+		var dbName string
+		if len(args) > 0 {
+			dbName = args[0]
+		} else if dbName = enclosingDBName(appRoot, relPath); dbName == "" {
+			fatal("could not find an Encore service with a database in this directory (or any of the parent directories).\n\n" +
+				"Note: You can specify a database name to diff it directly.")
+		}
+
+		ctx := context.Background()
+		daemon := setupDaemon(ctx)
+		resp, err := daemon.DBDiff(ctx, &daemonpb.DBDiffRequest{
+			AppRoot:   appRoot,
+			DbName:    dbName,
+			Namespace: nonZeroPtr(nsName),
+		})
+		if err != nil {
+			if st, ok := status.FromError(err); ok {
+				if st.Code() == codes.NotFound {
+					fatalf("no such database found: %s", dbName)
+				}
+				fatal(st.Message())
+			}
+			fatal(err)
+		}
+		if resp.Up == "" {
+			_, _ = fmt.Fprintln(os.Stderr, "no schema differences")
+			return
+		}
+
+		if dbDiffDryRun {
+			fmt.Printf("-- up\n%s\n-- down\n%s", resp.Up, resp.Down)
+			return
+		}
+
+		name := migrationDescription(dbDiffName)
+		dir := filepath.Join(appRoot, filepath.FromSlash(resp.MigrationRelPath))
+		base := fmt.Sprintf("%d_%s", resp.NextNumber, name)
+		for _, f := range []struct{ ext, data string }{{".up.sql", resp.Up}, {".down.sql", resp.Down}} {
+			path := filepath.Join(dir, base+f.ext)
+			if err := os.WriteFile(path, []byte(f.data), 0644); err != nil {
+				fatal(err)
+			}
+			rel, _ := filepath.Rel(appRoot, path)
+			_, _ = fmt.Fprintf(os.Stderr, "wrote %s\n", rel)
+		}
+
+		// The database already has the changes, so mark the migration as applied.
+		_, err = daemon.DBMigrate(ctx, &daemonpb.DBMigrateRequest{
+			AppRoot:     appRoot,
+			DbName:      dbName,
+			ClusterType: daemonpb.DBClusterType_DB_CLUSTER_TYPE_RUN,
+			Namespace:   nonZeroPtr(nsName),
+			Action:      daemonpb.DBMigrateRequest_ACTION_FORCE,
+			Version:     resp.NextNumber,
+		})
+		if err != nil {
+			if st, ok := status.FromError(err); ok {
+				fatalf("could not mark migration %d as applied: %s", resp.NextNumber, st.Message())
+			}
+			fatal(err)
+		}
+	},
+}
+
+var nonDescriptionChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// migrationDescription turns s into the description part of a migration filename.
+func migrationDescription(s string) string {
+	s = strings.Trim(nonDescriptionChars.ReplaceAllString(strings.ToLower(s), "_"), "_")
+	if s == "" {
+		return "schema_changes"
+	}
+	return s
+}
+
+func init() {
+	dbCmd.AddCommand(dbDiffCmd)
+	dbDiffCmd.Flags().StringVarP(&nsName, "namespace", "n", "", "Namespace to use (defaults to active namespace)")
+	dbDiffCmd.Flags().StringVar(&dbDiffName, "name", "", "Description to use in the migration filename")
+	dbDiffCmd.Flags().BoolVar(&dbDiffDryRun, "dry-run", false, "Print the migration instead of writing it")
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"encr.dev/cli/cmd/encore/cmdutil"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+var dbMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Inspect and apply database migrations",
+	Long: `Inspect and apply the migrations of a local database.
+
+The migrations are applied to the database of your local environment.
+Use --shadow to migrate the shadow database instead.
+
+Migrations are reverted using their corresponding .down.sql files,
+so you can iterate on a migration without resetting the whole database.
+Note that 'encore run' applies all pending migrations when it starts.
+
+Data migrations written in Go are listed together with the SQL migrations.
+They're run in the app by 'encore run' once it's ready, so 'up' stops
+at a pending data migration and the later migrations are applied after it has run.
+`,
+}
+
+var dbMigrateOutput = cmdutil.Oneof{Value: "columns", Allowed: []string{"columns", "json"}}
+
+var dbMigrateStatusCmd = &cobra.Command{
+	Use:   "status [<db-name>] [--shadow]",
+	Short: "Shows which migrations are applied",
+	Args:  cobra.MaximumNArgs(1),
+
+	DisableFlagsInUseLine: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		resp := runDBMigrate(args, &daemonpb.DBMigrateRequest{
+			Action: daemonpb.DBMigrateRequest_ACTION_STATUS,
+		})
+		printMigrations(resp)
+	},
+}
+
+var dbMigrateUpTo uint64
+
+var dbMigrateUpCmd = &cobra.Command{
+	Use:   "up [<db-name>] [--to=<version>] [--shadow]",
+	Short: "Applies pending migrations",
+	Long: `Applies the pending migrations of a database.
+
+Use --to to only apply the migrations up to and including the given version.
+`,
+	Args: cobra.MaximumNArgs(1),
+
+	DisableFlagsInUseLine: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		req := &daemonpb.DBMigrateRequest{Action: daemonpb.DBMigrateRequest_ACTION_UP}
+		if cmd.Flags().Changed("to") {
+			req.To = &dbMigrateUpTo
+		}
+		resp := runDBMigrate(args, req)
+		printMigrationChanges(resp, "applied")
+	},
+}
+
+var dbMigrateDownSteps uint32
+
+var dbMigrateDownCmd = &cobra.Command{
+	Use:   "down [<db-name>] [--steps=<n>] [--shadow]",
+	Short: "Reverts the most recently applied migrations",
+	Long: `Reverts the most recently applied migrations of a database,
+using their corresponding .down.sql files.
+
+Use --steps to revert more than one migration.
+`,
+	Args: cobra.MaximumNArgs(1),
+
+	DisableFlagsInUseLine: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		resp := runDBMigrate(args, &daemonpb.DBMigrateRequest{
+			Action: daemonpb.DBMigrateRequest_ACTION_DOWN,
+			Steps:  dbMigrateDownSteps,
+		})
+		printMigrationChanges(resp, "reverted")
+	},
+}
+
+var dbMigrateForceCmd = &cobra.Command{
+	Use:   "force <version> [<db-name>] [--shadow]",
+	Short: "Sets the current migration version without running any migrations",
+	Long: `Marks the given migration as the current version of a database, without running any migrations.
+
+The migrations up to and including the given version are marked as applied,
+and the later ones as not applied. This also clears the dirty state left by
+a migration that failed to apply. Use version 0 to mark no migrations as applied.
+`,
+	Args: cobra.RangeArgs(1, 2),
+
+	DisableFlagsInUseLine: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		version, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			fatalf("invalid version %q: must be a migration number", args[0])
+		}
+		resp := runDBMigrate(args[1:], &daemonpb.DBMigrateRequest{
+			Action:  daemonpb.DBMigrateRequest_ACTION_FORCE,
+			Version: version,
+		})
+		printMigrations(resp)
+	},
+}
+
+// runDBMigrate runs the migrate request against the database given by args,
+// or the database of the enclosing service if args is empty.
+func runDBMigrate(args []string, req *daemonpb.DBMigrateRequest) *daemonpb.DBMigrateResponse {
+	appRoot, relPath := determineAppRoot()
+	if len(args) > 0 {
+		req.DbName = args[0]
+	} else if req.DbName = enclosingDBName(appRoot, relPath); req.DbName == "" {
+		fatal("could not find an Encore service with a database in this directory (or any of the parent directories).\n\n" +
+			"Note: You can specify a database name to migrate it directly.")
+	}
+	req.AppRoot = appRoot
+	req.ClusterType = dbClusterType()
+	req.Namespace = nonZeroPtr(nsName)
+
+	ctx := context.Background()
+	daemon := setupDaemon(ctx)
+	resp, err := daemon.DBMigrate(ctx, req)
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == codes.NotFound {
+				fatalf("no such database found: %s", req.DbName)
+			}
+			fatal(st.Message())
+		}
+		fatal(err)
+	}
+	return resp
+}
+
+// enclosingDBName returns the name of the database of the service enclosing relPath,
+// found by looking for the "migrations" folder. It returns "" if there is none.
+func enclosingDBName(appRoot, relPath string) string {
+	for p := relPath; p != "."; p = filepath.Dir(p) {
+		absPath := filepath.Join(appRoot, p)
+		if _, err := os.Stat(filepath.Join(absPath, "migrations")); err == nil {
+			pkgs, err := resolvePackages(absPath, ".")
+			if err == nil && len(pkgs) > 0 {
+				return filepath.Base(pkgs[0])
+			}
+		}
+	}
+	return ""
+}
+
+func printMigrations(resp *daemonpb.DBMigrateResponse) {
+	if dbMigrateOutput.Value == "json" {
+		out, err := protojson.MarshalOptions{UseProtoNames: true, Multiline: true, EmitUnpopulated: true}.Marshal(resp)
+		if err != nil {
+			fatal(err)
+		}
+		fmt.Println(string(out))
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.StripEscape)
+	_, _ = fmt.Fprint(w, "VERSION\tNAME\tTYPE\tSTATUS\tDOWN\n")
+	var dirty *daemonpb.DBMigrationStatus
+	var prev uint64
+	for _, m := range resp.Migrations {
+		st := "pending"
+		switch {
+		case m.Dirty:
+			st = "dirty"
+			if dirty == nil {
+				dirty = m
+			}
+		case m.Applied:
+			st = "applied"
+		}
+		name, typ := m.Description, "sql"
+		if m.DataMigration {
+			typ = "go"
+		} else if m.Filename == "" {
+			name = "(file missing)"
+		}
+		down := "no"
+		if m.HasDown {
+			down = "yes"
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", m.Number, name, typ, st, down)
+		if dirty == nil && !m.DataMigration {
+			prev = m.Number
+		}
+	}
+	_ = w.Flush()
+
+	if dirty != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "\nMigration %d is dirty: it failed to apply and was rolled back.\n"+
+			"Fix the migration and run 'encore db migrate force %d' to mark the previous migration as the current version.\n",
+			dirty.Number, prev)
+	}
+}
+
+func printMigrationChanges(resp *daemonpb.DBMigrateResponse, verb string) {
+	if dbMigrateOutput.Value == "json" {
+		printMigrations(resp)
+		return
+	}
+	if len(resp.Changed) == 0 {
+		_, _ = fmt.Fprintln(os.Stderr, "no change")
+		return
+	}
+	for _, num := range resp.Changed {
+		for _, m := range resp.Migrations {
+			if m.Number == num {
+				_, _ = fmt.Fprintf(os.Stderr, "%s %s\n", verb, m.Filename)
+			}
+		}
+	}
+}
+
+func init() {
+	dbCmd.AddCommand(dbMigrateCmd)
+	dbMigrateCmd.PersistentFlags().StringVarP(&nsName, "namespace", "n", "", "Namespace to use (defaults to active namespace)")
+	dbMigrateCmd.PersistentFlags().BoolVar(&shadowDB, "shadow", false, "Migrate the shadow database instead")
+
+	dbMigrateUpCmd.Flags().Uint64Var(&dbMigrateUpTo, "to", 0, "Only apply migrations up to and including this version")
+	dbMigrateDownCmd.Flags().Uint32Var(&dbMigrateDownSteps, "steps", 1, "Number of migrations to revert")
+	for _, cmd := range []*cobra.Command{dbMigrateStatusCmd, dbMigrateUpCmd, dbMigrateDownCmd, dbMigrateForceCmd} {
+		dbMigrateOutput.AddFlag(cmd)
+		dbMigrateCmd.AddCommand(cmd)
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+var dbSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Save and restore snapshots of the local databases",
+	Long: `Save and restore snapshots of all the local databases of the app.
+
+Snapshots are stored per app, so a snapshot saved in one namespace
+can be restored into any other namespace using --namespace.
+Restoring a snapshot replaces the contents of the databases it contains,
+including which migrations are applied.
+`,
+}
+
+var dbSnapshotSaveCmd = &cobra.Command{
+	Use:   "save <name>",
+	Short: "Saves a snapshot of the local databases",
+	Long:  "Saves a snapshot of the local databases, replacing any existing snapshot with the same name.",
+	Args:  cobra.ExactArgs(1),
+
+	DisableFlagsInUseLine: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		resp := runDBSnapshot(&daemonpb.DBSnapshotRequest{
+			Action: daemonpb.DBSnapshotRequest_ACTION_SAVE,
+			Name:   args[0],
+		})
+		for _, snap := range resp.Snapshots {
+			_, _ = fmt.Fprintf(os.Stderr, "saved snapshot %s (%s)\n", snap.Name, strings.Join(snap.Databases, ", "))
+		}
+	},
+}
+
+var dbSnapshotRestoreCmd = &cobra.Command{
+	Use:   "restore <name>",
+	Short: "Restores the local databases from a snapshot",
+	Args:  cobra.ExactArgs(1),
+
+	DisableFlagsInUseLine: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		resp := runDBSnapshot(&daemonpb.DBSnapshotRequest{
+			Action: daemonpb.DBSnapshotRequest_ACTION_RESTORE,
+			Name:   args[0],
+		})
+		if len(resp.Restored) == 0 {
+			_, _ = fmt.Fprintln(os.Stderr, "the snapshot contains none of the app's databases")
+			return
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "restored snapshot %s (%s)\n", args[0], strings.Join(resp.Restored, ", "))
+	},
+}
+
+var dbSnapshotListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the saved snapshots",
+	Args:  cobra.NoArgs,
+
+	DisableFlagsInUseLine: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		resp := runDBSnapshot(&daemonpb.DBSnapshotRequest{
+			Action: daemonpb.DBSnapshotRequest_ACTION_LIST,
+		})
+		if len(resp.Snapshots) == 0 {
+			_, _ = fmt.Fprintln(os.Stderr, "no snapshots saved")
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.StripEscape)
+		_, _ = fmt.Fprint(w, "NAME\tNAMESPACE\tCREATED\tDATABASES\n")
+		for _, snap := range resp.Snapshots {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", snap.Name, snap.Namespace,
+				snap.CreatedAt.AsTime().Local().Format(time.DateTime), strings.Join(snap.Databases, ", "))
+		}
+		_ = w.Flush()
+	},
+}
+
+func runDBSnapshot(req *daemonpb.DBSnapshotRequest) *daemonpb.DBSnapshotResponse {
+	appRoot, _ := determineAppRoot()
+	req.AppRoot = appRoot
+	req.Namespace = nonZeroPtr(nsName)
+
+	ctx := context.Background()
+	daemon := setupDaemon(ctx)
+	resp, err := daemon.DBSnapshot(ctx, req)
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == codes.NotFound {
+				fatalf("no such snapshot: %s", req.Name)
+			}
+			fatal(st.Message())
+		}
+		fatal(err)
+	}
+	return resp
+}
+
+func init() {
+	dbCmd.AddCommand(dbSnapshotCmd)
+	dbSnapshotCmd.PersistentFlags().StringVarP(&nsName, "namespace", "n", "", "Namespace to use (defaults to active namespace)")
+	dbSnapshotCmd.AddCommand(dbSnapshotSaveCmd, dbSnapshotRestoreCmd, dbSnapshotListCmd)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -13,6 +14,7 @@ import (
 	"encr.dev/cli/internal/manifest"
 	"encr.dev/pkg/appfile"
 	"encr.dev/pkg/clientgen"
+	"encr.dev/pkg/externalgen"
 	daemonpb "encr.dev/proto/encore/daemon"
 )
 
@@ -49,7 +51,9 @@ Supported language codes are:
   typescript: A TypeScript client using the Fetch API
   javascript: A JavaScript client using the Fetch API
   go: A Go client using net/http"
+  python: A Python client using urllib, with sync and asyncio variants
   openapi: An OpenAPI specification (EXPERIMENTAL)
+  asyncapi: An AsyncAPI 3 document describing the Pub/Sub topics (EXPERIMENTAL)
 
 By default all services with a non-private API endpoint are included.
 To further narrow down the services to generate, use the '--services' flag.
@@ -109,7 +113,7 @@ To further narrow down the services to generate, use the '--services' flag.
 				// Validate the user input for the language
 				l, err := clientgen.GetLang(lang)
 				if err != nil {
-					fatal(fmt.Sprintf("%s: supported languages are `typescript`, `javascript`, `go` and `openapi`", err))
+					fatal(fmt.Sprintf("%s: supported languages are `typescript`, `javascript`, `go`, `python`, `openapi` and `asyncapi`", err))
 				}
 				lang = string(l)
 			}
@@ -154,7 +158,8 @@ To further narrow down the services to generate, use the '--services' flag.
 	genWrappersCmd := &cobra.Command{
 		Use:   "wrappers",
 		Short: "Generates user-facing wrapper code",
-		Long: `Manually regenerates user-facing wrapper code.
+		Long: `Manually regenerates user-facing wrapper code,
+including the typed query functions generated from .sql query files.
 
 This is typically not something you ever need to call during regular development,
 as Encore automatically regenerates the wrappers whenever the code-base changes.
@@ -177,19 +182,68 @@ which may require the user-facing wrapper code to be manually generated.`,
 		},
 	}
 
+	var externalOutput string
+	genExternalCmd := &cobra.Command{
+		Use:   "external <name> <openapi-spec> [--output=<dir>]",
+		Short: "Generates a typed client for an external API from its OpenAPI spec",
+		Long: `Generates a typed Go client package for a third-party HTTP API
+from its OpenAPI 3 specification (in JSON or YAML format).
+
+The API credentials are read from an Encore secret, requests are traced
+with their OpenAPI operation id, and the API can be mocked in tests
+using et.MockExternal.
+
+The package is written to the directory given by --output,
+which defaults to a directory named after the API.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			name, specPath := args[0], args[1]
+			spec, err := os.ReadFile(specPath)
+			if err != nil {
+				fatal(err)
+			}
+			res, err := externalgen.Generate(externalgen.GenerateParams{Name: name, Spec: spec})
+			if err != nil {
+				fatalf("unable to generate client for %s: %v", name, err)
+			}
+
+			dir := externalOutput
+			if dir == "" {
+				dir = externalgen.PackageName(name)
+			}
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				fatal(err)
+			}
+			path := filepath.Join(dir, "client.go")
+			if err := os.WriteFile(path, res.Code, 0644); err != nil {
+				fatal(err)
+			}
+
+			for _, skipped := range res.Skipped {
+				fmt.Fprintf(os.Stderr, "warning: skipped operation %s\n", skipped)
+			}
+			fmt.Printf("successfully generated client for %s in %s.\n", name, path)
+		},
+	}
+	genExternalCmd.Flags().StringVarP(&externalOutput, "output", "o", "", "The directory to write the generated package to")
+	_ = genExternalCmd.MarkFlagDirname("output")
+
 	genCmd.AddCommand(genClientCmd)
 	genCmd.AddCommand(genWrappersCmd)
+	genCmd.AddCommand(genExternalCmd)
 
-	genClientCmd.Flags().StringVarP(&lang, "lang", "l", "", "The language to generate code for (\"typescript\", \"javascript\", \"go\", and \"openapi\" are supported)")
+	genClientCmd.Flags().StringVarP(&lang, "lang", "l", "", "The language to generate code for (\"typescript\", \"javascript\", \"go\", \"python\", \"openapi\", and \"asyncapi\" are supported)")
 	_ = genClientCmd.RegisterFlagCompletionFunc("lang", cmdutil.AutoCompleteFromStaticList(
 		"typescript\tA TypeScript client using the in-browser Fetch API",
 		"javascript\tA JavaScript client using the in-browser Fetch API",
 		"go\tA Go client using net/http",
+		"python\tA Python client using urllib",
 		"openapi\tAn OpenAPI specification",
+		"asyncapi\tAn AsyncAPI specification of the Pub/Sub topics",
 	))
 
 	genClientCmd.Flags().StringVarP(&output, "output", "o", "", "The filename to write the generated client code to")
-	_ = genClientCmd.MarkFlagFilename("output", "go", "ts", "tsx", "js", "jsx")
+	_ = genClientCmd.MarkFlagFilename("output", "go", "ts", "tsx", "js", "jsx", "py")
 
 	genClientCmd.Flags().StringVarP(&envName, "env", "e", "local", "The environment to fetch the API for (defaults to the local environment)")
 	_ = genClientCmd.RegisterFlagCompletionFunc("env", cmdutil.AutoCompleteEnvSlug)
@@ -10,19 +10,28 @@ import (
 
 	"encr.dev/cli/cmd/encore/cmdutil"
 	"encr.dev/cli/cmd/encore/root"
+	"encr.dev/pkg/sqlcexec"
 
 	// Register commands
 	_ "encr.dev/cli/cmd/encore/app"
+	_ "encr.dev/cli/cmd/encore/cache"
+	_ "encr.dev/cli/cmd/encore/chaos"
 	_ "encr.dev/cli/cmd/encore/config"
 	_ "encr.dev/cli/cmd/encore/k8s"
 	_ "encr.dev/cli/cmd/encore/namespace"
+	_ "encr.dev/cli/cmd/encore/pubsub"
 	_ "encr.dev/cli/cmd/encore/secrets"
+	_ "encr.dev/cli/cmd/encore/traffic"
 )
 
 // for backwards compatibility, for now
 var rootCmd = root.Cmd
 
 func main() {
+	// Run sqlc through this binary's hidden 'sqlc' command.
+	if exe, err := os.Executable(); err == nil {
+		sqlcexec.SetEncoreBinary(exe)
+	}
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 	if err := root.Cmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -0,0 +1,216 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"encr.dev/cli/cmd/encore/cmdutil"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+var dlqCmd = &cobra.Command{
+	Use:   "dlq",
+	Short: "Inspect and replay dead-lettered messages",
+	Long: `Inspect and replay messages that exhausted their retries.
+
+When a subscription's retry policy is exhausted the message is kept
+as a dead letter, along with the last error, the number of delivery attempts
+and the trace of the last attempt.`,
+}
+
+// dlqSelector holds the flags selecting which dead-lettered messages to operate on.
+type dlqSelector struct {
+	topic        string
+	subscription string
+	all          bool
+}
+
+func (s *dlqSelector) addFlags(cmd *cobra.Command, withAll bool) {
+	cmd.Flags().StringVar(&s.topic, "topic", "", "Only include messages from the given topic")
+	cmd.Flags().StringVar(&s.subscription, "subscription", "", "Only include messages from the given subscription")
+	if withAll {
+		cmd.Flags().BoolVar(&s.all, "all", false, "Include all messages")
+	}
+}
+
+// filter returns the filter for the selected messages.
+// If requireSelection is set, it fails unless ids, a selector flag or --all is given.
+func (s *dlqSelector) filter(appRoot string, ids []string, requireSelection bool) *daemonpb.PubSubDeadLetterFilter {
+	if requireSelection && len(ids) == 0 && s.topic == "" && s.subscription == "" && !s.all {
+		cmdutil.Fatal("specify message ids, --topic, --subscription or --all")
+	}
+	f := &daemonpb.PubSubDeadLetterFilter{AppRoot: appRoot, Ids: ids}
+	if s.topic != "" {
+		f.Topic = proto.String(s.topic)
+	}
+	if s.subscription != "" {
+		f.Subscription = proto.String(s.subscription)
+	}
+	return f
+}
+
+func init() {
+	var (
+		listSel dlqSelector
+		output  = cmdutil.Oneof{Value: "columns", Allowed: []string{"columns", "json"}}
+	)
+	listCmd := &cobra.Command{
+		Use:   "list [--topic=<topic>] [--subscription=<subscription>] [--output=json]",
+		Short: "List dead-lettered messages",
+		Args:  cobra.NoArgs,
+
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			appRoot, _ := cmdutil.AppRoot()
+			daemon := cmdutil.ConnectDaemon(ctx)
+			resp, err := daemon.PubSubListDeadLetters(ctx, listSel.filter(appRoot, nil, false))
+			if err != nil {
+				cmdutil.Fatal(err)
+			}
+
+			if output.Value == "json" {
+				msgs := make([]json.RawMessage, 0, len(resp.Messages))
+				for _, m := range resp.Messages {
+					msgs = append(msgs, deadLetterJSON(m))
+				}
+				printJSON(msgs)
+				return
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.StripEscape)
+			_, _ = fmt.Fprint(w, "ID\tTOPIC\tSUBSCRIPTION\tMESSAGE ID\tATTEMPTS\tDEAD-LETTERED\tLAST ERROR\n")
+			for _, m := range resp.Messages {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+					m.Id, m.Topic, m.Subscription, m.MessageId, m.Attempts,
+					m.DeadLetteredAt.AsTime().Local().Format(time.DateTime), firstLine(m.LastError))
+			}
+			_ = w.Flush()
+		},
+	}
+	listSel.addFlags(listCmd, false)
+	output.AddFlag(listCmd)
+
+	showCmd := &cobra.Command{
+		Use:   "show <id>",
+		Short: "Show a dead-lettered message",
+		Long:  "Show a dead-lettered message, including its data, attributes and the last error.",
+		Args:  cobra.ExactArgs(1),
+
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			appRoot, _ := cmdutil.AppRoot()
+			daemon := cmdutil.ConnectDaemon(ctx)
+			resp, err := daemon.PubSubListDeadLetters(ctx, &daemonpb.PubSubDeadLetterFilter{
+				AppRoot: appRoot,
+				Ids:     args,
+			})
+			if err != nil {
+				cmdutil.Fatal(err)
+			} else if len(resp.Messages) == 0 {
+				cmdutil.Fatalf("dead-lettered message %q not found", args[0])
+			}
+			printJSON(deadLetterJSON(resp.Messages[0]))
+		},
+	}
+
+	var replaySel dlqSelector
+	replayCmd := &cobra.Command{
+		Use:   "replay [<id>...] [--topic=<topic>] [--subscription=<subscription>] [--all]",
+		Short: "Redeliver dead-lettered messages to their subscriptions",
+		Long: `Redeliver dead-lettered messages to the subscriptions they were dead-lettered from.
+
+Messages are redelivered with their original message ids, and only to the subscription
+that failed to process them. Replayed messages are removed from the dead letters.`,
+
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			appRoot, _ := cmdutil.AppRoot()
+			daemon := cmdutil.ConnectDaemon(ctx)
+			resp, err := daemon.PubSubReplayDeadLetters(ctx, replaySel.filter(appRoot, args, true))
+			if err != nil {
+				cmdutil.Fatal(err)
+			}
+			_, _ = fmt.Fprintf(os.Stderr, "replayed %d messages\n", resp.Count)
+		},
+	}
+	replaySel.addFlags(replayCmd, true)
+
+	var purgeSel dlqSelector
+	purgeCmd := &cobra.Command{
+		Use:   "purge [<id>...] [--topic=<topic>] [--subscription=<subscription>] [--all]",
+		Short: "Discard dead-lettered messages",
+
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			appRoot, _ := cmdutil.AppRoot()
+			daemon := cmdutil.ConnectDaemon(ctx)
+			resp, err := daemon.PubSubPurgeDeadLetters(ctx, purgeSel.filter(appRoot, args, true))
+			if err != nil {
+				cmdutil.Fatal(err)
+			}
+			_, _ = fmt.Fprintf(os.Stderr, "purged %d messages\n", resp.Count)
+		},
+	}
+	purgeSel.addFlags(purgeCmd, true)
+
+	dlqCmd.AddCommand(listCmd, showCmd, replayCmd, purgeCmd)
+	pubsubCmd.AddCommand(dlqCmd)
+}
+
+// printJSON prints v as indented JSON.
+func printJSON(v any) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		cmdutil.Fatal(err)
+	}
+}
+
+// deadLetterJSON encodes a dead-lettered message as JSON, with the message data inlined
+// rather than base64-encoded.
+func deadLetterJSON(m *daemonpb.PubSubDeadLetter) json.RawMessage {
+	withoutData := proto.CloneOf(m)
+	withoutData.Data = nil
+	encoded, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(withoutData)
+	if err != nil {
+		cmdutil.Fatal(err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		cmdutil.Fatal(err)
+	}
+	fields["data"] = m.Data
+	if encoded, err = json.Marshal(fields); err != nil {
+		cmdutil.Fatal(err)
+	}
+	return encoded
+}
+
+// firstLine returns the first line of s.
+func firstLine(s string) string {
+	if line, _, multiline := strings.Cut(s, "\n"); multiline {
+		return line + " ..."
+	}
+	return s
+}
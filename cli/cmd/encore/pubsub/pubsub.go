@@ -0,0 +1,125 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"encr.dev/cli/cmd/encore/cmdutil"
+	"encr.dev/cli/cmd/encore/root"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+var pubsubCmd = &cobra.Command{
+	Use:   "pubsub",
+	Short: "Interact with the Pub/Sub topics of a running app",
+	Long:  `Interact with the Pub/Sub topics of an app running locally with 'encore run'.`,
+}
+
+var publishCmd = &cobra.Command{
+	Use:   "publish <topic> <message|->",
+	Short: "Publish a message to a topic",
+	Long: `Publish a message to a topic.
+
+The message is given as JSON, or read from stdin if it's "-".
+It's validated against the topic's message type before being published,
+and any fields tagged as message attributes are sent as attributes.`,
+	Example: `encore pubsub publish user-signups '{"user_id": 123}'`,
+	Args:    cobra.ExactArgs(2),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		msg := []byte(args[1])
+		if args[1] == "-" {
+			var err error
+			if msg, err = io.ReadAll(os.Stdin); err != nil {
+				cmdutil.Fatal(err)
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		appRoot, _ := cmdutil.AppRoot()
+		daemon := cmdutil.ConnectDaemon(ctx)
+		resp, err := daemon.PubSubPublish(ctx, &daemonpb.PubSubPublishRequest{
+			AppRoot: appRoot,
+			Topic:   args[0],
+			Message: msg,
+		})
+		if err != nil {
+			cmdutil.Fatal(err)
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "published message %s\n", resp.MessageId)
+	},
+}
+
+func init() {
+	output := cmdutil.Oneof{Value: "columns", Allowed: []string{"columns", "json"}}
+	statsCmd := &cobra.Command{
+		Use:   "stats [--output=json]",
+		Short: "Show the state of each topic's subscriptions",
+		Long: `Show the state of each topic's subscriptions.
+
+For each subscription it reports the number of messages waiting to be delivered (depth),
+delivered but not yet acknowledged (in flight), waiting to be retried (deferred),
+and the total number of messages requeued for a retry.`,
+		Args: cobra.NoArgs,
+
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			appRoot, _ := cmdutil.AppRoot()
+			daemon := cmdutil.ConnectDaemon(ctx)
+			resp, err := daemon.PubSubStats(ctx, &daemonpb.PubSubStatsRequest{AppRoot: appRoot})
+			if err != nil {
+				cmdutil.Fatal(err)
+			}
+
+			if output.Value == "json" {
+				data, err := protojson.MarshalOptions{
+					Multiline:       true,
+					UseProtoNames:   true,
+					EmitUnpopulated: true,
+				}.Marshal(resp)
+				if err != nil {
+					cmdutil.Fatal(err)
+				}
+				_, _ = fmt.Fprintln(os.Stdout, string(data))
+				return
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.StripEscape)
+			_, _ = fmt.Fprint(w, "TOPIC\tSUBSCRIPTION\tCONNECTED\tDEPTH\tIN FLIGHT\tDEFERRED\tREQUEUED\tTOTAL\n")
+			for _, t := range resp.Topics {
+				if len(t.Subscriptions) == 0 {
+					_, _ = fmt.Fprintf(w, "%s\t-\t-\t%d\t-\t-\t-\t%d\n", t.Topic, t.Depth, t.Messages)
+				}
+				for _, s := range t.Subscriptions {
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%d\t%d\n",
+						t.Topic, s.Subscription, yesNo(s.Connected), s.Depth, s.InFlight, s.Deferred, s.Requeued, s.Messages)
+				}
+			}
+			_ = w.Flush()
+		},
+	}
+	output.AddFlag(statsCmd)
+
+	pubsubCmd.AddCommand(publishCmd)
+	pubsubCmd.AddCommand(statsCmd)
+	root.Cmd.AddCommand(pubsubCmd)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -173,6 +173,16 @@ func init() {
 			return nil
 		},
 	}
+	sqlcCmd := &cobra.Command{
+		Use:                "sqlc [args...]",
+		Short:              "Runs the embedded sqlc",
+		Hidden:             true,
+		DisableFlagParsing: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			os.Exit(cli.Run(args))
+		},
+	}
 	rootCmd.AddCommand(genCmd)
 	rootCmd.AddCommand(pluginCmd)
+	rootCmd.AddCommand(sqlcCmd)
 }
@@ -14,10 +14,13 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/mod/modfile"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"encr.dev/cli/cmd/encore/cmdutil"
+	"encr.dev/pkg/fns"
 	daemonpb "encr.dev/proto/encore/daemon"
 )
 
@@ -33,6 +36,8 @@ var testCmd = &cobra.Command{
 			codegenDebug bool
 			prepareOnly  bool
 			noColor      bool
+			chaos        bool
+			out          testOutputOptions
 		)
 		// Support specific args but otherwise let all args be passed on to "go test"
 		for i := 0; i < len(args); i++ {
@@ -40,23 +45,18 @@ var testCmd = &cobra.Command{
 			if arg == "-h" || arg == "--help" {
 				_ = cmd.Help()
 				return
-			} else if arg == "--trace" || strings.HasPrefix(arg, "--trace=") {
-				// Drop this argument always.
-				args = slices.Delete(args, i, i+1)
+			} else if value, rest, ok := takeStringFlag(args, i, "--trace"); ok {
+				traceFile, args = value, rest
+				i--
+			} else if value, rest, ok := takeStringFlag(args, i, "--report"); ok {
+				out.report, args = value, rest
+				i--
+			} else if value, rest, ok := takeStringFlag(args, i, "--report-file"); ok {
+				out.reportFile, args = value, rest
+				i--
+			} else if value, rest, ok := takeStringFlag(args, i, "--coverage"); ok {
+				out.coverage, args = value, rest
 				i--
-
-				// We either have '--trace=file' or '--trace file'.
-				// Handle both.
-				if _, value, ok := strings.Cut(arg, "="); ok {
-					traceFile = value
-				} else {
-					// Make sure there is a next argument.
-					if i < len(args) {
-						traceFile = args[i]
-						args = slices.Delete(args, i, i+1)
-						i--
-					}
-				}
 			} else if arg == "--codegen-debug" {
 				codegenDebug = true
 				args = slices.Delete(args, i, i+1)
@@ -69,11 +69,20 @@ var testCmd = &cobra.Command{
 				noColor = true
 				args = slices.Delete(args, i, i+1)
 				i--
+			} else if arg == "--chaos" {
+				chaos = true
+				args = slices.Delete(args, i, i+1)
+				i--
 			}
 		}
+		if out.report != "" && out.report != "json" && out.report != "junit" {
+			fatalf("unknown report format %q: must be one of json, junit", out.report)
+		} else if out.reportFile != "" && out.report == "" {
+			fatal("--report-file requires --report")
+		}
 
 		appRoot, relPath := determineAppRoot()
-		exitCode, err := runTests(appRoot, relPath, args, traceFile, codegenDebug, prepareOnly, noColor)
+		exitCode, err := runTests(appRoot, relPath, args, traceFile, codegenDebug, prepareOnly, noColor, chaos, out)
 		if err != nil {
 			fatal(err)
 		}
@@ -81,7 +90,14 @@ var testCmd = &cobra.Command{
 	},
 }
 
-func runTests(appRoot, testDir string, args []string, traceFile string, codegenDebug, prepareOnly, noColor bool) (int, error) {
+// testOutputOptions configures the structured output of a test run.
+type testOutputOptions struct {
+	report     string // report format: "json", "junit", or "" for no report
+	reportFile string // file to write the report to; stdout if empty or "-"
+	coverage   string // file to write the merged coverage profile to, if any
+}
+
+func runTests(appRoot, testDir string, args []string, traceFile string, codegenDebug, prepareOnly, noColor, chaos bool, out testOutputOptions) (int, error) {
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, os.Interrupt)
 
@@ -92,7 +108,7 @@ func runTests(appRoot, testDir string, args []string, traceFile string, codegenD
 	}()
 
 	converter := cmdutil.ConvertJSONLogs(cmdutil.Colorize(!noColor))
-	if slices.Contains(args, "-json") {
+	if slices.Contains(args, "-json") && out.report == "" {
 		converter = convertTestEventOutputOnly(converter)
 	}
 
@@ -114,12 +130,17 @@ func runTests(appRoot, testDir string, args []string, traceFile string, codegenD
 	// Is this a node package?
 	packageJsonPath := filepath.Join(appRoot, "package.json")
 	if _, err := os.Stat(packageJsonPath); err == nil || prepareOnly {
+		if out.report != "" || out.coverage != "" {
+			return 1, errors.New("--report and --coverage are not supported for TypeScript apps or with --prepare")
+		}
+
 		spec, err := daemon.TestSpec(ctx, &daemonpb.TestSpecRequest{
 			AppRoot:    appRoot,
 			WorkingDir: testDir,
 			Args:       args,
 			Environ:    os.Environ(),
 			TempDir:    tempDir,
+			Chaos:      chaos,
 		})
 		if status.Code(err) == codes.NotFound {
 			return 1, errors.New("application does not define any tests.\nNote: Add a 'test' script command to package.json to run tests.")
@@ -151,6 +172,33 @@ func runTests(appRoot, testDir string, args []string, traceFile string, codegenD
 		return 0, nil
 	}
 
+	var reporter *testReporter
+	if out.report != "" {
+		reporter = newTestReporter()
+		converter = reporter.converter(converter, slices.Contains(args, "-json"), isVerbose(args))
+		if !slices.Contains(args, "-json") {
+			args = append([]string{"-json"}, args...)
+		}
+		if out.reportFile == "" || out.reportFile == "-" {
+			// The report is written to stdout, so write the test output to stderr.
+			converter = writeTo(os.Stderr, converter)
+		}
+	}
+
+	// Measure coverage of all the app's packages, so that calls between services are included.
+	modulePath := appModulePath(appRoot)
+	var coverFile string
+	if out.coverage != "" {
+		if tempDir == "" {
+			return 1, errors.New("--coverage cannot be used when compiling tests without running them")
+		}
+		coverFile = filepath.Join(tempDir, "coverage.out")
+		args = append([]string{"-coverprofile=" + coverFile}, args...)
+		if modulePath != "" && !slices.ContainsFunc(args, func(arg string) bool { return strings.HasPrefix(arg, "-coverpkg") }) {
+			args = append([]string{"-coverpkg=" + modulePath + "/..."}, args...)
+		}
+	}
+
 	stream, err := daemon.Test(ctx, &daemonpb.TestRequest{
 		AppRoot:      appRoot,
 		WorkingDir:   testDir,
@@ -159,11 +207,127 @@ func runTests(appRoot, testDir string, args []string, traceFile string, codegenD
 		TraceFile:    nonZeroPtr(traceFile),
 		CodegenDebug: codegenDebug,
 		TempDir:      tempDir,
+		Chaos:        chaos,
 	})
 	if err != nil {
 		return 1, err
 	}
-	return cmdutil.StreamCommandOutput(stream, converter), nil
+	exitCode := cmdutil.StreamCommandOutput(stream, converter)
+
+	var coverage *float64
+	if coverFile != "" {
+		// The profile is missing if the tests failed to build.
+		if _, err := os.Stat(coverFile); err == nil {
+			percent, err := mergeCoverProfile(coverFile, out.coverage)
+			if err != nil {
+				return 1, err
+			}
+			_, _ = fmt.Fprintf(os.Stderr, "coverage: %.1f%% of statements\n", percent)
+			coverage = &percent
+		}
+	}
+
+	if reporter != nil {
+		rep := reporter.finish(exitCode)
+		rep.Coverage = coverage
+		linkTestTraces(ctx, daemon, appRoot, modulePath, rep)
+		printFailedTests(rep)
+
+		w := os.Stdout
+		if out.reportFile != "" && out.reportFile != "-" {
+			f, err := os.Create(out.reportFile)
+			if err != nil {
+				return 1, err
+			}
+			defer fns.CloseIgnore(f)
+			w = f
+		}
+		if err := writeTestReport(w, rep, out.report); err != nil {
+			return 1, fmt.Errorf("write test report: %w", err)
+		}
+	}
+	return exitCode, nil
+}
+
+// linkTestTraces links the tests of the report to the traces recorded while they ran.
+func linkTestTraces(ctx context.Context, daemon daemonpb.DaemonClient, appRoot, modulePath string, rep *testReport) {
+	// Traces are recorded asynchronously, so retry a few times
+	// if some failed tests are not yet linked to their trace.
+	for attempt := 0; attempt < 3; attempt++ {
+		resp, err := daemon.TestTraces(ctx, &daemonpb.TestTracesRequest{
+			AppRoot: appRoot,
+			Since:   timestamppb.New(rep.Started),
+		})
+		if err != nil {
+			return
+		}
+		rep.linkTraces(resp.Traces, modulePath)
+
+		linked := true
+		for _, pkg := range rep.Packages {
+			for _, t := range pkg.failedTests() {
+				linked = linked && t.TraceID != ""
+			}
+		}
+		if linked {
+			return
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+// printFailedTests prints the failed tests of the report along with the traces they recorded.
+func printFailedTests(rep *testReport) {
+	var failed []string
+	for _, pkg := range rep.Packages {
+		for _, t := range pkg.failedTests() {
+			line := fmt.Sprintf("  %s %s", pkg.Package, t.Name)
+			if t.TraceURL != "" {
+				line += "\n      trace: " + t.TraceURL
+			}
+			failed = append(failed, line)
+		}
+	}
+	if len(failed) > 0 {
+		_, _ = fmt.Fprintf(os.Stderr, "\nFailed tests:\n%s\n", strings.Join(failed, "\n"))
+	}
+}
+
+// appModulePath returns the module path of the app, or "" if it can't be determined.
+func appModulePath(appRoot string) string {
+	data, err := os.ReadFile(filepath.Join(appRoot, "go.mod"))
+	if err != nil {
+		return ""
+	}
+	return modfile.ModulePath(data)
+}
+
+// isVerbose reports whether the "go test" args enable verbose output.
+func isVerbose(args []string) bool {
+	return slices.ContainsFunc(args, func(arg string) bool {
+		return arg == "-v" || arg == "-v=true" || arg == "-test.v" || arg == "-test.v=true"
+	})
+}
+
+// takeStringFlag reports whether args[i] is the string flag with the given name,
+// given as either "--name=value" or "--name value".
+// If so, it returns the flag's value and args with the flag removed.
+func takeStringFlag(args []string, i int, name string) (value string, rest []string, ok bool) {
+	arg := args[i]
+	if arg != name && !strings.HasPrefix(arg, name+"=") {
+		return "", args, false
+	}
+
+	args = slices.Delete(args, i, i+1)
+	if _, value, found := strings.Cut(arg, "="); found {
+		return value, args, true
+	}
+	// Make sure there is a next argument.
+	if i < len(args) {
+		value = args[i]
+		args = slices.Delete(args, i, i+1)
+	}
+	return value, args, true
 }
 
 func init() {
@@ -176,6 +340,10 @@ func init() {
 	testCmd.Flags().Bool("prepare", false, "Prepare for running tests (without running them)")
 	testCmd.Flags().String("trace", "", "Specifies a trace file to write trace information about the parse and compilation process to.")
 	testCmd.Flags().Bool("no-color", false, "Disable colorized output")
+	testCmd.Flags().Bool("chaos", false, "Apply the fault injection rules added with 'encore chaos add --test'")
+	testCmd.Flags().String("report", "", "Write a structured test report in the given format (json, junit)")
+	testCmd.Flags().String("report-file", "", "Write the test report to the given file instead of stdout")
+	testCmd.Flags().String("coverage", "", "Write a coverage profile of all the app's packages, excluding generated code, to the given file")
 
 }
 
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"golang.org/x/tools/cover"
+)
+
+// isGeneratedCoverageFile reports whether the file of a coverage profile
+// is generated by Encore, and should be excluded from coverage reports.
+func isGeneratedCoverageFile(fileName string) bool {
+	base := path.Base(fileName)
+	return strings.HasPrefix(base, "encore_internal__") || base == "encore.gen.go"
+}
+
+// mergeCoverProfile merges the coverage profile written by "go test" at src,
+// which contains the blocks of each package once per test binary, into a single
+// profile at dst that excludes Encore-generated files.
+//
+// It returns the percentage of statements covered.
+func mergeCoverProfile(src, dst string) (percent float64, err error) {
+	profiles, err := cover.ParseProfiles(src)
+	if err != nil {
+		return 0, fmt.Errorf("parse coverage profile: %w", err)
+	} else if len(profiles) == 0 {
+		return 0, nil
+	}
+
+	f, err := os.Create(dst)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	w := bufio.NewWriter(f)
+	_, _ = fmt.Fprintf(w, "mode: %s\n", profiles[0].Mode)
+
+	var total, covered int
+	for _, p := range profiles {
+		if isGeneratedCoverageFile(p.FileName) {
+			continue
+		}
+		for _, b := range p.Blocks {
+			_, _ = fmt.Fprintf(w, "%s:%d.%d,%d.%d %d %d\n",
+				p.FileName, b.StartLine, b.StartCol, b.EndLine, b.EndCol, b.NumStmt, b.Count)
+			total += b.NumStmt
+			if b.Count > 0 {
+				covered += b.NumStmt
+			}
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return 0, err
+	}
+
+	if total > 0 {
+		percent = 100 * float64(covered) / float64(total)
+	}
+	return percent, nil
+}
@@ -0,0 +1,375 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"encr.dev/cli/cmd/encore/cmdutil"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+// testReport is the structured report of a test run,
+// built from the events reported by "go test -json".
+type testReport struct {
+	Passed   bool             `json:"passed"`
+	Started  time.Time        `json:"started_at"`
+	Duration float64          `json:"duration_secs"`
+	Coverage *float64         `json:"coverage_percent,omitempty"`
+	Packages []*packageReport `json:"packages"`
+}
+
+type packageReport struct {
+	Package string  `json:"package"`
+	Status  string  `json:"status"` // "pass", "fail" or "skip"
+	Elapsed float64 `json:"elapsed_secs"`
+	// Output is the package-level output, such as build errors.
+	// It's only included for failed packages.
+	Output string        `json:"output,omitempty"`
+	Tests  []*testResult `json:"tests"`
+
+	output strings.Builder
+	tests  map[string]*testResult
+}
+
+type testResult struct {
+	Name    string  `json:"name"`
+	Status  string  `json:"status"` // "pass", "fail" or "skip"
+	Elapsed float64 `json:"elapsed_secs"`
+	// Output is the output of the test. It's only included for failed and skipped tests.
+	Output   string `json:"output,omitempty"`
+	TraceID  string `json:"trace_id,omitempty"`
+	TraceURL string `json:"trace_url,omitempty"`
+
+	output strings.Builder
+}
+
+// testReporter collects the events of a test run into a testReport.
+type testReporter struct {
+	mu       sync.Mutex
+	report   testReport
+	packages map[string]*packageReport
+}
+
+func newTestReporter() *testReporter {
+	return &testReporter{
+		report:   testReport{Started: time.Now()},
+		packages: make(map[string]*packageReport),
+	}
+}
+
+// record records a "go test -json" event.
+func (r *testReporter) record(ev *testJSONEvent) {
+	if ev.Package == "" {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pkg, ok := r.packages[ev.Package]
+	if !ok {
+		pkg = &packageReport{Package: ev.Package, tests: make(map[string]*testResult)}
+		r.packages[ev.Package] = pkg
+		r.report.Packages = append(r.report.Packages, pkg)
+	}
+
+	if ev.Test == "" {
+		switch ev.Action {
+		case "output":
+			if ev.Output != nil {
+				pkg.output.Write(*ev.Output)
+			}
+		case "pass", "fail", "skip":
+			pkg.Status = ev.Action
+			if ev.Elapsed != nil {
+				pkg.Elapsed = *ev.Elapsed
+			}
+		}
+		return
+	}
+
+	test, ok := pkg.tests[ev.Test]
+	if !ok {
+		test = &testResult{Name: ev.Test}
+		pkg.tests[ev.Test] = test
+		pkg.Tests = append(pkg.Tests, test)
+	}
+	switch ev.Action {
+	case "output":
+		if ev.Output != nil {
+			test.output.Write(*ev.Output)
+		}
+	case "pass", "fail", "skip":
+		test.Status = ev.Action
+		if ev.Elapsed != nil {
+			test.Elapsed = *ev.Elapsed
+		}
+	}
+}
+
+// converter returns an output converter that records the "go test -json" events
+// of the test output into the report.
+//
+// If passJSON is set the events are passed through as JSON, as with convertTestEventOutputOnly.
+// Otherwise they are converted back to the regular "go test" output. Unless verbose is set,
+// that only includes the output of failed tests, like "go test" does.
+func (r *testReporter) converter(logs cmdutil.OutputConverter, passJSON, verbose bool) cmdutil.OutputConverter {
+	passthrough := convertTestEventOutputOnly(logs)
+	return func(line []byte) []byte {
+		if len(line) == 0 || line[0] != '{' {
+			return line
+		}
+		ev := &testJSONEvent{}
+		if err := json.Unmarshal(line, ev); err != nil || ev.Action == "" {
+			return logs(line)
+		}
+		r.record(ev)
+
+		switch {
+		case passJSON:
+			return passthrough(line)
+		case ev.Action == "output" && ev.Output != nil && (verbose || ev.Test == ""):
+			return convertOutput(logs, *ev.Output)
+		case ev.Action == "fail" && ev.Test != "" && !verbose:
+			return convertOutput(logs, r.testOutput(ev.Package, ev.Test))
+		}
+		return nil
+	}
+}
+
+// convertOutput converts the logs in the output of a test.
+func convertOutput(logs cmdutil.OutputConverter, output []byte) []byte {
+	var buf bytes.Buffer
+	for _, line := range bytes.SplitAfter(output, []byte("\n")) {
+		if bytes.HasPrefix(line, []byte("{")) {
+			line = logs(line)
+		}
+		buf.Write(line)
+	}
+	return buf.Bytes()
+}
+
+// testOutput returns the output of a test, excluding the
+// "=== RUN" lines (and the like) that only "go test -v" prints.
+func (r *testReporter) testOutput(pkg, test string) []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.packages[pkg]
+	if !ok || p.tests[test] == nil {
+		return nil
+	}
+
+	var out []byte
+	for _, line := range strings.SplitAfter(p.tests[test].output.String(), "\n") {
+		if !strings.HasPrefix(line, "=== ") {
+			out = append(out, line...)
+		}
+	}
+	return out
+}
+
+// writeTo returns an output converter that writes the converted output to w
+// instead of returning it.
+func writeTo(w io.Writer, converter cmdutil.OutputConverter) cmdutil.OutputConverter {
+	return func(line []byte) []byte {
+		_, _ = w.Write(converter(line))
+		return nil
+	}
+}
+
+// finish completes the report once the test run has finished.
+func (r *testReporter) finish(exitCode int) *testReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rep := &r.report
+	rep.Passed = exitCode == 0
+	rep.Duration = time.Since(rep.Started).Seconds()
+	for _, pkg := range rep.Packages {
+		if pkg.Status == "" {
+			// The package never reported a result, e.g. because it failed to build.
+			pkg.Status = "fail"
+		}
+		if pkg.Status == "fail" {
+			pkg.Output = pkg.output.String()
+		}
+		for _, test := range pkg.Tests {
+			if test.Status == "" {
+				// The test never finished, e.g. because the test binary panicked or timed out.
+				test.Status = "fail"
+			}
+			if test.Status != "pass" {
+				test.Output = test.output.String()
+			}
+		}
+	}
+	return rep
+}
+
+// failedTests returns the failed tests of the package.
+func (p *packageReport) failedTests() []*testResult {
+	var failed []*testResult
+	for _, t := range p.Tests {
+		if t.Status == "fail" {
+			failed = append(failed, t)
+		}
+	}
+	return failed
+}
+
+// linkTraces links the tests of the report to the traces they recorded.
+// Subtests are linked to the trace of their top-level test, which they are recorded as part of.
+//
+// Traces are matched by test name and, as the same test name can be used in several packages,
+// by the directory of the test file relative to the app root. modulePath is the app's module path.
+func (rep *testReport) linkTraces(traces []*daemonpb.TestTrace, modulePath string) {
+	type key struct{ dir, name string }
+	byKey := make(map[key]*daemonpb.TestTrace)
+	byName := make(map[string][]*daemonpb.TestTrace)
+	for _, tr := range traces {
+		k := key{path.Dir(filepath.ToSlash(tr.TestFile)), tr.TestName}
+		// Keep the most recent trace if a test ran several times.
+		if prev, ok := byKey[k]; !ok || tr.StartedAt.AsTime().After(prev.StartedAt.AsTime()) {
+			byKey[k] = tr
+		}
+		byName[tr.TestName] = append(byName[tr.TestName], tr)
+	}
+
+	for _, pkg := range rep.Packages {
+		dir := "."
+		if modulePath != "" && pkg.Package != modulePath {
+			dir = strings.TrimPrefix(pkg.Package, modulePath+"/")
+		}
+		for _, test := range pkg.Tests {
+			name, _, _ := strings.Cut(test.Name, "/")
+			tr, ok := byKey[key{dir, name}]
+			if !ok && len(byName[name]) == 1 {
+				tr = byName[name][0]
+			}
+			if tr != nil {
+				test.TraceID = tr.TraceId
+				test.TraceURL = tr.TraceUrl
+			}
+		}
+	}
+}
+
+// writeTestReport writes the report in the given format ("json" or "junit").
+func writeTestReport(w io.Writer, rep *testReport, format string) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rep)
+	case "junit":
+		return writeJUnitReport(w, rep)
+	default:
+		return fmt.Errorf("unknown report format %q", format)
+	}
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Skipped  int               `xml:"skipped,attr"`
+	Time     string            `xml:"time,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	Skipped   int              `xml:"skipped,attr"`
+	Time      string           `xml:"time,attr"`
+	Timestamp string           `xml:"timestamp,attr"`
+	Cases     []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName  string          `xml:"classname,attr"`
+	Name       string          `xml:"name,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Failure    *junitMessage   `xml:"failure,omitempty"`
+	Skipped    *junitMessage   `xml:"skipped,omitempty"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+func writeJUnitReport(w io.Writer, rep *testReport) error {
+	secs := func(s float64) string { return fmt.Sprintf("%.3f", s) }
+
+	suites := &junitTestSuites{Time: secs(rep.Duration)}
+	for _, pkg := range rep.Packages {
+		suite := &junitTestSuite{
+			Name:      pkg.Package,
+			Time:      secs(pkg.Elapsed),
+			Timestamp: rep.Started.UTC().Format(time.RFC3339),
+		}
+		for _, test := range pkg.Tests {
+			tc := &junitTestCase{ClassName: pkg.Package, Name: test.Name, Time: secs(test.Elapsed)}
+			if test.TraceURL != "" {
+				tc.Properties = append(tc.Properties, junitProperty{Name: "trace_url", Value: test.TraceURL})
+			}
+			switch test.Status {
+			case "fail":
+				body := test.Output
+				if test.TraceURL != "" {
+					body += "\nTrace: " + test.TraceURL + "\n"
+				}
+				tc.Failure = &junitMessage{Message: "Failed", Body: body}
+				suite.Failures++
+			case "skip":
+				tc.Skipped = &junitMessage{Message: "Skipped", Body: test.Output}
+				suite.Skipped++
+			}
+			suite.Cases = append(suite.Cases, tc)
+		}
+
+		// Report package failures that aren't caused by a test, like build errors, as a test case
+		// so they're not silently ignored.
+		if pkg.Status == "fail" && len(pkg.failedTests()) == 0 {
+			suite.Cases = append(suite.Cases, &junitTestCase{
+				ClassName: pkg.Package,
+				Name:      pkg.Package,
+				Time:      secs(pkg.Elapsed),
+				Failure:   &junitMessage{Message: "Failed", Body: pkg.Output},
+			})
+			suite.Failures++
+		}
+
+		suite.Tests = len(suite.Cases)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+func TestTestReporter(t *testing.T) {
+	c := qt.New(t)
+	events := []string{
+		`{"Action":"start","Package":"example.com/app/users"}`,
+		`{"Action":"run","Package":"example.com/app/users","Test":"TestSignup"}`,
+		`{"Action":"output","Package":"example.com/app/users","Test":"TestSignup","Output":"=== RUN   TestSignup\n"}`,
+		`{"Action":"output","Package":"example.com/app/users","Test":"TestSignup","Output":"    users_test.go:12: boom\n"}`,
+		`{"Action":"output","Package":"example.com/app/users","Test":"TestSignup","Output":"--- FAIL: TestSignup (0.01s)\n"}`,
+		`{"Action":"fail","Package":"example.com/app/users","Test":"TestSignup","Elapsed":0.01}`,
+		`{"Action":"run","Package":"example.com/app/users","Test":"TestLogin"}`,
+		`{"Action":"pass","Package":"example.com/app/users","Test":"TestLogin","Elapsed":0.02}`,
+		`{"Action":"output","Package":"example.com/app/users","Output":"FAIL\n"}`,
+		`{"Action":"fail","Package":"example.com/app/users","Elapsed":0.5}`,
+	}
+
+	r := newTestReporter()
+	conv := r.converter(func(line []byte) []byte { return line }, false, false)
+	var out bytes.Buffer
+	for _, ev := range events {
+		out.Write(conv([]byte(ev + "\n")))
+	}
+	// Only the output of failed tests is printed, without the "=== RUN" lines.
+	c.Assert(out.String(), qt.Equals, "    users_test.go:12: boom\n--- FAIL: TestSignup (0.01s)\nFAIL\n")
+
+	rep := r.finish(1)
+	rep.linkTraces([]*daemonpb.TestTrace{
+		{TraceId: "old", TestName: "TestSignup", TestFile: "users/users_test.go", StartedAt: timestamppb.New(time.Unix(1, 0))},
+		{TraceId: "new", TestName: "TestSignup", TestFile: "users/users_test.go", StartedAt: timestamppb.New(time.Unix(2, 0)), TraceUrl: "http://dash/trace/new"},
+		{TraceId: "other", TestName: "TestSignup", TestFile: "orders/orders_test.go", StartedAt: timestamppb.New(time.Unix(3, 0))},
+	}, "example.com/app")
+
+	c.Assert(rep.Passed, qt.IsFalse)
+	c.Assert(rep.Packages, qt.HasLen, 1)
+	pkg := rep.Packages[0]
+	c.Assert(pkg.Status, qt.Equals, "fail")
+	c.Assert(pkg.Tests, qt.HasLen, 2)
+	c.Assert(pkg.Tests[0].Status, qt.Equals, "fail")
+	c.Assert(pkg.Tests[0].TraceID, qt.Equals, "new")
+	c.Assert(pkg.Tests[1].Status, qt.Equals, "pass")
+	c.Assert(pkg.Tests[1].Output, qt.Equals, "")
+
+	var buf bytes.Buffer
+	c.Assert(writeTestReport(&buf, rep, "json"), qt.IsNil)
+	c.Assert(json.Valid(buf.Bytes()), qt.IsTrue)
+
+	buf.Reset()
+	c.Assert(writeTestReport(&buf, rep, "junit"), qt.IsNil)
+	junit := buf.String()
+	c.Assert(junit, qt.Contains, `<testsuite name="example.com/app/users" tests="2" failures="1" skipped="0"`)
+	c.Assert(junit, qt.Contains, `<property name="trace_url" value="http://dash/trace/new"></property>`)
+	c.Assert(junit, qt.Contains, "Trace: http://dash/trace/new")
+}
+
+func TestMergeCoverProfile(t *testing.T) {
+	c := qt.New(t)
+	dir := t.TempDir()
+	src, dst := filepath.Join(dir, "src.out"), filepath.Join(dir, "dst.out")
+
+	// The users package is covered both by its own tests and by the orders package's tests.
+	profile := strings.Join([]string{
+		"mode: set",
+		"example.com/app/users/users.go:3.20,5.2 2 1",
+		"example.com/app/users/users.go:7.20,9.2 2 0",
+		"example.com/app/users/encore_internal__api.go:1.1,2.2 5 1",
+		"example.com/app/users/users.go:3.20,5.2 2 0",
+		"example.com/app/users/users.go:7.20,9.2 2 1",
+		"example.com/app/orders/encore.gen.go:1.1,2.2 3 0",
+		"",
+	}, "\n")
+	c.Assert(os.WriteFile(src, []byte(profile), 0644), qt.IsNil)
+
+	percent, err := mergeCoverProfile(src, dst)
+	c.Assert(err, qt.IsNil)
+	c.Assert(percent, qt.Equals, 100.0)
+
+	merged, err := os.ReadFile(dst)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(merged), qt.Equals, "mode: set\n"+
+		"example.com/app/users/users.go:3.20,5.2 2 1\n"+
+		"example.com/app/users/users.go:7.20,9.2 2 1\n")
+}
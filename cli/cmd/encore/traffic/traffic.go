@@ -0,0 +1,219 @@
+package traffic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/logrusorgru/aurora/v3"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"encr.dev/cli/cmd/encore/cmdutil"
+	"encr.dev/cli/cmd/encore/root"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+var trafficCmd = &cobra.Command{
+	Use:   "traffic",
+	Short: "Record and replay the HTTP traffic of a running app",
+	Long: `Record the HTTP requests served by an app running locally with 'encore run',
+and replay them against it later.
+
+Recorded sessions are stored per app, and can be replayed any number of times,
+for example to reproduce a bug or to check for regressions after a change.`,
+}
+
+func init() {
+	var appendTo bool
+	recordCmd := &cobra.Command{
+		Use:   "record [<session>] [--append]",
+		Short: "Record the requests served by the running app",
+		Long: `Record the requests served by the running app into a session,
+until interrupted with Ctrl-C.
+
+The session is named after the current time unless a name is given.
+Requests and responses with bodies larger than 10 MiB, and WebSocket
+connections, are not recorded.`,
+		Args: cobra.MaximumNArgs(1),
+
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			session := time.Now().Format("20060102-150405")
+			if len(args) > 0 {
+				session = args[0]
+			}
+
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer cancel()
+
+			appRoot, _ := cmdutil.AppRoot()
+			daemon := cmdutil.ConnectDaemon(ctx)
+			stream, err := daemon.TrafficRecord(ctx, &daemonpb.TrafficRecordRequest{
+				AppRoot: appRoot,
+				Session: session,
+				Append:  appendTo,
+			})
+			if err != nil {
+				cmdutil.Fatal(err)
+			}
+
+			_, _ = fmt.Fprintf(os.Stderr, "recording requests to session %s, press Ctrl-C to stop\n", aurora.Bold(session))
+			n := 0
+			for {
+				e, err := stream.Recv()
+				if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled || ctx.Err() != nil {
+					break
+				} else if err != nil {
+					cmdutil.Fatal(err)
+				}
+				n++
+				_, _ = fmt.Fprintf(os.Stderr, "%4d  %-7s %s %s (%dms)\n",
+					e.Index, e.Method, e.Path, statusCode(e.StatusCode), e.DurationMs)
+			}
+			_, _ = fmt.Fprintf(os.Stderr, "\nrecorded %d requests to session %s\n", n, session)
+		},
+	}
+	recordCmd.Flags().BoolVar(&appendTo, "append", false, "Append to the session instead of replacing it")
+	trafficCmd.AddCommand(recordCmd)
+}
+
+func init() {
+	var (
+		speed        float64
+		ignoreFields []string
+		showDiff     bool
+	)
+	replayCmd := &cobra.Command{
+		Use:   "replay <session> [--speed=<factor>] [--ignore=<field>,...] [--diff]",
+		Short: "Replay a recorded session against the running app",
+		Long: `Replay a recorded session against the running app.
+
+Requests are sent in the order they were recorded, with the same timing.
+Use --speed to replay faster or slower, where --speed=2 replays twice as fast
+and --speed=0 sends each request as soon as the previous one has completed.
+
+The replayed responses are compared to the recorded ones. JSON bodies are compared
+structurally, and --ignore excludes fields that are expected to change between runs,
+such as ids or timestamps. The command exits with a non-zero status if any
+response differs.`,
+		Args: cobra.ExactArgs(1),
+
+		DisableFlagsInUseLine: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer cancel()
+
+			appRoot, _ := cmdutil.AppRoot()
+			daemon := cmdutil.ConnectDaemon(ctx)
+			stream, err := daemon.TrafficReplay(ctx, &daemonpb.TrafficReplayRequest{
+				AppRoot:      appRoot,
+				Session:      args[0],
+				Speed:        speed,
+				IgnoreFields: ignoreFields,
+			})
+			if err != nil {
+				cmdutil.Fatal(err)
+			}
+
+			var total, differed, failed int
+			for {
+				res, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				} else if status.Code(err) == codes.Canceled || ctx.Err() != nil {
+					_, _ = fmt.Fprintln(os.Stderr, "\nreplay interrupted")
+					break
+				} else if err != nil {
+					cmdutil.Fatal(err)
+				}
+
+				total++
+				var result string
+				switch {
+				case res.Error != "":
+					failed++
+					result = aurora.Red("failed: " + res.Error).String()
+				case res.Diff != "":
+					differed++
+					result = aurora.Yellow("differs").String()
+				default:
+					result = aurora.Green("ok").String()
+				}
+				_, _ = fmt.Fprintf(os.Stderr, "%4d  %-7s %s %s (%dms) %s\n",
+					res.Index, res.Method, res.Path, statusCode(res.StatusCode), res.DurationMs, result)
+				if showDiff && res.Diff != "" {
+					_, _ = fmt.Fprintln(os.Stderr, indent(res.Diff, "      "))
+				}
+			}
+
+			_, _ = fmt.Fprintf(os.Stderr, "\nreplayed %d requests: %d matched, %d differed, %d failed\n",
+				total, total-differed-failed, differed, failed)
+			if differed > 0 || failed > 0 {
+				if !showDiff && differed > 0 {
+					_, _ = fmt.Fprintln(os.Stderr, "run with --diff to show the differences")
+				}
+				os.Exit(1)
+			}
+		},
+	}
+	replayCmd.Flags().Float64Var(&speed, "speed", 1, "Replay speed relative to the recording (0 sends requests back-to-back)")
+	replayCmd.Flags().StringSliceVar(&ignoreFields, "ignore", nil, "Names of JSON fields to ignore when comparing responses")
+	replayCmd.Flags().BoolVar(&showDiff, "diff", false, "Show how replayed responses differ from the recorded ones")
+	trafficCmd.AddCommand(replayCmd)
+}
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the recorded sessions",
+	Args:  cobra.NoArgs,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		appRoot, _ := cmdutil.AppRoot()
+		daemon := cmdutil.ConnectDaemon(ctx)
+		resp, err := daemon.TrafficSessions(ctx, &daemonpb.TrafficSessionsRequest{AppRoot: appRoot})
+		if err != nil {
+			cmdutil.Fatal(err)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.StripEscape)
+		_, _ = fmt.Fprint(w, "SESSION\tREQUESTS\tRECORDED\n")
+		for _, s := range resp.Sessions {
+			_, _ = fmt.Fprintf(w, "%s\t%d\t%s\n", s.Name, s.Requests, s.RecordedAt.AsTime().Local().Format(time.DateTime))
+		}
+		_ = w.Flush()
+	},
+}
+
+func statusCode(code int32) string {
+	switch {
+	case code == 0:
+		return "-"
+	case code >= 500:
+		return aurora.Red(code).String()
+	case code >= 400:
+		return aurora.Yellow(code).String()
+	default:
+		return aurora.Green(code).String()
+	}
+}
+
+func indent(s, prefix string) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	return prefix + strings.Join(lines, "\n"+prefix)
+}
+
+func init() {
+	trafficCmd.AddCommand(listCmd)
+	root.Cmd.AddCommand(trafficCmd)
+}
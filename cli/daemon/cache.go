@@ -0,0 +1,70 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"encr.dev/cli/daemon/redis"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+// CacheFlush removes all keys from the local cache of a namespace.
+func (s *Server) CacheFlush(ctx context.Context, req *daemonpb.CacheFlushRequest) (*empty.Empty, error) {
+	app, err := s.apps.Track(req.AppRoot)
+	if err != nil {
+		return nil, err
+	}
+	ns, err := s.namespaceOrActive(ctx, app, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mgr.RedisMgr.Flush(ns.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "flush cache: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// CacheDump returns a snapshot of the local cache of a namespace.
+func (s *Server) CacheDump(ctx context.Context, req *daemonpb.CacheDumpRequest) (*daemonpb.CacheDumpResponse, error) {
+	app, err := s.apps.Track(req.AppRoot)
+	if err != nil {
+		return nil, err
+	}
+	ns, err := s.namespaceOrActive(ctx, app, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := s.mgr.RedisMgr.Dump(ns.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "dump cache: %v", err)
+	}
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "dump cache: %v", err)
+	}
+	return &daemonpb.CacheDumpResponse{Snapshot: data}, nil
+}
+
+// CacheRestore replaces the local cache of a namespace with a snapshot.
+func (s *Server) CacheRestore(ctx context.Context, req *daemonpb.CacheRestoreRequest) (*empty.Empty, error) {
+	app, err := s.apps.Track(req.AppRoot)
+	if err != nil {
+		return nil, err
+	}
+	ns, err := s.namespaceOrActive(ctx, app, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := redis.ParseSnapshot(req.Snapshot)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.mgr.RedisMgr.Restore(ns.ID, snap); err != nil {
+		return nil, status.Errorf(codes.Internal, "restore cache: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,105 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	chaosconf "encore.dev/appruntime/exported/chaos"
+	"encr.dev/cli/daemon/apps"
+	"encr.dev/cli/daemon/chaos"
+	"encr.dev/pkg/appfile"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+// ChaosRules lists the fault injection rules of an app.
+func (s *Server) ChaosRules(ctx context.Context, req *daemonpb.ChaosRulesRequest) (*daemonpb.ChaosRulesResponse, error) {
+	app, env, err := s.chaosApp(req.AppRoot, req.Env)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := chaos.Rules(app, env)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list chaos rules: %v", err)
+	}
+	resp := &daemonpb.ChaosRulesResponse{}
+	for _, r := range rules {
+		resp.Rules = append(resp.Rules, chaosRuleToProto(r))
+	}
+	return resp, nil
+}
+
+// ChaosAddRule adds a fault injection rule to an app.
+func (s *Server) ChaosAddRule(ctx context.Context, req *daemonpb.ChaosAddRuleRequest) (*daemonpb.ChaosRule, error) {
+	app, env, err := s.chaosApp(req.AppRoot, req.Env)
+	if err != nil {
+		return nil, err
+	}
+	if req.Rule == nil {
+		return nil, status.Error(codes.InvalidArgument, "no rule given")
+	}
+	rule, err := chaos.AddRule(app, env, chaosRuleFromProto(req.Rule))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return chaosRuleToProto(rule), nil
+}
+
+// ChaosRemoveRules removes fault injection rules from an app.
+func (s *Server) ChaosRemoveRules(ctx context.Context, req *daemonpb.ChaosRemoveRulesRequest) (*emptypb.Empty, error) {
+	app, env, err := s.chaosApp(req.AppRoot, req.Env)
+	if err != nil {
+		return nil, err
+	}
+	if err := chaos.RemoveRules(app, env, req.Ids...); errors.Is(err, chaos.ErrRuleNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "remove chaos rules: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// chaosApp returns the app at appRoot and the chaos environment named env.
+// Fault injection is only implemented by the Go runtime,
+// so it's rejected for apps in other languages.
+func (s *Server) chaosApp(appRoot, env string) (*apps.Instance, chaos.Env, error) {
+	app, err := s.apps.Track(appRoot)
+	if err != nil {
+		return nil, "", err
+	}
+	if app.Lang() != appfile.LangGo {
+		return nil, "", status.Error(codes.FailedPrecondition, "fault injection is only supported for Go apps")
+	}
+	chaosEnv, err := chaos.ParseEnv(env)
+	if err != nil {
+		return nil, "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	return app, chaosEnv, nil
+}
+
+func chaosRuleToProto(r *chaosconf.Rule) *daemonpb.ChaosRule {
+	return &daemonpb.ChaosRule{
+		Id:        r.ID,
+		Kind:      string(r.Kind),
+		Service:   r.Service,
+		Endpoint:  r.Endpoint,
+		Resource:  r.Resource,
+		LatencyMs: r.Latency.Milliseconds(),
+		ErrorRate: r.ErrorRate,
+	}
+}
+
+func chaosRuleFromProto(r *daemonpb.ChaosRule) *chaosconf.Rule {
+	return &chaosconf.Rule{
+		Kind:      chaosconf.Kind(r.Kind),
+		Service:   r.Service,
+		Endpoint:  r.Endpoint,
+		Resource:  r.Resource,
+		Latency:   time.Duration(r.LatencyMs) * time.Millisecond,
+		ErrorRate: r.ErrorRate,
+	}
+}
@@ -0,0 +1,156 @@
+// Package chaos stores the fault injection rules of apps,
+// which the runtime of locally running apps and tests picks up
+// to inject latency and errors into their operations.
+//
+// Rules are kept separately for 'encore run' and 'encore test',
+// so rules added while developing never make the tests flaky.
+package chaos
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	chaosconf "encore.dev/appruntime/exported/chaos"
+	"encr.dev/cli/daemon/apps"
+	"encr.dev/internal/conf"
+)
+
+// ErrRuleNotFound is reported when removing a rule that doesn't exist.
+var ErrRuleNotFound = errors.New("chaos rule not found")
+
+// Env is the environment fault injection rules apply to.
+type Env string
+
+const (
+	EnvRun  Env = "run"  // apps running with 'encore run'
+	EnvTest Env = "test" // tests run with 'encore test --chaos'
+)
+
+// ParseEnv parses the name of an environment.
+// The empty string is the run environment.
+func ParseEnv(name string) (Env, error) {
+	switch Env(name) {
+	case "", EnvRun:
+		return EnvRun, nil
+	case EnvTest:
+		return EnvTest, nil
+	default:
+		return "", errors.Newf("unknown chaos environment %q: must be run or test", name)
+	}
+}
+
+// mu serializes changes to the rules files.
+var mu sync.Mutex
+
+// configPath returns the path of the file holding the rules of the app in env.
+func configPath(app *apps.Instance, env Env) (string, error) {
+	dir, err := conf.DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "chaos", app.PlatformOrLocalID(), string(env)+".json"), nil
+}
+
+// Environ returns the environment variables that make the app
+// pick up its fault injection rules for env.
+func Environ(app *apps.Instance, env Env) []string {
+	path, err := configPath(app, env)
+	if err != nil {
+		return nil
+	}
+	return []string{chaosconf.EnvName + "=" + path}
+}
+
+// Rules returns the fault injection rules of the app in env.
+func Rules(app *apps.Instance, env Env) ([]*chaosconf.Rule, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	cfg, _, err := load(app, env)
+	if err != nil {
+		return nil, err
+	}
+	return cfg.Rules, nil
+}
+
+// AddRule validates the rule, assigns it an id and adds it to the app in env.
+// The rule applies to the app from then on, also if it's already running.
+func AddRule(app *apps.Instance, env Env, rule *chaosconf.Rule) (*chaosconf.Rule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	cfg, path, err := load(app, env)
+	if err != nil {
+		return nil, err
+	}
+
+	maxID := 0
+	for _, r := range cfg.Rules {
+		if id, err := strconv.Atoi(r.ID); err == nil && id > maxID {
+			maxID = id
+		}
+	}
+	rule.ID = strconv.Itoa(maxID + 1)
+	cfg.Rules = append(cfg.Rules, rule)
+	if err := save(path, cfg); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// RemoveRules removes the rules with the given ids from the app in env,
+// or all its rules if no ids are given.
+func RemoveRules(app *apps.Instance, env Env, ids ...string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	cfg, path, err := load(app, env)
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		cfg.Rules = nil
+	}
+	for _, id := range ids {
+		idx := slices.IndexFunc(cfg.Rules, func(r *chaosconf.Rule) bool { return r.ID == id })
+		if idx < 0 {
+			return errors.Wrapf(ErrRuleNotFound, "rule %s", id)
+		}
+		cfg.Rules = slices.Delete(cfg.Rules, idx, idx+1)
+	}
+	return save(path, cfg)
+}
+
+func load(app *apps.Instance, env Env) (*chaosconf.Config, string, error) {
+	path, err := configPath(app, env)
+	if err != nil {
+		return nil, "", err
+	}
+	cfg, err := chaosconf.Load(path)
+	return cfg, path, err
+}
+
+// save writes the rules to the file at path.
+// The file is replaced atomically so running apps never observe a partial write.
+func save(path string, cfg *chaosconf.Config) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrap(err, "create chaos dir")
+	}
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return errors.Wrap(err, "write chaos rules")
+	}
+	return errors.Wrap(os.Rename(tmp, path), "write chaos rules")
+}
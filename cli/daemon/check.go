@@ -1,8 +1,16 @@
 package daemon
 
 import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"encr.dev/cli/daemon/apps"
 	"encr.dev/cli/daemon/run"
+	"encr.dev/pkg/apicompat"
+	"encr.dev/pkg/errinsrc"
 	daemonpb "encr.dev/proto/encore/daemon"
+	"encr.dev/v2/parser/infra/sqldb"
 )
 
 // Check checks the app for compilation errors.
@@ -24,6 +32,12 @@ func (s *Server) Check(req *daemonpb.CheckRequest, stream daemonpb.Daemon_CheckS
 		Environ:      req.Environ,
 		Tests:        req.ParseTests,
 	})
+	if err == nil {
+		err = lintMigrations(app, req.MigrationsBase, slog)
+	}
+	if err == nil && req.ApiCompatBase != "" {
+		err = s.checkAPICompat(stream.Context(), app, req.ApiCompatBase, slog)
+	}
 
 	exitCode := 0
 	if err != nil {
@@ -37,3 +51,88 @@ func (s *Server) Check(req *daemonpb.CheckRequest, stream daemonpb.Daemon_CheckS
 	streamExit(stream, exitCode)
 	return nil
 }
+
+// lintMigrations checks the database migrations added or modified since
+// the git revision base for unsafe operations. It writes any warnings to slog,
+// and returns the unsafe operations as an error.
+func lintMigrations(app *apps.Instance, base string, slog *streamLog) error {
+	if base == "" {
+		base = "HEAD"
+	}
+	md, err := app.CachedMetadata()
+	if err != nil || md == nil {
+		return err
+	}
+
+	var errs errinsrc.List
+	for _, db := range md.SqlDatabases {
+		if db.MigrationRelPath == nil {
+			continue
+		}
+		migrations := make([]sqldb.MigrationFile, 0, len(db.Migrations))
+		for _, m := range db.Migrations {
+			migrations = append(migrations, sqldb.MigrationFile{
+				Filename:    m.Filename,
+				Number:      m.Number,
+				Description: m.Description,
+			})
+		}
+
+		migrationDir := filepath.Join(app.Root(), filepath.FromSlash(*db.MigrationRelPath))
+		lintErrs, warnings, err := sqldb.LintMigrations(app.Root(), migrationDir, migrations, base)
+		if err != nil {
+			return fmt.Errorf("lint migrations of database %s: %v", db.Name, err)
+		}
+		for _, w := range warnings {
+			_, _ = fmt.Fprint(slog.Stderr(false), errinsrc.FromTemplate(w, nil).Error())
+		}
+		for _, e := range lintErrs {
+			errs = append(errs, errinsrc.FromTemplate(e, nil))
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// checkAPICompat compares the app's API with the one at the git revision base.
+// It writes the changes to slog, and returns an error if any of them are breaking.
+func (s *Server) checkAPICompat(ctx context.Context, app *apps.Instance, base string, slog *streamLog) error {
+	head, err := app.CachedMetadata()
+	if err != nil || head == nil {
+		return err
+	}
+
+	root, cleanup, err := apicompat.CheckoutRevision(app.Root(), base)
+	if err != nil {
+		return fmt.Errorf("check out %s: %v", base, err)
+	}
+	defer cleanup()
+
+	baseMd, err := s.parseApp(ctx, apps.NewInstance(root, app.LocalID(), ""))
+	if err != nil {
+		return fmt.Errorf("parse app at %s: %v", base, err)
+	}
+
+	changes, err := apicompat.Compare(baseMd, head)
+	if err != nil {
+		return fmt.Errorf("compare API with %s: %v", base, err)
+	}
+
+	w := slog.Stdout(false)
+	numBreaking := 0
+	for _, c := range changes {
+		if c.Breaking {
+			numBreaking++
+			_, _ = fmt.Fprintf(w, "breaking:     %s\n", c)
+		} else {
+			_, _ = fmt.Fprintf(w, "non-breaking: %s\n", c)
+		}
+	}
+	if numBreaking > 0 {
+		return fmt.Errorf("found %d breaking API change(s) since %s", numBreaking, base)
+	}
+	_, _ = fmt.Fprintf(w, "no breaking API changes since %s\n", base)
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"encr.dev/cli/daemon/apps"
+	"encr.dev/cli/daemon/engine/trace2"
 	"encr.dev/cli/daemon/mcp"
 	"encr.dev/cli/daemon/namespace"
 	"encr.dev/cli/daemon/run"
@@ -47,6 +48,7 @@ type Server struct {
 	sm   *secret.Manager
 	ns   *namespace.Manager
 	mcp  *mcp.Manager
+	tr   trace2.Store
 
 	mu      sync.Mutex
 	streams map[string]*streamLog // run id -> stream
@@ -61,7 +63,7 @@ type Server struct {
 }
 
 // New creates a new Server.
-func New(appsMgr *apps.Manager, mgr *run.Manager, cm *sqldb.ClusterManager, sm *secret.Manager, ns *namespace.Manager, mcp *mcp.Manager) *Server {
+func New(appsMgr *apps.Manager, mgr *run.Manager, cm *sqldb.ClusterManager, sm *secret.Manager, ns *namespace.Manager, mcp *mcp.Manager, tr trace2.Store) *Server {
 	srv := &Server{
 		apps:    appsMgr,
 		mgr:     mgr,
@@ -69,6 +71,7 @@ func New(appsMgr *apps.Manager, mgr *run.Manager, cm *sqldb.ClusterManager, sm *
 		sm:      sm,
 		ns:      ns,
 		mcp:     mcp,
+		tr:      tr,
 		streams: make(map[string]*streamLog),
 
 		appDebouncers: make(map[*apps.Instance]*regenerateCodeDebouncer),
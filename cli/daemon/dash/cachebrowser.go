@@ -0,0 +1,131 @@
+package dash
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"encr.dev/cli/daemon/redis"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// defaultCacheKeyLimit is the default maximum number of keys returned when listing a keyspace.
+const defaultCacheKeyLimit = 100
+
+// CacheKeyspaceRequest identifies a cache keyspace by its cluster and key pattern.
+type CacheKeyspaceRequest struct {
+	AppID    string `json:"appId"`
+	Cluster  string `json:"cluster"`
+	Keyspace string `json:"keyspace"` // key pattern, e.g. "user/:id"
+}
+
+// CacheListRequest represents the request body for the cache/keys/list method.
+type CacheListRequest struct {
+	CacheKeyspaceRequest
+	Limit      int  `json:"limit"`
+	WithValues bool `json:"withValues"`
+}
+
+// CacheListResponse represents the response body for the cache/keys/list method.
+type CacheListResponse struct {
+	Entries   []*redis.Entry `json:"entries"`
+	Truncated bool           `json:"truncated"`
+}
+
+// CacheGetRequest represents the request body for the cache/keys/get method.
+type CacheGetRequest struct {
+	CacheKeyspaceRequest
+	Key string `json:"key"`
+}
+
+// CacheSetRequest represents the request body for the cache/keys/set method.
+type CacheSetRequest struct {
+	CacheKeyspaceRequest
+	Key        string          `json:"key"`
+	Type       string          `json:"type"` // "string", "list" or "set"; defaults to the existing type
+	Value      json.RawMessage `json:"value"`
+	TTLSeconds float64         `json:"ttlSeconds"` // zero keeps the existing TTL
+}
+
+// CacheDeleteRequest represents the request body for the cache/keys/delete method.
+type CacheDeleteRequest struct {
+	CacheKeyspaceRequest
+	Keys []string `json:"keys"`
+}
+
+func (h *handler) CacheListKeys(ctx context.Context, req CacheListRequest) (*CacheListResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultCacheKeyLimit
+	}
+
+	var resp CacheListResponse
+	err := h.withCacheKeyspace(ctx, req.CacheKeyspaceRequest, func(srv *redis.Server, ks *meta.CacheCluster_Keyspace) (err error) {
+		resp.Entries, resp.Truncated, err = srv.KeyspaceEntries(ks, limit, req.WithValues)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Entries == nil {
+		resp.Entries = []*redis.Entry{}
+	}
+	return &resp, nil
+}
+
+func (h *handler) CacheGetKey(ctx context.Context, req CacheGetRequest) (*redis.Entry, error) {
+	var entry *redis.Entry
+	err := h.withCacheKeyspace(ctx, req.CacheKeyspaceRequest, func(srv *redis.Server, ks *meta.CacheCluster_Keyspace) (err error) {
+		entry, err = srv.GetEntry(ks, req.Key)
+		return err
+	})
+	return entry, err
+}
+
+func (h *handler) CacheSetKey(ctx context.Context, req CacheSetRequest) (*redis.Entry, error) {
+	ttl := time.Duration(req.TTLSeconds * float64(time.Second))
+
+	var entry *redis.Entry
+	err := h.withCacheKeyspace(ctx, req.CacheKeyspaceRequest, func(srv *redis.Server, ks *meta.CacheCluster_Keyspace) (err error) {
+		if err := srv.SetEntry(ks, req.Key, req.Type, req.Value, ttl); err != nil {
+			return err
+		}
+		entry, err = srv.GetEntry(ks, req.Key)
+		return err
+	})
+	return entry, err
+}
+
+func (h *handler) CacheDeleteKeys(ctx context.Context, req CacheDeleteRequest) (int, error) {
+	var deleted int
+	err := h.withCacheKeyspace(ctx, req.CacheKeyspaceRequest, func(srv *redis.Server, ks *meta.CacheCluster_Keyspace) (err error) {
+		deleted, err = srv.DeleteEntries(ks, req.Keys)
+		return err
+	})
+	return deleted, err
+}
+
+// withCacheKeyspace resolves the requested keyspace and calls fn with
+// the Redis server of the app's namespace.
+func (h *handler) withCacheKeyspace(ctx context.Context, req CacheKeyspaceRequest, fn func(srv *redis.Server, ks *meta.CacheCluster_Keyspace) error) error {
+	md, err := h.GetMeta(req.AppID)
+	if err != nil {
+		return err
+	} else if md == nil {
+		return errors.New("app metadata not found, try running encore run")
+	}
+	ks, err := redis.FindKeyspace(md, req.Cluster, req.Keyspace)
+	if err != nil {
+		return err
+	}
+
+	ns, err := h.GetNamespace(ctx, req.AppID)
+	if err != nil {
+		return err
+	}
+	return h.run.RedisMgr.Use(ns.ID, func(srv *redis.Server) error {
+		return fn(srv, ks)
+	})
+}
@@ -0,0 +1,116 @@
+package dash
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	chaosconf "encore.dev/appruntime/exported/chaos"
+	"encr.dev/cli/daemon/apps"
+	"encr.dev/cli/daemon/chaos"
+	"encr.dev/pkg/appfile"
+)
+
+// ChaosRule is a fault injection rule, as exchanged with the dashboard.
+type ChaosRule struct {
+	ID        string  `json:"id"`
+	Kind      string  `json:"kind"`
+	Service   string  `json:"service"`
+	Endpoint  string  `json:"endpoint"`
+	Resource  string  `json:"resource"`
+	LatencyMs int64   `json:"latencyMs"`
+	ErrorRate float64 `json:"errorRate"`
+}
+
+// ChaosRulesRequest represents the request body for the chaos/rules/list method.
+type ChaosRulesRequest struct {
+	AppID string `json:"appId"`
+	Env   string `json:"env"` // "run" or "test"; empty for "run"
+}
+
+// ChaosAddRuleRequest represents the request body for the chaos/rules/add method.
+type ChaosAddRuleRequest struct {
+	AppID string    `json:"appId"`
+	Env   string    `json:"env"` // "run" or "test"; empty for "run"
+	Rule  ChaosRule `json:"rule"`
+}
+
+// ChaosRemoveRulesRequest represents the request body for the chaos/rules/remove method.
+// All rules are removed if no ids are given.
+type ChaosRemoveRulesRequest struct {
+	AppID string   `json:"appId"`
+	Env   string   `json:"env"` // "run" or "test"; empty for "run"
+	IDs   []string `json:"ids"`
+}
+
+func (h *handler) ChaosRules(ctx context.Context, req ChaosRulesRequest) ([]*ChaosRule, error) {
+	app, env, err := h.chaosApp(req.AppID, req.Env)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := chaos.Rules(app, env)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*ChaosRule, 0, len(rules))
+	for _, r := range rules {
+		res = append(res, newChaosRule(r))
+	}
+	return res, nil
+}
+
+func (h *handler) ChaosAddRule(ctx context.Context, req ChaosAddRuleRequest) (*ChaosRule, error) {
+	app, env, err := h.chaosApp(req.AppID, req.Env)
+	if err != nil {
+		return nil, err
+	}
+	rule, err := chaos.AddRule(app, env, &chaosconf.Rule{
+		Kind:      chaosconf.Kind(req.Rule.Kind),
+		Service:   req.Rule.Service,
+		Endpoint:  req.Rule.Endpoint,
+		Resource:  req.Rule.Resource,
+		Latency:   time.Duration(req.Rule.LatencyMs) * time.Millisecond,
+		ErrorRate: req.Rule.ErrorRate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newChaosRule(rule), nil
+}
+
+func (h *handler) ChaosRemoveRules(ctx context.Context, req ChaosRemoveRulesRequest) error {
+	app, env, err := h.chaosApp(req.AppID, req.Env)
+	if err != nil {
+		return err
+	}
+	return chaos.RemoveRules(app, env, req.IDs...)
+}
+
+// chaosApp returns the app with the given id and the chaos environment named env.
+// Fault injection is only implemented by the Go runtime.
+func (h *handler) chaosApp(appID, env string) (*apps.Instance, chaos.Env, error) {
+	app, err := h.apps.FindLatestByPlatformOrLocalID(appID)
+	if err != nil {
+		return nil, "", err
+	}
+	if app.Lang() != appfile.LangGo {
+		return nil, "", errors.New("fault injection is only supported for Go apps")
+	}
+	chaosEnv, err := chaos.ParseEnv(env)
+	if err != nil {
+		return nil, "", err
+	}
+	return app, chaosEnv, nil
+}
+
+func newChaosRule(r *chaosconf.Rule) *ChaosRule {
+	return &ChaosRule{
+		ID:        r.ID,
+		Kind:      string(r.Kind),
+		Service:   r.Service,
+		Endpoint:  r.Endpoint,
+		Resource:  r.Resource,
+		LatencyMs: r.Latency.Milliseconds(),
+		ErrorRate: r.ErrorRate,
+	}
+}
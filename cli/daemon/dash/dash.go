@@ -47,7 +47,7 @@ func (h *handler) GetMeta(appID string) (*meta.Data, error) {
 	runInstance := h.run.FindRunByAppID(appID)
 	var md *meta.Data
 	if runInstance != nil && runInstance.ProcGroup() != nil {
-		md = runInstance.ProcGroup().Meta
+		md = runInstance.ProcGroup().Meta()
 	} else {
 		app, err := h.apps.FindLatestByPlatformOrLocalID(appID)
 		if err != nil {
@@ -105,6 +105,89 @@ func (h *handler) Handle(ctx context.Context, reply jsonrpc2.Replier, r jsonrpc2
 		}
 		res, err := h.Transaction(ctx, p)
 		return reply(ctx, res, err)
+	case "cache/keys/list":
+		var p CacheListRequest
+		if err := unmarshal(&p); err != nil {
+			return reply(ctx, nil, err)
+		}
+		res, err := h.CacheListKeys(ctx, p)
+		return reply(ctx, res, err)
+	case "cache/keys/get":
+		var p CacheGetRequest
+		if err := unmarshal(&p); err != nil {
+			return reply(ctx, nil, err)
+		}
+		res, err := h.CacheGetKey(ctx, p)
+		return reply(ctx, res, err)
+	case "cache/keys/set":
+		var p CacheSetRequest
+		if err := unmarshal(&p); err != nil {
+			return reply(ctx, nil, err)
+		}
+		res, err := h.CacheSetKey(ctx, p)
+		return reply(ctx, res, err)
+	case "cache/keys/delete":
+		var p CacheDeleteRequest
+		if err := unmarshal(&p); err != nil {
+			return reply(ctx, nil, err)
+		}
+		res, err := h.CacheDeleteKeys(ctx, p)
+		return reply(ctx, res, err)
+	case "pubsub/publish":
+		var p PubSubPublishRequest
+		if err := unmarshal(&p); err != nil {
+			return reply(ctx, nil, err)
+		}
+		res, err := h.PubSubPublish(ctx, p)
+		return reply(ctx, res, err)
+	case "pubsub/stats":
+		var p PubSubStatsRequest
+		if err := unmarshal(&p); err != nil {
+			return reply(ctx, nil, err)
+		}
+		res, err := h.PubSubStats(ctx, p)
+		return reply(ctx, res, err)
+	case "pubsub/deadletters/list":
+		var p PubSubDeadLettersRequest
+		if err := unmarshal(&p); err != nil {
+			return reply(ctx, nil, err)
+		}
+		res, err := h.PubSubListDeadLetters(ctx, p)
+		return reply(ctx, res, err)
+	case "pubsub/deadletters/replay":
+		var p PubSubDeadLettersRequest
+		if err := unmarshal(&p); err != nil {
+			return reply(ctx, nil, err)
+		}
+		res, err := h.PubSubReplayDeadLetters(ctx, p)
+		return reply(ctx, res, err)
+	case "pubsub/deadletters/purge":
+		var p PubSubDeadLettersRequest
+		if err := unmarshal(&p); err != nil {
+			return reply(ctx, nil, err)
+		}
+		res, err := h.PubSubPurgeDeadLetters(ctx, p)
+		return reply(ctx, res, err)
+	case "chaos/rules/list":
+		var p ChaosRulesRequest
+		if err := unmarshal(&p); err != nil {
+			return reply(ctx, nil, err)
+		}
+		res, err := h.ChaosRules(ctx, p)
+		return reply(ctx, res, err)
+	case "chaos/rules/add":
+		var p ChaosAddRuleRequest
+		if err := unmarshal(&p); err != nil {
+			return reply(ctx, nil, err)
+		}
+		res, err := h.ChaosAddRule(ctx, p)
+		return reply(ctx, res, err)
+	case "chaos/rules/remove":
+		var p ChaosRemoveRulesRequest
+		if err := unmarshal(&p); err != nil {
+			return reply(ctx, nil, err)
+		}
+		return reply(ctx, nil, h.ChaosRemoveRules(ctx, p))
 	case "onboarding/get":
 		state, err := onboarding.Load()
 		if err != nil {
@@ -816,7 +899,7 @@ func buildAppStatus(app *apps.Instance, runInstance *run.Run) (s appStatus, err
 	if runInstance != nil {
 		proc := runInstance.ProcGroup()
 		if proc != nil {
-			md = proc.Meta
+			md = proc.Meta()
 		}
 	}
 
@@ -0,0 +1,101 @@
+package dash
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cockroachdb/errors"
+
+	"encr.dev/cli/daemon/pubsub"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// PubSubPublishRequest represents the request body for the pubsub/publish method.
+type PubSubPublishRequest struct {
+	AppID   string          `json:"appId"`
+	Topic   string          `json:"topic"`
+	Message json.RawMessage `json:"message"`
+}
+
+// PubSubPublishResponse represents the response body for the pubsub/publish method.
+type PubSubPublishResponse struct {
+	MessageID string `json:"messageId"`
+}
+
+// PubSubStatsRequest represents the request body for the pubsub/stats method.
+type PubSubStatsRequest struct {
+	AppID string `json:"appId"`
+}
+
+// PubSubDeadLettersRequest represents the request body for the pubsub/deadletters/* methods.
+// Empty fields match all dead-lettered messages.
+type PubSubDeadLettersRequest struct {
+	AppID        string   `json:"appId"`
+	Topic        string   `json:"topic"`
+	Subscription string   `json:"subscription"`
+	IDs          []string `json:"ids"`
+}
+
+func (r *PubSubDeadLettersRequest) filter() pubsub.DeadLetterFilter {
+	return pubsub.DeadLetterFilter{Topic: r.Topic, Subscription: r.Subscription, IDs: r.IDs}
+}
+
+// PubSubDeadLettersCountResponse represents the response body for the
+// pubsub/deadletters/replay and pubsub/deadletters/purge methods.
+type PubSubDeadLettersCountResponse struct {
+	Count int `json:"count"`
+}
+
+func (h *handler) PubSubPublish(ctx context.Context, req PubSubPublishRequest) (*PubSubPublishResponse, error) {
+	nsq, md, err := h.runningPubSub(req.AppID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := nsq.Publish(md, req.Topic, req.Message)
+	if err != nil {
+		return nil, err
+	}
+	return &PubSubPublishResponse{MessageID: id}, nil
+}
+
+func (h *handler) PubSubStats(ctx context.Context, req PubSubStatsRequest) ([]*pubsub.TopicStats, error) {
+	nsq, md, err := h.runningPubSub(req.AppID)
+	if err != nil {
+		return nil, err
+	}
+	return nsq.TopicStats(md)
+}
+
+func (h *handler) PubSubListDeadLetters(ctx context.Context, req PubSubDeadLettersRequest) ([]*pubsub.DeadLetter, error) {
+	nsq, _, err := h.runningPubSub(req.AppID)
+	if err != nil {
+		return nil, err
+	}
+	return nsq.DeadLetters(req.filter()), nil
+}
+
+func (h *handler) PubSubReplayDeadLetters(ctx context.Context, req PubSubDeadLettersRequest) (*PubSubDeadLettersCountResponse, error) {
+	nsq, md, err := h.runningPubSub(req.AppID)
+	if err != nil {
+		return nil, err
+	}
+	n, err := nsq.ReplayDeadLetters(md, req.filter())
+	return &PubSubDeadLettersCountResponse{Count: n}, err
+}
+
+func (h *handler) PubSubPurgeDeadLetters(ctx context.Context, req PubSubDeadLettersRequest) (*PubSubDeadLettersCountResponse, error) {
+	nsq, _, err := h.runningPubSub(req.AppID)
+	if err != nil {
+		return nil, err
+	}
+	return &PubSubDeadLettersCountResponse{Count: nsq.PurgeDeadLetters(req.filter())}, nil
+}
+
+// runningPubSub returns the NSQ daemon and metadata of the running app.
+func (h *handler) runningPubSub(appID string) (*pubsub.NSQDaemon, *meta.Data, error) {
+	run := h.run.FindRunByAppID(appID)
+	if run == nil {
+		return nil, nil, errors.New("app is not running, try running encore run")
+	}
+	return run.PubSub()
+}
@@ -12,14 +12,17 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"encr.dev/cli/daemon/apps"
 	"encr.dev/cli/daemon/sqldb"
 	"encr.dev/cli/internal/platform"
 	"encr.dev/pkg/appfile"
 	"encr.dev/pkg/builder"
 	"encr.dev/pkg/builder/builderimpl"
 	"encr.dev/pkg/fns"
+	"encr.dev/pkg/option"
 	"encr.dev/pkg/pgproxy"
 	daemonpb "encr.dev/proto/encore/daemon"
+	meta "encr.dev/proto/encore/parser/meta/v1"
 )
 
 func toRoleType(role daemonpb.DBRole) sqldb.RoleType {
@@ -368,10 +371,132 @@ func (s *Server) DBReset(req *daemonpb.DBResetRequest, stream daemonpb.Daemon_DB
 	err = cluster.Recreate(stream.Context(), req.AppRoot, req.DatabaseNames, parse.Meta)
 	if err != nil {
 		sendErr(err)
+		return nil
+	}
+
+	// Seed data is only meant for local development.
+	if clusterType == sqldb.Run && !req.SkipSeeds {
+		slog := &streamLog{stream: stream, buffered: false}
+		if err := s.seedDatabases(stream.Context(), app, clusterNS, cluster, parse.Meta, req.DatabaseNames, req.Environ, slog); err != nil {
+			sendErr(err)
+		}
 	}
 	return nil
 }
 
+// DBMigrate reports or changes which migrations are applied to a local database.
+func (s *Server) DBMigrate(ctx context.Context, req *daemonpb.DBMigrateRequest) (*daemonpb.DBMigrateResponse, error) {
+	clusterType := getClusterType(req)
+	if clusterType == sqldb.Test {
+		return nil, status.Error(codes.InvalidArgument, "test databases are migrated from scratch for each test run, and can't be migrated manually")
+	}
+
+	app, err := s.apps.Track(req.AppRoot)
+	if err != nil {
+		return nil, err
+	}
+	md, err := s.parseApp(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+	dbMeta, ok := fns.Find(md.SqlDatabases, func(db *meta.SQLDatabase) bool { return db.Name == req.DbName })
+	if !ok {
+		return nil, errDatabaseNotFound
+	}
+
+	clusterNS, err := s.namespaceOrActive(ctx, app, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	clusterID := sqldb.GetClusterID(app, clusterType, clusterNS)
+	cluster, ok := s.cm.Get(clusterID)
+	if !ok {
+		cluster = s.cm.Create(ctx, &sqldb.CreateParams{
+			ClusterID: clusterID,
+			Memfs:     clusterType.Memfs(),
+		})
+	}
+	if cluster.IsExternalDB(req.DbName) {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot migrate %q: migrating external databases is disabled", req.DbName)
+	}
+	if _, err := cluster.Start(ctx, nil); err != nil {
+		return nil, err
+	}
+	db := cluster.GetOrInitDB(req.DbName)
+
+	var (
+		migrations []*sqldb.MigrationStatus
+		changed    []uint64
+	)
+	switch req.Action {
+	case daemonpb.DBMigrateRequest_ACTION_STATUS:
+		migrations, err = db.MigrationStatus(ctx, req.AppRoot, dbMeta)
+	case daemonpb.DBMigrateRequest_ACTION_UP:
+		to := option.None[uint64]()
+		if req.To != nil {
+			to = option.Some(*req.To)
+		}
+		migrations, changed, err = db.MigrateUp(ctx, req.AppRoot, dbMeta, to)
+	case daemonpb.DBMigrateRequest_ACTION_DOWN:
+		if req.Steps == 0 {
+			return nil, status.Error(codes.InvalidArgument, "the number of migrations to revert must be positive")
+		}
+		migrations, changed, err = db.MigrateDown(ctx, req.AppRoot, dbMeta, int(req.Steps))
+	case daemonpb.DBMigrateRequest_ACTION_FORCE:
+		migrations, changed, err = db.ForceMigrationVersion(ctx, req.AppRoot, dbMeta, req.Version)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown action %v", req.Action)
+	}
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	resp := &daemonpb.DBMigrateResponse{Changed: changed}
+	for _, m := range migrations {
+		resp.Migrations = append(resp.Migrations, &daemonpb.DBMigrationStatus{
+			Number:        m.Number,
+			Description:   m.Description,
+			Filename:      m.Filename,
+			Applied:       m.Applied,
+			Dirty:         m.Dirty,
+			HasDown:       m.HasDown,
+			DataMigration: m.DataMigration,
+		})
+	}
+	return resp, nil
+}
+
+// parseApp parses the app to figure out what infrastructure is needed.
+func (s *Server) parseApp(ctx context.Context, app *apps.Instance) (*meta.Data, error) {
+	expSet, err := app.Experiments(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	bld := builderimpl.Resolve(app.Lang(), expSet)
+	defer fns.CloseIgnore(bld)
+	prepareResult, err := bld.Prepare(ctx, builder.PrepareParams{
+		Build:      builder.DefaultBuildInfo(),
+		App:        app,
+		WorkingDir: ".",
+	})
+	if err != nil {
+		return nil, err
+	}
+	parse, err := bld.Parse(ctx, builder.ParseParams{
+		Build:       builder.DefaultBuildInfo(),
+		App:         app,
+		Experiments: expSet,
+		WorkingDir:  ".",
+		ParseTests:  false,
+		Prepare:     prepareResult,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parse.Meta, nil
+}
+
 func serveProxy(ctx context.Context, ln net.Listener, handler func(context.Context, net.Conn)) error {
 	var tempDelay time.Duration // how long to sleep on accept failure
 	for {
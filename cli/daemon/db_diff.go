@@ -0,0 +1,77 @@
+package daemon
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"encr.dev/cli/daemon/sqldb"
+	"encr.dev/pkg/fns"
+	daemonpb "encr.dev/proto/encore/daemon"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// DBDiff compares the schema of a local database with the schema
+// produced by its migrations.
+func (s *Server) DBDiff(ctx context.Context, req *daemonpb.DBDiffRequest) (*daemonpb.DBDiffResponse, error) {
+	app, err := s.apps.Track(req.AppRoot)
+	if err != nil {
+		return nil, err
+	}
+	md, err := s.parseApp(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+	dbMeta, ok := fns.Find(md.SqlDatabases, func(db *meta.SQLDatabase) bool { return db.Name == req.DbName })
+	if !ok {
+		return nil, errDatabaseNotFound
+	} else if dbMeta.MigrationRelPath == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "database %q has no migrations directory", req.DbName)
+	}
+
+	clusterNS, err := s.namespaceOrActive(ctx, app, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	clusterID := sqldb.GetClusterID(app, sqldb.Run, clusterNS)
+	cluster, ok := s.cm.Get(clusterID)
+	if !ok {
+		cluster = s.cm.Create(ctx, &sqldb.CreateParams{
+			ClusterID: clusterID,
+			Memfs:     sqldb.Run.Memfs(),
+		})
+	}
+	if cluster.IsExternalDB(req.DbName) {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot diff %q: diffing external databases is not supported", req.DbName)
+	}
+	if _, err := cluster.Start(ctx, nil); err != nil {
+		return nil, err
+	}
+	if err := cluster.Setup(ctx, app.Root(), md); err != nil {
+		return nil, err
+	}
+
+	db := cluster.GetOrInitDB(req.DbName)
+	up, down, err := db.DiffSchema(ctx, app.Root(), dbMeta)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	// Number the new migration after both the SQL and the data migrations,
+	// since they share the same sequence.
+	var last uint64
+	for _, m := range dbMeta.Migrations {
+		last = max(last, m.Number)
+	}
+	for _, m := range dbMeta.DataMigrations {
+		last = max(last, m.Number)
+	}
+
+	return &daemonpb.DBDiffResponse{
+		Up:               up,
+		Down:             down,
+		NextNumber:       last + 1,
+		MigrationRelPath: *dbMeta.MigrationRelPath,
+	}, nil
+}
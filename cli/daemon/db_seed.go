@@ -0,0 +1,87 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"golang.org/x/mod/modfile"
+
+	"encr.dev/cli/daemon/apps"
+	"encr.dev/cli/daemon/namespace"
+	"encr.dev/cli/daemon/run"
+	"encr.dev/cli/daemon/sqldb"
+	"encr.dev/pkg/appfile"
+	"encr.dev/pkg/paths"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// seedDatabases inserts the seed data of the given databases after they've been reset.
+// If databaseNames is the nil slice it seeds all databases.
+//
+// The .sql seed files are run first, followed by any Go seed program,
+// which is run with the local app environment set up like with "encore exec".
+func (s *Server) seedDatabases(ctx context.Context, app *apps.Instance, ns *namespace.Namespace, cluster *sqldb.Cluster, md *meta.Data, databaseNames, environ []string, slog *streamLog) error {
+	stdout := slog.Stdout(false)
+
+	var goSeeds []*meta.SQLDatabase
+	for _, dbMeta := range md.SqlDatabases {
+		if databaseNames != nil && !slices.Contains(databaseNames, dbMeta.Name) {
+			continue
+		} else if dbMeta.SeedRelPath == nil || cluster.IsExternalDB(dbMeta.Name) {
+			continue
+		}
+
+		sqlFiles, hasGoSeed, err := sqldb.SeedFiles(app.Root(), dbMeta)
+		if err != nil {
+			return fmt.Errorf("seed %s: %v", dbMeta.Name, err)
+		}
+		if len(sqlFiles) > 0 {
+			db, ok := cluster.GetDB(dbMeta.Name)
+			if !ok {
+				return fmt.Errorf("seed %s: database not found", dbMeta.Name)
+			}
+			_, _ = fmt.Fprintf(stdout, "Seeding database %s (%d files)...\n", dbMeta.Name, len(sqlFiles))
+			if err := db.Seed(ctx, app.Root(), dbMeta); err != nil {
+				return fmt.Errorf("seed %s: %v", dbMeta.Name, err)
+			}
+		}
+		if hasGoSeed {
+			goSeeds = append(goSeeds, dbMeta)
+		}
+	}
+
+	if len(goSeeds) == 0 {
+		return nil
+	} else if app.Lang() != appfile.LangGo {
+		return fmt.Errorf("seed %s: Go seed programs are only supported in Go apps", goSeeds[0].Name)
+	}
+
+	modPath := filepath.Join(app.Root(), "go.mod")
+	modData, err := os.ReadFile(modPath)
+	if err != nil {
+		return err
+	}
+	mod, err := modfile.Parse(modPath, modData, nil)
+	if err != nil {
+		return err
+	}
+	for _, dbMeta := range goSeeds {
+		_, _ = fmt.Fprintf(stdout, "Running Go seed program for database %s...\n", dbMeta.Name)
+		err := s.mgr.ExecScript(ctx, run.ExecScriptParams{
+			App:        app,
+			NS:         ns,
+			WorkingDir: ".",
+			Environ:    environ,
+			MainPkg:    paths.Pkg(mod.Module.Mod.Path).JoinSlash(paths.RelSlash(*dbMeta.SeedRelPath)),
+			Stdout:     stdout,
+			Stderr:     slog.Stderr(false),
+		})
+		if err != nil {
+			return fmt.Errorf("seed %s: %v", dbMeta.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"encr.dev/cli/daemon/sqldb"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+// DBSnapshot saves, restores or lists snapshots of the local databases.
+func (s *Server) DBSnapshot(ctx context.Context, req *daemonpb.DBSnapshotRequest) (*daemonpb.DBSnapshotResponse, error) {
+	app, err := s.apps.Track(req.AppRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Action == daemonpb.DBSnapshotRequest_ACTION_LIST {
+		snapshots, err := sqldb.ListSnapshots(app)
+		if err != nil {
+			return nil, err
+		}
+		resp := &daemonpb.DBSnapshotResponse{}
+		for _, snap := range snapshots {
+			resp.Snapshots = append(resp.Snapshots, snapshotToProto(snap))
+		}
+		return resp, nil
+	}
+
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "no snapshot name given")
+	}
+	md, err := s.parseApp(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+	clusterNS, err := s.namespaceOrActive(ctx, app, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	clusterID := sqldb.GetClusterID(app, sqldb.Run, clusterNS)
+	cluster, ok := s.cm.Get(clusterID)
+	if !ok {
+		cluster = s.cm.Create(ctx, &sqldb.CreateParams{
+			ClusterID: clusterID,
+			Memfs:     sqldb.Run.Memfs(),
+		})
+	}
+	if _, err := cluster.Start(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	switch req.Action {
+	case daemonpb.DBSnapshotRequest_ACTION_SAVE:
+		if err := cluster.Setup(ctx, app.Root(), md); err != nil {
+			return nil, err
+		}
+		snap, err := cluster.SaveSnapshot(ctx, req.Name, md)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return &daemonpb.DBSnapshotResponse{
+			Snapshots: []*daemonpb.DBSnapshotResponse_Snapshot{snapshotToProto(snap)},
+		}, nil
+
+	case daemonpb.DBSnapshotRequest_ACTION_RESTORE:
+		restored, err := cluster.RestoreSnapshot(ctx, req.Name, md)
+		if errors.Is(err, sqldb.ErrSnapshotNotFound) {
+			return nil, status.Errorf(codes.NotFound, "snapshot %q not found", req.Name)
+		} else if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return &daemonpb.DBSnapshotResponse{Restored: restored}, nil
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown action %v", req.Action)
+	}
+}
+
+func snapshotToProto(snap *sqldb.Snapshot) *daemonpb.DBSnapshotResponse_Snapshot {
+	return &daemonpb.DBSnapshotResponse_Snapshot{
+		Name:      snap.Name,
+		Namespace: snap.Namespace,
+		Databases: snap.Databases,
+		CreatedAt: timestamppb.New(snap.CreatedAt),
+	}
+}
@@ -294,11 +294,18 @@ func buildAndValidateInfraConfig(params EmbeddedInfraConfigParams) (*infra.Infra
 				return b.Name == name
 			})
 			if ok {
+				infraCfg.Versioned = metaBkt.Versioned
 				if metaBkt.Public && infraCfg.PublicBaseURL == "" {
 					path := infra.JSONPath("buckets").Append(infra.JSONPath(name)).Append("public_base_url")
 					validationErrors[path] = errors.New("Bucket is public but no public base URL is set")
 					return nil, "", configError(missing, validationErrors)
 				}
+				for _, n := range metaBkt.Notifications {
+					if !slices.Contains(infraCfg.EventTopics, n.Topic) {
+						path := infra.JSONPath("buckets").Append(infra.JSONPath(name)).Append("event_topics")
+						validationErrors[path] = fmt.Errorf("Bucket events must be delivered to topic %q", n.Topic)
+					}
+				}
 			}
 
 			buckets, ok = fns.Delete(buckets, name)
@@ -3,18 +3,212 @@ package mcp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
-	"strings"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"google.golang.org/protobuf/encoding/protojson"
+
+	"encr.dev/cli/daemon/redis"
+	metav1 "encr.dev/proto/encore/parser/meta/v1"
 )
 
 func (m *Manager) registerCacheTools() {
 	m.server.AddTool(mcp.NewTool("get_cache_keyspaces",
 		mcp.WithDescription("Retrieve comprehensive information about all cache keyspaces in the currently open Encore, including their configurations, usage patterns, and the services that interact with them. This tool helps understand the application's caching strategy and data access patterns."),
 	), m.getCacheKeyspaces)
+
+	m.server.AddTool(mcp.NewTool("list_cache_keys",
+		mcp.WithDescription("List the keys stored in the local cache for a cache keyspace in the currently open Encore, using the active infrastructure namespace. Returns each key's Redis data type and remaining time to live, and optionally its value decoded using the keyspace's value type."),
+		mcp.WithString("cluster", mcp.Description("The name of the cache cluster the keyspace belongs to.")),
+		mcp.WithString("keyspace", mcp.Description("The key pattern of the keyspace, as returned by get_cache_keyspaces (e.g. 'user/:id').")),
+		mcp.WithNumber("limit", mcp.Description("Maximum number of keys to return. Default is 100.")),
+		mcp.WithBoolean("include_values", mcp.Description("When true, includes the decoded value of each key.")),
+	), m.listCacheKeys)
+
+	m.server.AddTool(mcp.NewTool("get_cache_values",
+		mcp.WithDescription("Read the values of specific keys in a cache keyspace from the local cache of the currently open Encore, using the active infrastructure namespace. Values are decoded using the keyspace's value type: strings, numbers, booleans, JSON for struct values, and arrays for list and set keyspaces."),
+		mcp.WithString("cluster", mcp.Description("The name of the cache cluster the keyspace belongs to.")),
+		mcp.WithString("keyspace", mcp.Description("The key pattern of the keyspace, as returned by get_cache_keyspaces (e.g. 'user/:id').")),
+		mcp.WithArray("keys",
+			mcp.Items(map[string]any{
+				"type":        "string",
+				"description": "List of full cache keys to read (e.g. 'user/123'). Each key must match the keyspace's key pattern.",
+			})),
+	), m.getCacheValues)
+
+	m.server.AddTool(mcp.NewTool("set_cache_value",
+		mcp.WithDescription("Set the value of a key in a cache keyspace in the local cache of the currently open Encore, using the active infrastructure namespace. The value is validated and encoded using the keyspace's value type, the same way the application would store it."),
+		mcp.WithString("cluster", mcp.Description("The name of the cache cluster the keyspace belongs to.")),
+		mcp.WithString("keyspace", mcp.Description("The key pattern of the keyspace, as returned by get_cache_keyspaces (e.g. 'user/:id').")),
+		mcp.WithString("key", mcp.Description("The full cache key to set (e.g. 'user/123'). It must match the keyspace's key pattern.")),
+		mcp.WithString("value", mcp.Description("The JSON-encoded value to store. Use a JSON string for string keyspaces, a number for int and float keyspaces, an object for struct keyspaces, and an array for list and set keyspaces.")),
+		mcp.WithString("type", mcp.Description("The Redis data type to store: 'string', 'list' or 'set'. Defaults to the type of the existing key, or 'string' for new keys.")),
+		mcp.WithNumber("ttl_seconds", mcp.Description("Optional time to live in seconds. If not provided, the key's existing time to live is kept.")),
+	), m.setCacheValue)
+
+	m.server.AddTool(mcp.NewTool("delete_cache_keys",
+		mcp.WithDescription("Delete keys in a cache keyspace from the local cache of the currently open Encore, using the active infrastructure namespace."),
+		mcp.WithString("cluster", mcp.Description("The name of the cache cluster the keyspace belongs to.")),
+		mcp.WithString("keyspace", mcp.Description("The key pattern of the keyspace, as returned by get_cache_keyspaces (e.g. 'user/:id').")),
+		mcp.WithArray("keys",
+			mcp.Items(map[string]any{
+				"type":        "string",
+				"description": "List of full cache keys to delete (e.g. 'user/123'). Each key must match the keyspace's key pattern.",
+			})),
+	), m.deleteCacheKeys)
+}
+
+func (m *Manager) listCacheKeys(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	limit := 100
+	if l, ok := request.Params.Arguments["limit"].(float64); ok && l > 0 {
+		limit = int(l)
+	}
+	includeValues, _ := request.Params.Arguments["include_values"].(bool)
+
+	var (
+		entries   []*redis.Entry
+		truncated bool
+	)
+	err := m.withCacheKeyspace(ctx, request, func(srv *redis.Server, ks *metav1.CacheCluster_Keyspace) (err error) {
+		entries, truncated, err = srv.KeyspaceEntries(ks, limit, includeValues)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if entries == nil {
+		entries = []*redis.Entry{}
+	}
+
+	jsonData, err := json.Marshal(map[string]any{
+		"entries":   entries,
+		"truncated": truncated,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cache keys: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+func (m *Manager) getCacheValues(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	keys, err := stringArrayArg(request, "keys")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]any, 0, len(keys))
+	err = m.withCacheKeyspace(ctx, request, func(srv *redis.Server, ks *metav1.CacheCluster_Keyspace) error {
+		for _, key := range keys {
+			entry, err := srv.GetEntry(ks, key)
+			if errors.Is(err, redis.ErrKeyNotFound) {
+				result = append(result, map[string]any{"key": key, "error": "not found"})
+				continue
+			} else if err != nil {
+				return err
+			}
+			result = append(result, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	jsonData, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cache values: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+func (m *Manager) setCacheValue(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	key, _ := request.Params.Arguments["key"].(string)
+	if key == "" {
+		return nil, fmt.Errorf("invalid or missing key parameter")
+	}
+	value, _ := request.Params.Arguments["value"].(string)
+	if !json.Valid([]byte(value)) {
+		return nil, fmt.Errorf("value must be valid JSON")
+	}
+	typ, _ := request.Params.Arguments["type"].(string)
+	var ttl time.Duration
+	if secs, ok := request.Params.Arguments["ttl_seconds"].(float64); ok {
+		ttl = time.Duration(secs * float64(time.Second))
+	}
+
+	var entry *redis.Entry
+	err := m.withCacheKeyspace(ctx, request, func(srv *redis.Server, ks *metav1.CacheCluster_Keyspace) (err error) {
+		if err := srv.SetEntry(ks, key, typ, json.RawMessage(value), ttl); err != nil {
+			return err
+		}
+		entry, err = srv.GetEntry(ks, key)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set cache value: %w", err)
+	}
+
+	jsonData, err := json.Marshal(entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cache entry: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+func (m *Manager) deleteCacheKeys(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	keys, err := stringArrayArg(request, "keys")
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted int
+	err = m.withCacheKeyspace(ctx, request, func(srv *redis.Server, ks *metav1.CacheCluster_Keyspace) (err error) {
+		deleted, err = srv.DeleteEntries(ks, keys)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete cache keys: %w", err)
+	}
+
+	jsonData, err := json.Marshal(map[string]any{"deleted": deleted})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// withCacheKeyspace resolves the keyspace given by the "cluster" and "keyspace"
+// arguments and calls fn with the Redis server of the app's active namespace.
+func (m *Manager) withCacheKeyspace(ctx context.Context, request mcp.CallToolRequest, fn func(srv *redis.Server, ks *metav1.CacheCluster_Keyspace) error) error {
+	cluster, _ := request.Params.Arguments["cluster"].(string)
+	pattern, _ := request.Params.Arguments["keyspace"].(string)
+	if cluster == "" || pattern == "" {
+		return fmt.Errorf("invalid or missing cluster or keyspace parameter")
+	}
+
+	inst, err := m.getApp(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get app: %w", err)
+	}
+	md, err := inst.CachedMetadata()
+	if err != nil {
+		return fmt.Errorf("failed to get metadata: %w", err)
+	}
+	ks, err := redis.FindKeyspace(md, cluster, pattern)
+	if err != nil {
+		return err
+	}
+
+	ns, err := m.ns.GetActive(ctx, inst)
+	if err != nil {
+		return fmt.Errorf("failed to get active namespace: %w", err)
+	}
+	return m.run.RedisMgr.Use(ns.ID, func(srv *redis.Server) error {
+		return fn(srv, ks)
+	})
 }
 
 func (m *Manager) getCacheKeyspaces(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -101,11 +295,7 @@ func (m *Manager) getCacheKeyspaces(ctx context.Context, request mcp.CallToolReq
 
 			// Add path pattern if available
 			if keyspace.PathPattern != nil {
-				pathPattern := make([]string, 0)
-				for _, segment := range keyspace.PathPattern.Segments {
-					pathPattern = append(pathPattern, segment.Value)
-				}
-				keyspaceInfo["path_pattern"] = strings.Join(pathPattern, "/")
+				keyspaceInfo["path_pattern"] = redis.KeyspacePath(keyspace)
 			}
 
 			// Add definition location if available
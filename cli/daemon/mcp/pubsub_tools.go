@@ -7,12 +7,81 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"google.golang.org/protobuf/encoding/protojson"
+
+	"encr.dev/cli/daemon/pubsub"
+	metav1 "encr.dev/proto/encore/parser/meta/v1"
 )
 
 func (m *Manager) registerPubSubTools() {
 	m.server.AddTool(mcp.NewTool("get_pubsub",
 		mcp.WithDescription("Retrieve detailed information about all PubSub topics and their subscriptions in the currently open Encore. This includes topic configurations, subscription patterns, message schemas, and the services that publish to or subscribe to each topic."),
 	), m.getPubSub)
+
+	m.server.AddTool(mcp.NewTool("publish_pubsub_message",
+		mcp.WithDescription("Publish a message to a PubSub topic of the currently open Encore app, which must be running locally. The message is validated against the topic's message type before being published, and fields tagged as message attributes are sent as attributes. Useful for testing subscriptions without writing an endpoint that publishes."),
+		mcp.WithString("topic", mcp.Description("The name of the topic to publish to.")),
+		mcp.WithString("message", mcp.Description("The JSON-encoded message to publish. It must be an object matching the topic's message type, as returned by get_pubsub.")),
+	), m.publishPubSubMessage)
+
+	m.server.AddTool(mcp.NewTool("get_pubsub_subscription_stats",
+		mcp.WithDescription("Retrieve the current state of every PubSub subscription in the currently open Encore app, which must be running locally. For each subscription this includes whether the subscriber is connected, the number of messages waiting to be delivered (depth), delivered but not yet acknowledged (in_flight), waiting to be retried (deferred), and the total number of messages received, requeued for a retry and timed out."),
+	), m.getPubSubSubscriptionStats)
+}
+
+func (m *Manager) publishPubSubMessage(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	topic, _ := request.Params.Arguments["topic"].(string)
+	if topic == "" {
+		return nil, fmt.Errorf("invalid or missing topic parameter")
+	}
+	message, _ := request.Params.Arguments["message"].(string)
+	if message == "" {
+		return nil, fmt.Errorf("invalid or missing message parameter")
+	}
+
+	nsq, md, err := m.runningPubSub(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := nsq.Publish(md, topic, []byte(message))
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish message: %w", err)
+	}
+
+	jsonData, err := json.Marshal(map[string]any{"message_id": id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+func (m *Manager) getPubSubSubscriptionStats(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	nsq, md, err := m.runningPubSub(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := nsq.TopicStats(md)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription stats: %w", err)
+	}
+
+	jsonData, err := json.Marshal(stats)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal subscription stats: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// runningPubSub returns the NSQ daemon and metadata of the running app.
+func (m *Manager) runningPubSub(ctx context.Context) (*pubsub.NSQDaemon, *metav1.Data, error) {
+	inst, err := m.getApp(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get app: %w", err)
+	}
+	appRun := m.run.FindRunByAppID(inst.PlatformOrLocalID())
+	if appRun == nil {
+		return nil, nil, fmt.Errorf("the app is not running: start it with 'encore run'")
+	}
+	return appRun.PubSub()
 }
 
 func (m *Manager) getPubSub(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
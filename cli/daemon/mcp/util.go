@@ -1,8 +1,11 @@
 package mcp
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/mark3labs/mcp-go/mcp"
+
 	metav1 "encr.dev/proto/encore/parser/meta/v1"
 )
 
@@ -21,3 +24,20 @@ func formatDuration(nanos int64) string {
 	duration := time.Duration(nanos) * time.Nanosecond
 	return duration.String()
 }
+
+// stringArrayArg returns the non-empty string array argument with the given name.
+func stringArrayArg(request mcp.CallToolRequest, name string) ([]string, error) {
+	arr, ok := request.Params.Arguments[name].([]any)
+	if !ok || len(arr) == 0 {
+		return nil, fmt.Errorf("invalid or missing %s parameter", name)
+	}
+	strs := make([]string, 0, len(arr))
+	for _, v := range arr {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an array of strings", name)
+		}
+		strs = append(strs, s)
+	}
+	return strs, nil
+}
@@ -3,9 +3,14 @@ package objects
 import (
 	// nosemgrep
 
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"encr.dev/cli/daemon/namespace"
 	"encr.dev/pkg/emulators/storage/gcsemu"
@@ -27,8 +32,15 @@ type Server struct {
 	ln        net.Listener
 	srv       *http.Server
 	inMemory  bool
+
+	mu      sync.Mutex
+	md      *meta.Data     // set by Initialize and SetMetadata
+	publish EventPublisher // set by SetEventPublisher
 }
 
+// EventPublisher publishes a JSON-encoded message to the pub/sub topic with the given name.
+type EventPublisher func(md *meta.Data, topic string, data []byte) error
+
 func NewInMemoryServer(public *PublicBucketServer) *Server {
 	id := xid.New().String()
 	store := gcsemu.NewMemStore()
@@ -41,13 +53,14 @@ func NewDirServer(public *PublicBucketServer, nsID namespace.ID, baseDir string)
 }
 
 func newServer(public *PublicBucketServer, id string, store gcsemu.Store, isInMem bool) *Server {
-	return &Server{
+	s := &Server{
 		public:   public,
 		id:       id,
 		store:    store,
-		emu:      gcsemu.NewGcsEmu(gcsemu.Options{Store: store}),
 		inMemory: isInMem,
 	}
+	s.emu = gcsemu.NewGcsEmu(gcsemu.Options{Store: store, OnObjectEvent: s.onObjectEvent})
+	return s
 }
 
 func (s *Server) Initialize(md *meta.Data) error {
@@ -56,9 +69,91 @@ func (s *Server) Initialize(md *meta.Data) error {
 			return errors.Wrap(err, "initialize object storage bucket")
 		}
 	}
+	s.SetMetadata(md)
 	return nil
 }
 
+// SetMetadata updates the app metadata used to determine
+// which bucket notifications to deliver.
+func (s *Server) SetMetadata(md *meta.Data) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.md = md
+}
+
+// SetEventPublisher sets the function used to deliver bucket notifications.
+func (s *Server) SetEventPublisher(p EventPublisher) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.publish = p
+}
+
+// objectEvent is the JSON encoding of an objects.Event in the Go runtime.
+// It must be kept in sync with runtimes/go/storage/objects/events.go.
+type objectEvent struct {
+	Type        string
+	Bucket      string
+	Name        string
+	Version     string
+	Size        int64
+	ETag        string
+	ContentType string
+	Time        time.Time
+}
+
+// onObjectEvent publishes the event to the pub/sub topics
+// of the bucket's notifications that match it.
+func (s *Server) onObjectEvent(ev gcsemu.ObjectEvent) {
+	s.mu.Lock()
+	md, publish := s.md, s.publish
+	s.mu.Unlock()
+	if md == nil || publish == nil || ev.Object == nil {
+		return
+	}
+
+	for _, bkt := range md.Buckets {
+		// Local buckets use the bucket name as the cloud name.
+		if bkt.Name != ev.Bucket {
+			continue
+		}
+
+		var data []byte
+		for _, n := range bkt.Notifications {
+			switch {
+			case ev.Type == gcsemu.ObjectFinalized && !n.ObjectCreated,
+				ev.Type == gcsemu.ObjectDeleted && !n.ObjectDeleted,
+				!strings.HasPrefix(ev.Object.Name, n.Prefix):
+				continue
+			}
+
+			if data == nil {
+				e := objectEvent{
+					Type:        string(ev.Type),
+					Bucket:      ev.Bucket,
+					Name:        ev.Object.Name,
+					Size:        int64(ev.Object.Size),
+					ETag:        ev.Object.Etag,
+					ContentType: ev.Object.ContentType,
+					Time:        time.Now(),
+				}
+				if bkt.Versioned {
+					e.Version = strconv.FormatInt(ev.Object.Generation, 10)
+				}
+				var err error
+				if data, err = json.Marshal(e); err != nil {
+					log.Error().Err(err).Msg("unable to marshal object event")
+					return
+				}
+			}
+
+			if err := publish(md, n.Topic, data); err != nil {
+				log.Error().Err(err).Str("bucket", bkt.Name).Str("topic", n.Topic).
+					Msg("unable to publish object event")
+			}
+		}
+	}
+}
+
 func (s *Server) Start() error {
 	return s.startOnce.Do(func() error {
 		if s.inMemory {
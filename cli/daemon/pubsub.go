@@ -0,0 +1,138 @@
+package daemon
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"encr.dev/cli/daemon/pubsub"
+	daemonpb "encr.dev/proto/encore/daemon"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// PubSubPublish publishes a message to a topic of a running app.
+func (s *Server) PubSubPublish(ctx context.Context, req *daemonpb.PubSubPublishRequest) (*daemonpb.PubSubPublishResponse, error) {
+	nsq, md, err := s.runningPubSub(req.AppRoot)
+	if err != nil {
+		return nil, err
+	}
+	id, err := nsq.Publish(md, req.Topic, req.Message)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &daemonpb.PubSubPublishResponse{MessageId: id}, nil
+}
+
+// PubSubStats reports the state of the topics and subscriptions of a running app.
+func (s *Server) PubSubStats(ctx context.Context, req *daemonpb.PubSubStatsRequest) (*daemonpb.PubSubStatsResponse, error) {
+	nsq, md, err := s.runningPubSub(req.AppRoot)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := nsq.TopicStats(md)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get stats: %v", err)
+	}
+
+	resp := &daemonpb.PubSubStatsResponse{}
+	for _, t := range stats {
+		topic := &daemonpb.PubSubTopicStats{
+			Topic:    t.Topic,
+			Depth:    t.Depth,
+			Messages: t.Messages,
+		}
+		for _, sub := range t.Subscriptions {
+			topic.Subscriptions = append(topic.Subscriptions, &daemonpb.PubSubSubscriptionStats{
+				Subscription: sub.Subscription,
+				Connected:    sub.Connected,
+				Depth:        sub.Depth,
+				InFlight:     int32(sub.InFlight),
+				Deferred:     int32(sub.Deferred),
+				Messages:     sub.Messages,
+				Requeued:     sub.Requeued,
+				TimedOut:     sub.TimedOut,
+			})
+		}
+		resp.Topics = append(resp.Topics, topic)
+	}
+	return resp, nil
+}
+
+// PubSubListDeadLetters lists the dead-lettered messages of a running app.
+func (s *Server) PubSubListDeadLetters(ctx context.Context, req *daemonpb.PubSubDeadLetterFilter) (*daemonpb.PubSubListDeadLettersResponse, error) {
+	nsq, _, err := s.runningPubSub(req.AppRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &daemonpb.PubSubListDeadLettersResponse{}
+	for _, dl := range nsq.DeadLetters(deadLetterFilter(req)) {
+		msg := &daemonpb.PubSubDeadLetter{
+			Id:             dl.ID,
+			Topic:          dl.Topic,
+			Subscription:   dl.Subscription,
+			MessageId:      dl.MessageID,
+			Attributes:     dl.Attributes,
+			Data:           dl.Data,
+			PublishTime:    timestamppb.New(dl.PublishTime),
+			Attempts:       int32(dl.Attempts),
+			LastError:      dl.LastError,
+			DeadLetteredAt: timestamppb.New(dl.DeadLettered),
+		}
+		if dl.TraceID != "" {
+			msg.TraceId = &dl.TraceID
+		}
+		resp.Messages = append(resp.Messages, msg)
+	}
+	return resp, nil
+}
+
+// PubSubReplayDeadLetters redelivers dead-lettered messages to their subscriptions.
+func (s *Server) PubSubReplayDeadLetters(ctx context.Context, req *daemonpb.PubSubDeadLetterFilter) (*daemonpb.PubSubDeadLettersCount, error) {
+	nsq, md, err := s.runningPubSub(req.AppRoot)
+	if err != nil {
+		return nil, err
+	}
+	n, err := nsq.ReplayDeadLetters(md, deadLetterFilter(req))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "replayed %d messages: %v", n, err)
+	}
+	return &daemonpb.PubSubDeadLettersCount{Count: int32(n)}, nil
+}
+
+// PubSubPurgeDeadLetters discards dead-lettered messages.
+func (s *Server) PubSubPurgeDeadLetters(ctx context.Context, req *daemonpb.PubSubDeadLetterFilter) (*daemonpb.PubSubDeadLettersCount, error) {
+	nsq, _, err := s.runningPubSub(req.AppRoot)
+	if err != nil {
+		return nil, err
+	}
+	n := nsq.PurgeDeadLetters(deadLetterFilter(req))
+	return &daemonpb.PubSubDeadLettersCount{Count: int32(n)}, nil
+}
+
+func deadLetterFilter(req *daemonpb.PubSubDeadLetterFilter) pubsub.DeadLetterFilter {
+	return pubsub.DeadLetterFilter{
+		Topic:        req.GetTopic(),
+		Subscription: req.GetSubscription(),
+		IDs:          req.Ids,
+	}
+}
+
+// runningPubSub returns the NSQ daemon and metadata of the running app at appRoot.
+func (s *Server) runningPubSub(appRoot string) (*pubsub.NSQDaemon, *meta.Data, error) {
+	app, err := s.apps.Track(appRoot)
+	if err != nil {
+		return nil, nil, err
+	}
+	run := s.mgr.FindRunByAppID(app.PlatformOrLocalID())
+	if run == nil {
+		return nil, nil, status.Error(codes.FailedPrecondition, "app is not running: start it with 'encore run'")
+	}
+	nsq, md, err := run.PubSub()
+	if err != nil {
+		return nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return nsq, md, nil
+}
@@ -0,0 +1,195 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nsqio/go-nsq"
+	"github.com/nsqio/nsq/nsqd"
+	"github.com/rs/xid"
+	"github.com/rs/zerolog/log"
+
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// DeadLetterTopic is the NSQ topic that subscriptions forward messages to
+// once they have exhausted their retries.
+const DeadLetterTopic = "encore-deadletter"
+
+// deadLetterChannel is the NSQ channel the daemon consumes the dead letter topic on.
+const deadLetterChannel = "encore-daemon"
+
+// maxDeadLettersPerSubscription is the number of dead-lettered messages kept
+// per subscription. When exceeded, the oldest messages are discarded.
+const maxDeadLettersPerSubscription = 1000
+
+// DeadLetter is a message that exhausted its retries.
+// The JSON fields must be kept in sync with runtimes/go/pubsub/internal/nsq/topic.go.
+type DeadLetter struct {
+	// ID uniquely identifies the dead-lettered message within the daemon.
+	ID           string            `json:"id"`
+	Topic        string            `json:"topic"`
+	Subscription string            `json:"subscription"`
+	MessageID    string            `json:"message_id"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+	Data         json.RawMessage   `json:"data"`
+	PublishTime  time.Time         `json:"publish_time"`
+	Attempts     int               `json:"attempts"`
+	LastError    string            `json:"last_error,omitempty"`
+	TraceID      string            `json:"trace_id,omitempty"`
+	DeadLettered time.Time         `json:"dead_lettered"`
+}
+
+// DeadLetterFilter selects dead-lettered messages.
+// Empty fields match all messages.
+type DeadLetterFilter struct {
+	Topic        string
+	Subscription string
+	IDs          []string
+}
+
+func (f *DeadLetterFilter) matches(dl *DeadLetter) bool {
+	return (f.Topic == "" || f.Topic == dl.Topic) &&
+		(f.Subscription == "" || f.Subscription == dl.Subscription) &&
+		(len(f.IDs) == 0 || slices.Contains(f.IDs, dl.ID))
+}
+
+type subKey struct {
+	topic, subscription string
+}
+
+// DeadLetterStore keeps dead-lettered messages in memory, per subscription.
+type DeadLetterStore struct {
+	mu    sync.Mutex
+	subs  map[subKey][]*DeadLetter // oldest first
+	order []subKey                 // subscriptions in the order they were first seen
+}
+
+// Add adds a dead-lettered message to the store, assigning it an id if it has none.
+func (s *DeadLetterStore) Add(dl *DeadLetter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.subs == nil {
+		s.subs = make(map[subKey][]*DeadLetter)
+	}
+
+	if dl.ID == "" {
+		dl.ID = xid.New().String()
+	}
+	key := subKey{dl.Topic, dl.Subscription}
+	msgs, ok := s.subs[key]
+	if !ok {
+		s.order = append(s.order, key)
+	}
+	msgs = append(msgs, dl)
+	if len(msgs) > maxDeadLettersPerSubscription {
+		msgs = msgs[len(msgs)-maxDeadLettersPerSubscription:]
+	}
+	s.subs[key] = msgs
+}
+
+// List returns the dead-lettered messages matching the filter,
+// grouped by subscription and oldest first.
+func (s *DeadLetterStore) List(f DeadLetterFilter) []*DeadLetter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []*DeadLetter
+	for _, key := range s.order {
+		for _, dl := range s.subs[key] {
+			if f.matches(dl) {
+				result = append(result, dl)
+			}
+		}
+	}
+	return result
+}
+
+// Remove removes the dead-lettered messages matching the filter
+// and returns them.
+func (s *DeadLetterStore) Remove(f DeadLetterFilter) []*DeadLetter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var removed []*DeadLetter
+	for _, key := range s.order {
+		s.subs[key] = slices.DeleteFunc(s.subs[key], func(dl *DeadLetter) bool {
+			if f.matches(dl) {
+				removed = append(removed, dl)
+				return true
+			}
+			return false
+		})
+	}
+	return removed
+}
+
+// DeadLetters returns the dead-lettered messages matching the filter.
+func (n *NSQDaemon) DeadLetters(f DeadLetterFilter) []*DeadLetter {
+	return n.deadLetters.List(f)
+}
+
+// PurgeDeadLetters discards the dead-lettered messages matching the filter.
+// It returns the number of messages discarded.
+func (n *NSQDaemon) PurgeDeadLetters(f DeadLetterFilter) int {
+	return len(n.deadLetters.Remove(f))
+}
+
+// ReplayDeadLetters redelivers the dead-lettered messages matching the filter
+// to the subscriptions they were dead-lettered from, with their original message ids.
+// The messages are encoded in the NSQ message format of the app's runtime.
+// It returns the number of messages redelivered.
+func (n *NSQDaemon) ReplayDeadLetters(md *meta.Data, f DeadLetterFilter) (int, error) {
+	if n.nsqd == nil {
+		return 0, errors.New("nsqd not started")
+	}
+
+	msgs := n.deadLetters.Remove(f)
+	for i, dl := range msgs {
+		if err := n.replay(md.Language, dl); err != nil {
+			// Put back the messages that were not replayed.
+			for _, dl := range msgs[i:] {
+				n.deadLetters.Add(dl)
+			}
+			return i, errors.Wrapf(err, "replay message %s", dl.ID)
+		}
+	}
+	return len(msgs), nil
+}
+
+// replay publishes a dead-lettered message to the NSQ channel of its subscription only.
+func (n *NSQDaemon) replay(lang meta.Lang, dl *DeadLetter) error {
+	encoded, err := marshalMessage(lang, dl.MessageID, dl.Attributes, dl.Data)
+	if err != nil {
+		return err
+	}
+	t := n.nsqd.GetTopic(NSQName(dl.Topic))
+	ch := t.GetChannel(NSQName(dl.Subscription))
+	return ch.PutMessage(nsqd.NewMessage(t.GenerateID(), encoded))
+}
+
+// consumeDeadLetters starts consuming the dead letter topic into the dead letter store.
+func (n *NSQDaemon) consumeDeadLetters() error {
+	consumer, err := nsq.NewConsumer(DeadLetterTopic, deadLetterChannel, nsq.NewConfig())
+	if err != nil {
+		return errors.Wrap(err, "create dead letter consumer")
+	}
+	consumer.SetLogger(&logAdapter{"nsq dead letter consumer"}, nsq.LogLevelWarning)
+	consumer.AddHandler(nsq.HandlerFunc(func(m *nsq.Message) error {
+		var dl DeadLetter
+		if err := json.Unmarshal(m.Body, &dl); err != nil {
+			// Don't requeue messages that can never be parsed.
+			log.Err(err).Msg("failed to parse dead-lettered message, discarding it")
+			return nil
+		}
+		n.deadLetters.Add(&dl)
+		return nil
+	}))
+	if err := consumer.ConnectToNSQD(n.Addr()); err != nil {
+		consumer.Stop()
+		return errors.Wrap(err, "connect dead letter consumer")
+	}
+	n.deadLetterConsumer = consumer
+	return nil
+}
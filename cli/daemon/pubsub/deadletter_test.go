@@ -0,0 +1,90 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/nsqio/go-nsq"
+
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+func TestDeadLetterStore(t *testing.T) {
+	c := qt.New(t)
+	var s DeadLetterStore
+	for i := 0; i < maxDeadLettersPerSubscription+1; i++ {
+		s.Add(&DeadLetter{Topic: "signups", Subscription: "send-welcome-email", Attempts: i})
+	}
+	s.Add(&DeadLetter{Topic: "signups", Subscription: "audit-log"})
+
+	// The oldest message is discarded once the subscription is full.
+	msgs := s.List(DeadLetterFilter{Subscription: "send-welcome-email"})
+	c.Assert(msgs, qt.HasLen, maxDeadLettersPerSubscription)
+	c.Assert(msgs[0].Attempts, qt.Equals, 1)
+	c.Assert(s.List(DeadLetterFilter{Topic: "signups"}), qt.HasLen, maxDeadLettersPerSubscription+1)
+	c.Assert(s.List(DeadLetterFilter{Topic: "missing"}), qt.HasLen, 0)
+
+	removed := s.Remove(DeadLetterFilter{IDs: []string{msgs[0].ID, msgs[1].ID}})
+	c.Assert(removed, qt.DeepEquals, msgs[:2])
+	c.Assert(s.List(DeadLetterFilter{}), qt.HasLen, maxDeadLettersPerSubscription-1)
+}
+
+func TestReplayDeadLetters(t *testing.T) {
+	c := qt.New(t)
+
+	n := &NSQDaemon{}
+	c.Assert(n.Start(), qt.IsNil)
+	defer n.Stop()
+
+	// Dead-letter a message the way the runtime does.
+	record := []byte(`{"topic": "signups", "subscription": "send-welcome-email", "message_id": "msg-1",
+		"attributes": {"region": "eu"}, "data": {"user_id": 1}, "attempts": 3, "last_error": "boom",
+		"trace_id": "trace-1", "publish_time": "2024-01-01T00:00:00Z", "dead_lettered": "2024-01-01T00:00:01Z"}`)
+	c.Assert(n.publishRaw(DeadLetterTopic, record), qt.IsNil)
+
+	var msgs []*DeadLetter
+	for i := 0; i < 100 && len(msgs) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+		msgs = n.DeadLetters(DeadLetterFilter{})
+	}
+	c.Assert(msgs, qt.HasLen, 1)
+	dl := msgs[0]
+	c.Assert(dl.MessageID, qt.Equals, "msg-1")
+	c.Assert(dl.Attempts, qt.Equals, 3)
+	c.Assert(dl.LastError, qt.Equals, "boom")
+	c.Assert(dl.TraceID, qt.Equals, "trace-1")
+
+	// Another subscription to the same topic must not receive the replayed message.
+	topic := n.nsqd.GetTopic(NSQName("signups"))
+	other := topic.GetChannel(NSQName("audit-log"))
+
+	// Replay to a TypeScript app, which uses the core runtime's message format.
+	md := &meta.Data{Language: meta.Lang_TYPESCRIPT}
+	replayed, err := n.ReplayDeadLetters(md, DeadLetterFilter{IDs: []string{dl.ID}})
+	c.Assert(err, qt.IsNil)
+	c.Assert(replayed, qt.Equals, 1)
+	c.Assert(n.DeadLetters(DeadLetterFilter{}), qt.HasLen, 0)
+	c.Assert(topic.GetChannel(NSQName("send-welcome-email")).Depth(), qt.Equals, int64(1))
+	c.Assert(other.Depth(), qt.Equals, int64(0))
+
+	bodies := make(chan []byte, 1)
+	consumer, err := nsq.NewConsumer(NSQName("signups"), NSQName("send-welcome-email"), nsq.NewConfig())
+	c.Assert(err, qt.IsNil)
+	consumer.AddHandler(nsq.HandlerFunc(func(m *nsq.Message) error {
+		bodies <- m.Body
+		return nil
+	}))
+	c.Assert(consumer.ConnectToNSQD(n.Addr()), qt.IsNil)
+	defer consumer.Stop()
+
+	var msg tsMessage
+	select {
+	case body := <-bodies:
+		c.Assert(json.Unmarshal(body, &msg), qt.IsNil)
+	case <-time.After(5 * time.Second):
+		c.Fatal("timed out waiting for the replayed message")
+	}
+	c.Assert(msg, qt.DeepEquals, tsMessage{ID: "msg-1", Attrs: map[string]string{"region": "eu"}, Body: json.RawMessage(`{"user_id":1}`)})
+}
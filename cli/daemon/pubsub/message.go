@@ -0,0 +1,456 @@
+package pubsub
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gofrs/uuid"
+
+	meta "encr.dev/proto/encore/parser/meta/v1"
+	schema "encr.dev/proto/encore/parser/schema/v1"
+)
+
+// attrTag is the struct tag used to mark message fields as attributes.
+const attrTag = "pubsub-attr"
+
+// FindTopic finds the topic with the given name.
+func FindTopic(md *meta.Data, name string) (*meta.PubSubTopic, error) {
+	for _, topic := range md.PubsubTopics {
+		if topic.Name == name {
+			return topic, nil
+		}
+	}
+	return nil, errors.Newf("pubsub topic %q not found", name)
+}
+
+// ValidateMessage validates that data is a JSON object matching the topic's message type.
+// It returns the message with any attribute-only fields removed,
+// along with the attributes the message defines.
+func ValidateMessage(md *meta.Data, topic *meta.PubSubTopic, data []byte) (body []byte, attrs map[string]string, err error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var msg any
+	if err := dec.Decode(&msg); err != nil {
+		return nil, nil, errors.Wrap(err, "invalid JSON message")
+	} else if dec.More() {
+		return nil, nil, errors.New("invalid JSON message: unexpected data after the message")
+	}
+
+	v := &validator{
+		decls: make(map[uint32]*schema.Decl, len(md.Decls)),
+		lang:  md.Language,
+	}
+	for _, d := range md.Decls {
+		v.decls[d.Id] = d
+	}
+
+	fields, ok := msg.(map[string]any)
+	if !ok {
+		return nil, nil, errors.New("message must be a JSON object")
+	}
+	st, typeArgs := v.structType(topic.MessageType, nil)
+	if st == nil {
+		return nil, nil, errors.Newf("topic %q does not have a struct message type", topic.Name)
+	}
+	if err := v.validateStruct(st, typeArgs, fields, "message"); err != nil {
+		return nil, nil, err
+	}
+
+	// Extract the attributes from the message.
+	// Fields only transmitted as attributes are removed from the message body.
+	attrs = make(map[string]string)
+	omitted := false
+	for _, f := range st.Fields {
+		attrName, isAttr := fieldTag(f, attrTag)
+		if !isAttr {
+			continue
+		}
+		key, val, found := v.lookup(fields, fieldKey(f))
+		if !found || val == nil {
+			continue
+		}
+		if jsonName(f) == "-" {
+			delete(fields, key)
+			omitted = true
+		}
+		switch val := val.(type) {
+		case string:
+			attrs[attrName] = val
+		case json.Number:
+			attrs[attrName] = val.String()
+		case bool:
+			attrs[attrName] = strconv.FormatBool(val)
+		default:
+			return nil, nil, errors.Newf("attribute %q must be a string, number or boolean", attrName)
+		}
+	}
+
+	if topic.OrderingKey != "" && attrs[topic.OrderingKey] == "" {
+		return nil, nil, errors.Newf("ordering attribute %q must be set to a non-empty value", topic.OrderingKey)
+	}
+
+	body = data
+	if omitted {
+		if body, err = json.Marshal(fields); err != nil {
+			return nil, nil, errors.Wrap(err, "marshal message")
+		}
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, body); err != nil {
+		return nil, nil, errors.Wrap(err, "invalid JSON message")
+	}
+	return buf.Bytes(), attrs, nil
+}
+
+// validator validates JSON values against schema types, following the
+// decoding rules of the app's language: Go apps decode messages with
+// encoding/json, which matches keys case-insensitively and treats
+// missing fields and nulls as zero values.
+type validator struct {
+	decls map[uint32]*schema.Decl
+	lang  meta.Lang
+}
+
+// resolve resolves named types and type parameters to their underlying type.
+func (v *validator) resolve(typ *schema.Type, typeArgs []*schema.Type) (*schema.Type, []*schema.Type) {
+	for {
+		switch t := typ.GetTyp().(type) {
+		case *schema.Type_Named:
+			decl, ok := v.decls[t.Named.Id]
+			if !ok {
+				return typ, typeArgs
+			}
+			args := make([]*schema.Type, len(t.Named.TypeArguments))
+			for i, arg := range t.Named.TypeArguments {
+				args[i] = v.substitute(arg, typeArgs)
+			}
+			typ, typeArgs = decl.Type, args
+		case *schema.Type_TypeParameter:
+			idx := int(t.TypeParameter.ParamIdx)
+			if idx >= len(typeArgs) {
+				return typ, typeArgs
+			}
+			typ, typeArgs = typeArgs[idx], nil
+		case *schema.Type_Config:
+			typ = t.Config.Elem
+		default:
+			return typ, typeArgs
+		}
+	}
+}
+
+// substitute replaces a top-level type parameter reference with its type argument.
+func (v *validator) substitute(typ *schema.Type, typeArgs []*schema.Type) *schema.Type {
+	if p := typ.GetTypeParameter(); p != nil && int(p.ParamIdx) < len(typeArgs) {
+		return typeArgs[p.ParamIdx]
+	}
+	return typ
+}
+
+func (v *validator) structType(typ *schema.Type, typeArgs []*schema.Type) (*schema.Struct, []*schema.Type) {
+	typ, typeArgs = v.resolve(typ, typeArgs)
+	if ptr := typ.GetPointer(); ptr != nil {
+		return v.structType(ptr.Base, typeArgs)
+	}
+	return typ.GetStruct(), typeArgs
+}
+
+func (v *validator) validate(typ *schema.Type, typeArgs []*schema.Type, val any, path string) error {
+	if val == nil && v.lang == meta.Lang_GO {
+		return nil
+	}
+	typ, typeArgs = v.resolve(typ, typeArgs)
+
+	switch t := typ.GetTyp().(type) {
+	case *schema.Type_Struct:
+		obj, ok := val.(map[string]any)
+		if !ok {
+			return typeError(path, "an object", val)
+		}
+		return v.validateStruct(t.Struct, typeArgs, obj, path)
+
+	case *schema.Type_Map:
+		if val == nil {
+			return nil
+		}
+		obj, ok := val.(map[string]any)
+		if !ok {
+			return typeError(path, "an object", val)
+		}
+		for k, elem := range obj {
+			if err := v.validate(t.Map.Value, typeArgs, elem, fmt.Sprintf("%s[%q]", path, k)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case *schema.Type_List:
+		if val == nil {
+			return nil
+		}
+		if b := t.List.Elem.GetBuiltin(); b == schema.Builtin_UINT8 {
+			// Byte slices are encoded as base64 strings.
+			return v.validateBuiltin(schema.Builtin_BYTES, val, path)
+		}
+		arr, ok := val.([]any)
+		if !ok {
+			return typeError(path, "an array", val)
+		}
+		for i, elem := range arr {
+			if err := v.validate(t.List.Elem, typeArgs, elem, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case *schema.Type_Pointer:
+		if val == nil {
+			return nil
+		}
+		return v.validate(t.Pointer.Base, typeArgs, val, path)
+
+	case *schema.Type_Option:
+		if val == nil {
+			return nil
+		}
+		return v.validate(t.Option.Value, typeArgs, val, path)
+
+	case *schema.Type_Union:
+		for _, alt := range t.Union.Types {
+			if v.validate(alt, typeArgs, val, path) == nil {
+				return nil
+			}
+		}
+		return errors.Newf("%s: value does not match any of the union's types", path)
+
+	case *schema.Type_Literal:
+		return validateLiteral(t.Literal, val, path)
+
+	case *schema.Type_Builtin:
+		return v.validateBuiltin(t.Builtin, val, path)
+	}
+
+	// Types we can't validate (such as unresolved type parameters) accept any value.
+	return nil
+}
+
+func (v *validator) validateStruct(st *schema.Struct, typeArgs []*schema.Type, obj map[string]any, path string) error {
+	known := make(map[string]bool, len(st.Fields))
+	for _, f := range st.Fields {
+		name := fieldKey(f)
+		if name == "-" {
+			continue
+		}
+		key, val, found := v.lookup(obj, name)
+		if !found {
+			if !f.Optional && v.lang != meta.Lang_GO {
+				return errors.Newf("%s: missing required field %q", path, name)
+			}
+			continue
+		}
+		known[key] = true
+		if val == nil && f.Optional {
+			continue
+		}
+		if err := v.validate(f.Typ, typeArgs, val, path+"."+name); err != nil {
+			return err
+		}
+	}
+
+	for key := range obj {
+		if !known[key] {
+			return errors.Newf("%s: unknown field %q", path, key)
+		}
+	}
+	return nil
+}
+
+func (v *validator) validateBuiltin(b schema.Builtin, val any, path string) error {
+	switch b {
+	case schema.Builtin_ANY, schema.Builtin_JSON:
+		return nil
+
+	case schema.Builtin_BOOL:
+		if _, ok := val.(bool); !ok {
+			return typeError(path, "a boolean", val)
+		}
+
+	case schema.Builtin_INT, schema.Builtin_INT8, schema.Builtin_INT16, schema.Builtin_INT32, schema.Builtin_INT64:
+		n, ok := val.(json.Number)
+		if !ok {
+			return typeError(path, "an integer", val)
+		}
+		if _, err := strconv.ParseInt(n.String(), 10, intBits(b)); err != nil {
+			return errors.Newf("%s: %s is not a valid %s", path, n, strings.ToLower(b.String()))
+		}
+
+	case schema.Builtin_UINT, schema.Builtin_UINT8, schema.Builtin_UINT16, schema.Builtin_UINT32, schema.Builtin_UINT64:
+		n, ok := val.(json.Number)
+		if !ok {
+			return typeError(path, "a non-negative integer", val)
+		}
+		if _, err := strconv.ParseUint(n.String(), 10, intBits(b)); err != nil {
+			return errors.Newf("%s: %s is not a valid %s", path, n, strings.ToLower(b.String()))
+		}
+
+	case schema.Builtin_FLOAT32, schema.Builtin_FLOAT64:
+		if _, ok := val.(json.Number); !ok {
+			return typeError(path, "a number", val)
+		}
+
+	case schema.Builtin_DECIMAL:
+		switch val := val.(type) {
+		case json.Number:
+		case string:
+			if _, err := strconv.ParseFloat(val, 64); err != nil {
+				return errors.Newf("%s: %q is not a valid decimal", path, val)
+			}
+		default:
+			return typeError(path, "a decimal", val)
+		}
+
+	case schema.Builtin_STRING, schema.Builtin_USER_ID:
+		if _, ok := val.(string); !ok {
+			return typeError(path, "a string", val)
+		}
+
+	case schema.Builtin_BYTES:
+		s, ok := val.(string)
+		if !ok {
+			return typeError(path, "a base64-encoded string", val)
+		}
+		if _, err := base64.StdEncoding.DecodeString(s); err != nil {
+			return errors.Newf("%s: invalid base64 data", path)
+		}
+
+	case schema.Builtin_TIME:
+		s, ok := val.(string)
+		if !ok {
+			return typeError(path, "an RFC 3339 timestamp", val)
+		}
+		if _, err := time.Parse(time.RFC3339Nano, s); err != nil {
+			return errors.Newf("%s: %q is not a valid RFC 3339 timestamp", path, s)
+		}
+
+	case schema.Builtin_UUID:
+		s, ok := val.(string)
+		if !ok {
+			return typeError(path, "a UUID", val)
+		}
+		if _, err := uuid.FromString(s); err != nil {
+			return errors.Newf("%s: %q is not a valid UUID", path, s)
+		}
+	}
+	return nil
+}
+
+func validateLiteral(lit *schema.Literal, val any, path string) error {
+	var ok bool
+	switch l := lit.Value.(type) {
+	case *schema.Literal_Str:
+		ok = val == l.Str
+	case *schema.Literal_Boolean:
+		ok = val == l.Boolean
+	case *schema.Literal_Int:
+		n, isNum := val.(json.Number)
+		ok = isNum && n.String() == strconv.FormatInt(l.Int, 10)
+	case *schema.Literal_Float:
+		n, isNum := val.(json.Number)
+		if isNum {
+			f, err := n.Float64()
+			ok = err == nil && f == l.Float
+		}
+	case *schema.Literal_Null:
+		ok = val == nil
+	}
+	if !ok {
+		return errors.Newf("%s: value does not match the expected literal", path)
+	}
+	return nil
+}
+
+// lookup finds the value for the given key in obj.
+// It returns the key as it appears in obj.
+func (v *validator) lookup(obj map[string]any, key string) (string, any, bool) {
+	if val, ok := obj[key]; ok {
+		return key, val, true
+	}
+	if v.lang == meta.Lang_GO {
+		for k, val := range obj {
+			if strings.EqualFold(k, key) {
+				return k, val, true
+			}
+		}
+	}
+	return "", nil, false
+}
+
+func jsonName(f *schema.Field) string {
+	if f.JsonName != "" {
+		return f.JsonName
+	}
+	return f.Name
+}
+
+// fieldKey returns the key of the field in a JSON message.
+// Fields omitted from JSON but tagged as attributes are given by their attribute name,
+// and any other omitted field is reported as "-".
+func fieldKey(f *schema.Field) string {
+	name := jsonName(f)
+	if name == "-" {
+		if attr, ok := fieldTag(f, attrTag); ok {
+			return attr
+		}
+	}
+	return name
+}
+
+// fieldTag returns the name of the field's tag with the given key, if any.
+func fieldTag(f *schema.Field, key string) (string, bool) {
+	for _, tag := range f.Tags {
+		if tag.Key == key {
+			return tag.Name, true
+		}
+	}
+	return "", false
+}
+
+func intBits(b schema.Builtin) int {
+	switch b {
+	case schema.Builtin_INT8, schema.Builtin_UINT8:
+		return 8
+	case schema.Builtin_INT16, schema.Builtin_UINT16:
+		return 16
+	case schema.Builtin_INT32, schema.Builtin_UINT32:
+		return 32
+	default:
+		return 64
+	}
+}
+
+func typeError(path, want string, got any) error {
+	var desc string
+	switch got.(type) {
+	case nil:
+		desc = "null"
+	case bool:
+		desc = "a boolean"
+	case json.Number:
+		desc = "a number"
+	case string:
+		desc = "a string"
+	case []any:
+		desc = "an array"
+	case map[string]any:
+		desc = "an object"
+	default:
+		desc = fmt.Sprintf("%T", got)
+	}
+	return errors.Newf("%s: expected %s, got %s", path, want, desc)
+}
@@ -16,6 +16,9 @@ type NSQDaemon struct {
 	nsqd      *nsqd.NSQD
 	startOnce syncutil.Once
 
+	deadLetters        DeadLetterStore
+	deadLetterConsumer *nsq.Consumer
+
 	Opts *nsqd.Options
 }
 
@@ -75,11 +78,17 @@ func (n *NSQDaemon) Start() error {
 			}
 		}()
 		// Ping the daemon to make sure it has started correctly
-		return n.isReady()
+		if err := n.isReady(); err != nil {
+			return err
+		}
+		return n.consumeDeadLetters()
 	})
 }
 
 func (n *NSQDaemon) Stop() {
+	if n.deadLetterConsumer != nil {
+		n.deadLetterConsumer.Stop()
+	}
 	if n.nsqd != nil {
 		n.nsqd.Exit()
 	}
@@ -1,4 +1,4 @@
-package run
+package pubsub
 
 import (
 	"encoding/hex"
@@ -23,8 +23,9 @@ func hashNSQName(name string) string {
 	return hex.EncodeToString(hash[:])
 }
 
-// ensureValidNSQName returns the name if it's valid, otherwise returns a hashed version.
-func ensureValidNSQName(name string) string {
+// NSQName returns the NSQ topic or channel name to use for the given Encore name.
+// It returns the name if it's valid, otherwise returns a hashed version.
+func NSQName(name string) string {
 	if isValidNSQName(name) {
 		return name
 	}
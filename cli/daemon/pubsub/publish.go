@@ -0,0 +1,158 @@
+package pubsub
+
+import (
+	"encoding/json"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nsqio/nsq/nsqd"
+	"github.com/rs/xid"
+
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// goMessage is the NSQ message format of the Go runtime.
+// It must be kept in sync with runtimes/go/pubsub/internal/nsq/topic.go.
+type goMessage struct {
+	ID         string
+	Attributes map[string]string
+	Data       json.RawMessage
+}
+
+// tsMessage is the NSQ message format of the TypeScript runtime.
+// It must be kept in sync with runtimes/core/src/pubsub/nsq/topic.rs.
+type tsMessage struct {
+	ID    string            `json:"id"`
+	Attrs map[string]string `json:"attrs"`
+	Body  json.RawMessage   `json:"body"`
+}
+
+// Publish validates the JSON message against the topic's message type
+// and publishes it to the topic. It returns the id of the published message.
+func (n *NSQDaemon) Publish(md *meta.Data, topicName string, data []byte) (id string, err error) {
+	topic, err := FindTopic(md, topicName)
+	if err != nil {
+		return "", err
+	}
+	id, encoded, err := encodeMessage(md, topic, data)
+	if err != nil {
+		return "", err
+	}
+	if err := n.publishRaw(NSQName(topic.Name), encoded); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// encodeMessage validates the JSON message and encodes it
+// in the NSQ message format of the app's runtime.
+func encodeMessage(md *meta.Data, topic *meta.PubSubTopic, data []byte) (id string, encoded []byte, err error) {
+	body, attrs, err := ValidateMessage(md, topic, data)
+	if err != nil {
+		return "", nil, err
+	}
+
+	id = xid.New().String()
+	encoded, err = marshalMessage(md.Language, id, attrs, body)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, encoded, nil
+}
+
+// marshalMessage encodes a message in the NSQ message format of the given runtime language.
+func marshalMessage(lang meta.Lang, id string, attrs map[string]string, body json.RawMessage) ([]byte, error) {
+	var msg any
+	if lang == meta.Lang_TYPESCRIPT {
+		msg = &tsMessage{ID: id, Attrs: attrs, Body: body}
+	} else {
+		msg = &goMessage{ID: id, Attributes: attrs, Data: body}
+	}
+	encoded, err := json.Marshal(msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal message")
+	}
+	return encoded, nil
+}
+
+// publishRaw publishes an encoded message to the NSQ topic with the given name.
+func (n *NSQDaemon) publishRaw(nsqTopic string, encoded []byte) error {
+	if n.nsqd == nil {
+		return errors.New("nsqd not started")
+	}
+	t := n.nsqd.GetTopic(nsqTopic)
+	if err := t.PutMessage(nsqd.NewMessage(t.GenerateID(), encoded)); err != nil {
+		return errors.Wrap(err, "publish message")
+	}
+	return nil
+}
+
+// TopicStats describes the state of a topic and its subscriptions.
+type TopicStats struct {
+	Topic string `json:"topic"`
+	// Depth is the number of messages not yet delivered to any subscription.
+	Depth         int64                `json:"depth"`
+	Messages      uint64               `json:"messages"`
+	Subscriptions []*SubscriptionStats `json:"subscriptions"`
+}
+
+// SubscriptionStats describes the state of a subscription.
+type SubscriptionStats struct {
+	Subscription string `json:"subscription"`
+	// Connected is whether the subscriber is connected to the topic.
+	Connected bool `json:"connected"`
+	// Depth is the number of messages waiting to be delivered.
+	Depth int64 `json:"depth"`
+	// InFlight is the number of messages delivered but not yet acknowledged.
+	InFlight int `json:"in_flight"`
+	// Deferred is the number of messages waiting to be retried.
+	Deferred int `json:"deferred"`
+	// Messages is the total number of messages received.
+	Messages uint64 `json:"messages"`
+	// Requeued is the total number of messages that have been requeued for a retry.
+	Requeued uint64 `json:"requeued"`
+	// TimedOut is the total number of messages not acknowledged within the ack deadline.
+	TimedOut uint64 `json:"timed_out"`
+}
+
+// TopicStats returns the state of all the app's topics and subscriptions.
+func (n *NSQDaemon) TopicStats(md *meta.Data) ([]*TopicStats, error) {
+	stats, err := n.Stats()
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]nsqd.TopicStats, len(stats.Topics))
+	for _, t := range stats.Topics {
+		byName[t.TopicName] = t
+	}
+
+	result := make([]*TopicStats, 0, len(md.PubsubTopics))
+	for _, topic := range md.PubsubTopics {
+		nsqTopic := byName[NSQName(topic.Name)]
+		channels := make(map[string]nsqd.ChannelStats, len(nsqTopic.Channels))
+		for _, ch := range nsqTopic.Channels {
+			channels[ch.ChannelName] = ch
+		}
+
+		ts := &TopicStats{
+			Topic:         topic.Name,
+			Depth:         nsqTopic.Depth,
+			Messages:      nsqTopic.MessageCount,
+			Subscriptions: make([]*SubscriptionStats, 0, len(topic.Subscriptions)),
+		}
+		for _, sub := range topic.Subscriptions {
+			ch, ok := channels[NSQName(sub.Name)]
+			ts.Subscriptions = append(ts.Subscriptions, &SubscriptionStats{
+				Subscription: sub.Name,
+				Connected:    ok && ch.ClientCount > 0,
+				Depth:        ch.Depth,
+				InFlight:     ch.InFlightCount,
+				Deferred:     ch.DeferredCount,
+				Messages:     ch.MessageCount,
+				Requeued:     ch.RequeueCount,
+				TimedOut:     ch.TimeoutCount,
+			})
+		}
+		result = append(result, ts)
+	}
+	return result, nil
+}
@@ -0,0 +1,119 @@
+package pubsub
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	meta "encr.dev/proto/encore/parser/meta/v1"
+	schema "encr.dev/proto/encore/parser/schema/v1"
+)
+
+func testMeta() *meta.Data {
+	builtin := func(b schema.Builtin) *schema.Type {
+		return &schema.Type{Typ: &schema.Type_Builtin{Builtin: b}}
+	}
+	return &meta.Data{
+		Decls: []*schema.Decl{{
+			Id:   1,
+			Name: "SignupEvent",
+			Type: &schema.Type{Typ: &schema.Type_Struct{Struct: &schema.Struct{Fields: []*schema.Field{
+				{Name: "UserID", JsonName: "user_id", Typ: builtin(schema.Builtin_INT64)},
+				{Name: "Email", Typ: builtin(schema.Builtin_STRING)},
+				{Name: "Tags", Typ: &schema.Type{Typ: &schema.Type_List{List: &schema.List{Elem: builtin(schema.Builtin_STRING)}}}},
+				{Name: "Region", JsonName: "-", Typ: builtin(schema.Builtin_STRING), Tags: []*schema.Tag{{Key: attrTag, Name: "region"}}},
+			}}}},
+		}},
+		PubsubTopics: []*meta.PubSubTopic{{
+			Name:          "signups",
+			MessageType:   &schema.Type{Typ: &schema.Type_Named{Named: &schema.Named{Id: 1}}},
+			OrderingKey:   "region",
+			Subscriptions: []*meta.PubSubTopic_Subscription{{Name: "send-welcome-email"}},
+		}},
+	}
+}
+
+func TestValidateMessage(t *testing.T) {
+	c := qt.New(t)
+	md := testMeta()
+	topic := md.PubsubTopics[0]
+
+	body, attrs, err := ValidateMessage(md, topic, []byte(`{"user_id": 1, "email": "a@b.c", "Tags": null, "region": "eu"}`))
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(body), qt.Equals, `{"Tags":null,"email":"a@b.c","user_id":1}`)
+	c.Assert(attrs, qt.DeepEquals, map[string]string{"region": "eu"})
+
+	tests := []struct {
+		msg  string
+		want string
+	}{
+		{`[]`, "message must be a JSON object"},
+		{`{"user_id": 1.5, "region": "eu"}`, `message.user_id: 1.5 is not a valid int64`},
+		{`{"user_id": "1", "region": "eu"}`, `message.user_id: expected an integer, got a string`},
+		{`{"Tags": [1], "region": "eu"}`, `message.Tags\[0\]: expected a string, got a number`},
+		{`{"unknown": true, "region": "eu"}`, `message: unknown field "unknown"`},
+		{`{"user_id": 1}`, `ordering attribute "region" must be set to a non-empty value`},
+	}
+	for _, test := range tests {
+		_, _, err := ValidateMessage(md, topic, []byte(test.msg))
+		c.Assert(err, qt.ErrorMatches, test.want, qt.Commentf("message %s", test.msg))
+	}
+}
+
+func TestPublish(t *testing.T) {
+	c := qt.New(t)
+	md := testMeta()
+
+	n := &NSQDaemon{}
+	c.Assert(n.Start(), qt.IsNil)
+	defer n.Stop()
+
+	// Create the subscription's channel, as the subscriber would.
+	n.nsqd.GetTopic(NSQName("signups")).GetChannel(NSQName("send-welcome-email"))
+
+	id, err := n.Publish(md, "signups", []byte(`{"user_id": 1, "region": "eu"}`))
+	c.Assert(err, qt.IsNil)
+	c.Assert(id, qt.Not(qt.Equals), "")
+
+	_, err = n.Publish(md, "missing", []byte(`{}`))
+	c.Assert(err, qt.ErrorMatches, `pubsub topic "missing" not found`)
+
+	// Messages are copied to the subscriptions asynchronously.
+	var sub *SubscriptionStats
+	for i := 0; i < 100; i++ {
+		stats, err := n.TopicStats(md)
+		c.Assert(err, qt.IsNil)
+		c.Assert(stats, qt.HasLen, 1)
+		c.Assert(stats[0].Subscriptions, qt.HasLen, 1)
+		if sub = stats[0].Subscriptions[0]; sub.Depth > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	c.Assert(sub.Subscription, qt.Equals, "send-welcome-email")
+	c.Assert(sub.Connected, qt.IsFalse)
+	c.Assert(sub.Depth, qt.Equals, int64(1))
+}
+
+func TestEncodeMessage(t *testing.T) {
+	c := qt.New(t)
+	md := testMeta()
+	data := []byte(`{"user_id": 1, "region": "eu"}`)
+
+	// Go apps use the Go runtime's message format.
+	id, encoded, err := encodeMessage(md, md.PubsubTopics[0], data)
+	c.Assert(err, qt.IsNil)
+	var goMsg goMessage
+	c.Assert(json.Unmarshal(encoded, &goMsg), qt.IsNil)
+	c.Assert(goMsg, qt.DeepEquals, goMessage{ID: id, Attributes: map[string]string{"region": "eu"}, Data: json.RawMessage(`{"user_id":1}`)})
+
+	// TypeScript apps use the core runtime's message format.
+	md.Language = meta.Lang_TYPESCRIPT
+	id, encoded, err = encodeMessage(md, md.PubsubTopics[0], []byte(`{"user_id": 1, "Email": "", "Tags": [], "region": "eu"}`))
+	c.Assert(err, qt.IsNil)
+	var tsMsg tsMessage
+	c.Assert(json.Unmarshal(encoded, &tsMsg), qt.IsNil)
+	c.Assert(tsMsg, qt.DeepEquals, tsMessage{ID: id, Attrs: map[string]string{"region": "eu"}, Body: json.RawMessage(`{"Email":"","Tags":[],"user_id":1}`)})
+}
@@ -0,0 +1,348 @@
+package redis
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	meta "encr.dev/proto/encore/parser/meta/v1"
+	schema "encr.dev/proto/encore/parser/schema/v1"
+)
+
+// ErrKeyNotFound is reported when a cache key does not exist.
+var ErrKeyNotFound = errors.New("cache key not found")
+
+// Entry describes a single cache entry, decoded according
+// to the value type of the keyspace it belongs to.
+type Entry struct {
+	Key string `json:"key"`
+	// Type is the Redis data type of the entry: "string", "list" or "set".
+	Type string `json:"type"`
+	// Value is the decoded value. It's omitted when listing keys without values.
+	Value any `json:"value,omitempty"`
+	// TTL is the remaining time to live. It's empty if the entry never expires.
+	TTL string `json:"ttl,omitempty"`
+}
+
+// KeyspacePath renders the keyspace's key pattern, such as "user/:id".
+func KeyspacePath(ks *meta.CacheCluster_Keyspace) string {
+	var b strings.Builder
+	for i, seg := range ks.GetPathPattern().GetSegments() {
+		if i > 0 {
+			b.WriteByte('/')
+		}
+		if seg.Type != meta.PathSegment_LITERAL {
+			b.WriteByte(':')
+		}
+		b.WriteString(seg.Value)
+	}
+	return b.String()
+}
+
+// FindKeyspace finds the keyspace in the given cache cluster with the given key pattern.
+// The pattern is matched with or without the ':' prefix for parameters.
+func FindKeyspace(md *meta.Data, clusterName, pattern string) (*meta.CacheCluster_Keyspace, error) {
+	normalize := func(p string) string {
+		segs := strings.Split(p, "/")
+		for i, s := range segs {
+			segs[i] = strings.TrimLeft(s, ":*")
+		}
+		return strings.Join(segs, "/")
+	}
+
+	want := normalize(pattern)
+	for _, cluster := range md.CacheClusters {
+		if cluster.Name != clusterName {
+			continue
+		}
+		for _, ks := range cluster.Keyspaces {
+			if normalize(KeyspacePath(ks)) == want {
+				return ks, nil
+			}
+		}
+		return nil, errors.Newf("keyspace %q not found in cache cluster %q", pattern, clusterName)
+	}
+	return nil, errors.Newf("cache cluster %q not found", clusterName)
+}
+
+// keyspaceRegexp returns a regexp matching the keys belonging to the keyspace.
+func keyspaceRegexp(ks *meta.CacheCluster_Keyspace) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteByte('^')
+	for i, seg := range ks.GetPathPattern().GetSegments() {
+		if i > 0 {
+			b.WriteByte('/')
+		}
+		if seg.Type == meta.PathSegment_LITERAL {
+			b.WriteString(regexp.QuoteMeta(seg.Value))
+		} else {
+			// Parameters never contain unescaped slashes,
+			// since the key mapper escapes them as `\/`.
+			b.WriteString(`(?:\\/|[^/])*`)
+		}
+	}
+	b.WriteByte('$')
+	return regexp.MustCompile(b.String())
+}
+
+// KeyspaceEntries lists up to limit entries belonging to the keyspace, ordered by key.
+// If withValues is false the entries' values are not decoded.
+// It reports whether there were more entries than the limit.
+func (s *Server) KeyspaceEntries(ks *meta.CacheCluster_Keyspace, limit int, withValues bool) (entries []*Entry, truncated bool, err error) {
+	re := keyspaceRegexp(ks)
+	for _, key := range s.mini.Keys() {
+		if !re.MatchString(key) {
+			continue
+		}
+		if len(entries) >= limit {
+			return entries, true, nil
+		}
+
+		var e *Entry
+		if withValues {
+			e, err = s.entry(ks, key)
+			if errors.Is(err, ErrKeyNotFound) {
+				continue
+			} else if err != nil {
+				return nil, false, err
+			}
+		} else {
+			e = &Entry{Key: key, Type: s.mini.Type(key), TTL: s.ttl(key)}
+		}
+		entries = append(entries, e)
+	}
+	return entries, false, nil
+}
+
+// GetEntry returns the entry with the given key in the keyspace.
+func (s *Server) GetEntry(ks *meta.CacheCluster_Keyspace, key string) (*Entry, error) {
+	if !keyspaceRegexp(ks).MatchString(key) {
+		return nil, errors.Newf("key %q does not match keyspace pattern %q", key, KeyspacePath(ks))
+	}
+	return s.entry(ks, key)
+}
+
+func (s *Server) entry(ks *meta.CacheCluster_Keyspace, key string) (*Entry, error) {
+	e := &Entry{Key: key, Type: s.mini.Type(key)}
+
+	var err error
+	switch e.Type {
+	case "":
+		return nil, ErrKeyNotFound
+	case "string":
+		var raw string
+		if raw, err = s.mini.Get(key); err == nil {
+			e.Value = decodeValue(raw, ks.ValueType)
+		}
+	case "list", "set":
+		var raws []string
+		if e.Type == "list" {
+			raws, err = s.mini.List(key)
+		} else {
+			raws, err = s.mini.Members(key)
+		}
+		vals := make([]any, len(raws))
+		for i, raw := range raws {
+			vals[i] = decodeValue(raw, ks.ValueType)
+		}
+		e.Value = vals
+	default:
+		return nil, errors.Newf("unsupported type %q for key %q", e.Type, key)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "read key %q", key)
+	}
+
+	e.TTL = s.ttl(key)
+	return e, nil
+}
+
+// SetEntry sets the value of the entry with the given key in the keyspace.
+//
+// The value is given as JSON and is encoded according to the keyspace's value type.
+// The typ is the Redis data type to store ("string", "list" or "set"); if empty,
+// the type of the existing entry is used, defaulting to "string".
+//
+// If ttl is positive it becomes the entry's time to live;
+// otherwise the existing time to live (if any) is kept.
+func (s *Server) SetEntry(ks *meta.CacheCluster_Keyspace, key, typ string, value json.RawMessage, ttl time.Duration) error {
+	if !keyspaceRegexp(ks).MatchString(key) {
+		return errors.Newf("key %q does not match keyspace pattern %q", key, KeyspacePath(ks))
+	}
+
+	existing := s.mini.Type(key)
+	if typ == "" {
+		typ = existing
+		if typ == "" {
+			typ = "string"
+		}
+	}
+	if ttl <= 0 {
+		ttl = s.mini.TTL(key)
+	}
+
+	switch typ {
+	case "string":
+		raw, err := encodeValue(value, ks.ValueType)
+		if err != nil {
+			return err
+		}
+		s.mini.Del(key)
+		if err := s.mini.Set(key, raw); err != nil {
+			return errors.Wrapf(err, "set key %q", key)
+		}
+	case "list", "set":
+		var elems []json.RawMessage
+		if err := json.Unmarshal(value, &elems); err != nil {
+			return errors.Newf("value for a %s must be a JSON array", typ)
+		}
+		raws := make([]string, len(elems))
+		for i, elem := range elems {
+			raw, err := encodeValue(elem, ks.ValueType)
+			if err != nil {
+				return errors.Wrapf(err, "element %d", i)
+			}
+			raws[i] = raw
+		}
+
+		s.mini.Del(key)
+		if len(raws) == 0 {
+			// Redis has no empty lists or sets.
+			return nil
+		}
+		var err error
+		if typ == "list" {
+			_, err = s.mini.Push(key, raws...)
+		} else {
+			_, err = s.mini.SetAdd(key, raws...)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "set key %q", key)
+		}
+	default:
+		return errors.Newf("unsupported type %q: must be one of string, list or set", typ)
+	}
+
+	if ttl > 0 {
+		s.mini.SetTTL(key, ttl)
+	}
+	return nil
+}
+
+// DeleteEntries deletes the entries with the given keys in the keyspace.
+// It reports the number of entries that were deleted.
+func (s *Server) DeleteEntries(ks *meta.CacheCluster_Keyspace, keys []string) (int, error) {
+	re := keyspaceRegexp(ks)
+	for _, key := range keys {
+		if !re.MatchString(key) {
+			return 0, errors.Newf("key %q does not match keyspace pattern %q", key, KeyspacePath(ks))
+		}
+	}
+
+	deleted := 0
+	for _, key := range keys {
+		if s.mini.Del(key) {
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (s *Server) ttl(key string) string {
+	if ttl := s.mini.TTL(key); ttl > 0 {
+		return ttl.String()
+	}
+	return ""
+}
+
+// decodeValue decodes a raw Redis value according to the keyspace value type.
+// Values that cannot be decoded are returned as the raw string.
+func decodeValue(raw string, typ *schema.Type) any {
+	b, isBuiltin := typ.GetTyp().(*schema.Type_Builtin)
+	if !isBuiltin {
+		// Struct values are stored as JSON.
+		if json.Valid([]byte(raw)) {
+			return json.RawMessage(raw)
+		}
+		return raw
+	}
+
+	switch b.Builtin {
+	case schema.Builtin_BOOL:
+		if v, err := strconv.ParseBool(raw); err == nil {
+			return v
+		}
+	case schema.Builtin_INT, schema.Builtin_INT8, schema.Builtin_INT16, schema.Builtin_INT32, schema.Builtin_INT64:
+		if _, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			return json.Number(raw)
+		}
+	case schema.Builtin_UINT, schema.Builtin_UINT8, schema.Builtin_UINT16, schema.Builtin_UINT32, schema.Builtin_UINT64:
+		if _, err := strconv.ParseUint(raw, 10, 64); err == nil {
+			return json.Number(raw)
+		}
+	case schema.Builtin_FLOAT32, schema.Builtin_FLOAT64:
+		if _, err := strconv.ParseFloat(raw, 64); err == nil {
+			return json.Number(raw)
+		}
+	case schema.Builtin_JSON:
+		if json.Valid([]byte(raw)) {
+			return json.RawMessage(raw)
+		}
+	}
+	return raw
+}
+
+// encodeValue encodes a JSON value for storage in Redis according
+// to the keyspace value type, matching the encoding used by the runtime.
+func encodeValue(value json.RawMessage, typ *schema.Type) (string, error) {
+	b, isBuiltin := typ.GetTyp().(*schema.Type_Builtin)
+	if !isBuiltin || b.Builtin == schema.Builtin_JSON {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, value); err != nil {
+			return "", errors.Wrap(err, "invalid JSON value")
+		}
+		return buf.String(), nil
+	}
+
+	switch b.Builtin {
+	case schema.Builtin_BOOL:
+		var v bool
+		if err := json.Unmarshal(value, &v); err != nil {
+			return "", errors.New("value must be a boolean")
+		}
+		// Matches how the Redis client encodes booleans.
+		if v {
+			return "1", nil
+		}
+		return "0", nil
+	case schema.Builtin_INT, schema.Builtin_INT8, schema.Builtin_INT16, schema.Builtin_INT32, schema.Builtin_INT64:
+		var v int64
+		if err := json.Unmarshal(value, &v); err != nil {
+			return "", errors.New("value must be an integer")
+		}
+		return strconv.FormatInt(v, 10), nil
+	case schema.Builtin_UINT, schema.Builtin_UINT8, schema.Builtin_UINT16, schema.Builtin_UINT32, schema.Builtin_UINT64:
+		var v uint64
+		if err := json.Unmarshal(value, &v); err != nil {
+			return "", errors.New("value must be a non-negative integer")
+		}
+		return strconv.FormatUint(v, 10), nil
+	case schema.Builtin_FLOAT32, schema.Builtin_FLOAT64:
+		var v float64
+		if err := json.Unmarshal(value, &v); err != nil {
+			return "", errors.New("value must be a number")
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		var v string
+		if err := json.Unmarshal(value, &v); err != nil {
+			return "", errors.New("value must be a string")
+		}
+		return v, nil
+	}
+}
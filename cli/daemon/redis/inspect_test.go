@@ -0,0 +1,72 @@
+package redis
+
+import (
+	"encoding/json"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	meta "encr.dev/proto/encore/parser/meta/v1"
+	schema "encr.dev/proto/encore/parser/schema/v1"
+)
+
+func TestKeyspaceEntries(t *testing.T) {
+	c := qt.New(t)
+
+	ks := &meta.CacheCluster_Keyspace{
+		ValueType: &schema.Type{Typ: &schema.Type_Builtin{Builtin: schema.Builtin_INT64}},
+		PathPattern: &meta.Path{Segments: []*meta.PathSegment{
+			{Type: meta.PathSegment_LITERAL, Value: "user"},
+			{Type: meta.PathSegment_PARAM, Value: "id"},
+		}},
+	}
+	c.Assert(KeyspacePath(ks), qt.Equals, "user/:id")
+
+	srv := New()
+	c.Assert(srv.SetEntry(ks, "user/1", "", json.RawMessage("42"), 0), qt.IsNil)
+	c.Assert(srv.SetEntry(ks, `user/a\/b`, "list", json.RawMessage("[1, 2]"), 0), qt.IsNil)
+	c.Assert(srv.mini.Set("user/1/extra", "nope"), qt.IsNil)
+	c.Assert(srv.mini.Set("other/1", "nope"), qt.IsNil)
+
+	// Values must match the keyspace's value type.
+	c.Assert(srv.SetEntry(ks, "user/2", "", json.RawMessage(`"str"`), 0), qt.ErrorMatches, "value must be an integer")
+	// Keys must match the keyspace's pattern.
+	c.Assert(srv.SetEntry(ks, "other/2", "", json.RawMessage("1"), 0), qt.ErrorMatches, `key "other/2" does not match .*`)
+
+	entries, truncated, err := srv.KeyspaceEntries(ks, 10, true)
+	c.Assert(err, qt.IsNil)
+	c.Assert(truncated, qt.IsFalse)
+	c.Assert(entries, qt.DeepEquals, []*Entry{
+		{Key: "user/1", Type: "string", Value: json.Number("42")},
+		{Key: `user/a\/b`, Type: "list", Value: []any{json.Number("1"), json.Number("2")}},
+	})
+
+	_, truncated, err = srv.KeyspaceEntries(ks, 1, false)
+	c.Assert(err, qt.IsNil)
+	c.Assert(truncated, qt.IsTrue)
+
+	n, err := srv.DeleteEntries(ks, []string{"user/1", "user/3"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(n, qt.Equals, 1)
+	_, err = srv.GetEntry(ks, "user/1")
+	c.Assert(err, qt.Equals, ErrKeyNotFound)
+}
+
+func TestFindKeyspace(t *testing.T) {
+	c := qt.New(t)
+	ks := &meta.CacheCluster_Keyspace{PathPattern: &meta.Path{Segments: []*meta.PathSegment{
+		{Type: meta.PathSegment_LITERAL, Value: "user"},
+		{Type: meta.PathSegment_PARAM, Value: "id"},
+	}}}
+	md := &meta.Data{CacheClusters: []*meta.CacheCluster{{Name: "cluster", Keyspaces: []*meta.CacheCluster_Keyspace{ks}}}}
+
+	for _, pattern := range []string{"user/:id", "user/id"} {
+		got, err := FindKeyspace(md, "cluster", pattern)
+		c.Assert(err, qt.IsNil)
+		c.Assert(got, qt.Equals, ks)
+	}
+	_, err := FindKeyspace(md, "cluster", "user/:id/x")
+	c.Assert(err, qt.ErrorMatches, `keyspace "user/:id/x" not found in cache cluster "cluster"`)
+	_, err = FindKeyspace(md, "missing", "user/:id")
+	c.Assert(err, qt.ErrorMatches, `cache cluster "missing" not found`)
+}
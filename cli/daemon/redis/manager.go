@@ -0,0 +1,196 @@
+package redis
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"encr.dev/cli/daemon/apps"
+	"encr.dev/cli/daemon/namespace"
+)
+
+// NewClusterManager creates a new ClusterManager.
+func NewClusterManager() *ClusterManager {
+	return &ClusterManager{
+		servers: make(map[namespace.ID]*sharedServer),
+	}
+}
+
+// ClusterManager manages the persistent Redis servers, one per namespace.
+// A namespace's server is shared between all the runs that use it,
+// and its contents are persisted to disk when the last user releases it.
+type ClusterManager struct {
+	mu      sync.Mutex
+	servers map[namespace.ID]*sharedServer
+}
+
+type sharedServer struct {
+	srv  *Server
+	refs int
+}
+
+// BaseDir returns the directory where the Redis data for the given namespace is stored.
+func (cm *ClusterManager) BaseDir(ns namespace.ID) (string, error) {
+	cache, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cache, "encore", "redis", ns.String()), nil
+}
+
+func (cm *ClusterManager) snapshotPath(ns namespace.ID) (string, error) {
+	baseDir, err := cm.BaseDir(ns)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(baseDir, "snapshot.json"), nil
+}
+
+// Acquire returns the Redis server for the given namespace,
+// starting it and restoring its persisted contents if necessary.
+// Each call must be paired with a call to Release.
+func (cm *ClusterManager) Acquire(ns namespace.ID) (*Server, error) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if s, ok := cm.servers[ns]; ok {
+		s.refs++
+		return s.srv, nil
+	}
+
+	path, err := cm.snapshotPath(ns)
+	if err != nil {
+		return nil, err
+	}
+	srv := NewPersistent(path)
+	if err := srv.Start(); err != nil {
+		return nil, err
+	}
+	cm.servers[ns] = &sharedServer{srv: srv, refs: 1}
+	return srv, nil
+}
+
+// Release releases a server previously returned by Acquire.
+// When the last user releases the server it is persisted and stopped.
+func (cm *ClusterManager) Release(ns namespace.ID) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	s, ok := cm.servers[ns]
+	if !ok {
+		return
+	}
+	s.refs--
+	if s.refs <= 0 {
+		delete(cm.servers, ns)
+		s.srv.Stop()
+	}
+}
+
+// Use calls fn with the Redis server for the given namespace.
+// If the server isn't already running it's started from its persisted
+// contents for the duration of the call, and any changes are persisted.
+func (cm *ClusterManager) Use(ns namespace.ID, fn func(srv *Server) error) error {
+	srv, err := cm.Acquire(ns)
+	if err != nil {
+		return err
+	}
+	defer cm.Release(ns)
+	return fn(srv)
+}
+
+// Get returns the running Redis server for the given namespace, if any.
+func (cm *ClusterManager) Get(ns namespace.ID) (*Server, bool) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	if s, ok := cm.servers[ns]; ok {
+		return s.srv, true
+	}
+	return nil, false
+}
+
+// Flush removes all keys from the cache of the given namespace,
+// whether or not its server is currently running.
+func (cm *ClusterManager) Flush(ns namespace.ID) error {
+	if srv, ok := cm.Get(ns); ok {
+		return srv.Flush()
+	}
+
+	path, err := cm.snapshotPath(ns)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Wrap(err, "remove snapshot")
+	}
+	return nil
+}
+
+// Dump returns a snapshot of the cache of the given namespace.
+// If the server is not running the persisted snapshot is returned.
+func (cm *ClusterManager) Dump(ns namespace.ID) (*Snapshot, error) {
+	if srv, ok := cm.Get(ns); ok {
+		return srv.Snapshot(), nil
+	}
+
+	path, err := cm.snapshotPath(ns)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := readSnapshotFile(path)
+	if err != nil {
+		return nil, err
+	} else if snap == nil {
+		snap = &Snapshot{Version: snapshotVersion}
+	}
+	return snap, nil
+}
+
+// Restore replaces the cache of the given namespace with the snapshot.
+func (cm *ClusterManager) Restore(ns namespace.ID, snap *Snapshot) error {
+	if srv, ok := cm.Get(ns); ok {
+		return srv.Restore(snap)
+	}
+
+	path, err := cm.snapshotPath(ns)
+	if err != nil {
+		return err
+	}
+	return writeSnapshotFile(path, snap)
+}
+
+// Close persists the contents of all running servers.
+// It's called when the daemon shuts down.
+func (cm *ClusterManager) Close() error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	var errs []error
+	for _, s := range cm.servers {
+		if err := s.srv.Save(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CanDeleteNamespace implements namespace.DeletionHandler.
+func (cm *ClusterManager) CanDeleteNamespace(ctx context.Context, app *apps.Instance, ns *namespace.Namespace) error {
+	if _, ok := cm.Get(ns.ID); ok {
+		return errors.New("the namespace's cache is in use")
+	}
+	return nil
+}
+
+// DeleteNamespace implements namespace.DeletionHandler.
+func (cm *ClusterManager) DeleteNamespace(ctx context.Context, app *apps.Instance, ns *namespace.Namespace) error {
+	baseDir, err := cm.BaseDir(ns.ID)
+	if err == nil {
+		err = os.RemoveAll(baseDir)
+	}
+	return err
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
 	"go4.org/syncutil"
 
 	meta "encr.dev/proto/encore/parser/meta/v1"
@@ -17,10 +18,15 @@ type Server struct {
 	cleanup   *time.Ticker
 	quit      chan struct{}
 	addr      string
+
+	// snapshotPath is the file the server's contents are persisted to.
+	// If empty the server is purely in-memory.
+	snapshotPath string
 }
 
 const tickInterval = 1 * time.Second
 
+// New creates a new in-memory Redis server.
 func New() *Server {
 	return &Server{
 		mini: miniredis.NewMiniRedis(),
@@ -28,8 +34,30 @@ func New() *Server {
 	}
 }
 
+// NewPersistent creates a new Redis server that restores its contents
+// from the snapshot at snapshotPath when started, and saves them back
+// periodically and when stopped.
+func NewPersistent(snapshotPath string) *Server {
+	srv := New()
+	srv.snapshotPath = snapshotPath
+	return srv
+}
+
 func (s *Server) Start() error {
 	return s.startOnce.Do(func() error {
+		if s.snapshotPath != "" {
+			if snap, err := readSnapshotFile(s.snapshotPath); err != nil {
+				// Don't fail the whole run because of a corrupt snapshot;
+				// start with an empty cache instead.
+				log.Error().Err(err).Str("path", s.snapshotPath).Msg("redis: unable to read snapshot, starting empty")
+			} else if snap != nil {
+				if err := restoreSnapshot(s.mini, snap, time.Now()); err != nil {
+					log.Error().Err(err).Str("path", s.snapshotPath).Msg("redis: unable to restore snapshot, starting empty")
+					s.mini.FlushAll()
+				}
+			}
+		}
+
 		if err := s.mini.Start(); err != nil {
 			return errors.Wrap(err, "failed to start redis server")
 		}
@@ -39,10 +67,42 @@ func (s *Server) Start() error {
 		return nil
 	})
 }
+
 func (s *Server) Stop() {
-	s.mini.Close()
 	s.cleanup.Stop()
 	close(s.quit)
+	if err := s.Save(); err != nil {
+		log.Error().Err(err).Str("path", s.snapshotPath).Msg("redis: unable to save snapshot")
+	}
+	s.mini.Close()
+}
+
+// Save persists the server's contents to its snapshot file.
+// It is a no-op for in-memory servers.
+func (s *Server) Save() error {
+	if s.snapshotPath == "" {
+		return nil
+	}
+	return writeSnapshotFile(s.snapshotPath, s.Snapshot())
+}
+
+// Snapshot returns a copy of the server's current contents.
+func (s *Server) Snapshot() *Snapshot {
+	return takeSnapshot(s.mini, time.Now())
+}
+
+// Restore replaces the server's contents with the given snapshot.
+func (s *Server) Restore(snap *Snapshot) error {
+	if err := restoreSnapshot(s.mini, snap, time.Now()); err != nil {
+		return err
+	}
+	return s.Save()
+}
+
+// Flush removes all keys from the server.
+func (s *Server) Flush() error {
+	s.mini.FlushAll()
+	return s.Save()
 }
 
 func (s *Server) Miniredis() *miniredis.Miniredis {
@@ -58,8 +118,11 @@ func (s *Server) Addr() string {
 }
 
 func (s *Server) doCleanup() {
-	var acc time.Duration
-	const cleanupInterval = 15 * time.Second
+	var acc, persistAcc time.Duration
+	const (
+		cleanupInterval = 15 * time.Second
+		persistInterval = 30 * time.Second
+	)
 
 	for {
 		select {
@@ -75,6 +138,16 @@ func (s *Server) doCleanup() {
 			acc -= cleanupInterval
 			s.clearKeys()
 		}
+
+		// Persist the contents every so often so that little
+		// is lost if the daemon exits without stopping the server.
+		persistAcc += tickInterval
+		if persistAcc > persistInterval {
+			persistAcc -= persistInterval
+			if err := s.Save(); err != nil {
+				log.Error().Err(err).Str("path", s.snapshotPath).Msg("redis: unable to save snapshot")
+			}
+		}
 	}
 }
 
@@ -0,0 +1,216 @@
+package redis
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// snapshotVersion is the current version of the snapshot format.
+const snapshotVersion = 1
+
+// Snapshot is a serializable copy of the contents of a Redis server.
+type Snapshot struct {
+	Version int            `json:"version"`
+	TakenAt time.Time      `json:"taken_at"`
+	Keys    []*SnapshotKey `json:"keys"`
+}
+
+// SnapshotKey is a single key in a Snapshot.
+// Only the field corresponding to Type is set.
+type SnapshotKey struct {
+	Key  string `json:"key"`
+	Type string `json:"type"`
+
+	// ExpiresAt is when the key expires, if it has a TTL.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	String    *string            `json:"string,omitempty"`
+	List      []string           `json:"list,omitempty"`
+	Set       []string           `json:"set,omitempty"`
+	Hash      map[string]string  `json:"hash,omitempty"`
+	SortedSet map[string]float64 `json:"zset,omitempty"`
+	Stream    []StreamEntry      `json:"stream,omitempty"`
+}
+
+// StreamEntry is a single entry in a Redis stream.
+type StreamEntry struct {
+	ID     string   `json:"id"`
+	Values []string `json:"values"`
+}
+
+// takeSnapshot copies the contents of mini into a snapshot.
+// Keys of unsupported types (such as HyperLogLogs) are skipped.
+func takeSnapshot(mini *miniredis.Miniredis, now time.Time) *Snapshot {
+	snap := &Snapshot{
+		Version: snapshotVersion,
+		TakenAt: now,
+	}
+
+	keys := mini.Keys()
+	sort.Strings(keys)
+	for _, key := range keys {
+		k := &SnapshotKey{Key: key, Type: mini.Type(key)}
+
+		var err error
+		switch k.Type {
+		case "string":
+			var val string
+			if val, err = mini.Get(key); err == nil {
+				k.String = &val
+			}
+		case "list":
+			k.List, err = mini.List(key)
+		case "set":
+			k.Set, err = mini.Members(key)
+		case "hash":
+			var fields []string
+			if fields, err = mini.HKeys(key); err == nil {
+				k.Hash = make(map[string]string, len(fields))
+				for _, f := range fields {
+					k.Hash[f] = mini.HGet(key, f)
+				}
+			}
+		case "zset":
+			k.SortedSet, err = mini.SortedSet(key)
+		case "stream":
+			var entries []miniredis.StreamEntry
+			if entries, err = mini.Stream(key); err == nil {
+				for _, e := range entries {
+					k.Stream = append(k.Stream, StreamEntry{ID: e.ID, Values: e.Values})
+				}
+			}
+		case "":
+			// The key expired or was deleted after we listed it.
+			continue
+		default:
+			log.Debug().Str("key", key).Str("type", k.Type).Msg("redis: skipping unsupported key type in snapshot")
+			continue
+		}
+
+		if err != nil {
+			// The key was most likely modified concurrently; skip it.
+			log.Debug().Err(err).Str("key", key).Msg("redis: unable to snapshot key")
+			continue
+		}
+
+		if ttl := mini.TTL(key); ttl > 0 {
+			exp := now.Add(ttl)
+			k.ExpiresAt = &exp
+		}
+		snap.Keys = append(snap.Keys, k)
+	}
+
+	return snap
+}
+
+// restoreSnapshot replaces the contents of mini with the snapshot.
+// Keys that have expired since the snapshot was taken are not restored.
+func restoreSnapshot(mini *miniredis.Miniredis, snap *Snapshot, now time.Time) error {
+	if snap.Version > snapshotVersion {
+		return errors.Newf("unsupported snapshot version %d", snap.Version)
+	}
+
+	mini.FlushAll()
+	for _, k := range snap.Keys {
+		var ttl time.Duration
+		if k.ExpiresAt != nil {
+			if ttl = k.ExpiresAt.Sub(now); ttl <= 0 {
+				continue
+			}
+		}
+
+		var err error
+		switch k.Type {
+		case "string":
+			if k.String != nil {
+				err = mini.Set(k.Key, *k.String)
+			}
+		case "list":
+			_, err = mini.Push(k.Key, k.List...)
+		case "set":
+			_, err = mini.SetAdd(k.Key, k.Set...)
+		case "hash":
+			fv := make([]string, 0, 2*len(k.Hash))
+			for f, v := range k.Hash {
+				fv = append(fv, f, v)
+			}
+			mini.HSet(k.Key, fv...)
+		case "zset":
+			for member, score := range k.SortedSet {
+				if _, err = mini.ZAdd(k.Key, score, member); err != nil {
+					break
+				}
+			}
+		case "stream":
+			for _, e := range k.Stream {
+				if _, err = mini.XAdd(k.Key, e.ID, e.Values); err != nil {
+					break
+				}
+			}
+		default:
+			err = errors.Newf("unsupported type %q", k.Type)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "restore key %q", k.Key)
+		}
+
+		if ttl > 0 {
+			mini.SetTTL(k.Key, ttl)
+		}
+	}
+	return nil
+}
+
+// ParseSnapshot parses a JSON-encoded snapshot.
+func ParseSnapshot(data []byte) (*Snapshot, error) {
+	var snap Snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, errors.Wrap(err, "parse snapshot")
+	}
+	return &snap, nil
+}
+
+// readSnapshotFile reads the snapshot stored at path.
+// If the file does not exist it reports (nil, nil).
+func readSnapshotFile(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "read snapshot")
+	}
+	return ParseSnapshot(data)
+}
+
+// writeSnapshotFile atomically writes the snapshot to path.
+func writeSnapshotFile(path string, snap *Snapshot) error {
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return errors.Wrap(err, "marshal snapshot")
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrap(err, "create snapshot dir")
+	}
+	tmp, err := os.CreateTemp(dir, "snapshot-*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "create snapshot")
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }() // no-op after a successful rename
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return errors.Wrap(err, "write snapshot")
+	} else if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "write snapshot")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), path), "write snapshot")
+}
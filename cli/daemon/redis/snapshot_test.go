@@ -0,0 +1,57 @@
+package redis
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	qt "github.com/frankban/quicktest"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	c := qt.New(t)
+	now := time.Now()
+
+	src := miniredis.NewMiniRedis()
+	c.Assert(src.Set("str", "value"), qt.IsNil)
+	_, _ = src.Push("list", "a", "b", "c")
+	_, _ = src.SetAdd("set", "x", "y")
+	src.HSet("hash", "f1", "v1", "f2", "v2")
+	_, _ = src.ZAdd("zset", 1.5, "m")
+	c.Assert(src.Set("ttl", "soon"), qt.IsNil)
+	src.SetTTL("ttl", time.Minute)
+
+	snap := takeSnapshot(src, now)
+	path := filepath.Join(c.TempDir(), "snapshot.json")
+	c.Assert(writeSnapshotFile(path, snap), qt.IsNil)
+	loaded, err := readSnapshotFile(path)
+	c.Assert(err, qt.IsNil)
+
+	dst := miniredis.NewMiniRedis()
+	c.Assert(restoreSnapshot(dst, loaded, now.Add(time.Second)), qt.IsNil)
+
+	got, _ := dst.Get("str")
+	c.Assert(got, qt.Equals, "value")
+	list, _ := dst.List("list")
+	c.Assert(list, qt.DeepEquals, []string{"a", "b", "c"})
+	members, _ := dst.Members("set")
+	c.Assert(members, qt.DeepEquals, []string{"x", "y"})
+	c.Assert(dst.HGet("hash", "f2"), qt.Equals, "v2")
+	zset, _ := dst.SortedSet("zset")
+	c.Assert(zset, qt.DeepEquals, map[string]float64{"m": 1.5})
+	c.Assert(dst.TTL("ttl"), qt.Equals, time.Minute-time.Second)
+
+	// Keys that expired while the snapshot was stored are not restored.
+	dst = miniredis.NewMiniRedis()
+	c.Assert(restoreSnapshot(dst, loaded, now.Add(2*time.Minute)), qt.IsNil)
+	c.Assert(dst.Exists("ttl"), qt.IsFalse)
+	c.Assert(dst.Exists("str"), qt.IsTrue)
+}
+
+func TestReadMissingSnapshot(t *testing.T) {
+	c := qt.New(t)
+	snap, err := readSnapshotFile(filepath.Join(c.TempDir(), "missing.json"))
+	c.Assert(err, qt.IsNil)
+	c.Assert(snap, qt.IsNil)
+}
@@ -255,7 +255,7 @@ func (s *Server) Run(req *daemonpb.RunRequest, stream daemonpb.Daemon_RunServer)
 			return
 		case <-time.After(5 * time.Second):
 			if proc := runInstance.ProcGroup(); proc != nil {
-				showFirstRunExperience(runInstance, proc.Meta, stderr)
+				showFirstRunExperience(runInstance, proc.Meta(), stderr)
 			}
 		}
 	}()
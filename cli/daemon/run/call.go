@@ -43,7 +43,8 @@ func CallAPI(ctx context.Context, run *Run, p *ApiCallParams) (map[string]any, e
 		return nil, fmt.Errorf("app not running")
 	}
 
-	rpc := findRPC(proc.Meta, p.Service, p.Endpoint)
+	md := proc.Meta()
+	rpc := findRPC(md, p.Service, p.Endpoint)
 	if rpc == nil {
 		return nil, fmt.Errorf("unknown service/endpoint: %s/%s", p.Service, p.Endpoint)
 	}
@@ -52,7 +53,7 @@ func CallAPI(ctx context.Context, run *Run, p *ApiCallParams) (map[string]any, e
 	}
 
 	baseURL := "http://" + run.ListenAddr
-	req, err := prepareRequest(ctx, baseURL, proc.Meta, p)
+	req, err := prepareRequest(ctx, baseURL, md, p)
 	if err != nil {
 		log.Error().Err(err).Msg("dash: unable to prepare request")
 		return nil, err
@@ -72,7 +73,7 @@ func CallAPI(ctx context.Context, run *Run, p *ApiCallParams) (map[string]any, e
 
 	// Encode the body back into a Go style struct
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		body = handleResponse(proc.Meta, p, resp.Header, body)
+		body = handleResponse(md, p, resp.Header, body)
 	}
 
 	log.Info().Int("status", resp.StatusCode).Msg("dash: api call completed")
@@ -0,0 +1,137 @@
+package run
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/cockroachdb/errors"
+
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// runDataMigrations runs the pending data migrations of the app's databases
+// in the process group p once it has started, so the data migrations
+// can call the app's APIs. They're run in order together with the SQL migrations.
+//
+// It's called before p starts serving traffic, and reports an error
+// if a data migration fails.
+func (r *Run) runDataMigrations(ctx context.Context, p *ProcGroup, md *meta.Data) error {
+	cluster := r.ResourceManager.GetSQLCluster()
+	if cluster == nil {
+		return nil
+	}
+
+	for _, dbMeta := range md.SqlDatabases {
+		if len(dbMeta.DataMigrations) == 0 {
+			continue
+		}
+		db, ok := cluster.GetDB(dbMeta.Name)
+		if !ok {
+			continue
+		}
+
+		// Data migrations run in the process hosting the database's data migrations.
+		proc, ok := p.procForPkg(md, dbMeta.DataMigrations[0].Pkg)
+		if !ok {
+			continue
+		}
+
+		select {
+		case <-db.Ready():
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if err := proc.waitUntilReady(ctx); err != nil {
+			return err
+		}
+
+		err := db.RunDataMigrations(ctx, r.App.Root(), dbMeta, func(ctx context.Context, number uint64) error {
+			return proc.runDataMigration(ctx, dbMeta.Name, number)
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to migrate database %s", dbMeta.Name)
+		}
+	}
+	return nil
+}
+
+// procForPkg returns the process running the service containing the package
+// at relPath, or any process if the package is not part of a service
+// or all the services run in the same process.
+func (pg *ProcGroup) procForPkg(md *meta.Data, relPath string) (*Proc, bool) {
+	pg.procMu.Lock()
+	defer pg.procMu.Unlock()
+
+	for _, svc := range md.Svcs {
+		if relPath == svc.RelPath || strings.HasPrefix(relPath, svc.RelPath+"/") {
+			if p, ok := pg.Services[svc.Name]; ok {
+				return p, true
+			}
+		}
+	}
+	if len(pg.allProcesses) > 0 {
+		return pg.allProcesses[0], true
+	}
+	return nil, false
+}
+
+// waitUntilReady polls the health check of the process until it
+// reports that the process is ready to serve, or the context is canceled.
+func (p *Proc) waitUntilReady(ctx context.Context) error {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = 50 * time.Millisecond
+	b.MaxInterval = 500 * time.Millisecond
+	b.MaxElapsedTime = 0 // until the context is canceled
+
+	return backoff.Retry(func() error {
+		req, err := http.NewRequestWithContext(ctx, "GET", "http://"+p.listenAddr.String()+"/__encore/healthz", nil)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return errors.Newf("process not ready: %s", resp.Status)
+		}
+		return nil
+	}, backoff.WithContext(b, ctx))
+}
+
+// runDataMigration runs the data migration with the given number
+// of the given database in the process.
+func (p *Proc) runDataMigration(ctx context.Context, dbName string, number uint64) error {
+	u := fmt.Sprintf("http://%s/__encore/sqldb/data-migrations/%s/%d", p.listenAddr, url.PathEscape(dbName), number)
+	req, err := http.NewRequestWithContext(ctx, "POST", u, nil)
+	if err != nil {
+		return err
+	}
+	addAuthKeyToRequest(req, p.group.authKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	var errResp struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &errResp) == nil && errResp.Message != "" {
+		return errors.New(errResp.Message)
+	}
+	return errors.Newf("unexpected response: %s", resp.Status)
+}
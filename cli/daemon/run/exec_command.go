@@ -61,7 +61,7 @@ func (mgr *Manager) ExecCommand(ctx context.Context, p ExecCommandParams) (err e
 		return err
 	}
 
-	rm := infra.NewResourceManager(p.App, mgr.ClusterMgr, mgr.ObjectsMgr, mgr.PublicBuckets, p.NS, p.Environ, mgr.DBProxyPort, false)
+	rm := infra.NewResourceManager(p.App, mgr.ClusterMgr, mgr.ObjectsMgr, mgr.RedisMgr, mgr.PublicBuckets, p.NS, p.Environ, mgr.DBProxyPort, false)
 	defer rm.StopAll()
 
 	tracker := p.OpTracker
@@ -65,7 +65,7 @@ func (mgr *Manager) ExecScript(ctx context.Context, p ExecScriptParams) (err err
 		return err
 	}
 
-	rm := infra.NewResourceManager(p.App, mgr.ClusterMgr, mgr.ObjectsMgr, mgr.PublicBuckets, p.NS, p.Environ, mgr.DBProxyPort, false)
+	rm := infra.NewResourceManager(p.App, mgr.ClusterMgr, mgr.ObjectsMgr, mgr.RedisMgr, mgr.PublicBuckets, p.NS, p.Environ, mgr.DBProxyPort, false)
 	defer rm.StopAll()
 
 	tracker := p.OpTracker
@@ -13,9 +13,10 @@ import (
 )
 
 // ServeHTTP implements http.Handler by forwarding the request to the currently running process.
+// The request is recorded if traffic is being recorded for the run.
 func (r *Run) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	proc := r.proc.Load().(*ProcGroup)
-	proc.ProxyReq(w, req)
+	r.Traffic.Serve(w, req, http.HandlerFunc(proc.ProxyReq))
 }
 
 func addAuthKeyToRequest(req *http.Request, authKey config.EncoreAuthKey) {
@@ -0,0 +1,214 @@
+package run
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"encr.dev/pkg/watcher"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// changedServices reports which services need their processes restarted
+// to apply the given file changes, going from the app described by prev
+// to the app described by next.
+//
+// It reports ok == false if the changes cannot be applied by restarting
+// individual services: when they touch packages outside of services,
+// non-Go files or the auth handler, or when they change the metadata
+// of the app beyond the internals of its services.
+func changedServices(appRoot string, prev, next *meta.Data, events []watcher.Event) (svcs []string, ok bool) {
+	if prev == nil || next == nil || len(events) == 0 {
+		return nil, false
+	}
+
+	pkgs := make(map[string]*meta.Package, len(next.Pkgs))
+	for _, pkg := range next.Pkgs {
+		pkgs[pkg.RelPath] = pkg
+	}
+	prevPkgs := make(map[string]bool, len(prev.Pkgs))
+	for _, pkg := range prev.Pkgs {
+		prevPkgs[pkg.RelPath] = true
+	}
+
+	// Determine the packages with changes.
+	var changed []string
+	for _, ev := range events {
+		if ignoreEvent(ev) {
+			continue
+		}
+		if filepath.Ext(ev.Path) != ".go" {
+			return nil, false
+		} else if strings.HasSuffix(ev.Path, "_test.go") {
+			// Tests don't affect the running app.
+			continue
+		}
+
+		path := ev.Path
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(appRoot, path)
+		}
+		rel, err := filepath.Rel(appRoot, filepath.Dir(path))
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil, false
+		}
+		rel = filepath.ToSlash(rel)
+
+		pkg, found := pkgs[rel]
+		if !found || !prevPkgs[rel] || pkg.ServiceName == "" {
+			// Added, removed and shared packages affect the whole app.
+			return nil, false
+		}
+		if !slices.Contains(changed, rel) {
+			changed = append(changed, rel)
+		}
+	}
+
+	if !proto.Equal(metaContract(prev), metaContract(next)) {
+		return nil, false
+	}
+
+	// Packages within a service can be imported by other services,
+	// so find all the packages depending on the changed ones.
+	importedBy := importGraph(appRoot, next)
+	affected := make(map[string]bool)
+	var visit func(rel string)
+	visit = func(rel string) {
+		if affected[rel] {
+			return
+		}
+		affected[rel] = true
+		for _, importer := range importedBy[rel] {
+			visit(importer)
+		}
+	}
+	for _, rel := range changed {
+		visit(rel)
+	}
+
+	// The gateways run the auth handler and global middleware,
+	// so they must be restarted if any of those are affected.
+	if ah := next.AuthHandler; ah != nil && (affected[ah.PkgPath] || affected[relPkgPath(next.ModulePath, ah.PkgPath)]) {
+		return nil, false
+	}
+	for _, mw := range next.Middleware {
+		if mw.Global && mw.Name != nil && affected[mw.Name.Pkg] {
+			return nil, false
+		}
+	}
+
+	for rel := range affected {
+		pkg, found := pkgs[rel]
+		if !found || pkg.ServiceName == "" {
+			return nil, false
+		}
+		if !slices.Contains(svcs, pkg.ServiceName) {
+			svcs = append(svcs, pkg.ServiceName)
+		}
+	}
+	slices.Sort(svcs)
+	return svcs, true
+}
+
+// importGraph parses the imports of the packages of the app,
+// and returns the packages importing each package, by relative path.
+func importGraph(appRoot string, md *meta.Data) map[string][]string {
+	importedBy := make(map[string][]string)
+	fset := token.NewFileSet()
+	for _, pkg := range md.Pkgs {
+		dir := filepath.Join(appRoot, filepath.FromSlash(pkg.RelPath))
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		seen := make(map[string]bool)
+		for _, e := range entries {
+			name := e.Name()
+			if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+				continue
+			}
+			f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ImportsOnly)
+			if err != nil {
+				continue
+			}
+			for _, spec := range f.Imports {
+				path, err := strconv.Unquote(spec.Path.Value)
+				if err != nil {
+					continue
+				}
+				rel := relPkgPath(md.ModulePath, path)
+				if rel != "" && !seen[rel] {
+					seen[rel] = true
+					importedBy[rel] = append(importedBy[rel], pkg.RelPath)
+				}
+			}
+		}
+	}
+	return importedBy
+}
+
+// relPkgPath returns the path relative to the app root of the package
+// with the given import path, or "" if it's not part of the app module.
+func relPkgPath(modulePath, importPath string) string {
+	if importPath == modulePath {
+		return "."
+	}
+	if rel, ok := strings.CutPrefix(importPath, modulePath+"/"); ok {
+		return rel
+	}
+	return ""
+}
+
+// ignoredContractFields are the metadata fields that can change
+// without affecting anything outside of the service they're part of.
+var ignoredContractFields = map[protoreflect.Name]bool{
+	"app_revision":        true,
+	"uncommitted_changes": true,
+	"doc":                 true,
+	"loc":                 true,
+	"trace_nodes":         true,
+	"rpc_calls":           true,
+}
+
+// metaContract returns a copy of md without the fields that
+// don't affect the contracts between services.
+func metaContract(md *meta.Data) *meta.Data {
+	md = proto.Clone(md).(*meta.Data)
+	clearFields(md.ProtoReflect(), ignoredContractFields)
+	return md
+}
+
+// clearFields clears the fields with the given names, recursively.
+func clearFields(m protoreflect.Message, names map[protoreflect.Name]bool) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case names[fd.Name()]:
+			m.Clear(fd)
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					clearFields(mv.Message(), names)
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					clearFields(list.Get(i).Message(), names)
+				}
+			}
+		case fd.Message() != nil:
+			clearFields(v.Message(), names)
+		}
+		return true
+	})
+}
@@ -0,0 +1,244 @@
+package run
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"google.golang.org/protobuf/proto"
+
+	"encr.dev/pkg/watcher"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+	schema "encr.dev/proto/encore/parser/schema/v1"
+)
+
+// writeTestApp writes the Go files of a test app to a temporary directory.
+// The orders service imports a package of the users service,
+// and the auth service defines the auth handler.
+func writeTestApp(c *qt.C) string {
+	root := c.TempDir()
+	files := map[string]string{
+		"go.mod":              "module example.com/app\n",
+		"users/users.go":      "package users\n\nimport _ \"example.com/app/users/db\"\n",
+		"users/db/db.go":      "package db\n",
+		"orders/orders.go":    "package orders\n\nimport (\n\t_ \"example.com/app/pkg/util\"\n\t_ \"example.com/app/users/db\"\n)\n",
+		"auth/auth.go":        "package auth\n",
+		"pkg/util/util.go":    "package util\n",
+		"users/users_test.go": "package users\n\nimport _ \"example.com/app/orders\"\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		c.Assert(os.MkdirAll(filepath.Dir(path), 0755), qt.IsNil)
+		c.Assert(os.WriteFile(path, []byte(content), 0644), qt.IsNil)
+	}
+	return root
+}
+
+// testAppMeta returns the metadata of the app written by writeTestApp.
+func testAppMeta() *meta.Data {
+	return &meta.Data{
+		ModulePath:  "example.com/app",
+		AppRevision: "rev1",
+		Pkgs: []*meta.Package{
+			{RelPath: "users", Name: "users", ServiceName: "users"},
+			{RelPath: "users/db", Name: "db", ServiceName: "users"},
+			{RelPath: "orders", Name: "orders", ServiceName: "orders"},
+			{RelPath: "auth", Name: "auth", ServiceName: "auth"},
+			{RelPath: "pkg/util", Name: "util"},
+		},
+		Svcs: []*meta.Service{
+			{Name: "users", RelPath: "users", Rpcs: []*meta.RPC{{
+				Name:          "Get",
+				ServiceName:   "users",
+				AccessType:    meta.RPC_PUBLIC,
+				RequestSchema: &schema.Type{Typ: &schema.Type_Builtin{Builtin: schema.Builtin_STRING}},
+				Loc:           &schema.Loc{PkgPath: "users", StartPos: 10},
+			}}},
+			{Name: "orders", RelPath: "orders"},
+			{Name: "auth", RelPath: "auth"},
+		},
+		AuthHandler: &meta.AuthHandler{Name: "Auth", PkgPath: "example.com/app/auth", ServiceName: "auth"},
+	}
+}
+
+func TestChangedServices(t *testing.T) {
+	c := qt.New(t)
+	root := writeTestApp(c)
+
+	testCases := map[string]struct {
+		paths    []string         // the changed files, relative to the app root
+		absPaths []string         // the changed files, as absolute paths
+		modify   func(*meta.Data) // modifies the metadata of the new version of the app
+		svcs     []string
+		ok       bool
+	}{
+		"service_internal": {
+			paths: []string{"orders/orders.go"},
+			svcs:  []string{"orders"},
+			ok:    true,
+		},
+		"service_internal_abs_path": {
+			absPaths: []string{filepath.Join(root, "orders", "orders.go")},
+			svcs:     []string{"orders"},
+			ok:       true,
+		},
+		"service_package_imported_by_other_service": {
+			paths: []string{"users/db/db.go"},
+			svcs:  []string{"orders", "users"},
+			ok:    true,
+		},
+		"multiple_services": {
+			paths: []string{"users/users.go", "orders/orders.go"},
+			svcs:  []string{"orders", "users"},
+			ok:    true,
+		},
+		"internal_metadata_changes": {
+			paths: []string{"users/users.go"},
+			modify: func(md *meta.Data) {
+				md.AppRevision = "rev2"
+				md.UncommittedChanges = true
+				md.Svcs[0].Rpcs[0].Doc = proto.String("Get gets a user.")
+				md.Svcs[0].Rpcs[0].Loc.StartPos = 20
+			},
+			svcs: []string{"users"},
+			ok:   true,
+		},
+		"test_files_only": {
+			paths: []string{"users/users_test.go"},
+			ok:    true,
+		},
+		"generated_files_only": {
+			paths: []string{"users/encore.gen.go"},
+			ok:    true,
+		},
+		"shared_package": {
+			paths: []string{"pkg/util/util.go"},
+			ok:    false,
+		},
+		"auth_handler": {
+			paths: []string{"auth/auth.go"},
+			ok:    false,
+		},
+		"api_signature": {
+			paths: []string{"users/users.go"},
+			modify: func(md *meta.Data) {
+				md.Svcs[0].Rpcs[0].RequestSchema = &schema.Type{Typ: &schema.Type_Builtin{Builtin: schema.Builtin_INT}}
+			},
+			ok: false,
+		},
+		"api_access": {
+			paths: []string{"users/users.go"},
+			modify: func(md *meta.Data) {
+				md.Svcs[0].Rpcs[0].AccessType = meta.RPC_AUTH
+			},
+			ok: false,
+		},
+		"new_endpoint": {
+			paths: []string{"users/users.go"},
+			modify: func(md *meta.Data) {
+				md.Svcs[0].Rpcs = append(md.Svcs[0].Rpcs, &meta.RPC{Name: "List", ServiceName: "users"})
+			},
+			ok: false,
+		},
+		"new_resource": {
+			paths: []string{"orders/orders.go"},
+			modify: func(md *meta.Data) {
+				md.PubsubTopics = append(md.PubsubTopics, &meta.PubSubTopic{Name: "orders"})
+			},
+			ok: false,
+		},
+		"new_package": {
+			paths: []string{"orders/items/items.go"},
+			modify: func(md *meta.Data) {
+				md.Pkgs = append(md.Pkgs, &meta.Package{RelPath: "orders/items", Name: "items", ServiceName: "orders"})
+			},
+			ok: false,
+		},
+		"sql_migration": {
+			paths: []string{"users/migrations/1_create.up.sql"},
+			ok:    false,
+		},
+		"go_mod": {
+			paths: []string{"go.mod"},
+			ok:    false,
+		},
+		"app_file": {
+			paths: []string{"encore.app"},
+			ok:    false,
+		},
+		"go_and_non_go_files": {
+			paths: []string{"orders/orders.go", "orders/config.cue"},
+			ok:    false,
+		},
+		"outside_app_root": {
+			absPaths: []string{filepath.Join(filepath.Dir(root), "other", "other.go")},
+			ok:       false,
+		},
+		"no_changes": {
+			ok: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		c.Run(name, func(c *qt.C) {
+			var events []watcher.Event
+			for _, p := range tc.paths {
+				events = append(events, watcher.Event{EventType: watcher.MODIFIED, Path: filepath.Join(root, filepath.FromSlash(p))})
+			}
+			for _, p := range tc.absPaths {
+				events = append(events, watcher.Event{EventType: watcher.MODIFIED, Path: p})
+			}
+
+			prev, next := testAppMeta(), testAppMeta()
+			if tc.modify != nil {
+				tc.modify(next)
+			}
+
+			svcs, ok := changedServices(root, prev, next, events)
+			c.Assert(ok, qt.Equals, tc.ok)
+			if tc.ok {
+				c.Assert(svcs, qt.DeepEquals, tc.svcs)
+			}
+		})
+	}
+}
+
+func TestChangedServices_NoPrevious(t *testing.T) {
+	c := qt.New(t)
+	root := writeTestApp(c)
+
+	events := []watcher.Event{{EventType: watcher.MODIFIED, Path: filepath.Join(root, "orders", "orders.go")}}
+	_, ok := changedServices(root, nil, testAppMeta(), events)
+	c.Assert(ok, qt.IsFalse)
+}
+
+func TestImportGraph(t *testing.T) {
+	c := qt.New(t)
+	root := writeTestApp(c)
+
+	// Imports from test files and of packages outside the app are not included.
+	importedBy := importGraph(root, testAppMeta())
+	c.Assert(importedBy, qt.DeepEquals, map[string][]string{
+		"users/db": {"users", "orders"},
+		"pkg/util": {"orders"},
+	})
+}
+
+func TestMetaContract(t *testing.T) {
+	c := qt.New(t)
+
+	md := testAppMeta()
+	md.Svcs[0].Rpcs[0].Doc = proto.String("Get gets a user.")
+	md.Pkgs[0].RpcCalls = []*meta.QualifiedName{{Pkg: "orders", Name: "Get"}}
+	contract := metaContract(md)
+
+	// The contract excludes the internals of services, without modifying md.
+	c.Assert(contract.AppRevision, qt.Equals, "")
+	c.Assert(contract.Svcs[0].Rpcs[0].Doc, qt.IsNil)
+	c.Assert(contract.Svcs[0].Rpcs[0].Loc, qt.IsNil)
+	c.Assert(contract.Pkgs[0].RpcCalls, qt.HasLen, 0)
+	c.Assert(contract.Svcs[0].Rpcs[0].RequestSchema, qt.IsNotNil)
+	c.Assert(md.AppRevision, qt.Equals, "rev1")
+	c.Assert(*md.Svcs[0].Rpcs[0].Doc, qt.Equals, "Get gets a user.")
+}
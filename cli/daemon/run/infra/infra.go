@@ -49,6 +49,7 @@ type ResourceManager struct {
 	dbProxyPort   int
 	sqlMgr        *sqldb.ClusterManager
 	objectsMgr    *objects.ClusterManager
+	redisMgr      *redis.ClusterManager
 	publicBuckets *objects.PublicBucketServer
 	ns            *namespace.Namespace
 	environ       environ.Environ
@@ -59,12 +60,13 @@ type ResourceManager struct {
 	servers map[Type]Resource
 }
 
-func NewResourceManager(app *apps.Instance, sqlMgr *sqldb.ClusterManager, objectsMgr *objects.ClusterManager, publicBuckets *objects.PublicBucketServer, ns *namespace.Namespace, environ environ.Environ, dbProxyPort int, forTests bool) *ResourceManager {
+func NewResourceManager(app *apps.Instance, sqlMgr *sqldb.ClusterManager, objectsMgr *objects.ClusterManager, redisMgr *redis.ClusterManager, publicBuckets *objects.PublicBucketServer, ns *namespace.Namespace, environ environ.Environ, dbProxyPort int, forTests bool) *ResourceManager {
 	return &ResourceManager{
 		app:           app,
 		dbProxyPort:   dbProxyPort,
 		sqlMgr:        sqlMgr,
 		objectsMgr:    objectsMgr,
+		redisMgr:      redisMgr,
 		publicBuckets: publicBuckets,
 		ns:            ns,
 		environ:       environ,
@@ -106,8 +108,12 @@ func (rm *ResourceManager) StartRequiredServices(a *optracker.AsyncBuildJobs, md
 		a.Go("Starting Redis server", true, 250*time.Millisecond, rm.StartRedis)
 	}
 
-	if objects.IsUsed(md) && rm.GetObjects() == nil {
-		a.Go("Starting Object Storage server", true, 250*time.Millisecond, rm.StartObjects(md))
+	if objects.IsUsed(md) {
+		if srv := rm.GetObjects(); srv == nil {
+			a.Go("Starting Object Storage server", true, 250*time.Millisecond, rm.StartObjects(md))
+		} else {
+			srv.SetMetadata(md)
+		}
 	}
 }
 
@@ -137,15 +143,27 @@ func (rm *ResourceManager) GetPubSub() *pubsub.NSQDaemon {
 }
 
 // StartRedis starts a Redis server.
+//
+// Outside of tests the server is shared by all runs in the same namespace,
+// and its contents are persisted across runs and daemon restarts.
 func (rm *ResourceManager) StartRedis(ctx context.Context) error {
-	srv := redis.New()
-	err := srv.Start()
-	if err != nil {
-		return err
+	var res Resource
+	if rm.forTests || rm.redisMgr == nil {
+		srv := redis.New()
+		if err := srv.Start(); err != nil {
+			return err
+		}
+		res = srv
+	} else {
+		srv, err := rm.redisMgr.Acquire(rm.ns.ID)
+		if err != nil {
+			return err
+		}
+		res = &sharedRedis{srv: srv, mgr: rm.redisMgr, ns: rm.ns.ID}
 	}
 
 	rm.mutex.Lock()
-	rm.servers[Cache] = srv
+	rm.servers[Cache] = res
 	rm.mutex.Unlock()
 	return nil
 }
@@ -155,12 +173,27 @@ func (rm *ResourceManager) GetRedis() *redis.Server {
 	rm.mutex.Lock()
 	defer rm.mutex.Unlock()
 
-	if srv, found := rm.servers[Cache]; found {
-		return srv.(*redis.Server)
+	switch srv := rm.servers[Cache].(type) {
+	case *redis.Server:
+		return srv
+	case *sharedRedis:
+		return srv.srv
 	}
 	return nil
 }
 
+// sharedRedis is a Redis server acquired from a redis.ClusterManager.
+// Stopping it releases it back to the manager.
+type sharedRedis struct {
+	srv *redis.Server
+	mgr *redis.ClusterManager
+	ns  namespace.ID
+}
+
+func (s *sharedRedis) Stop() {
+	s.mgr.Release(s.ns)
+}
+
 // StartObjects starts an Object Storage server.
 func (rm *ResourceManager) StartObjects(md *meta.Data) func(context.Context) error {
 	return func(ctx context.Context) error {
@@ -180,6 +213,15 @@ func (rm *ResourceManager) StartObjects(md *meta.Data) func(context.Context) err
 			srv = objects.NewDirServer(rm.publicBuckets, rm.ns.ID, baseDir)
 		}
 
+		srv.SetEventPublisher(func(md *meta.Data, topic string, data []byte) error {
+			nsq := rm.GetPubSub()
+			if nsq == nil {
+				return fmt.Errorf("pubsub daemon not running")
+			}
+			_, err := nsq.Publish(md, topic, data)
+			return err
+		})
+
 		if err := srv.Initialize(md); err != nil {
 			return err
 		} else if err := srv.Start(); err != nil {
@@ -344,6 +386,7 @@ func (rm *ResourceManager) UpdateConfig(cfg *config.Runtime, md *meta.Data, dbPr
 					ID:           subscriptionID,
 					EncoreName:   s.Name,
 					ProviderName: s.Name,
+					DeadLetter:   pubsub.DeadLetterTopic,
 				}
 			}
 
@@ -13,6 +13,7 @@ import (
 	"encore.dev/appruntime/exported/config"
 	"encr.dev/cli/daemon/apps"
 	"encr.dev/cli/daemon/objects"
+	"encr.dev/cli/daemon/redis"
 	"encr.dev/cli/daemon/run/infra"
 	"encr.dev/cli/daemon/secret"
 	"encr.dev/cli/daemon/sqldb"
@@ -28,6 +29,7 @@ type Manager struct {
 	Secret        *secret.Manager
 	ClusterMgr    *sqldb.ClusterManager
 	ObjectsMgr    *objects.ClusterManager
+	RedisMgr      *redis.ClusterManager
 	PublicBuckets *objects.PublicBucketServer
 
 	listeners []EventListener
@@ -11,6 +11,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -29,6 +30,7 @@ import (
 	"encr.dev/pkg/fns"
 	"encr.dev/pkg/noopgateway"
 	"encr.dev/pkg/noopgwdesc"
+	"encr.dev/pkg/paths"
 	meta "encr.dev/proto/encore/parser/meta/v1"
 )
 
@@ -48,19 +50,19 @@ func newProcGroup(opts procGroupOptions) *ProcGroup {
 	p := &ProcGroup{
 		ID:          opts.ProcID,
 		Run:         opts.Run,
-		Meta:        opts.Meta,
 		Experiments: opts.Experiments,
 		workingDir:  opts.WorkingDir,
 		ctx:         opts.Ctx,
 		logger:      opts.Logger,
 		log:         opts.Run.log.With().Str("proc_id", opts.ProcID).Logger(),
-		ConfigGen:   opts.ConfigGen,
 
 		symParsed: make(chan struct{}),
 		Services:  make(map[string]*Proc),
 		Gateways:  make(map[string]*Proc),
 		authKey:   opts.AuthKey,
 	}
+	p.md.Store(opts.Meta)
+	p.configGen.Store(opts.ConfigGen)
 
 	p.procCond.L = &p.procMu
 	return p
@@ -73,13 +75,15 @@ func newProcGroup(opts procGroupOptions) *ProcGroup {
 type ProcGroup struct {
 	ID          string           // unique process id
 	Run         *Run             // the run the process belongs to
-	Meta        *meta.Data       // app metadata snapshot
 	Experiments *experiments.Set // enabled experiments
 
 	Gateways map[string]*Proc // the gateway processes, by name (if any)
 	Services map[string]*Proc // all the service processes by name
 
-	ConfigGen *RuntimeConfigGenerator // generates runtime configuration
+	// md and configGen are replaced with new snapshots
+	// when individual services are restarted.
+	md        atomic.Pointer[meta.Data]              // app metadata snapshot
+	configGen atomic.Pointer[RuntimeConfigGenerator] // generates runtime configuration
 
 	procMu       sync.Mutex // protects both allProcesses and runningProcs
 	procCond     sync.Cond  // used to signal a change in runningProcs
@@ -92,7 +96,7 @@ type ProcGroup struct {
 	workingDir string
 
 	// Used for proxying requests when there is no gateway.
-	noopGW *noopgateway.Gateway
+	noopGW atomic.Pointer[noopgateway.Gateway]
 
 	authKey   config.EncoreAuthKey
 	sym       *sym.Table
@@ -100,13 +104,31 @@ type ProcGroup struct {
 	symParsed chan struct{} // closed when sym and symErr are set
 }
 
+// Meta returns the metadata of the app the group is running.
+func (pg *ProcGroup) Meta() *meta.Data {
+	return pg.md.Load()
+}
+
+// ConfigGen returns the generator of the runtime configuration
+// of the group's processes.
+func (pg *ProcGroup) ConfigGen() *RuntimeConfigGenerator {
+	return pg.configGen.Load()
+}
+
+// update replaces the app metadata and runtime configuration generator of the group.
+// The values must not be modified afterwards, since they're read concurrently.
+func (pg *ProcGroup) update(md *meta.Data, configGen *RuntimeConfigGenerator) {
+	pg.md.Store(md)
+	pg.configGen.Store(configGen)
+}
+
 func (pg *ProcGroup) ProxyReq(w http.ResponseWriter, req *http.Request) {
 	// Currently we only support proxying to the default gateway.
 	// Need to rethink how this should work when we support multiple gateways.
 	if gw, ok := pg.Gateways["api-gateway"]; ok {
 		gw.ProxyReq(w, req)
 	} else {
-		pg.noopGW.ServeHTTP(w, req)
+		pg.noopGW.Load().ServeHTTP(w, req)
 	}
 }
 
@@ -140,7 +162,7 @@ func (pg *ProcGroup) Start() (err error) {
 		}
 	}
 
-	pg.noopGW = newNoopGateway(pg)
+	pg.noopGW.Store(newNoopGateway(pg))
 	return nil
 }
 
@@ -201,7 +223,7 @@ func (pg *ProcGroup) SymTable(ctx context.Context) (*sym.Table, error) {
 }
 
 // newProc creates a new process in the group and sets up the required stuff in the struct
-func (pg *ProcGroup) newProc(processName string, listenAddr netip.AddrPort) (*Proc, error) {
+func (pg *ProcGroup) newProc(processName string, listenAddr netip.AddrPort, buildDir paths.FS) (*Proc, error) {
 	dst := &url.URL{
 		Scheme: "http",
 		Host:   listenAddr.String(),
@@ -228,6 +250,7 @@ func (pg *ProcGroup) newProc(processName string, listenAddr netip.AddrPort) (*Pr
 		log:        pg.log.With().Str("proc", processName).Logger(),
 		listenAddr: listenAddr,
 		httpProxy:  proxy,
+		buildDir:   buildDir,
 		exit:       make(chan struct{}),
 	}
 
@@ -238,8 +261,8 @@ func (pg *ProcGroup) newProc(processName string, listenAddr netip.AddrPort) (*Pr
 	return p, nil
 }
 
-func (pg *ProcGroup) NewAllInOneProc(spec builder.Cmd, listenAddr netip.AddrPort, env []string) error {
-	p, err := pg.newProc("all-in-one", listenAddr)
+func (pg *ProcGroup) NewAllInOneProc(spec builder.Cmd, buildDir paths.FS, listenAddr netip.AddrPort, env []string) error {
+	p, err := pg.newProc("all-in-one", listenAddr, buildDir)
 	if err != nil {
 		return err
 	}
@@ -268,23 +291,101 @@ func (pg *ProcGroup) NewAllInOneProc(spec builder.Cmd, listenAddr netip.AddrPort
 	p.cmd = cmd
 
 	// Assign all the gateways to this process.
-	for _, gw := range pg.Meta.Gateways {
+	for _, gw := range pg.Meta().Gateways {
 		pg.Gateways[gw.EncoreName] = p
 	}
 
 	return nil
 }
 
-func (pg *ProcGroup) NewProcForService(serviceName string, listenAddr netip.AddrPort, spec builder.Cmd, env []string) error {
-	if !listenAddr.IsValid() {
-		return errors.New("invalid listen address")
+func (pg *ProcGroup) NewProcForService(serviceName string, listenAddr netip.AddrPort, spec builder.Cmd, buildDir paths.FS, env []string) error {
+	p, err := pg.newServiceProc(serviceName, listenAddr, spec, buildDir, env)
+	if err != nil {
+		return err
 	}
+	pg.Services[serviceName] = p
+	return nil
+}
 
-	p, err := pg.newProc(serviceName, listenAddr)
+// ReplaceService starts a new process for the given service, and once it's listening
+// routes the service's requests to it and shuts down the service's previous process.
+// The other processes in the group keep serving requests throughout.
+//
+// The build directory of the previous process is removed
+// once no other process in the group runs from it.
+func (pg *ProcGroup) ReplaceService(serviceName string, listenAddr netip.AddrPort, spec builder.Cmd, buildDir paths.FS, env []string) error {
+	pg.procMu.Lock()
+	prev, ok := pg.Services[serviceName]
+	pg.procMu.Unlock()
+	if !ok {
+		return errors.Newf("unknown service %q", serviceName)
+	}
+
+	p, err := pg.newServiceProc(serviceName, listenAddr, spec, buildDir, env)
 	if err != nil {
 		return err
 	}
+	if err := p.Start(); err != nil {
+		pg.removeProc(p)
+		return err
+	}
+	p.pollUntilProcessIsListening(pg.ctx)
+
+	pg.Run.SvcProxy.RegisterService(serviceName, listenAddr)
+	pg.procMu.Lock()
 	pg.Services[serviceName] = p
+	pg.noopGW.Store(newNoopGateway(pg))
+	pg.procMu.Unlock()
+
+	prev.Close()
+	pg.removeProc(prev)
+	if !slices.Contains(pg.BuildDirs(), prev.buildDir) {
+		pg.removeBuildDir(prev.buildDir)
+	}
+	return nil
+}
+
+// removeProc removes a process that is no longer running from the group.
+func (pg *ProcGroup) removeProc(p *Proc) {
+	pg.procMu.Lock()
+	defer pg.procMu.Unlock()
+	pg.allProcesses = slices.DeleteFunc(pg.allProcesses, func(other *Proc) bool { return other == p })
+}
+
+// BuildDirs returns the build directories the processes in the group run from.
+func (pg *ProcGroup) BuildDirs() []paths.FS {
+	pg.procMu.Lock()
+	defer pg.procMu.Unlock()
+
+	var dirs []paths.FS
+	for _, p := range pg.allProcesses {
+		if p.buildDir != "" && !slices.Contains(dirs, p.buildDir) {
+			dirs = append(dirs, p.buildDir)
+		}
+	}
+	return dirs
+}
+
+// removeBuildDir removes a build directory the group's processes no longer run from.
+func (pg *ProcGroup) removeBuildDir(dir paths.FS) {
+	if dir == "" {
+		return
+	}
+	if err := os.RemoveAll(dir.ToIO()); err != nil {
+		pg.log.Warn().Err(err).Str("dir", dir.ToIO()).Msg("unable to remove build directory")
+	}
+}
+
+// newServiceProc creates a new process for the given service, without starting it.
+func (pg *ProcGroup) newServiceProc(serviceName string, listenAddr netip.AddrPort, spec builder.Cmd, buildDir paths.FS, env []string) (*Proc, error) {
+	if !listenAddr.IsValid() {
+		return nil, errors.New("invalid listen address")
+	}
+
+	p, err := pg.newProc(serviceName, listenAddr, buildDir)
+	if err != nil {
+		return nil, err
+	}
 
 	// Append both the command-specific env and the base environment.
 	env = append(env, spec.Env...)
@@ -292,7 +393,8 @@ func (pg *ProcGroup) NewProcForService(serviceName string, listenAddr netip.Addr
 	cwd := filepath.Join(pg.Run.App.Root(), pg.workingDir)
 	binary, err := lookpath.InDir(cwd, env, spec.Command[0])
 	if err != nil {
-		return err
+		pg.removeProc(p)
+		return nil, err
 	}
 
 	// This is safe since the command comes from our build.
@@ -309,15 +411,15 @@ func (pg *ProcGroup) NewProcForService(serviceName string, listenAddr netip.Addr
 
 	p.cmd = cmd
 
-	return nil
+	return p, nil
 }
 
-func (pg *ProcGroup) NewProcForGateway(gatewayName string, listenAddr netip.AddrPort, spec builder.Cmd, env []string) error {
+func (pg *ProcGroup) NewProcForGateway(gatewayName string, listenAddr netip.AddrPort, spec builder.Cmd, buildDir paths.FS, env []string) error {
 	if !listenAddr.IsValid() {
 		return errors.New("invalid listen address")
 	}
 
-	p, err := pg.newProc("gateway-"+gatewayName, listenAddr)
+	p, err := pg.newProc("gateway-"+gatewayName, listenAddr, buildDir)
 	if err != nil {
 		return err
 	}
@@ -359,7 +461,7 @@ type warning struct {
 }
 
 func (pg *ProcGroup) Warnings() (rtn []warning) {
-	if missing := pg.ConfigGen.MissingSecrets(); len(missing) > 0 {
+	if missing := pg.ConfigGen().MissingSecrets(); len(missing) > 0 {
 		rtn = append(rtn, warning{
 			Title: "secrets not defined: " + strings.Join(missing, ", "),
 			Help:  "undefined secrets are left empty for local development only.\nsee https://encore.dev/docs/primitives/secrets for more information",
@@ -378,6 +480,7 @@ type Proc struct {
 
 	listenAddr netip.AddrPort         // The port the HTTP server of the process should listen on
 	httpProxy  *httputil.ReverseProxy // The reverse proxy for the HTTP server of the process
+	buildDir   paths.FS               // The directory of the build the process runs from
 
 	// The following fields are only valid after Start() has been called.
 	Started   atomic.Bool // whether the process has started
@@ -508,14 +611,15 @@ func (p *Proc) pollUntilProcessIsListening(ctx context.Context) (ok bool) {
 }
 
 func newNoopGateway(pg *ProcGroup) *noopgateway.Gateway {
+	md := pg.Meta()
 	svcDiscovery := make(map[noopgateway.ServiceName]string)
-	for _, svc := range pg.Meta.Svcs {
+	for _, svc := range md.Svcs {
 		if proc, ok := pg.Services[svc.Name]; ok {
 			svcDiscovery[noopgateway.ServiceName(svc.Name)] = proc.listenAddr.String()
 		}
 	}
 
-	desc := noopgwdesc.Describe(pg.Meta, svcDiscovery)
+	desc := noopgwdesc.Describe(md, svcDiscovery)
 	gw := noopgateway.New(desc)
 
 	gw.Rewrite = func(rp *httputil.ProxyRequest) {
@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"encoding/binary"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"net/netip"
@@ -32,12 +33,16 @@ import (
 	"encore.dev/appruntime/exported/config"
 	"encore.dev/appruntime/exported/experiments"
 	"encr.dev/cli/daemon/apps"
+	"encr.dev/cli/daemon/chaos"
 	"encr.dev/cli/daemon/namespace"
+	"encr.dev/cli/daemon/pubsub"
 	"encr.dev/cli/daemon/run/infra"
 	"encr.dev/cli/daemon/secret"
+	"encr.dev/cli/daemon/traffic"
 	"encr.dev/internal/optracker"
 	"encr.dev/internal/userconfig"
 	"encr.dev/internal/version"
+	"encr.dev/pkg/appfile"
 	"encr.dev/pkg/builder"
 	"encr.dev/pkg/builder/builderimpl"
 	"encr.dev/pkg/cueutil"
@@ -45,6 +50,7 @@ import (
 	"encr.dev/pkg/promise"
 	"encr.dev/pkg/svcproxy"
 	"encr.dev/pkg/vcs"
+	"encr.dev/pkg/watcher"
 	daemonpb "encr.dev/proto/encore/daemon"
 	meta "encr.dev/proto/encore/parser/meta/v1"
 )
@@ -58,6 +64,7 @@ type Run struct {
 	ResourceManager *infra.ResourceManager
 	NS              *namespace.Namespace
 	TempDir         string
+	Traffic         traffic.Tap // records the requests served by the run
 
 	Builder builder.Impl
 	log     zerolog.Logger
@@ -173,7 +180,7 @@ func (mgr *Manager) Start(ctx context.Context, params StartParams) (run *Run, er
 		ID:              GenID(),
 		App:             params.App,
 		NS:              params.NS,
-		ResourceManager: infra.NewResourceManager(params.App, mgr.ClusterMgr, mgr.ObjectsMgr, mgr.PublicBuckets, params.NS, params.Environ, mgr.DBProxyPort, false),
+		ResourceManager: infra.NewResourceManager(params.App, mgr.ClusterMgr, mgr.ObjectsMgr, mgr.RedisMgr, mgr.PublicBuckets, params.NS, params.Environ, mgr.DBProxyPort, false),
 		ListenAddr:      params.ListenAddr,
 		SvcProxy:        svcProxy,
 		log:             logger,
@@ -255,6 +262,20 @@ func (r *Run) StoreProc(p *ProcGroup) {
 	r.proc.Store(p)
 }
 
+// PubSub returns the NSQ daemon used by the run,
+// along with the metadata of the running app.
+func (r *Run) PubSub() (*pubsub.NSQDaemon, *meta.Data, error) {
+	proc := r.ProcGroup()
+	if proc == nil {
+		return nil, nil, errors.New("app is not running")
+	}
+	nsq := r.ResourceManager.GetPubSub()
+	if nsq == nil {
+		return nil, nil, errors.New("app does not use pubsub")
+	}
+	return nsq, proc.Meta(), nil
+}
+
 // Done returns a channel that is closed when the run is closed.
 func (r *Run) Done() <-chan struct{} {
 	return r.exited
@@ -263,7 +284,14 @@ func (r *Run) Done() <-chan struct{} {
 // Reload rebuilds the app and, if successful,
 // starts a new proc and switches over.
 func (r *Run) Reload() error {
-	err := r.buildAndStart(r.ctx, nil, true)
+	return r.reload(nil)
+}
+
+// reload rebuilds the app to apply the given file changes.
+// Only the processes of the services affected by the changes
+// are restarted when possible, and all of them otherwise.
+func (r *Run) reload(changes []watcher.Event) error {
+	err := r.buildAndStart(r.ctx, nil, changes, true)
 	if err != nil {
 		return err
 	}
@@ -286,7 +314,7 @@ func (r *Run) start(ln net.Listener, tracker *optracker.OpTracker) (err error) {
 		}
 	}()
 
-	err = r.buildAndStart(r.ctx, tracker, false)
+	err = r.buildAndStart(r.ctx, tracker, nil, false)
 	if err != nil {
 		return err
 	}
@@ -342,7 +370,10 @@ func (r *Run) start(ln net.Listener, tracker *optracker.OpTracker) (err error) {
 // buildAndStart builds the app, starts the proc, and cleans up
 // the build dir when it exits.
 // The proc exits when ctx is canceled.
-func (r *Run) buildAndStart(ctx context.Context, tracker *optracker.OpTracker, isReload bool) error {
+//
+// On reloads, changes are the file changes that caused the reload,
+// used to only restart the services they affect.
+func (r *Run) buildAndStart(ctx context.Context, tracker *optracker.OpTracker, changes []watcher.Event, isReload bool) error {
 	// Return early if the ctx is already canceled.
 	if err := ctx.Err(); err != nil {
 		return err
@@ -478,7 +509,7 @@ func (r *Run) buildAndStart(ctx context.Context, tracker *optracker.OpTracker, i
 	}
 
 	startOp := tracker.Add("Starting Encore application", start)
-	newProcess, err := r.StartProcGroup(&StartProcGroupParams{
+	procParams := &StartProcGroupParams{
 		Ctx:            ctx,
 		Outputs:        build.Outputs,
 		Meta:           parse.Meta,
@@ -489,7 +520,25 @@ func (r *Run) buildAndStart(ctx context.Context, tracker *optracker.OpTracker, i
 		WorkingDir:     r.Params.WorkingDir,
 		IsReload:       isReload,
 		Experiments:    expSet,
-	})
+	}
+
+	// If the changes are limited to the internals of some services,
+	// restart just those and keep the rest of the app running.
+	if prev := r.ProcGroup(); isReload && prev != nil && r.App.Lang() == appfile.LangGo && !isSingleProc(build.Outputs) &&
+		maps.Equal(prev.ConfigGen().DefinedSecrets, secrets) {
+		if svcs, ok := changedServices(r.App.Root(), prev.Meta(), parse.Meta, changes); ok {
+			// The existing processes keep running under the previous proc context.
+			cancelProcCtx()
+			if err := r.restartServices(prev, procParams, svcs); err != nil {
+				tracker.Fail(startOp, err)
+				return err
+			}
+			tracker.Done(startOp, 50*time.Millisecond)
+			return nil
+		}
+	}
+
+	newProcess, err := r.StartProcGroup(procParams)
 	if err != nil {
 		tracker.Fail(startOp, err)
 		return err
@@ -505,9 +554,26 @@ func (r *Run) buildAndStart(ctx context.Context, tracker *optracker.OpTracker, i
 		}
 	}()
 
+	// Run the data migrations before the new processes serve any traffic.
+	// Until then the previous processes, if any, keep serving.
+	if err := r.runDataMigrations(procCtx, newProcess, parse.Meta); err != nil {
+		newProcess.Close()
+		tracker.Fail(startOp, err)
+		return err
+	}
+
 	previousProcess := r.proc.Swap(newProcess)
 	if previousProcess != nil {
-		previousProcess.(*ProcGroup).Close()
+		prev := previousProcess.(*ProcGroup)
+		prev.Close()
+
+		// Remove the builds the new processes don't run from.
+		inUse := newProcess.BuildDirs()
+		for _, dir := range prev.BuildDirs() {
+			if !slices.Contains(inUse, dir) {
+				prev.removeBuildDir(dir)
+			}
+		}
 	}
 
 	tracker.Done(startOp, 50*time.Millisecond)
@@ -546,11 +612,7 @@ const gracefulShutdownTime = 10 * time.Second
 func (r *Run) StartProcGroup(params *StartProcGroupParams) (p *ProcGroup, err error) {
 	pid := GenID()
 
-	userEnv := append([]string{
-		"ENCORE_RUNTIME_LOG=error",
-		// Always include internal messages when developing locally.
-		"ENCORE_API_INCLUDE_INTERNAL_MESSAGE=1",
-	}, params.Environ...)
+	userEnv := r.userEnv(params.Environ)
 
 	daemonProxyAddr, err := netip.ParseAddrPort(strings.ReplaceAll(r.ListenAddr, "localhost", "127.0.0.1"))
 	if err != nil {
@@ -612,13 +674,13 @@ func (r *Run) StartProcGroup(params *StartProcGroupParams) (p *ProcGroup, err er
 	if isSingleProc(params.Outputs) {
 		entrypoint := params.Outputs[0].GetEntrypoints()[0]
 
-		conf, err := p.ConfigGen.AllInOneProc(entrypoint.UseRuntimeConfigV2)
+		conf, err := p.ConfigGen().AllInOneProc(entrypoint.UseRuntimeConfigV2)
 		if err != nil {
 			return nil, err
 		}
 
 		// Generate the environmental variables for the process
-		procEnv, err := p.ConfigGen.ProcEnvs(conf, entrypoint.UseRuntimeConfigV2)
+		procEnv, err := p.ConfigGen().ProcEnvs(conf, entrypoint.UseRuntimeConfigV2)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to generate environment variables")
 		}
@@ -627,8 +689,9 @@ func (r *Run) StartProcGroup(params *StartProcGroupParams) (p *ProcGroup, err er
 		env = append(env, procEnv...)
 
 		// Otherwise we're running everything inside a single process
-		cmd := entrypoint.Cmd.Expand(params.Outputs[0].GetArtifactDir())
-		if err := p.NewAllInOneProc(cmd, conf.ListenAddr, env); err != nil {
+		buildDir := params.Outputs[0].GetArtifactDir()
+		cmd := entrypoint.Cmd.Expand(buildDir)
+		if err := p.NewAllInOneProc(cmd, buildDir, conf.ListenAddr, env); err != nil {
 			return nil, err
 		}
 	} else {
@@ -638,12 +701,12 @@ func (r *Run) StartProcGroup(params *StartProcGroupParams) (p *ProcGroup, err er
 		)
 
 		if r.Builder.UseNewRuntimeConfig() {
-			_, svcConfs, gwConfs, err = p.ConfigGen.ProcPerServiceWithNewRuntimeConfig(r.SvcProxy)
+			_, svcConfs, gwConfs, err = p.ConfigGen().ProcPerServiceWithNewRuntimeConfig(r.SvcProxy)
 			if err != nil {
 				return nil, err
 			}
 		} else {
-			svcConfs, gwConfs, err = p.ConfigGen.ProcPerService(r.SvcProxy)
+			svcConfs, gwConfs, err = p.ConfigGen().ProcPerService(r.SvcProxy)
 			if err != nil {
 				return nil, err
 			}
@@ -659,7 +722,7 @@ func (r *Run) StartProcGroup(params *StartProcGroupParams) (p *ProcGroup, err er
 					if !ok {
 						return nil, errors.Newf("unknown service %q", svcName)
 					}
-					procEnv, err := p.ConfigGen.ProcEnvs(procConf, ep.UseRuntimeConfigV2)
+					procEnv, err := p.ConfigGen().ProcEnvs(procConf, ep.UseRuntimeConfigV2)
 					if err != nil {
 						return nil, errors.Wrap(err, "failed to generate environment variables")
 					}
@@ -667,7 +730,7 @@ func (r *Run) StartProcGroup(params *StartProcGroupParams) (p *ProcGroup, err er
 					env := slices.Clone(userEnv)
 					env = append(env, procEnv...)
 
-					if err := p.NewProcForService(svcName, procConf.ListenAddr, cmd, env); err != nil {
+					if err := p.NewProcForService(svcName, procConf.ListenAddr, cmd, o.GetArtifactDir(), env); err != nil {
 						return nil, err
 					}
 				}
@@ -678,7 +741,7 @@ func (r *Run) StartProcGroup(params *StartProcGroupParams) (p *ProcGroup, err er
 						return nil, errors.Newf("unknown gateway %q", gwName)
 					}
 
-					procEnv, err := p.ConfigGen.ProcEnvs(procConf, ep.UseRuntimeConfigV2)
+					procEnv, err := p.ConfigGen().ProcEnvs(procConf, ep.UseRuntimeConfigV2)
 					if err != nil {
 						return nil, errors.Wrap(err, "failed to generate environment variables")
 					}
@@ -686,7 +749,7 @@ func (r *Run) StartProcGroup(params *StartProcGroupParams) (p *ProcGroup, err er
 					env := slices.Clone(userEnv)
 					env = append(env, procEnv...)
 
-					if err := p.NewProcForGateway(gwName, procConf.ListenAddr, cmd, env); err != nil {
+					if err := p.NewProcForGateway(gwName, procConf.ListenAddr, cmd, o.GetArtifactDir(), env); err != nil {
 						return nil, err
 					}
 				}
@@ -731,6 +794,60 @@ func (r *Run) StartProcGroup(params *StartProcGroupParams) (p *ProcGroup, err er
 	return p, nil
 }
 
+// restartServices replaces the processes of the given services in the running
+// process group p with processes running the new build, leaving the gateways
+// and the other services running.
+func (r *Run) restartServices(p *ProcGroup, params *StartProcGroupParams, svcs []string) error {
+	if len(svcs) > 0 {
+		r.Mgr.RunStdout(r, []byte(fmt.Sprintf("Restarting %s...\n", strings.Join(svcs, ", "))))
+	}
+
+	// The group's metadata and config generator are read concurrently,
+	// so replace them instead of modifying them.
+	configGen := p.ConfigGen().withMeta(params.Meta, params.ServiceConfigs)
+	p.update(params.Meta, configGen)
+
+	userEnv := r.userEnv(params.Environ)
+	newRuntimeConf := r.Builder.UseNewRuntimeConfig()
+	for _, o := range params.Outputs {
+		for _, ep := range o.GetEntrypoints() {
+			cmd := ep.Cmd.Expand(o.GetArtifactDir())
+			for _, svcName := range ep.Services {
+				if !slices.Contains(svcs, svcName) {
+					continue
+				}
+
+				procConf, err := configGen.ProcForService(r.SvcProxy, svcName, newRuntimeConf)
+				if err != nil {
+					return err
+				}
+				procEnv, err := configGen.ProcEnvs(procConf, ep.UseRuntimeConfigV2)
+				if err != nil {
+					return errors.Wrap(err, "failed to generate environment variables")
+				}
+
+				env := slices.Clone(userEnv)
+				env = append(env, procEnv...)
+
+				if err := p.ReplaceService(svcName, procConf.ListenAddr, cmd, o.GetArtifactDir(), env); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// userEnv returns the environment variables to set for all the processes of the app.
+func (r *Run) userEnv(environ []string) []string {
+	userEnv := append([]string{
+		"ENCORE_RUNTIME_LOG=error",
+		// Always include internal messages when developing locally.
+		"ENCORE_API_INCLUDE_INTERNAL_MESSAGE=1",
+	}, chaos.Environ(r.App, chaos.EnvRun)...)
+	return append(userEnv, environ...)
+}
+
 // logWriter is an io.Writer that buffers incoming logs
 // and forwards whole log lines to fn.
 type logWriter struct {
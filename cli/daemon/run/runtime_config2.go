@@ -23,6 +23,7 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"encore.dev/appruntime/exported/config"
+	"encr.dev/cli/daemon/pubsub"
 	encoreEnv "encr.dev/internal/env"
 	"encr.dev/pkg/appfile"
 	"encr.dev/pkg/fns"
@@ -99,6 +100,31 @@ type RuntimeConfigGenerator struct {
 	authKeys []*runtimev1.EncoreAuthKey
 }
 
+// withMeta returns a new generator for the app described by md and
+// the given service configs, with the same settings as g.
+func (g *RuntimeConfigGenerator) withMeta(md *meta.Data, svcConfigs map[string]string) *RuntimeConfigGenerator {
+	return &RuntimeConfigGenerator{
+		md:                md,
+		app:               g.app,
+		infraManager:      g.infraManager,
+		AppID:             g.AppID,
+		EnvID:             g.EnvID,
+		EnvName:           g.EnvName,
+		EnvType:           g.EnvType,
+		EnvCloud:          g.EnvCloud,
+		TraceEndpoint:     g.TraceEndpoint,
+		DeployID:          g.DeployID,
+		Gateways:          g.Gateways,
+		AuthKey:           g.AuthKey,
+		IncludeMeta:       g.IncludeMeta,
+		MetaPath:          g.MetaPath,
+		RuntimeConfigPath: g.RuntimeConfigPath,
+		LogLevel:          g.LogLevel,
+		DefinedSecrets:    g.DefinedSecrets,
+		SvcConfigs:        svcConfigs,
+	}
+}
+
 type GatewayConfig struct {
 	BaseURL   string
 	Hostnames []string
@@ -248,7 +274,7 @@ func (g *RuntimeConfigGenerator) initialize() error {
 				}
 
 				// Ensure topic name is valid for NSQ
-				topicCloudName := ensureValidNSQName(topic.Name)
+				topicCloudName := pubsub.NSQName(topic.Name)
 
 				cluster.PubSubTopic(&runtimev1.PubSubTopic{
 					Rid:               topicRid,
@@ -261,7 +287,7 @@ func (g *RuntimeConfigGenerator) initialize() error {
 
 				for _, sub := range topic.Subscriptions {
 					// Ensure subscription name is valid for NSQ
-					subCloudName := ensureValidNSQName(sub.Name)
+					subCloudName := pubsub.NSQName(sub.Name)
 
 					cluster.PubSubSubscription(&runtimev1.PubSubSubscription{
 						Rid:                    newRid(),
@@ -270,6 +296,7 @@ func (g *RuntimeConfigGenerator) initialize() error {
 						TopicCloudName:         topicCloudName,
 						SubscriptionCloudName:  subCloudName,
 						PushOnly:               false,
+						DeadLetterCloudName:    proto.String(pubsub.DeadLetterTopic),
 						ProviderConfig:         nil,
 					})
 				}
@@ -691,6 +718,65 @@ func (g *RuntimeConfigGenerator) ProcPerServiceWithNewRuntimeConfig(proxy *svcpr
 	return
 }
 
+// ProcForService generates the config for a new process for a single service,
+// to replace the service's process in a running process group.
+//
+// The other processes reach the service through the proxy, so once the new
+// process is listening the service must be registered with the proxy
+// using the returned listen address.
+func (g *RuntimeConfigGenerator) ProcForService(proxy *svcproxy.SvcProxy, svcName string, newRuntimeConf bool) (*ProcConfig, error) {
+	if err := g.initialize(); err != nil {
+		return nil, err
+	}
+	if newRuntimeConf && len(g.SvcConfigs) > 0 {
+		return nil, errors.New("service configs not yet supported")
+	}
+
+	newRid := func() string { return "res_" + xid.New().String() }
+
+	sd := &runtimev1.ServiceDiscovery{Services: make(map[string]*runtimev1.ServiceDiscovery_Location)}
+	for _, svc := range g.md.Svcs {
+		sd.Services[svc.Name] = &runtimev1.ServiceDiscovery_Location{
+			BaseUrl: proxy.ServiceBaseURL(svc.Name),
+			AuthMethods: []*runtimev1.ServiceAuth{
+				{
+					AuthMethod: &runtimev1.ServiceAuth_EncoreAuth_{
+						EncoreAuth: &runtimev1.ServiceAuth_EncoreAuth{
+							AuthKeys: g.authKeys,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	conf, err := g.conf.Deployment(newRid()).
+		ServiceDiscovery(sd).
+		HostsServices(svcName).
+		ReduceWithMeta(g.md).
+		BuildRuntimeConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate runtime config")
+	}
+
+	listenAddr, err := freeLocalhostAddress()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find free localhost address")
+	}
+
+	procConf := &ProcConfig{
+		Runtime:    option.Some(conf),
+		ListenAddr: listenAddr,
+	}
+	if !newRuntimeConf {
+		usedSecrets := secretsUsedByServices(g.md, svcName)
+		procConf.ExtraEnv = append([]string{
+			fmt.Sprintf("%s=%s", appSecretsEnvVar, g.encodeSecrets(usedSecrets)),
+		}, g.encodeConfigs(svcName)...)
+	}
+	return procConf, nil
+}
+
 func (g *RuntimeConfigGenerator) ForTests(newRuntimeConf bool) (envs []string, err error) {
 	if err := g.initialize(); err != nil {
 		return nil, err
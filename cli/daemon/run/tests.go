@@ -172,7 +172,7 @@ func (mgr *Manager) testSpec(ctx context.Context, bld builder.Impl, expSet *expe
 		return nil, errors.Wrap(err, "cache metadata")
 	}
 
-	rm := infra.NewResourceManager(params.App, mgr.ClusterMgr, mgr.ObjectsMgr, mgr.PublicBuckets, params.NS, nil, mgr.DBProxyPort, true)
+	rm := infra.NewResourceManager(params.App, mgr.ClusterMgr, mgr.ObjectsMgr, mgr.RedisMgr, mgr.PublicBuckets, params.NS, nil, mgr.DBProxyPort, true)
 
 	jobs := optracker.NewAsyncBuildJobs(ctx, params.App.PlatformOrLocalID(), nil)
 	rm.StartRequiredServices(jobs, parse.Meta)
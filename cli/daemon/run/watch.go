@@ -17,7 +17,7 @@ func (mgr *Manager) watch(run *Run) error {
 		}
 
 		mgr.RunStdout(run, []byte("Changes detected, recompiling...\n"))
-		if err := run.Reload(); err != nil {
+		if err := run.reload(event); err != nil {
 			if errList := AsErrorList(err); errList != nil {
 				mgr.RunError(run, errList)
 			} else {
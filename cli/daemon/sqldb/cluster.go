@@ -277,6 +277,17 @@ func (c *Cluster) GetDB(name string) (*DB, bool) {
 	return db, ok
 }
 
+// GetOrInitDB gets the database with the given name, initializing it if necessary.
+// Unlike Setup it doesn't create or migrate the database.
+func (c *Cluster) GetOrInitDB(name string) *DB {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if db, ok := c.dbs[name]; ok {
+		return db
+	}
+	return c.initDB(name)
+}
+
 func (c *Cluster) IsExternalDB(name string) bool {
 	if c.isExternal == nil {
 		return false
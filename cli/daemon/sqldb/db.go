@@ -254,6 +254,10 @@ func (db *DB) ensureRoles(ctx context.Context, cloudName string, roles ...Role)
 }
 
 // Migrate migrates the database.
+//
+// Data migrations are run by the running application, so for databases
+// of running apps the SQL migrations numbered above the first pending
+// data migration are held back until RunDataMigrations runs it.
 func (db *DB) doMigrate(ctx context.Context, cloudName, appRoot string, dbMeta *meta.SQLDatabase) (err error) {
 	if db.Cluster.ID.Type == Shadow {
 		db.log.Debug().Msg("not applying migrations to shadow cluster")
@@ -274,6 +278,63 @@ func (db *DB) doMigrate(ctx context.Context, cloudName, appRoot string, dbMeta *
 		}
 	}()
 
+	return db.withAdminConn(ctx, cloudName, func(conn *sql.Conn) error {
+		var before option.Option[uint64]
+		if db.Cluster.ID.Type == Run {
+			if next, ok, err := nextDataMigration(ctx, conn, dbMeta); err != nil {
+				return err
+			} else if ok {
+				before = option.Some(next.Number)
+			}
+		}
+		return db.migrateSQL(ctx, conn, cloudName, appRoot, dbMeta, before)
+	})
+}
+
+// DataMigrationRunner runs the data migration with the given number
+// of a database in the running application.
+type DataMigrationRunner func(ctx context.Context, number uint64) error
+
+// RunDataMigrations runs the pending data migrations of the database using run,
+// in order together with the SQL migrations: each data migration runs after the
+// SQL migrations numbered below it, and before those numbered above it.
+// The SQL migrations after the last data migration are applied last.
+func (db *DB) RunDataMigrations(ctx context.Context, appRoot string, dbMeta *meta.SQLDatabase, run DataMigrationRunner) error {
+	if len(dbMeta.DataMigrations) == 0 || db.Cluster.ID.Type != Run {
+		return nil
+	}
+
+	db.setupMu.Lock()
+	defer db.setupMu.Unlock()
+
+	cloudName := db.ApplicationCloudName()
+	return db.withAdminConn(ctx, cloudName, func(conn *sql.Conn) error {
+		var prev *meta.DBDataMigration
+		for {
+			next, ok, err := nextDataMigration(ctx, conn, dbMeta)
+			if err != nil {
+				return err
+			} else if !ok {
+				break
+			} else if prev != nil && next.Number == prev.Number {
+				return fmt.Errorf("data migration %d_%s was not recorded as applied", next.Number, next.Description)
+			}
+
+			if err := db.migrateSQL(ctx, conn, cloudName, appRoot, dbMeta, option.Some(next.Number)); err != nil {
+				return err
+			}
+			db.log.Info().Uint64("version", next.Number).Str("name", next.Description).Msg("running data migration")
+			if err := run(ctx, next.Number); err != nil {
+				return fmt.Errorf("data migration %d_%s: %v", next.Number, next.Description, err)
+			}
+			prev = next
+		}
+		return db.migrateSQL(ctx, conn, cloudName, appRoot, dbMeta, option.None[uint64]())
+	})
+}
+
+// withAdminConn calls fn with an admin connection to the given database.
+func (db *DB) withAdminConn(ctx context.Context, cloudName string, fn func(conn *sql.Conn) error) error {
 	info, err := db.Cluster.Info(ctx)
 	if err != nil {
 		return err
@@ -293,13 +354,28 @@ func (db *DB) doMigrate(ctx context.Context, cloudName, appRoot string, dbMeta *
 	}
 	defer fns.CloseIgnore(pool)
 
-	path := filepath.Join(appRoot, *dbMeta.MigrationRelPath)
-	mdSrc := NewMetadataSource(NewOsMigrationReader(path), dbMeta.Migrations)
 	conn, err := pool.Conn(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to connect to postgres")
 	}
-	err = RunMigration(ctx, cloudName, dbMeta.AllowNonSequentialMigrations, conn, mdSrc)
+	defer fns.CloseIgnore(conn)
+	return fn(conn)
+}
+
+// migrateSQL applies the pending SQL migrations of the database,
+// only those numbered below before if given.
+func (db *DB) migrateSQL(ctx context.Context, conn *sql.Conn, cloudName, appRoot string, dbMeta *meta.SQLDatabase, before option.Option[uint64]) error {
+	migrations := dbMeta.Migrations
+	if limit, ok := before.Get(); ok {
+		migrations = fns.Filter(migrations, func(m *meta.DBMigration) bool { return m.Number < limit })
+	}
+	if len(migrations) == 0 || dbMeta.MigrationRelPath == nil {
+		return nil
+	}
+
+	path := filepath.Join(appRoot, *dbMeta.MigrationRelPath)
+	mdSrc := NewMetadataSource(NewOsMigrationReader(path), migrations)
+	err := RunMigration(ctx, cloudName, dbMeta.AllowNonSequentialMigrations, conn, mdSrc)
 
 	// If we have removed a migration that failed to apply we can get an ErrNoChange error
 	// after forcing the migration down to the previous version.
@@ -322,22 +398,28 @@ func (db *DB) ListAppliedMigrations(ctx context.Context) (map[uint64]bool, error
 	return LoadAppliedVersions(ctx, conn, "public", "schema_migrations")
 }
 
-func RunMigration(ctx context.Context, dbName string, allowNonSeq bool, conn *sql.Conn, mdSrc *MetadataSource) (err error) {
-	var (
-		dbDriver  database.Driver
-		srcDriver source.Driver
-	)
+// migrationDrivers returns the golang-migrate drivers for migrating the database
+// connected to by conn, using the migrations of mdSrc.
+func migrationDrivers(ctx context.Context, allowNonSeq bool, conn *sql.Conn, mdSrc *MetadataSource) (database.Driver, source.Driver, error) {
 	if allowNonSeq {
-		dbDriver, srcDriver, err = NonSequentialMigrator(ctx, conn, mdSrc)
-		if err != nil {
-			return errors.Wrap(err, "failed to connect to postgres")
-		}
-	} else {
-		dbDriver, err = postgres.WithConnection(ctx, conn, &postgres.Config{})
+		dbDriver, srcDriver, err := NonSequentialMigrator(ctx, conn, mdSrc)
 		if err != nil {
-			return errors.Wrap(err, "failed to connect to postgres")
+			return nil, nil, errors.Wrap(err, "failed to connect to postgres")
 		}
-		srcDriver = mdSrc
+		return dbDriver, srcDriver, nil
+	}
+
+	dbDriver, err := postgres.WithConnection(ctx, conn, &postgres.Config{})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to connect to postgres")
+	}
+	return dbDriver, mdSrc, nil
+}
+
+func RunMigration(ctx context.Context, dbName string, allowNonSeq bool, conn *sql.Conn, mdSrc *MetadataSource) (err error) {
+	dbDriver, srcDriver, err := migrationDrivers(ctx, allowNonSeq, conn, mdSrc)
+	if err != nil {
+		return err
 	}
 
 	curVersion, _, err := dbDriver.Version()
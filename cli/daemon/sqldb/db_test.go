@@ -1,11 +1,18 @@
 package sqldb
 
 import (
+	"errors"
+	"io"
 	"io/fs"
+	"os"
+	"path/filepath"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
 	_ "github.com/golang-migrate/migrate/v4/source/file" // for running migrations from the filesystem
+
+	"encr.dev/pkg/fns"
+	meta "encr.dev/proto/encore/parser/meta/v1"
 )
 
 func TestFindClosestVersion(t *testing.T) {
@@ -71,3 +78,52 @@ func TestFindClosestVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataSourceReadDown(t *testing.T) {
+	c := qt.New(t)
+	dir := t.TempDir()
+	c.Assert(os.WriteFile(filepath.Join(dir, "1_init.up.sql"), []byte("CREATE TABLE foo (id int)"), 0644), qt.IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, "1_init.down.sql"), []byte("DROP TABLE foo"), 0644), qt.IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, "2_bar.up.sql"), []byte("CREATE TABLE bar (id int)"), 0644), qt.IsNil)
+
+	src := NewMetadataSource(NewOsMigrationReader(dir), []*meta.DBMigration{
+		{Filename: "1_init.up.sql", Number: 1, Description: "init"},
+		{Filename: "2_bar.up.sql", Number: 2, Description: "bar"},
+	})
+
+	r, identifier, err := src.ReadDown(1)
+	c.Assert(err, qt.IsNil)
+	defer fns.CloseIgnore(r)
+	data, err := io.ReadAll(r)
+	c.Assert(err, qt.IsNil)
+	c.Assert(identifier, qt.Equals, "init")
+	c.Assert(string(data), qt.Equals, "DROP TABLE foo;\ndelete from schema_migrations where version = 1;")
+
+	// Migrations without a down migration can't be reverted.
+	_, _, err = src.ReadDown(2)
+	c.Assert(errors.Is(err, fs.ErrNotExist), qt.IsTrue)
+}
+
+func TestSeedFiles(t *testing.T) {
+	c := qt.New(t)
+	root := c.TempDir()
+	seedDir := filepath.Join(root, "svc", "seeds")
+	c.Assert(os.MkdirAll(filepath.Join(seedDir, "fixtures"), 0755), qt.IsNil)
+	for _, name := range []string{"2_posts.sql", "1_users.sql", "README.md", "main.go", "main_test.go"} {
+		c.Assert(os.WriteFile(filepath.Join(seedDir, name), nil, 0644), qt.IsNil)
+	}
+
+	seedPath := "svc/seeds"
+	files, hasGoSeed, err := SeedFiles(root, &meta.SQLDatabase{Name: "svc", SeedRelPath: &seedPath})
+	c.Assert(err, qt.IsNil)
+	c.Assert(files, qt.DeepEquals, []string{
+		filepath.Join(seedDir, "1_users.sql"),
+		filepath.Join(seedDir, "2_posts.sql"),
+	})
+	c.Assert(hasGoSeed, qt.IsTrue)
+
+	files, hasGoSeed, err = SeedFiles(root, &meta.SQLDatabase{Name: "other"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(files, qt.HasLen, 0)
+	c.Assert(hasGoSeed, qt.IsFalse)
+}
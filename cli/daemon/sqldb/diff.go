@@ -0,0 +1,110 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
+
+	"encr.dev/pkg/fns"
+	"encr.dev/pkg/option"
+	"encr.dev/pkg/pgschema"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// DiffSchema compares the schema of the database with the schema produced
+// by applying all its migrations to an empty database.
+//
+// It reports the SQL to migrate from the migrated schema to the schema of the database (up),
+// and the SQL to revert it (down). Both are empty if the schemas are the same.
+func (db *DB) DiffSchema(ctx context.Context, appRoot string, dbMeta *meta.SQLDatabase) (up, down string, err error) {
+	statuses, err := db.MigrationStatus(ctx, appRoot, dbMeta)
+	if err != nil {
+		return "", "", err
+	}
+	for _, s := range statuses {
+		if s.DataMigration {
+			continue
+		} else if s.Dirty {
+			return "", "", fmt.Errorf("migration %d is dirty: fix it before diffing the schema", s.Number)
+		} else if !s.Applied {
+			return "", "", fmt.Errorf("migration %d is not applied: apply all migrations before diffing the schema", s.Number)
+		}
+	}
+
+	info, err := db.Cluster.Info(ctx)
+	if err != nil {
+		return "", "", err
+	} else if info.Status != Running {
+		return "", "", errors.New("cluster not running")
+	}
+
+	// Migrate a temporary database from scratch to get the schema
+	// defined by the migrations.
+	tmpName := db.ApplicationCloudName() + "_diff"
+	if err := db.doDrop(ctx, tmpName); err != nil {
+		return "", "", errors.Wrapf(err, "drop db %s", tmpName)
+	}
+	if err := db.doCreate(ctx, tmpName, option.None[string]()); err != nil {
+		return "", "", errors.Wrapf(err, "create db %s", tmpName)
+	}
+	defer func() { _ = db.doDrop(context.Background(), tmpName) }()
+	if err := db.ensureRoles(ctx, tmpName, db.Cluster.Roles...); err != nil {
+		return "", "", fmt.Errorf("ensure db roles %s: %v", tmpName, err)
+	}
+	if err := db.migrateFromScratch(ctx, info, tmpName, appRoot, dbMeta); err != nil {
+		return "", "", err
+	}
+
+	migrated, err := loadSchema(ctx, info, tmpName)
+	if err != nil {
+		return "", "", err
+	}
+	live, err := loadSchema(ctx, info, db.ApplicationCloudName())
+	if err != nil {
+		return "", "", err
+	}
+	return pgschema.Diff(migrated, live), pgschema.Diff(live, migrated), nil
+}
+
+// migrateFromScratch applies all the migrations to the empty database cloudName.
+// Unlike doMigrate it doesn't mark db as migrated.
+func (db *DB) migrateFromScratch(ctx context.Context, info *ClusterInfo, cloudName, appRoot string, dbMeta *meta.SQLDatabase) error {
+	if len(dbMeta.Migrations) == 0 || dbMeta.MigrationRelPath == nil {
+		return nil
+	}
+	admin, ok := info.Encore.First(RoleAdmin, RoleSuperuser)
+	if !ok {
+		return errors.New("unable to find superuser or admin roles")
+	}
+	pool, err := sql.Open("pgx", info.ConnURI(cloudName, admin))
+	if err != nil {
+		return err
+	}
+	defer fns.CloseIgnore(pool)
+	conn, err := pool.Conn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to postgres")
+	}
+	defer fns.CloseIgnore(conn)
+
+	path := filepath.Join(appRoot, *dbMeta.MigrationRelPath)
+	mdSrc := NewMetadataSource(NewOsMigrationReader(path), dbMeta.Migrations)
+	if err := RunMigration(ctx, cloudName, dbMeta.AllowNonSequentialMigrations, conn, mdSrc); err != nil {
+		return fmt.Errorf("could not migrate database %s: %v", cloudName, err)
+	}
+	return nil
+}
+
+func loadSchema(ctx context.Context, info *ClusterInfo, cloudName string) (*pgschema.Schema, error) {
+	conn, err := pgx.Connect(ctx, info.ConnURI(cloudName, info.Config.Superuser))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to postgres")
+	}
+	defer func() { _ = conn.Close(context.Background()) }()
+	schema, err := pgschema.Load(ctx, conn)
+	return schema, errors.Wrapf(err, "load schema of %s", cloudName)
+}
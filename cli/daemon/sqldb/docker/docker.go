@@ -316,6 +316,55 @@ func (d *Driver) DestroyNamespaceData(ctx context.Context, ns *namespace.Namespa
 	return nil
 }
 
+func (d *Driver) DumpDatabase(ctx context.Context, id sqldb.ClusterID, cloudName string, w io.Writer) error {
+	status, cname, err := d.runningCluster(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "docker", "exec", cname,
+		"pg_dump", "--username", status.Config.Superuser.Username,
+		"--format=custom", "--no-owner", "--no-acl", cloudName)
+	cmd.Stdout = w
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "pg_dump %s: %s", cloudName, bytes.TrimSpace(stderr.Bytes()))
+	}
+	return nil
+}
+
+func (d *Driver) RestoreDatabase(ctx context.Context, id sqldb.ClusterID, cloudName, owner string, r io.Reader) error {
+	status, cname, err := d.runningCluster(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "docker", "exec", "-i", cname,
+		"pg_restore", "--username", status.Config.Superuser.Username,
+		"--dbname", cloudName, "--role", owner,
+		"--no-owner", "--no-acl", "--single-transaction", "--exit-on-error")
+	cmd.Stdin = r
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "pg_restore %s: %s", cloudName, bytes.TrimSpace(stderr.Bytes()))
+	}
+	return nil
+}
+
+// runningCluster reports the status and container name of the cluster,
+// and returns an error if it's not running.
+func (d *Driver) runningCluster(ctx context.Context, id sqldb.ClusterID) (*sqldb.ClusterStatus, string, error) {
+	status, cname, err := d.clusterStatus(ctx, id)
+	if err != nil {
+		return nil, "", err
+	} else if status.Status != sqldb.Running {
+		return nil, "", errors.New("database cluster is not running")
+	}
+	return status, cname, nil
+}
+
 func (d *Driver) createVolumeIfNeeded(ctx context.Context, name string) error {
 	if err := exec.CommandContext(ctx, "docker", "volume", "inspect", name).Run(); err == nil {
 		return nil
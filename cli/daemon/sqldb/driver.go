@@ -3,6 +3,7 @@ package sqldb
 import (
 	"context"
 	"errors"
+	"io"
 
 	"github.com/rs/zerolog"
 
@@ -38,6 +39,16 @@ type Driver interface {
 	// to use the driver.
 	CheckRequirements(ctx context.Context) error
 
+	// DumpDatabase writes a dump of the database with the given cloud name to w,
+	// in the pg_dump custom format.
+	// If a Driver doesn't support dumping databases it reports ErrUnsupported.
+	DumpDatabase(ctx context.Context, id ClusterID, cloudName string, w io.Writer) error
+
+	// RestoreDatabase restores a dump written by DumpDatabase into the existing,
+	// empty database with the given cloud name. The restored objects are owned by owner.
+	// If a Driver doesn't support restoring databases it reports ErrUnsupported.
+	RestoreDatabase(ctx context.Context, id ClusterID, cloudName, owner string, r io.Reader) error
+
 	// Meta reports driver metadata.
 	Meta() DriverMeta
 }
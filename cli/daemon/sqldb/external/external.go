@@ -3,6 +3,7 @@ package external
 
 import (
 	"context"
+	"io"
 
 	"github.com/rs/zerolog"
 
@@ -52,6 +53,14 @@ func (d *Driver) DestroyNamespaceData(ctx context.Context, ns *namespace.Namespa
 	return sqldb.ErrUnsupported
 }
 
+func (d *Driver) DumpDatabase(ctx context.Context, id sqldb.ClusterID, cloudName string, w io.Writer) error {
+	return sqldb.ErrUnsupported
+}
+
+func (d *Driver) RestoreDatabase(ctx context.Context, id sqldb.ClusterID, cloudName, owner string, r io.Reader) error {
+	return sqldb.ErrUnsupported
+}
+
 func (d *Driver) CheckRequirements(ctx context.Context) error {
 	return nil
 }
@@ -163,7 +163,31 @@ func (src *MetadataSource) Next(version uint) (nextVersion uint, err error) {
 }
 
 func (src *MetadataSource) ReadDown(version uint) (r io.ReadCloser, identifier string, err error) {
-	return nil, "", os.ErrNotExist
+	m, err := src.migration(version, 0)
+	if err != nil {
+		return nil, "", err
+	}
+	r, err = src.Read(downMigration(m))
+	if err != nil {
+		return nil, "", err
+	}
+	// Remove the migration from the migrations table in the same statement as it's reverted,
+	// for the same reason as in ReadUp. It's needed for non-sequential migrations, which track
+	// each applied migration separately.
+	statement := fmt.Sprintf(";\ndelete from schema_migrations where version = %d;", version)
+	return MultiReadCloser(
+		r,
+		strings.NewReader(statement),
+	), m.Description, nil
+}
+
+// downMigration returns the down migration that reverts the given migration.
+func downMigration(m *meta.DBMigration) *meta.DBMigration {
+	return &meta.DBMigration{
+		Filename:    strings.TrimSuffix(m.Filename, ".up.sql") + ".down.sql",
+		Number:      m.Number,
+		Description: m.Description,
+	}
 }
 
 func (src *MetadataSource) migration(version uint, offset int) (*meta.DBMigration, error) {
@@ -0,0 +1,448 @@
+package sqldb
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+
+	"encr.dev/pkg/fns"
+	"encr.dev/pkg/option"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// MigrationStatus describes a migration of a database, and whether it's applied.
+type MigrationStatus struct {
+	Number      uint64
+	Description string
+	Filename    string // empty if the migration is applied but its file no longer exists
+	Applied     bool
+	Dirty       bool // the migration failed to apply
+	HasDown     bool // whether there is a down migration to revert the migration
+
+	// DataMigration reports whether the migration is a data migration written in Go.
+	// Data migrations are run in the running application, by RunDataMigrations.
+	DataMigration bool
+}
+
+// MigrationStatus reports the migrations of the database and whether they're applied.
+func (db *DB) MigrationStatus(ctx context.Context, appRoot string, dbMeta *meta.SQLDatabase) ([]*MigrationStatus, error) {
+	statuses, _, err := db.withMigrator(ctx, appRoot, dbMeta, nil)
+	return statuses, err
+}
+
+// MigrateUp applies the pending migrations of the database, up to and including
+// the migration to if given. Migrations numbered above a pending data migration
+// are not applied, as data migrations are run by the running application.
+//
+// It reports the migrations after migrating, and the numbers of the migrations that were applied.
+func (db *DB) MigrateUp(ctx context.Context, appRoot string, dbMeta *meta.SQLDatabase, to option.Option[uint64]) ([]*MigrationStatus, []uint64, error) {
+	return db.withMigrator(ctx, appRoot, dbMeta, func(mg *migrator, statuses []*MigrationStatus) error {
+		if err := checkNotDirty(statuses); err != nil {
+			return err
+		}
+
+		// The SQL migrations after a pending data migration must wait for it to run.
+		next, hasNext, err := nextDataMigration(ctx, mg.conn, dbMeta)
+		if err != nil {
+			return err
+		}
+
+		target, ok := to.Get()
+		if !ok {
+			if !hasNext {
+				return mg.m.Up()
+			}
+			// Apply the migrations up to the data migration.
+			idx := slices.IndexFunc(statuses, func(s *MigrationStatus) bool { return s.Number > next.Number })
+			if idx == -1 {
+				idx = len(statuses)
+			}
+			if idx == 0 || statuses[idx-1].Applied {
+				return migrate.ErrNoChange
+			}
+			return mg.m.Migrate(uint(statuses[idx-1].Number))
+		}
+
+		idx := slices.IndexFunc(statuses, func(s *MigrationStatus) bool { return s.Number == target })
+		if idx == -1 || statuses[idx].Filename == "" {
+			return fmt.Errorf("migration %d does not exist", target)
+		} else if statuses[idx].Applied {
+			return fmt.Errorf("migration %d is already applied", target)
+		} else if hasNext && target > next.Number {
+			return fmt.Errorf("migration %d must be applied after the data migration %d_%s, which has not run: "+
+				"data migrations run when the app is started with 'encore run'", target, next.Number, next.Description)
+		}
+		return mg.m.Migrate(uint(target))
+	})
+}
+
+// MigrateDown reverts the given number of migrations of the database,
+// starting with the most recently applied one, using their down migrations.
+//
+// It reports the migrations after migrating, and the numbers of the migrations that were reverted.
+func (db *DB) MigrateDown(ctx context.Context, appRoot string, dbMeta *meta.SQLDatabase, steps int) ([]*MigrationStatus, []uint64, error) {
+	return db.withMigrator(ctx, appRoot, dbMeta, func(mg *migrator, statuses []*MigrationStatus) error {
+		if err := checkNotDirty(statuses); err != nil {
+			return err
+		}
+
+		applied := fns.Filter(statuses, func(s *MigrationStatus) bool { return s.Applied })
+		if len(applied) == 0 {
+			return migrate.ErrNoChange
+		} else if steps > len(applied) {
+			return fmt.Errorf("cannot revert %d migrations: only %d are applied", steps, len(applied))
+		}
+
+		// Make sure all the migrations can be reverted before reverting any of them,
+		// as golang-migrate treats a missing down migration as a no-op.
+		for _, s := range applied[len(applied)-steps:] {
+			if s.Filename == "" {
+				return fmt.Errorf("cannot revert migration %d: its migration file no longer exists", s.Number)
+			} else if !s.HasDown {
+				return fmt.Errorf("cannot revert migration %d: %s does not exist", s.Number, downMigration(&meta.DBMigration{Filename: s.Filename}).Filename)
+			}
+		}
+		return mg.m.Steps(-steps)
+	})
+}
+
+// ForceMigrationVersion marks the given migration as the current version of the database,
+// without running any migrations: the migrations up to and including it are marked as applied,
+// and the later ones as not applied. It resets the dirty state of the database.
+// A version of zero marks no migrations as applied.
+func (db *DB) ForceMigrationVersion(ctx context.Context, appRoot string, dbMeta *meta.SQLDatabase, version uint64) ([]*MigrationStatus, []uint64, error) {
+	return db.withMigrator(ctx, appRoot, dbMeta, func(mg *migrator, statuses []*MigrationStatus) error {
+		if version != 0 && !slices.ContainsFunc(statuses, func(s *MigrationStatus) bool { return s.Number == version }) {
+			return fmt.Errorf("migration %d does not exist", version)
+		}
+		if !mg.nonSeq {
+			if version == 0 {
+				return mg.m.Force(database.NilVersion)
+			}
+			return mg.m.Force(int(version))
+		}
+		return mg.forceNonSequential(ctx, statuses, version)
+	})
+}
+
+// checkNotDirty returns an error if any of the migrations are dirty.
+func checkNotDirty(statuses []*MigrationStatus) error {
+	for i, s := range statuses {
+		if !s.Dirty {
+			continue
+		}
+		var prev uint64
+		if i > 0 {
+			prev = statuses[i-1].Number
+		}
+		// Migrations are applied in a transaction, so the database is left as it was
+		// before the failed migration.
+		return fmt.Errorf("migration %d is dirty: it failed to apply and was rolled back. "+
+			"Fix the migration and run 'encore db migrate force %d' to mark the previous migration as the current version",
+			s.Number, prev)
+	}
+	return nil
+}
+
+// migrator runs migrations of a database on demand.
+type migrator struct {
+	m          *migrate.Migrate
+	dbDriver   database.Driver
+	src        *MetadataSource
+	conn       *sql.Conn
+	nonSeq     bool
+	migrations []*meta.DBMigration
+}
+
+// withMigrator runs fn with a migrator for the database, if fn is non-nil.
+// The database is created if it doesn't exist, but no migrations are applied other than by fn.
+//
+// It reports the migrations after running fn, and the numbers of the migrations
+// that were applied or reverted by it.
+func (db *DB) withMigrator(ctx context.Context, appRoot string, dbMeta *meta.SQLDatabase, fn func(mg *migrator, statuses []*MigrationStatus) error) (statuses []*MigrationStatus, changed []uint64, err error) {
+	if dbMeta.MigrationRelPath == nil {
+		return nil, nil, fmt.Errorf("database %s has no migrations", dbMeta.Name)
+	}
+
+	db.setupMu.Lock()
+	defer db.setupMu.Unlock()
+
+	cloudName := db.ApplicationCloudName()
+	if err := db.doCreate(ctx, cloudName, option.None[string]()); err != nil {
+		return nil, nil, errors.Wrapf(err, "create db %s", cloudName)
+	} else if err := db.ensureRoles(ctx, cloudName, db.Cluster.Roles...); err != nil {
+		return nil, nil, errors.Wrapf(err, "ensure db roles %s", cloudName)
+	}
+
+	info, err := db.Cluster.Info(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	admin, ok := info.Encore.First(RoleAdmin, RoleSuperuser)
+	if !ok {
+		return nil, nil, errors.New("unable to find superuser or admin roles")
+	}
+	pool, err := sql.Open("pgx", info.ConnURI(cloudName, admin))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer fns.CloseIgnore(pool)
+	conn, err := pool.Conn(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to connect to postgres")
+	}
+	defer fns.CloseIgnore(conn)
+
+	path := filepath.Join(appRoot, *dbMeta.MigrationRelPath)
+	mdSrc := NewMetadataSource(NewOsMigrationReader(path), dbMeta.Migrations)
+	dbDriver, srcDriver, err := migrationDrivers(ctx, dbMeta.AllowNonSequentialMigrations, conn, mdSrc)
+	if err != nil {
+		return nil, nil, err
+	}
+	m, err := migrate.NewWithInstance("src", srcDriver, "postgres", dbDriver)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create migration instance")
+	}
+
+	mg := &migrator{
+		m:          m,
+		dbDriver:   dbDriver,
+		src:        mdSrc,
+		conn:       conn,
+		nonSeq:     dbMeta.AllowNonSequentialMigrations,
+		migrations: dbMeta.Migrations,
+	}
+	before, err := mg.status(ctx)
+	if err != nil {
+		return nil, nil, err
+	} else if fn == nil {
+		statuses, err := mg.withDataMigrations(ctx, before, dbMeta.DataMigrations)
+		return statuses, nil, err
+	}
+
+	if err := fn(mg, before); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return nil, nil, err
+	}
+	// The migrations are now managed manually, so don't migrate the database
+	// when it's set up again.
+	db.migrated = true
+
+	after, err := mg.status(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	wasApplied := make(map[uint64]bool, len(before))
+	for _, s := range before {
+		wasApplied[s.Number] = s.Applied
+	}
+	for _, s := range after {
+		if s.Applied != wasApplied[s.Number] {
+			changed = append(changed, s.Number)
+		}
+	}
+	after, err = mg.withDataMigrations(ctx, after, dbMeta.DataMigrations)
+	return after, changed, err
+}
+
+// status reports the migrations and whether they're applied.
+func (mg *migrator) status(ctx context.Context) ([]*MigrationStatus, error) {
+	// applied maps the applied migrations to whether they're dirty.
+	var applied map[uint64]bool
+	if mg.nonSeq {
+		var err error
+		applied, err = LoadAppliedVersions(ctx, mg.conn, "public", "schema_migrations")
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// The migrations table only tracks the current version.
+		version, dirty, err := mg.dbDriver.Version()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get current version")
+		}
+		applied = make(map[uint64]bool)
+		if version != database.NilVersion {
+			for _, m := range mg.migrations {
+				if m.Number < uint64(version) {
+					applied[m.Number] = false
+				}
+			}
+			applied[uint64(version)] = dirty
+		}
+	}
+
+	statuses := make([]*MigrationStatus, 0, len(mg.migrations))
+	for _, m := range mg.migrations {
+		dirty, ok := applied[m.Number]
+		delete(applied, m.Number)
+		statuses = append(statuses, &MigrationStatus{
+			Number:      m.Number,
+			Description: m.Description,
+			Filename:    m.Filename,
+			Applied:     ok,
+			Dirty:       dirty,
+			HasDown:     mg.hasDown(m),
+		})
+	}
+
+	// Report applied migrations whose files have since been removed.
+	for num, dirty := range applied {
+		statuses = append(statuses, &MigrationStatus{Number: num, Applied: true, Dirty: dirty})
+	}
+	slices.SortFunc(statuses, func(a, b *MigrationStatus) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+	return statuses, nil
+}
+
+// forceNonSequential forces the version of a database using non-sequential migrations,
+// which tracks each applied migration separately.
+func (mg *migrator) forceNonSequential(ctx context.Context, statuses []*MigrationStatus, version uint64) error {
+	tx, err := mg.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }() // no-op if committed
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version > $1", version); err != nil {
+		return err
+	}
+	for _, s := range statuses {
+		if s.Number > version {
+			break
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO schema_migrations (version, dirty) VALUES ($1, false)
+			ON CONFLICT (version) DO UPDATE SET dirty = false
+		`, s.Number); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// hasDown reports whether the migration has a down migration.
+func (mg *migrator) hasDown(m *meta.DBMigration) bool {
+	r, err := mg.src.Read(downMigration(m))
+	if err != nil {
+		return false
+	}
+	_ = r.Close()
+	return true
+}
+
+// dataMigrationsTable is the table the runtime uses to track applied data migrations.
+const dataMigrationsTable = "encore_data_migrations"
+
+// withDataMigrations adds the data migrations of the database to statuses,
+// ordered together with the SQL migrations.
+func (mg *migrator) withDataMigrations(ctx context.Context, statuses []*MigrationStatus, migrations []*meta.DBDataMigration) ([]*MigrationStatus, error) {
+	if len(migrations) == 0 {
+		return statuses, nil
+	}
+
+	applied, err := appliedDataMigrations(ctx, mg.conn)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range migrations {
+		statuses = append(statuses, &MigrationStatus{
+			Number:        m.Number,
+			Description:   m.Description,
+			Applied:       applied[m.Number],
+			DataMigration: true,
+		})
+	}
+	slices.SortStableFunc(statuses, func(a, b *MigrationStatus) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+	return statuses, nil
+}
+
+// appliedDataMigrations reports the numbers of the data migrations that have been applied.
+func appliedDataMigrations(ctx context.Context, conn *sql.Conn) (map[uint64]bool, error) {
+	var exists bool
+	if err := conn.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", dataMigrationsTable).Scan(&exists); err != nil {
+		return nil, errors.Wrap(err, "failed to query data migrations")
+	}
+	applied := make(map[uint64]bool)
+	if !exists {
+		return applied, nil
+	}
+
+	rows, err := conn.QueryContext(ctx, "SELECT version FROM "+dataMigrationsTable)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query data migrations")
+	}
+	defer fns.CloseIgnore(rows)
+	for rows.Next() {
+		var version uint64
+		if err := rows.Scan(&version); err != nil {
+			return nil, errors.Wrap(err, "failed to query data migrations")
+		}
+		applied[version] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to query data migrations")
+	}
+	return applied, nil
+}
+
+// nextDataMigration reports the first data migration of the database that has not been applied.
+//
+// The SQL migrations numbered above it must not be applied until it has run.
+// It reports an error if any of them already have been, as the data migration
+// would then run against a different schema than it was written for.
+func nextDataMigration(ctx context.Context, conn *sql.Conn, dbMeta *meta.SQLDatabase) (m *meta.DBDataMigration, ok bool, err error) {
+	if len(dbMeta.DataMigrations) == 0 {
+		return nil, false, nil
+	}
+	applied, err := appliedDataMigrations(ctx, conn)
+	if err != nil {
+		return nil, false, err
+	}
+
+	migrations := slices.SortedFunc(slices.Values(dbMeta.DataMigrations), func(a, b *meta.DBDataMigration) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+	idx := slices.IndexFunc(migrations, func(m *meta.DBDataMigration) bool { return !applied[m.Number] })
+	if idx == -1 {
+		return nil, false, nil
+	}
+	m = migrations[idx]
+
+	latest, err := latestSQLMigration(ctx, conn)
+	if err != nil {
+		return nil, false, err
+	} else if latest > m.Number {
+		return nil, false, fmt.Errorf("data migration %d_%s has not run, but the later SQL migration %d has been applied: "+
+			"renumber the data migration to come after the applied migrations", m.Number, m.Description, latest)
+	}
+	return m, true, nil
+}
+
+// latestSQLMigration reports the number of the latest applied SQL migration, or 0 if there is none.
+func latestSQLMigration(ctx context.Context, conn *sql.Conn) (uint64, error) {
+	var exists bool
+	if err := conn.QueryRowContext(ctx, "SELECT to_regclass('schema_migrations') IS NOT NULL").Scan(&exists); err != nil {
+		return 0, errors.Wrap(err, "failed to query applied migrations")
+	} else if !exists {
+		return 0, nil
+	}
+
+	var latest int64
+	if err := conn.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations WHERE NOT dirty").Scan(&latest); err != nil {
+		return 0, errors.Wrap(err, "failed to query applied migrations")
+	} else if latest < 0 {
+		return 0, nil
+	}
+	return uint64(latest), nil
+}
@@ -0,0 +1,92 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
+
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// SeedFiles reports the .sql seed files of the database, in the order they're run,
+// and whether the seed directory contains a Go program to run after them.
+func SeedFiles(appRoot string, dbMeta *meta.SQLDatabase) (sqlFiles []string, hasGoSeed bool, err error) {
+	if dbMeta.SeedRelPath == nil {
+		return nil, false, nil
+	}
+	dir := filepath.Join(appRoot, filepath.FromSlash(*dbMeta.SeedRelPath))
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "read seed directory")
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		switch name := e.Name(); {
+		case strings.HasSuffix(strings.ToLower(name), ".sql"):
+			sqlFiles = append(sqlFiles, filepath.Join(dir, name))
+		case strings.HasSuffix(name, ".go") && !strings.HasSuffix(name, "_test.go"):
+			hasGoSeed = true
+		}
+	}
+	sort.Strings(sqlFiles)
+	return sqlFiles, hasGoSeed, nil
+}
+
+// Seed runs the database's .sql seed files, in a single transaction.
+// Go seed programs are run separately by the caller.
+func (db *DB) Seed(ctx context.Context, appRoot string, dbMeta *meta.SQLDatabase) error {
+	files, _, err := SeedFiles(appRoot, dbMeta)
+	if err != nil || len(files) == 0 {
+		return err
+	}
+
+	info, err := db.Cluster.Info(ctx)
+	if err != nil {
+		return err
+	} else if info.Status != Running {
+		return errors.New("cluster not running")
+	}
+
+	// Seed as the same role that runs the migrations, so the
+	// created objects have the same owner as the schema.
+	admin, ok := info.Encore.First(RoleAdmin, RoleSuperuser)
+	if !ok {
+		return errors.New("unable to find superuser or admin roles")
+	}
+	conn, err := pgx.Connect(ctx, info.ConnURI(db.ApplicationCloudName(), admin))
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to postgres")
+	}
+	defer func() { _ = conn.Close(context.Background()) }()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(context.Background()) }()
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		db.log.Debug().Str("file", file).Msg("running seed file")
+		// Run without arguments to use the simple protocol, which supports multiple statements.
+		if _, err := tx.Exec(ctx, string(data)); err != nil {
+			return fmt.Errorf("seed %s: %v", filepath.Base(file), err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	db.log.Info().Int("files", len(files)).Msg("seeded database")
+	return nil
+}
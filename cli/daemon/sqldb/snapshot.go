@@ -0,0 +1,242 @@
+package sqldb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"encr.dev/cli/daemon/apps"
+	"encr.dev/internal/conf"
+	"encr.dev/pkg/fns"
+	"encr.dev/pkg/option"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+)
+
+// Snapshot describes a saved snapshot of an app's local databases.
+//
+// Snapshots are stored per app rather than per namespace,
+// so they can be restored into any of the app's namespaces.
+type Snapshot struct {
+	Name      string    `json:"name"`
+	Namespace string    `json:"namespace"` // the namespace the snapshot was saved from
+	Databases []string  `json:"databases"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ErrSnapshotNotFound is reported when restoring a snapshot that doesn't exist.
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+var snapshotNameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+const snapshotManifest = "snapshot.json"
+
+// snapshotBaseDir returns the directory containing the snapshots of the app.
+func snapshotBaseDir(app *apps.Instance) (string, error) {
+	dir, err := conf.DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "db-snapshots", app.PlatformOrLocalID()), nil
+}
+
+// snapshotDir returns the directory containing the snapshot with the given name.
+func snapshotDir(app *apps.Instance, name string) (string, error) {
+	if !snapshotNameRe.MatchString(name) {
+		return "", fmt.Errorf("invalid snapshot name %q: must contain only letters, digits, '.', '-' and '_'", name)
+	}
+	base, err := snapshotBaseDir(app)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, name), nil
+}
+
+// ListSnapshots lists the saved snapshots of the app, ordered by name.
+func ListSnapshots(app *apps.Instance) ([]*Snapshot, error) {
+	base, err := snapshotBaseDir(app)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(base)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var snapshots []*Snapshot
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		snap, err := readSnapshot(filepath.Join(base, e.Name()))
+		if errors.Is(err, fs.ErrNotExist) {
+			// Not a snapshot, or one that's being saved.
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snap)
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Name < snapshots[j].Name
+	})
+	return snapshots, nil
+}
+
+func readSnapshot(dir string) (*Snapshot, error) {
+	data, err := os.ReadFile(filepath.Join(dir, snapshotManifest))
+	if err != nil {
+		return nil, err
+	}
+	var snap Snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, errors.Wrapf(err, "parse snapshot %s", filepath.Base(dir))
+	}
+	return &snap, nil
+}
+
+// SaveSnapshot saves a snapshot of the cluster's databases with the given name,
+// replacing any existing snapshot with the same name.
+func (c *Cluster) SaveSnapshot(ctx context.Context, name string, md *meta.Data) (*Snapshot, error) {
+	app := c.ID.NS.App
+	dir, err := snapshotDir(app, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Write the snapshot to a temporary directory first,
+	// so a failure doesn't leave a partial snapshot behind.
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return nil, err
+	}
+	tmpDir, err := os.MkdirTemp(filepath.Dir(dir), "."+name+"-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	snap := &Snapshot{
+		Name:      name,
+		Namespace: string(c.ID.NS.Name),
+		CreatedAt: time.Now().UTC(),
+	}
+	for _, dbMeta := range md.SqlDatabases {
+		if c.IsExternalDB(dbMeta.Name) {
+			continue
+		}
+		db := c.GetOrInitDB(dbMeta.Name)
+		if err := c.dumpDB(ctx, db, filepath.Join(tmpDir, dbMeta.Name+".dump")); err != nil {
+			return nil, errors.Wrapf(err, "snapshot database %s", dbMeta.Name)
+		}
+		snap.Databases = append(snap.Databases, dbMeta.Name)
+	}
+
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, snapshotManifest), data, 0644); err != nil {
+		return nil, err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmpDir, dir); err != nil {
+		return nil, err
+	}
+	c.log.Info().Str("snapshot", name).Strs("dbs", snap.Databases).Msg("saved database snapshot")
+	return snap, nil
+}
+
+func (c *Cluster) dumpDB(ctx context.Context, db *DB, path string) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	return c.driver.DumpDatabase(ctx, c.ID, db.ApplicationCloudName(), f)
+}
+
+// RestoreSnapshot replaces the cluster's databases with the contents of the
+// snapshot with the given name. Databases that are not part of the snapshot
+// are left as-is. It reports the names of the databases that were restored.
+func (c *Cluster) RestoreSnapshot(ctx context.Context, name string, md *meta.Data) (restored []string, err error) {
+	dir, err := snapshotDir(c.ID.NS.App, name)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := readSnapshot(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrSnapshotNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	for _, dbMeta := range md.SqlDatabases {
+		if !slices.Contains(snap.Databases, dbMeta.Name) {
+			continue
+		} else if c.IsExternalDB(dbMeta.Name) {
+			return restored, fmt.Errorf("cannot restore %q: restoring external databases is disabled", dbMeta.Name)
+		}
+		db := c.GetOrInitDB(dbMeta.Name)
+		if err := db.restore(ctx, filepath.Join(dir, dbMeta.Name+".dump")); err != nil {
+			return restored, errors.Wrapf(err, "restore database %s", dbMeta.Name)
+		}
+		restored = append(restored, dbMeta.Name)
+	}
+	c.log.Info().Str("snapshot", name).Strs("dbs", restored).Msg("restored database snapshot")
+	return restored, nil
+}
+
+// restore recreates the database from the dump at path.
+func (db *DB) restore(ctx context.Context, path string) (err error) {
+	db.setupMu.Lock()
+	defer db.setupMu.Unlock()
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer fns.CloseIgnore(f)
+
+	owner, ok := db.Cluster.Roles.First(RoleAdmin, RoleSuperuser)
+	if !ok {
+		return errors.New("unable to find admin or superuser roles")
+	}
+
+	if err := db.drop(ctx); err != nil {
+		return err
+	}
+	cloudName := db.ApplicationCloudName()
+	if err := db.doCreate(ctx, cloudName, option.None[string]()); err != nil {
+		return errors.Wrapf(err, "create db %s", cloudName)
+	}
+	if err := db.ensureRoles(ctx, cloudName, db.Cluster.Roles...); err != nil {
+		return fmt.Errorf("ensure db roles %s: %v", cloudName, err)
+	}
+	if err := db.Cluster.driver.RestoreDatabase(ctx, db.Cluster.ID, cloudName, owner.Username, f); err != nil {
+		return err
+	}
+
+	// The snapshot includes the migration state, so the database is ready to use.
+	db.migrated = true
+	if !db.readied {
+		db.readied = true
+		close(db.ready)
+	}
+	return nil
+}
@@ -10,10 +10,15 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"encr.dev/cli/daemon/apps"
+	"encr.dev/cli/daemon/chaos"
+	"encr.dev/cli/daemon/engine/trace2"
 	"encr.dev/cli/daemon/run"
+	"encr.dev/pkg/appfile"
 	"encr.dev/pkg/builder"
 	"encr.dev/pkg/fns"
 	daemonpb "encr.dev/proto/encore/daemon"
+	tracepb2 "encr.dev/proto/encore/engine/trace2"
 )
 
 // Test runs tests.
@@ -37,6 +42,9 @@ func (s *Server) Test(req *daemonpb.TestRequest, stream daemonpb.Daemon_TestServ
 	if err != nil {
 		sendErr(err)
 		return nil
+	} else if req.Chaos && app.Lang() != appfile.LangGo {
+		sendErr(errors.New("fault injection is only supported for Go apps"))
+		return nil
 	}
 
 	ns, err := s.namespaceOrActive(ctx, app, nil /* tests don't support different namespaces */)
@@ -67,7 +75,7 @@ func (s *Server) Test(req *daemonpb.TestRequest, stream daemonpb.Daemon_TestServ
 			}
 		}()
 
-		testEnv := append([]string{"ENCORE_RUNTIME_LOG=error"}, req.Environ...)
+		testEnv := testEnviron(app, req.Chaos, req.Environ)
 
 		tp := run.TestParams{
 			TestSpecParams: &run.TestSpecParams{
@@ -129,7 +137,10 @@ func (s *Server) TestSpec(ctx context.Context, req *daemonpb.TestSpecRequest) (r
 		}
 	}()
 
-	testEnv := append([]string{"ENCORE_RUNTIME_LOG=error"}, req.Environ...)
+	if req.Chaos && app.Lang() != appfile.LangGo {
+		return nil, status.Error(codes.InvalidArgument, "fault injection is only supported for Go apps")
+	}
+	testEnv := testEnviron(app, req.Chaos, req.Environ)
 
 	spec, err := s.mgr.TestSpec(ctx, run.TestSpecParams{
 		App:        app,
@@ -152,3 +163,52 @@ func (s *Server) TestSpec(ctx context.Context, req *daemonpb.TestSpecRequest) (r
 		Environ: spec.Environ,
 	}, nil
 }
+
+// TestTraces lists the traces recorded by tests.
+func (s *Server) TestTraces(ctx context.Context, req *daemonpb.TestTracesRequest) (*daemonpb.TestTracesResponse, error) {
+	app, err := s.apps.Track(req.AppRoot)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appID := app.PlatformOrLocalID()
+	testsOnly := true
+	query := &trace2.Query{
+		AppID:      appID,
+		TestFilter: &testsOnly,
+		Limit:      10000,
+	}
+	if req.Since != nil {
+		query.StartTime = req.Since.AsTime()
+	}
+
+	resp := &daemonpb.TestTracesResponse{}
+	err = s.tr.List(ctx, query, func(span *tracepb2.SpanSummary) bool {
+		resp.Traces = append(resp.Traces, &daemonpb.TestTrace{
+			TraceId:   span.TraceId,
+			TestName:  span.GetEndpointName(),
+			TestFile:  span.GetSrcFile(),
+			Service:   span.GetServiceName(),
+			Failed:    span.IsError,
+			Skipped:   span.GetTestSkipped(),
+			StartedAt: span.StartedAt,
+			TraceUrl:  fmt.Sprintf("%s/%s/traces/%s", s.mgr.DashBaseURL, appID, span.TraceId),
+		})
+		return true
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list test traces: %v", err)
+	}
+	return resp, nil
+}
+
+// testEnviron returns the environment to run the app's tests with.
+// The app's fault injection rules for tests only apply if chaos is set,
+// so that tests are never made flaky without asking for it.
+func testEnviron(app *apps.Instance, chaosEnabled bool, environ []string) []string {
+	env := []string{"ENCORE_RUNTIME_LOG=error"}
+	if chaosEnabled {
+		env = append(env, chaos.Environ(app, chaos.EnvTest)...)
+	}
+	return append(env, environ...)
+}
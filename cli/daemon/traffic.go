@@ -0,0 +1,170 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"encr.dev/cli/daemon/apps"
+	"encr.dev/cli/daemon/run"
+	"encr.dev/cli/daemon/traffic"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+// TrafficRecord records the requests served by the running app into a session,
+// until the call is cancelled or the app stops.
+func (s *Server) TrafficRecord(req *daemonpb.TrafficRecordRequest, stream daemonpb.Daemon_TrafficRecordServer) error {
+	app, err := s.apps.Track(req.AppRoot)
+	if err != nil {
+		return err
+	}
+	r, err := s.runningApp(app)
+	if err != nil {
+		return err
+	}
+	if req.Session == "" {
+		return status.Error(codes.InvalidArgument, "no session name given")
+	}
+	w, err := traffic.CreateSession(app, req.Session, req.Append)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer func() { _ = w.Close() }()
+
+	// Exchanges are persisted as they're recorded, so notifications to
+	// a slow client are dropped rather than holding up the requests.
+	recorded := make(chan *daemonpb.TrafficExchange, 128)
+	writeErr := make(chan error, 1)
+	unsubscribe := r.Traffic.Subscribe(func(e *traffic.Exchange) {
+		idx, err := w.Write(e)
+		if err != nil {
+			select {
+			case writeErr <- err:
+			default:
+			}
+			return
+		}
+		select {
+		case recorded <- &daemonpb.TrafficExchange{
+			Index:      int32(idx),
+			Method:     e.Method,
+			Path:       e.Path,
+			StatusCode: int32(e.Response.StatusCode),
+			DurationMs: e.Duration.Milliseconds(),
+		}:
+		default:
+		}
+	})
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-r.Done():
+			return status.Error(codes.Aborted, "app stopped running")
+		case err := <-writeErr:
+			return status.Errorf(codes.Internal, "record traffic: %v", err)
+		case e := <-recorded:
+			if err := stream.Send(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// TrafficReplay replays a recorded session against the running app.
+func (s *Server) TrafficReplay(req *daemonpb.TrafficReplayRequest, stream daemonpb.Daemon_TrafficReplayServer) error {
+	app, err := s.apps.Track(req.AppRoot)
+	if err != nil {
+		return err
+	}
+	r, err := s.runningApp(app)
+	if err != nil {
+		return err
+	}
+	exchanges, err := traffic.LoadSession(app, req.Session)
+	if errors.Is(err, traffic.ErrSessionNotFound) {
+		return status.Errorf(codes.NotFound, "traffic session %q not found", req.Session)
+	} else if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Speed < 0 {
+		return status.Error(codes.InvalidArgument, "speed must not be negative")
+	}
+
+	// Stop replaying if the app stops.
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-r.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var sendErr error
+	err = traffic.Replay(ctx, "http://"+r.ListenAddr, exchanges, traffic.ReplayOptions{
+		Speed:        req.Speed,
+		IgnoreFields: req.IgnoreFields,
+	}, func(res *traffic.Result) {
+		if sendErr != nil {
+			return
+		}
+		msg := &daemonpb.TrafficReplayResult{
+			Index:              int32(res.Index),
+			Method:             res.Exchange.Method,
+			Path:               res.Exchange.Path,
+			RecordedStatusCode: int32(res.Exchange.Response.StatusCode),
+			StatusCode:         int32(res.StatusCode),
+			DurationMs:         res.Duration.Milliseconds(),
+			Diff:               res.Diff,
+		}
+		if res.Err != nil {
+			msg.Error = res.Err.Error()
+		}
+		if sendErr = stream.Send(msg); sendErr != nil {
+			cancel()
+		}
+	})
+	if sendErr != nil {
+		return sendErr
+	} else if err != nil && stream.Context().Err() == nil {
+		return status.Error(codes.Aborted, "app stopped running")
+	}
+	return nil
+}
+
+// TrafficSessions lists the recorded traffic sessions of an app.
+func (s *Server) TrafficSessions(ctx context.Context, req *daemonpb.TrafficSessionsRequest) (*daemonpb.TrafficSessionsResponse, error) {
+	app, err := s.apps.Track(req.AppRoot)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := traffic.ListSessions(app)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list sessions: %v", err)
+	}
+	resp := &daemonpb.TrafficSessionsResponse{}
+	for _, sess := range sessions {
+		resp.Sessions = append(resp.Sessions, &daemonpb.TrafficSessionsResponse_Session{
+			Name:       sess.Name,
+			Requests:   int32(sess.Requests),
+			RecordedAt: timestamppb.New(sess.RecordedAt),
+		})
+	}
+	return resp, nil
+}
+
+// runningApp returns the run of the app.
+func (s *Server) runningApp(app *apps.Instance) (*run.Run, error) {
+	r := s.mgr.FindRunByAppID(app.PlatformOrLocalID())
+	if r == nil {
+		return nil, status.Error(codes.FailedPrecondition, "app is not running: start it with 'encore run'")
+	}
+	return r, nil
+}
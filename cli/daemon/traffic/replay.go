@@ -0,0 +1,171 @@
+package traffic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// ReplayOptions configure how a session is replayed.
+type ReplayOptions struct {
+	// Speed is the replay speed relative to the recording, where 2 replays
+	// the requests twice as fast as they were recorded.
+	// If zero, each request is sent as soon as the previous one has completed.
+	Speed float64
+
+	// IgnoreFields are the names of JSON object fields to ignore
+	// when comparing the recorded and replayed response bodies.
+	IgnoreFields []string
+
+	// Client is the HTTP client to send requests with.
+	// If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+// Result is the result of replaying a recorded exchange.
+type Result struct {
+	Index    int
+	Exchange *Exchange
+
+	StatusCode int // status code of the replayed request, or 0 if it failed
+	Duration   time.Duration
+	Err        error  // why the request failed, if it did
+	Diff       string // difference between the recorded and replayed responses, if any
+}
+
+// headersToDrop are request headers that are not replayed,
+// because they describe the recorded connection rather than the request.
+var headersToDrop = []string{
+	"Connection", "Content-Length", "Accept-Encoding", "Keep-Alive",
+	"Proxy-Connection", "Te", "Trailer", "Transfer-Encoding", "Upgrade",
+}
+
+// Replay sends the exchanges to the app at baseURL in order,
+// calling fn with the result of each request.
+func Replay(ctx context.Context, baseURL string, exchanges []*Exchange, opts ReplayOptions, fn func(*Result)) error {
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ignore := make(map[string]bool, len(opts.IgnoreFields))
+	for _, f := range opts.IgnoreFields {
+		ignore[f] = true
+	}
+
+	start := time.Now()
+	for i, e := range exchanges {
+		if opts.Speed > 0 {
+			offset := time.Duration(float64(e.Time.Sub(exchanges[0].Time)) / opts.Speed)
+			if err := sleepUntil(ctx, start.Add(offset)); err != nil {
+				return err
+			}
+		} else if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		res := replayOne(ctx, client, baseURL, e, ignore)
+		res.Index = i
+		fn(res)
+	}
+	return nil
+}
+
+func replayOne(ctx context.Context, client *http.Client, baseURL string, e *Exchange, ignore map[string]bool) *Result {
+	res := &Result{Exchange: e}
+	req, err := http.NewRequestWithContext(ctx, e.Method, strings.TrimSuffix(baseURL, "/")+e.Path, bytes.NewReader(e.Body))
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	req.Header = e.Header.Clone()
+	if req.Header == nil {
+		req.Header = make(http.Header)
+	}
+	for _, h := range headersToDrop {
+		req.Header.Del(h)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		res.Err = err
+		res.Duration = time.Since(start)
+		return res
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	res.Duration = time.Since(start)
+	res.StatusCode = resp.StatusCode
+	if err != nil {
+		res.Err = fmt.Errorf("read response: %w", err)
+		return res
+	}
+
+	res.Diff = diffResponse(e.Response, resp.StatusCode, body, ignore)
+	return res
+}
+
+// diffResponse describes the difference between the recorded response
+// and the replayed one, or returns "" if they match.
+func diffResponse(recorded Response, statusCode int, body []byte, ignore map[string]bool) string {
+	var b strings.Builder
+	if recorded.StatusCode != statusCode {
+		fmt.Fprintf(&b, "status code: %d => %d\n", recorded.StatusCode, statusCode)
+	}
+
+	var want, got any
+	if json.Unmarshal(recorded.Body, &want) == nil && json.Unmarshal(body, &got) == nil {
+		if d := cmp.Diff(dropFields(want, ignore), dropFields(got, ignore)); d != "" {
+			b.WriteString("body (-recorded +replayed):\n")
+			b.WriteString(d)
+		}
+	} else if !bytes.Equal(recorded.Body, body) {
+		b.WriteString("body (-recorded +replayed):\n")
+		b.WriteString(cmp.Diff(string(recorded.Body), string(body)))
+	}
+	return b.String()
+}
+
+// dropFields returns v with the object fields in ignore removed, at any depth.
+func dropFields(v any, ignore map[string]bool) any {
+	if len(ignore) == 0 {
+		return v
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if ignore[k] {
+				delete(v, k)
+			} else {
+				v[k] = dropFields(val, ignore)
+			}
+		}
+	case []any:
+		for i, val := range v {
+			v[i] = dropFields(val, ignore)
+		}
+	}
+	return v
+}
+
+func sleepUntil(ctx context.Context, t time.Time) error {
+	d := time.Until(t)
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,209 @@
+// Package traffic records the HTTP requests served by a running app,
+// and replays them against it.
+package traffic
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"encr.dev/cli/daemon/apps"
+	"encr.dev/internal/conf"
+)
+
+// Exchange is a recorded request and the response the app served for it.
+type Exchange struct {
+	// Time is when the request was received.
+	Time time.Time `json:"time"`
+
+	Method string      `json:"method"`
+	Path   string      `json:"path"` // request path, including the query string
+	Header http.Header `json:"header,omitempty"`
+	Body   []byte      `json:"body,omitempty"`
+
+	Response Response      `json:"response"`
+	Duration time.Duration `json:"duration"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       []byte      `json:"body,omitempty"`
+}
+
+// SessionInfo describes a recorded session.
+type SessionInfo struct {
+	Name       string
+	Requests   int
+	RecordedAt time.Time // when the session was last written to
+}
+
+// ErrSessionNotFound is reported when loading a session that doesn't exist.
+var ErrSessionNotFound = errors.New("traffic session not found")
+
+var sessionNameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+const sessionExt = ".jsonl"
+
+// sessionBaseDir returns the directory containing the sessions of the app.
+func sessionBaseDir(app *apps.Instance) (string, error) {
+	dir, err := conf.DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "traffic", app.PlatformOrLocalID()), nil
+}
+
+// sessionPath returns the path of the file holding the session with the given name.
+//
+// Sessions are stored as JSON lines, one exchange per line,
+// so each exchange can be persisted as soon as it's recorded.
+func sessionPath(app *apps.Instance, name string) (string, error) {
+	if !sessionNameRe.MatchString(name) {
+		return "", fmt.Errorf("invalid session name %q: must contain only letters, digits, '.', '-' and '_'", name)
+	}
+	base, err := sessionBaseDir(app)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, name+sessionExt), nil
+}
+
+// SessionWriter writes exchanges to a session.
+type SessionWriter struct {
+	mu  sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+	n   int
+}
+
+// CreateSession creates the session with the given name for writing.
+// If appendTo is false any existing session with the same name is replaced.
+func CreateSession(app *apps.Instance, name string, appendTo bool) (*SessionWriter, error) {
+	path, err := sessionPath(app, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, errors.Wrap(err, "create session dir")
+	}
+
+	n := 0
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if appendTo {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+		if existing, err := readSession(path); err == nil {
+			n = len(existing)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	f, err := os.OpenFile(path, flags, 0644)
+	if err != nil {
+		return nil, errors.Wrap(err, "open session")
+	}
+	return &SessionWriter{f: f, enc: json.NewEncoder(f), n: n}, nil
+}
+
+// Write appends the exchange to the session, and returns its index within the session.
+func (w *SessionWriter) Write(e *Exchange) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.enc.Encode(e); err != nil {
+		return 0, errors.Wrap(err, "write exchange")
+	}
+	w.n++
+	return w.n - 1, nil
+}
+
+// Close closes the session.
+func (w *SessionWriter) Close() error {
+	return w.f.Close()
+}
+
+// LoadSession loads the exchanges of the session with the given name, in the order they were recorded.
+func LoadSession(app *apps.Instance, name string) ([]*Exchange, error) {
+	path, err := sessionPath(app, name)
+	if err != nil {
+		return nil, err
+	}
+	exchanges, err := readSession(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrSessionNotFound
+	}
+	return exchanges, err
+}
+
+func readSession(path string) ([]*Exchange, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var exchanges []*Exchange
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 2*maxBodySize+1<<20)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var e Exchange
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, errors.Wrapf(err, "parse session %s", strings.TrimSuffix(filepath.Base(path), sessionExt))
+		}
+		exchanges = append(exchanges, &e)
+	}
+	return exchanges, scanner.Err()
+}
+
+// ListSessions lists the recorded sessions of the app, ordered by name.
+func ListSessions(app *apps.Instance) ([]*SessionInfo, error) {
+	base, err := sessionBaseDir(app)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(base)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var sessions []*SessionInfo
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), sessionExt)
+		if !ok || e.IsDir() {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		exchanges, err := readSession(filepath.Join(base, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, &SessionInfo{
+			Name:       name,
+			Requests:   len(exchanges),
+			RecordedAt: info.ModTime(),
+		})
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Name < sessions[j].Name
+	})
+	return sessions, nil
+}
@@ -0,0 +1,158 @@
+package traffic
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxBodySize is the maximum size of request and response bodies that are recorded.
+// Requests with larger bodies are served as usual, but not recorded.
+const maxBodySize = 10 << 20
+
+// Tap captures the requests served by a handler and passes them to its subscribers.
+// The zero value is ready to use.
+type Tap struct {
+	mu   sync.Mutex
+	subs map[*subscription]bool
+}
+
+type subscription struct {
+	fn func(*Exchange)
+}
+
+// Subscribe calls fn with every exchange served through the tap until unsubscribe is called.
+// fn is called concurrently for concurrent requests.
+func (t *Tap) Subscribe(fn func(*Exchange)) (unsubscribe func()) {
+	sub := &subscription{fn: fn}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.subs == nil {
+		t.subs = make(map[*subscription]bool)
+	}
+	t.subs[sub] = true
+
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.subs, sub)
+	}
+}
+
+func (t *Tap) subscribers() []*subscription {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	subs := make([]*subscription, 0, len(t.subs))
+	for sub := range t.subs {
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+// Serve serves the request using next, recording the exchange if there are any subscribers.
+func (t *Tap) Serve(w http.ResponseWriter, req *http.Request, next http.Handler) {
+	subs := t.subscribers()
+	if len(subs) == 0 || !recordable(req) {
+		next.ServeHTTP(w, req)
+		return
+	}
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		data, err := io.ReadAll(io.LimitReader(req.Body, maxBodySize+1))
+		if err != nil || len(data) > maxBodySize {
+			// Serve the request without recording it, with the part of the body we've already read.
+			req.Body = readCloser{io.MultiReader(bytes.NewReader(data), req.Body), req.Body}
+			next.ServeHTTP(w, req)
+			return
+		}
+		body = data
+		req.Body = io.NopCloser(bytes.NewReader(data))
+	}
+
+	e := &Exchange{
+		Time:   time.Now(),
+		Method: req.Method,
+		Path:   req.URL.RequestURI(),
+		Header: req.Header.Clone(),
+		Body:   body,
+	}
+	rw := &recordingWriter{ResponseWriter: w}
+	next.ServeHTTP(rw, req)
+	if rw.overflow {
+		return
+	}
+
+	e.Duration = time.Since(e.Time)
+	e.Response = Response{
+		StatusCode: rw.status(),
+		Header:     w.Header().Clone(),
+		Body:       rw.body.Bytes(),
+	}
+	for _, sub := range subs {
+		sub.fn(e)
+	}
+}
+
+// recordable reports whether the request can be recorded.
+// Protocol upgrades like WebSockets can't be replayed, and are not recorded.
+func recordable(req *http.Request) bool {
+	for _, v := range req.Header.Values("Connection") {
+		if strings.Contains(strings.ToLower(v), "upgrade") {
+			return false
+		}
+	}
+	return true
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// recordingWriter is a http.ResponseWriter that records the response it writes.
+type recordingWriter struct {
+	http.ResponseWriter
+	code     int
+	body     bytes.Buffer
+	overflow bool // whether the body exceeded maxBodySize
+}
+
+func (w *recordingWriter) WriteHeader(code int) {
+	if w.code == 0 {
+		w.code = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	if !w.overflow {
+		if w.body.Len()+len(p) > maxBodySize {
+			w.overflow = true
+			w.body = bytes.Buffer{}
+		} else {
+			w.body.Write(p)
+		}
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *recordingWriter) status() int {
+	if w.code == 0 {
+		return http.StatusOK
+	}
+	return w.code
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer,
+// so streaming responses are flushed as usual.
+func (w *recordingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *recordingWriter) Flush() {
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
@@ -0,0 +1,98 @@
+package traffic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	c := qt.New(t)
+
+	var (
+		mu      sync.Mutex
+		counter int
+		broken  bool
+	)
+	app := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		mu.Lock()
+		counter++
+		n, isBroken := counter, broken
+		mu.Unlock()
+
+		if isBroken && req.URL.Path == "/echo" {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = io.WriteString(w, `{"code":"internal"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"path": req.URL.RequestURI(),
+			"body": string(body),
+			"n":    n,
+		})
+	})
+
+	var tap Tap
+	var recorded []*Exchange
+	unsubscribe := tap.Subscribe(func(e *Exchange) {
+		recorded = append(recorded, e)
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		tap.Serve(w, req, app)
+	}))
+	defer srv.Close()
+
+	for _, path := range []string{"/hello?name=foo", "/echo"} {
+		resp, err := http.Post(srv.URL+path, "text/plain", strings.NewReader("payload"))
+		c.Assert(err, qt.IsNil)
+		_ = resp.Body.Close()
+	}
+	unsubscribe()
+
+	c.Assert(recorded, qt.HasLen, 2)
+	c.Assert(recorded[0].Method, qt.Equals, "POST")
+	c.Assert(recorded[0].Path, qt.Equals, "/hello?name=foo")
+	c.Assert(string(recorded[0].Body), qt.Equals, "payload")
+	c.Assert(recorded[0].Response.StatusCode, qt.Equals, http.StatusOK)
+	c.Assert(recorded[1].Path, qt.Equals, "/echo")
+
+	replay := func(ignore ...string) []*Result {
+		var results []*Result
+		err := Replay(context.Background(), srv.URL, recorded, ReplayOptions{IgnoreFields: ignore}, func(r *Result) {
+			results = append(results, r)
+		})
+		c.Assert(err, qt.IsNil)
+		return results
+	}
+
+	// The counter differs, unless it's ignored.
+	results := replay()
+	c.Assert(results, qt.HasLen, 2)
+	c.Assert(results[0].Diff, qt.Contains, `"n"`)
+	for _, r := range replay("n") {
+		c.Assert(r.Err, qt.IsNil)
+		c.Assert(r.StatusCode, qt.Equals, http.StatusOK)
+		c.Assert(r.Diff, qt.Equals, "")
+	}
+
+	// Replays aren't recorded after unsubscribing.
+	c.Assert(recorded, qt.HasLen, 2)
+
+	mu.Lock()
+	broken = true
+	mu.Unlock()
+	results = replay("n")
+	c.Assert(results[0].Diff, qt.Equals, "")
+	c.Assert(results[1].StatusCode, qt.Equals, http.StatusInternalServerError)
+	c.Assert(results[1].Diff, qt.Contains, fmt.Sprintf("status code: %d => %d", http.StatusOK, http.StatusInternalServerError))
+}
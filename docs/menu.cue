@@ -542,6 +542,11 @@
 				text: "Use Dependency Injection"
 				path: "/go/how-to/dependency-injection"
 				file: "go/how-to/dependency-injection"
+			}, {
+				kind: "basic"
+				text: "Write Parser Plugins"
+				path: "/go/how-to/parser-plugins"
+				file: "go/how-to/parser-plugins"
 			}, {
 				kind: "basic"
 				text: "Use Auth0 Authentication"
@@ -0,0 +1,636 @@
+// Package apicompat compares the APIs of two versions of an Encore application
+// and classifies the differences as breaking or non-breaking for existing clients.
+package apicompat
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"encr.dev/parser/encoding"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+	schema "encr.dev/proto/encore/parser/schema/v1"
+)
+
+// Kind identifies the kind of change.
+type Kind string
+
+const (
+	KindEndpointAdded    Kind = "endpoint-added"
+	KindEndpointRemoved  Kind = "endpoint-removed"
+	KindAccessChanged    Kind = "access-changed"
+	KindPathChanged      Kind = "path-changed"
+	KindMethodsChanged   Kind = "methods-changed"
+	KindProtocolChanged  Kind = "protocol-changed"
+	KindFieldAdded       Kind = "field-added"
+	KindFieldRemoved     Kind = "field-removed"
+	KindFieldMoved       Kind = "field-moved"
+	KindFieldOptionality Kind = "field-optionality"
+	KindTypeChanged      Kind = "type-changed"
+	KindTopicAdded       Kind = "topic-added"
+	KindTopicRemoved     Kind = "topic-removed"
+)
+
+// Change is a difference between the two versions of the API.
+type Change struct {
+	Kind     Kind
+	Breaking bool
+
+	// Subject is what changed: an endpoint ("svc.Endpoint") or a topic ("topic name").
+	Subject string
+	// Message describes the change.
+	Message string
+}
+
+func (c Change) String() string {
+	return c.Subject + ": " + c.Message
+}
+
+// HasBreaking reports whether any of the changes are breaking.
+func HasBreaking(changes []Change) bool {
+	return slices.ContainsFunc(changes, func(c Change) bool { return c.Breaking })
+}
+
+// Compare compares the API described by base with the one described by head.
+// Only endpoints accessible from outside the application and Pub/Sub topics are compared.
+func Compare(base, head *meta.Data) ([]Change, error) {
+	c := &comparer{base: base, head: head, seen: make(map[Change]bool)}
+	if err := c.compareEndpoints(); err != nil {
+		return nil, err
+	}
+	c.compareTopics()
+	return c.changes, nil
+}
+
+// direction describes which way data flows,
+// which determines whether a change breaks existing clients.
+type direction int
+
+const (
+	// request data is sent by clients, so clients must keep working
+	// when new fields are required or fields become stricter.
+	request direction = iota
+	// response data is received by clients, so clients must keep working
+	// when fields are removed or become looser.
+	response
+	// both is used for data that's both sent and received, like Pub/Sub messages.
+	both
+)
+
+type comparer struct {
+	base, head *meta.Data
+	changes    []Change
+	seen       map[Change]bool
+}
+
+func (c *comparer) add(kind Kind, breaking bool, subject, format string, args ...any) {
+	ch := Change{Kind: kind, Breaking: breaking, Subject: subject, Message: fmt.Sprintf(format, args...)}
+	if !c.seen[ch] {
+		c.seen[ch] = true
+		c.changes = append(c.changes, ch)
+	}
+}
+
+// exposedRPCs returns the RPCs accessible from outside the application, keyed by "svc.Name".
+func exposedRPCs(md *meta.Data) map[string]*meta.RPC {
+	rpcs := make(map[string]*meta.RPC)
+	for _, svc := range md.Svcs {
+		for _, rpc := range svc.Rpcs {
+			if rpc.AccessType != meta.RPC_PRIVATE {
+				rpcs[svc.Name+"."+rpc.Name] = rpc
+			}
+		}
+	}
+	return rpcs
+}
+
+func (c *comparer) compareEndpoints() error {
+	baseRPCs, headRPCs := exposedRPCs(c.base), exposedRPCs(c.head)
+	for _, name := range sortedKeys(baseRPCs, headRPCs) {
+		a, b := baseRPCs[name], headRPCs[name]
+		switch {
+		case b == nil:
+			c.add(KindEndpointRemoved, true, name, "endpoint removed or made private")
+		case a == nil:
+			c.add(KindEndpointAdded, false, name, "endpoint added")
+		default:
+			if err := c.compareEndpoint(name, a, b); err != nil {
+				return fmt.Errorf("compare endpoint %s: %v", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *comparer) compareEndpoint(name string, a, b *meta.RPC) error {
+	if a.AccessType != b.AccessType {
+		if b.AccessType == meta.RPC_AUTH {
+			c.add(KindAccessChanged, true, name, "endpoint now requires authentication")
+		} else {
+			c.add(KindAccessChanged, false, name, "endpoint no longer requires authentication")
+		}
+	}
+
+	if pa, pb := pathString(a.Path, true), pathString(b.Path, true); pa != pb {
+		c.add(KindPathChanged, true, name, "path changed from %s to %s", pathString(a.Path, false), pathString(b.Path, false))
+	}
+
+	methodsA, methodsB := httpMethods(a), httpMethods(b)
+	for _, m := range methodsA {
+		if !slices.Contains(methodsB, m) {
+			c.add(KindMethodsChanged, true, name, "HTTP method %s no longer supported", m)
+		}
+	}
+	for _, m := range methodsB {
+		if !slices.Contains(methodsA, m) {
+			c.add(KindMethodsChanged, false, name, "HTTP method %s added", m)
+		}
+	}
+
+	if pa, pb := protoName(a), protoName(b); pa != pb {
+		c.add(KindProtocolChanged, true, name, "endpoint changed from %s to %s", pa, pb)
+		return nil
+	} else if a.Proto == meta.RPC_RAW {
+		// Raw endpoints have no schema to compare.
+		return nil
+	}
+
+	opts := &encoding.Options{}
+	for _, m := range methodsA {
+		if !slices.Contains(methodsB, m) {
+			continue
+		}
+		reqA, err := describeRequest(c.base, a.RequestSchema, opts, m)
+		if err != nil {
+			return err
+		}
+		reqB, err := describeRequest(c.head, b.RequestSchema, opts, m)
+		if err != nil {
+			return err
+		}
+		c.compareParams(name, "request", reqA, reqB, request)
+	}
+
+	respA, err := describeResponse(c.base, a.ResponseSchema, opts)
+	if err != nil {
+		return err
+	}
+	respB, err := describeResponse(c.head, b.ResponseSchema, opts)
+	if err != nil {
+		return err
+	}
+	c.compareParams(name, "response", respA, respB, response)
+	return nil
+}
+
+// compareParams compares the HTTP parameters of a request or response.
+func (c *comparer) compareParams(subject, what string, a, b []*encoding.ParameterEncoding, dir direction) {
+	key := func(p *encoding.ParameterEncoding) string { return string(p.Location) + ":" + p.Name }
+	mapA, mapB := make(map[string]*encoding.ParameterEncoding), make(map[string]*encoding.ParameterEncoding)
+	for _, p := range a {
+		mapA[key(p)] = p
+	}
+	for _, p := range b {
+		mapB[key(p)] = p
+	}
+
+	// findMoved finds a parameter in ps for the same struct field as p.
+	findMoved := func(p *encoding.ParameterEncoding, ps []*encoding.ParameterEncoding) *encoding.ParameterEncoding {
+		for _, other := range ps {
+			if other.SrcName == p.SrcName {
+				return other
+			}
+		}
+		return nil
+	}
+
+	for _, k := range sortedKeys(mapA, mapB) {
+		pa, pb := mapA[k], mapB[k]
+		switch {
+		case pb == nil:
+			if moved := findMoved(pa, b); moved != nil {
+				c.add(KindFieldMoved, true, subject, "%s field %s moved from %s to %s",
+					what, pa.SrcName, paramString(pa), paramString(moved))
+			} else {
+				c.add(KindFieldRemoved, true, subject, "%s field %s removed", what, paramString(pa))
+			}
+		case pa == nil:
+			if findMoved(pb, a) != nil {
+				// Already reported above.
+				continue
+			}
+			switch {
+			case dir == response:
+				c.add(KindFieldAdded, false, subject, "%s field %s added", what, paramString(pb))
+			case pb.Optional:
+				c.add(KindFieldAdded, false, subject, "optional %s field %s added", what, paramString(pb))
+			default:
+				c.add(KindFieldAdded, true, subject, "required %s field %s added", what, paramString(pb))
+			}
+		default:
+			path := what + " field " + paramString(pb)
+			c.compareOptionality(subject, path, pa.Optional, pb.Optional, dir)
+			tc := &typeComparer{comparer: c, subject: subject, visited: make(map[[2]*schema.Named]bool)}
+			tc.compare(path, typeRef{t: pa.Type}, typeRef{t: pb.Type}, dir)
+		}
+	}
+}
+
+func (c *comparer) compareOptionality(subject, path string, optA, optB bool, dir direction) {
+	switch {
+	case optA && !optB:
+		c.add(KindFieldOptionality, dir != response, subject, "%s is now required", path)
+	case !optA && optB:
+		c.add(KindFieldOptionality, dir != request, subject, "%s is now optional", path)
+	}
+}
+
+func (c *comparer) compareTopics() {
+	topics := func(md *meta.Data) map[string]*meta.PubSubTopic {
+		m := make(map[string]*meta.PubSubTopic)
+		for _, t := range md.PubsubTopics {
+			m[t.Name] = t
+		}
+		return m
+	}
+	baseTopics, headTopics := topics(c.base), topics(c.head)
+	for _, name := range sortedKeys(baseTopics, headTopics) {
+		a, b := baseTopics[name], headTopics[name]
+		subject := "topic " + name
+		switch {
+		case b == nil:
+			c.add(KindTopicRemoved, true, subject, "topic removed")
+		case a == nil:
+			c.add(KindTopicAdded, false, subject, "topic added")
+		default:
+			tc := &typeComparer{comparer: c, subject: subject, visited: make(map[[2]*schema.Named]bool)}
+			tc.compare("message", typeRef{t: a.MessageType}, typeRef{t: b.MessageType}, both)
+		}
+	}
+}
+
+// typeComparer compares two types structurally, resolving named and generic types.
+type typeComparer struct {
+	*comparer
+	subject string
+
+	// visited tracks the named types being compared, to handle recursive types.
+	visited map[[2]*schema.Named]bool
+}
+
+// typeRef is a type together with the type arguments in scope for it.
+type typeRef struct {
+	t   *schema.Type
+	env *typeEnv
+}
+
+// typeEnv is the type arguments of an instantiated generic type.
+type typeEnv struct {
+	args   []*schema.Type
+	parent *typeEnv // the env the args are defined in
+}
+
+// resolve resolves named types, type parameters and transparent wrappers,
+// returning the underlying type along with the named type it was resolved from, if any.
+func resolve(md *meta.Data, ref typeRef) (typeRef, *schema.Named) {
+	var named *schema.Named
+	for {
+		switch t := ref.t.GetTyp().(type) {
+		case *schema.Type_Named:
+			if named == nil {
+				named = t.Named
+			}
+			decl := md.Decls[t.Named.Id]
+			ref = typeRef{t: decl.Type, env: &typeEnv{args: t.Named.TypeArguments, parent: ref.env}}
+		case *schema.Type_TypeParameter:
+			if ref.env == nil || int(t.TypeParameter.ParamIdx) >= len(ref.env.args) {
+				return ref, named
+			}
+			ref = typeRef{t: ref.env.args[t.TypeParameter.ParamIdx], env: ref.env.parent}
+		case *schema.Type_Pointer:
+			ref = typeRef{t: t.Pointer.Base, env: ref.env}
+		case *schema.Type_Config:
+			ref = typeRef{t: t.Config.Elem, env: ref.env}
+		default:
+			return ref, named
+		}
+	}
+}
+
+func (tc *typeComparer) compare(path string, a, b typeRef, dir direction) {
+	a, namedA := resolve(tc.base, a)
+	b, namedB := resolve(tc.head, b)
+	if namedA != nil && namedB != nil {
+		key := [2]*schema.Named{namedA, namedB}
+		if tc.visited[key] {
+			return
+		}
+		tc.visited[key] = true
+		defer delete(tc.visited, key)
+	}
+
+	typeChanged := func() {
+		tc.add(KindTypeChanged, true, tc.subject, "%s changed type from %s to %s",
+			path, typeString(tc.base, a.t), typeString(tc.head, b.t))
+	}
+
+	// Nullability changes are only breaking in one direction.
+	optA, optB := a.t.GetOption(), b.t.GetOption()
+	switch {
+	case optA != nil && optB != nil:
+		tc.compare(path, typeRef{t: optA.Value, env: a.env}, typeRef{t: optB.Value, env: b.env}, dir)
+		return
+	case optA != nil:
+		tc.add(KindTypeChanged, dir != response, tc.subject, "%s is no longer nullable", path)
+		tc.compare(path, typeRef{t: optA.Value, env: a.env}, b, dir)
+		return
+	case optB != nil:
+		tc.add(KindTypeChanged, dir != request, tc.subject, "%s is now nullable", path)
+		tc.compare(path, a, typeRef{t: optB.Value, env: b.env}, dir)
+		return
+	}
+
+	switch ta := a.t.GetTyp().(type) {
+	case *schema.Type_Builtin:
+		if bb, ok := b.t.GetTyp().(*schema.Type_Builtin); !ok || bb.Builtin != ta.Builtin {
+			typeChanged()
+		}
+
+	case *schema.Type_Literal:
+		lb := b.t.GetLiteral()
+		if lb == nil || !proto.Equal(ta.Literal, lb) {
+			typeChanged()
+		}
+
+	case *schema.Type_List:
+		lb := b.t.GetList()
+		if lb == nil {
+			typeChanged()
+			return
+		}
+		tc.compare(path+"[]", typeRef{t: ta.List.Elem, env: a.env}, typeRef{t: lb.Elem, env: b.env}, dir)
+
+	case *schema.Type_Map:
+		mb := b.t.GetMap()
+		if mb == nil {
+			typeChanged()
+			return
+		}
+		tc.compare(path+" (map key)", typeRef{t: ta.Map.Key, env: a.env}, typeRef{t: mb.Key, env: b.env}, dir)
+		tc.compare(path+"[]", typeRef{t: ta.Map.Value, env: a.env}, typeRef{t: mb.Value, env: b.env}, dir)
+
+	case *schema.Type_Union:
+		ub := b.t.GetUnion()
+		if ub == nil {
+			typeChanged()
+			return
+		}
+		tc.compareUnion(path, a, b, ta.Union, ub, dir)
+
+	case *schema.Type_Struct:
+		sb := b.t.GetStruct()
+		if sb == nil {
+			typeChanged()
+			return
+		}
+		tc.compareStruct(path, a, b, ta.Struct, sb, dir)
+
+	default:
+		// Unresolved type parameters and other types can only be compared by name.
+		if typeString(tc.base, a.t) != typeString(tc.head, b.t) {
+			typeChanged()
+		}
+	}
+}
+
+func (tc *typeComparer) compareUnion(path string, a, b typeRef, ua, ub *schema.Union, dir direction) {
+	cases := func(md *meta.Data, env *typeEnv, u *schema.Union) []string {
+		var s []string
+		for _, t := range u.Types {
+			r, _ := resolve(md, typeRef{t: t, env: env})
+			s = append(s, typeString(md, r.t))
+		}
+		return s
+	}
+	casesA, casesB := cases(tc.base, a.env, ua), cases(tc.head, b.env, ub)
+	for _, s := range casesA {
+		if !slices.Contains(casesB, s) {
+			tc.add(KindTypeChanged, dir != response, tc.subject, "%s no longer accepts %s", path, s)
+		}
+	}
+	for _, s := range casesB {
+		if !slices.Contains(casesA, s) {
+			tc.add(KindTypeChanged, dir != request, tc.subject, "%s now accepts %s", path, s)
+		}
+	}
+}
+
+func (tc *typeComparer) compareStruct(path string, a, b typeRef, sa, sb *schema.Struct, dir direction) {
+	fields := func(s *schema.Struct) map[string]*schema.Field {
+		m := make(map[string]*schema.Field)
+		for _, f := range s.Fields {
+			if name := jsonName(f); name != "" && !encoding.IgnoreField(f) {
+				m[name] = f
+			}
+		}
+		return m
+	}
+	fieldsA, fieldsB := fields(sa), fields(sb)
+	for _, name := range sortedKeys(fieldsA, fieldsB) {
+		fa, fb := fieldsA[name], fieldsB[name]
+		fieldPath := path + "." + name
+		switch {
+		case fb == nil:
+			tc.add(KindFieldRemoved, true, tc.subject, "%s removed", fieldPath)
+		case fa == nil:
+			switch {
+			case dir == response:
+				tc.add(KindFieldAdded, false, tc.subject, "field %s added", fieldPath)
+			case fb.Optional:
+				tc.add(KindFieldAdded, false, tc.subject, "optional field %s added", fieldPath)
+			default:
+				tc.add(KindFieldAdded, true, tc.subject, "required field %s added", fieldPath)
+			}
+		default:
+			tc.compareOptionality(tc.subject, fieldPath, fa.Optional, fb.Optional, dir)
+			tc.compare(fieldPath, typeRef{t: fa.Typ, env: a.env}, typeRef{t: fb.Typ, env: b.env}, dir)
+		}
+	}
+}
+
+// jsonName returns the name of the field in JSON, or "" if it's omitted.
+func jsonName(f *schema.Field) string {
+	switch f.JsonName {
+	case "-":
+		return ""
+	case "":
+		return f.Name
+	default:
+		return f.JsonName
+	}
+}
+
+func describeRequest(md *meta.Data, typ *schema.Type, opts *encoding.Options, method string) ([]*encoding.ParameterEncoding, error) {
+	if typ == nil {
+		return nil, nil
+	}
+	encs, err := encoding.DescribeRequest(md, typ, opts, method)
+	if err != nil {
+		return nil, err
+	}
+	var params []*encoding.ParameterEncoding
+	for _, enc := range encs {
+		params = append(params, enc.HeaderParameters...)
+		params = append(params, enc.QueryParameters...)
+		params = append(params, enc.CookieParameters...)
+		params = append(params, enc.BodyParameters...)
+	}
+	return params, nil
+}
+
+func describeResponse(md *meta.Data, typ *schema.Type, opts *encoding.Options) ([]*encoding.ParameterEncoding, error) {
+	if typ == nil {
+		return nil, nil
+	}
+	enc, err := encoding.DescribeResponse(md, typ, opts)
+	if err != nil {
+		return nil, err
+	}
+	var params []*encoding.ParameterEncoding
+	params = append(params, enc.HeaderParameters...)
+	params = append(params, enc.CookieParameters...)
+	params = append(params, enc.BodyParameters...)
+	return params, nil
+}
+
+func paramString(p *encoding.ParameterEncoding) string {
+	if p.Location == encoding.Body {
+		return fmt.Sprintf("%q", p.Name)
+	}
+	return fmt.Sprintf("%q (%s)", p.Name, p.Location)
+}
+
+// httpMethods returns the HTTP methods the endpoint supports.
+func httpMethods(rpc *meta.RPC) []string {
+	if slices.Contains(rpc.HttpMethods, "*") {
+		return []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "TRACE", "CONNECT"}
+	}
+	return rpc.HttpMethods
+}
+
+func protoName(rpc *meta.RPC) string {
+	switch {
+	case rpc.Proto == meta.RPC_RAW:
+		return "a raw endpoint"
+	case rpc.StreamingRequest && rpc.StreamingResponse:
+		return "a bidirectional stream"
+	case rpc.StreamingRequest:
+		return "an inbound stream"
+	case rpc.StreamingResponse:
+		return "an outbound stream"
+	default:
+		return "a regular endpoint"
+	}
+}
+
+// pathString renders the path of an endpoint.
+// If normalize is true the names of parameters are left out,
+// since renaming them doesn't affect clients.
+func pathString(p *meta.Path, normalize bool) string {
+	var b strings.Builder
+	for _, s := range p.GetSegments() {
+		b.WriteByte('/')
+		prefix := ""
+		switch s.Type {
+		case meta.PathSegment_LITERAL:
+			b.WriteString(s.Value)
+			continue
+		case meta.PathSegment_PARAM:
+			prefix = ":"
+		case meta.PathSegment_WILDCARD:
+			prefix = "*"
+		case meta.PathSegment_FALLBACK:
+			prefix = "!"
+		}
+		b.WriteString(prefix)
+		if !normalize {
+			b.WriteString(s.Value)
+		}
+	}
+	if b.Len() == 0 {
+		return "/"
+	}
+	return b.String()
+}
+
+// typeString renders a type for use in messages.
+func typeString(md *meta.Data, t *schema.Type) string {
+	switch t := t.GetTyp().(type) {
+	case *schema.Type_Builtin:
+		return strings.ToLower(t.Builtin.String())
+	case *schema.Type_Named:
+		decl := md.Decls[t.Named.Id]
+		name := decl.Loc.PkgName + "." + decl.Name
+		if len(t.Named.TypeArguments) > 0 {
+			args := make([]string, len(t.Named.TypeArguments))
+			for i, arg := range t.Named.TypeArguments {
+				args[i] = typeString(md, arg)
+			}
+			name += "[" + strings.Join(args, ", ") + "]"
+		}
+		return name
+	case *schema.Type_List:
+		return "[]" + typeString(md, t.List.Elem)
+	case *schema.Type_Map:
+		return "map[" + typeString(md, t.Map.Key) + "]" + typeString(md, t.Map.Value)
+	case *schema.Type_Pointer:
+		return "*" + typeString(md, t.Pointer.Base)
+	case *schema.Type_Option:
+		return "option[" + typeString(md, t.Option.Value) + "]"
+	case *schema.Type_Config:
+		return typeString(md, t.Config.Elem)
+	case *schema.Type_Struct:
+		return "struct"
+	case *schema.Type_Union:
+		cases := make([]string, len(t.Union.Types))
+		for i, c := range t.Union.Types {
+			cases[i] = typeString(md, c)
+		}
+		return strings.Join(cases, " | ")
+	case *schema.Type_Literal:
+		switch v := t.Literal.Value.(type) {
+		case *schema.Literal_Str:
+			return fmt.Sprintf("%q", v.Str)
+		case *schema.Literal_Int:
+			return fmt.Sprint(v.Int)
+		case *schema.Literal_Float:
+			return fmt.Sprint(v.Float)
+		case *schema.Literal_Boolean:
+			return fmt.Sprint(v.Boolean)
+		default:
+			return "null"
+		}
+	case *schema.Type_TypeParameter:
+		decl := md.Decls[t.TypeParameter.DeclId]
+		return decl.TypeParams[t.TypeParameter.ParamIdx].Name
+	default:
+		return "unknown"
+	}
+}
+
+// sortedKeys returns the union of the keys of the given maps, sorted.
+func sortedKeys[V any](maps ...map[string]V) []string {
+	var keys []string
+	for _, m := range maps {
+		for k := range m {
+			if !slices.Contains(keys, k) {
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
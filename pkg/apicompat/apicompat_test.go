@@ -0,0 +1,169 @@
+package apicompat
+
+import (
+	"context"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/rogpeppe/go-internal/txtar"
+
+	"encr.dev/cli/daemon/apps"
+	"encr.dev/pkg/builder"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+	"encr.dev/v2/v2builder"
+)
+
+const baseApp = `
+-- go.mod --
+module app
+
+-- encore.app --
+{"id": ""}
+
+-- svc/svc.go --
+package svc
+
+import (
+	"context"
+
+	"encore.dev/pubsub"
+)
+
+type Params struct {
+	Name  string
+	Limit int    ` + "`query:\"limit\"`" + `
+	Note  string ` + "`json:\",omitempty\" encore:\"optional\"`" + `
+}
+
+type Item struct {
+	ID    int
+	Label string
+	Tags  []string
+}
+
+type Event struct {
+	ID int
+}
+
+var Events = pubsub.NewTopic[*Event]("events", pubsub.TopicConfig{DeliveryGuarantee: pubsub.AtLeastOnce})
+
+//encore:api public method=POST path=/items/:id
+func Update(ctx context.Context, id int, p *Params) (*Item, error) { return nil, nil }
+
+//encore:api public
+func Removed(ctx context.Context) error { return nil }
+
+//encore:api private
+func Internal(ctx context.Context, p *Params) error { return nil }
+`
+
+const headApp = `
+-- go.mod --
+module app
+
+-- encore.app --
+{"id": ""}
+
+-- svc/svc.go --
+package svc
+
+import (
+	"context"
+
+	"encore.dev/beta/auth"
+	"encore.dev/pubsub"
+)
+
+type Params struct {
+	Name   string
+	Limit  int    ` + "`header:\"X-Limit\"`" + `
+	Note   string ` + "`json:\",omitempty\" encore:\"optional\"`" + `
+	Owner  string
+	Filter string ` + "`json:\",omitempty\" encore:\"optional\"`" + `
+}
+
+type Item struct {
+	ID      string
+	Tags    []string
+	Created string
+}
+
+type Event struct {
+	ID     int
+	Source string
+}
+
+var Events = pubsub.NewTopic[*Event]("events", pubsub.TopicConfig{DeliveryGuarantee: pubsub.AtLeastOnce})
+
+//encore:api auth method=POST path=/items/:key/update
+func Update(ctx context.Context, key int, p *Params) (*Item, error) { return nil, nil }
+
+//encore:api public
+func Added(ctx context.Context) error { return nil }
+
+//encore:api private
+func Internal(ctx context.Context) error { return nil }
+
+//encore:authhandler
+func Auth(ctx context.Context, token string) (auth.UID, error) { return "", nil }
+`
+
+func parseApp(c *qt.C, src string) *meta.Data {
+	dir := c.TempDir()
+	c.Assert(txtar.Write(txtar.Parse([]byte(src)), dir), qt.IsNil)
+
+	ctx := context.Background()
+	bld := v2builder.New()
+	app := apps.NewInstance(dir, "app", "")
+	prepareResult, err := bld.Prepare(ctx, builder.PrepareParams{
+		Build:      builder.DefaultBuildInfo(),
+		App:        app,
+		WorkingDir: ".",
+	})
+	c.Assert(err, qt.IsNil)
+	res, err := bld.Parse(ctx, builder.ParseParams{
+		Build:      builder.DefaultBuildInfo(),
+		App:        app,
+		WorkingDir: ".",
+		Prepare:    prepareResult,
+	})
+	c.Assert(err, qt.IsNil)
+	return res.Meta
+}
+
+func TestCompare(t *testing.T) {
+	c := qt.New(t)
+	base := parseApp(c, baseApp)
+	head := parseApp(c, headApp)
+
+	changes, err := Compare(base, head)
+	c.Assert(err, qt.IsNil)
+
+	type change struct {
+		Breaking bool
+		Msg      string
+	}
+	var got []change
+	for _, ch := range changes {
+		got = append(got, change{ch.Breaking, ch.String()})
+	}
+	c.Assert(got, qt.DeepEquals, []change{
+		{false, "svc.Added: endpoint added"},
+		{true, "svc.Removed: endpoint removed or made private"},
+		{true, "svc.Update: endpoint now requires authentication"},
+		{true, "svc.Update: path changed from /items/:id to /items/:key/update"},
+		{false, `svc.Update: optional request field "Filter" added`},
+		{true, `svc.Update: required request field "Owner" added`},
+		{true, `svc.Update: request field Limit moved from "limit" (query) to "X-Limit" (header)`},
+		{false, `svc.Update: response field "Created" added`},
+		{true, `svc.Update: response field "ID" changed type from int to string`},
+		{true, `svc.Update: response field "Label" removed`},
+		{true, `topic events: required field message.Source added`},
+	})
+	c.Assert(HasBreaking(changes), qt.IsTrue)
+
+	// Comparing an app with itself finds no changes.
+	changes, err = Compare(base, base)
+	c.Assert(err, qt.IsNil)
+	c.Assert(changes, qt.HasLen, 0)
+}
@@ -0,0 +1,54 @@
+package apicompat
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// CheckoutRevision checks out the git revision rev of the repository containing dir
+// into a temporary worktree. It returns the path corresponding to dir within the worktree,
+// and a function for removing the worktree once done.
+func CheckoutRevision(dir, rev string) (root string, cleanup func(), err error) {
+	git := func(dir string, args ...string) (string, error) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.Output()
+		if err != nil {
+			if ee, ok := err.(*exec.ExitError); ok && len(ee.Stderr) > 0 {
+				return "", fmt.Errorf("git %s: %s", args[0], bytes.TrimSpace(ee.Stderr))
+			}
+			return "", fmt.Errorf("git %s: %v", args[0], err)
+		}
+		return string(bytes.TrimSpace(out)), nil
+	}
+
+	prefix, err := git(dir, "rev-parse", "--show-prefix")
+	if err != nil {
+		return "", nil, err
+	}
+	commit, err := git(dir, "rev-parse", "--verify", "--quiet", rev+"^{commit}")
+	if err != nil {
+		return "", nil, fmt.Errorf("unknown git revision %q", rev)
+	}
+
+	tmp, err := os.MkdirTemp("", "encore-api-compat-")
+	if err != nil {
+		return "", nil, err
+	}
+	worktree := filepath.Join(tmp, "src")
+	if _, err := git(dir, "worktree", "add", "--detach", worktree, commit); err != nil {
+		_ = os.RemoveAll(tmp)
+		return "", nil, err
+	}
+
+	cleanup = func() {
+		_, _ = git(dir, "worktree", "remove", "--force", worktree)
+		_ = os.RemoveAll(tmp)
+	}
+	root = filepath.Join(worktree, filepath.FromSlash(strings.TrimSuffix(prefix, "/")))
+	return root, cleanup, nil
+}
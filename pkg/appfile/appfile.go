@@ -70,6 +70,31 @@ type File struct {
 	// LogLevel is the minimum log level for the app.
 	// If empty it defaults to "trace".
 	LogLevel string `json:"log_level,omitempty"`
+
+	// Architecture configures rules for how services may depend
+	// on each other and on infrastructure, enforced when parsing the app.
+	Architecture *Architecture `json:"architecture,omitempty"`
+
+	// Plugins are out-of-process plugins that handle custom
+	// //encore:<name> directives when parsing and generating code for the app.
+	Plugins []Plugin `json:"plugins,omitempty"`
+}
+
+// Plugin configures an out-of-process parser and code generation plugin.
+type Plugin struct {
+	// Name is the name of the plugin, used in error messages and metadata.
+	Name string `json:"name"`
+
+	// Command is the command to run the plugin, and its arguments.
+	// Relative paths are resolved relative to the app root.
+	Command []string `json:"command"`
+
+	// Directives are the names of the //encore:<name> directives the plugin handles.
+	Directives []string `json:"directives"`
+
+	// Timeout is the maximum duration of each call to the plugin, like "30s".
+	// If empty it defaults to one minute.
+	Timeout string `json:"timeout,omitempty"`
 }
 
 type Build struct {
@@ -212,6 +237,52 @@ type CORS struct {
 	AllowOriginsWithCredentials []string `json:"allow_origins_with_credentials,omitempty"`
 }
 
+// Architecture describes the architecture rules of an app.
+// Dependencies between services are the API calls from one service to another.
+type Architecture struct {
+	// NoCycles forbids services from depending on each other in a cycle.
+	NoCycles bool `json:"no_cycles,omitempty"`
+
+	// Layers orders services into layers, from the top layer to the bottom one.
+	// Services may only depend on services in the same layer or in layers below.
+	// Services not part of any layer are not restricted.
+	Layers []Layer `json:"layers,omitempty"`
+
+	// Dependencies restricts which services a service may depend on.
+	Dependencies []DependencyRule `json:"dependencies,omitempty"`
+
+	// Resources restricts which services may access a database or bucket.
+	Resources []ResourceRule `json:"resources,omitempty"`
+}
+
+// Layer is a named group of services in an architecture.
+type Layer struct {
+	Name     string   `json:"name"`
+	Services []string `json:"services"`
+}
+
+// DependencyRule restricts the dependencies of a service.
+type DependencyRule struct {
+	// Service is the name of the service the rule applies to.
+	Service string `json:"service"`
+
+	// Allow, if non-empty, lists the only services the service may depend on.
+	Allow []string `json:"allow,omitempty"`
+
+	// Deny lists services the service must not depend on.
+	Deny []string `json:"deny,omitempty"`
+}
+
+// ResourceRule restricts the services that may access a resource.
+// Exactly one of Database and Bucket must be set.
+type ResourceRule struct {
+	Database string `json:"database,omitempty"`
+	Bucket   string `json:"bucket,omitempty"`
+
+	// Services lists the only services that may access the resource.
+	Services []string `json:"services"`
+}
+
 // Parse parses the app file data into a File.
 func Parse(data []byte) (*File, error) {
 	var f File
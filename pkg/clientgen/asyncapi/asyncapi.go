@@ -0,0 +1,270 @@
+// Package asyncapi generates AsyncAPI 3 documents describing the
+// Pub/Sub topics of an Encore application.
+package asyncapi
+
+import (
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"encr.dev/pkg/clientgen/clientgentypes"
+	"encr.dev/pkg/clientgen/openapi"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+	schema "encr.dev/proto/encore/parser/schema/v1"
+)
+
+type GenVersion int
+
+const (
+	// Initial is the originally released AsyncAPI generator
+	Initial GenVersion = iota
+
+	// Experimental can be used to lock experimental or uncompleted features in the generated code
+	// It should always be the last item in the enum.
+	Experimental
+
+	LatestVersion GenVersion = Experimental - 1
+)
+
+// natsSubjectTag is the tag the parser adds to the pseudo-endpoints
+// of NATS subscriptions, followed by the subject.
+const natsSubjectTag = "nats-subject:"
+
+type Generator struct {
+	ver     GenVersion
+	md      *meta.Data
+	doc     *document
+	schemas *openapi.SchemaConverter
+}
+
+func New(version GenVersion) *Generator {
+	return &Generator{ver: version}
+}
+
+func (g *Generator) Version() int {
+	return int(g.ver)
+}
+
+func (g *Generator) Generate(p clientgentypes.GenerateParams) error {
+	g.md = p.Meta
+	g.doc = newDocument(p.AppSlug)
+	g.schemas = openapi.NewSchemaConverter(p.Meta)
+
+	natsSubjects := natsSubjects(p.Meta)
+	for _, topic := range p.Meta.PubsubTopics {
+		if err := g.addTopic(topic, p.Services, natsSubjects[topic.Name]); err != nil {
+			return errors.Wrapf(err, "topic %s", topic.Name)
+		}
+	}
+	g.doc.Components.Schemas = g.schemas.Schemas()
+
+	out, err := json.MarshalIndent(g.doc, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshal asyncapi document")
+	}
+	_, err = p.Buf.Write(out)
+	return err
+}
+
+func (g *Generator) addTopic(topic *meta.PubSubTopic, services clientgentypes.ServiceSet, isNATS bool) error {
+	id := componentID(topic.Name)
+	channelRef := &reference{Ref: "#/channels/" + id}
+	messageRef := &reference{Ref: "#/channels/" + id + "/messages/" + id}
+
+	var ops []*operation
+	var opIDs []string
+	for _, pub := range topic.Publishers {
+		if !services.Has(pub.ServiceName) {
+			continue
+		}
+		opIDs = append(opIDs, componentID(pub.ServiceName+".publish."+topic.Name))
+		ops = append(ops, &operation{
+			Action:   "send",
+			Channel:  channelRef,
+			Title:    "Publish to " + topic.Name,
+			Messages: []*reference{messageRef},
+			Tags:     []*tag{{Name: pub.ServiceName}},
+			Service:  pub.ServiceName,
+		})
+	}
+	for _, sub := range topic.Subscriptions {
+		if !services.Has(sub.ServiceName) {
+			continue
+		}
+		opIDs = append(opIDs, componentID(sub.ServiceName+"."+sub.Name))
+		ops = append(ops, &operation{
+			Action:       "receive",
+			Channel:      channelRef,
+			Title:        "Subscription " + sub.Name,
+			Messages:     []*reference{messageRef},
+			Tags:         []*tag{{Name: sub.ServiceName}},
+			Service:      sub.ServiceName,
+			Subscription: newSubscription(sub),
+		})
+	}
+
+	// Only document topics used by the included services,
+	// unless the topic isn't used by any service at all.
+	if len(ops) == 0 && (len(topic.Publishers) > 0 || len(topic.Subscriptions) > 0) {
+		return nil
+	}
+
+	msg, err := g.newMessage(topic)
+	if err != nil {
+		return err
+	}
+
+	ch := &channel{
+		Address:  topic.Name,
+		Messages: map[string]*reference{id: {Ref: "#/components/messages/" + id}},
+	}
+	if topic.Doc != nil {
+		ch.Summary, ch.Description = splitDoc(*topic.Doc)
+	}
+	if isNATS {
+		ch.Tags = []*tag{{Name: "nats", Description: "A NATS subject"}}
+		ch.Bindings = map[string]any{"nats": map[string]any{}}
+	} else {
+		ch.Tags = []*tag{{Name: "pubsub", Description: "An Encore Pub/Sub topic"}}
+		ch.DeliveryGuarantee = deliveryGuarantee(topic.DeliveryGuarantee)
+		ch.OrderingAttribute = topic.OrderingKey
+	}
+
+	g.doc.Channels[id] = ch
+	g.doc.Components.Messages[id] = msg
+	for i, op := range ops {
+		g.doc.Operations[opIDs[i]] = op
+	}
+	return nil
+}
+
+func (g *Generator) newMessage(topic *meta.PubSubTopic) (*message, error) {
+	payload, err := g.schemas.Convert(topic.MessageType)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &message{
+		Name:        g.schemas.DefinitionName(topic.MessageType),
+		Title:       topic.Name,
+		ContentType: "application/json",
+		Payload:     payload,
+	}
+	if msg.Name == "" {
+		msg.Name = componentID(topic.Name)
+	}
+
+	// Message attributes are sent as headers.
+	if attrs := g.attributes(topic.MessageType); len(attrs) > 0 {
+		headers := openapi3.NewObjectSchema()
+		headers.Properties = make(openapi3.Schemas)
+		for _, attr := range attrs {
+			s := openapi3.NewStringSchema()
+			s.Description = attr.doc
+			headers.Properties[attr.name] = s.NewRef()
+			if attr.name == topic.OrderingKey {
+				headers.Required = append(headers.Required, attr.name)
+			}
+		}
+		msg.Headers = headers.NewRef()
+	}
+	return msg, nil
+}
+
+type attribute struct {
+	name, doc string
+}
+
+// attributes returns the message attributes of the message type,
+// as declared by fields with a "pubsub-attr" tag.
+func (g *Generator) attributes(typ *schema.Type) []attribute {
+	for {
+		switch t := typ.GetTyp().(type) {
+		case *schema.Type_Named:
+			typ = g.md.Decls[t.Named.Id].Type
+			continue
+		case *schema.Type_Pointer:
+			typ = t.Pointer.Base
+			continue
+		}
+		break
+	}
+
+	var attrs []attribute
+	for _, f := range typ.GetStruct().GetFields() {
+		for _, tag := range f.Tags {
+			if tag.Key == "pubsub-attr" && tag.Name != "" && tag.Name != "-" {
+				attrs = append(attrs, attribute{name: tag.Name, doc: strings.TrimSpace(f.Doc)})
+			}
+		}
+	}
+	return attrs
+}
+
+func newSubscription(sub *meta.PubSubTopic_Subscription) *subscription {
+	s := &subscription{
+		AckDeadline:      duration(sub.AckDeadline),
+		MessageRetention: duration(sub.MessageRetention),
+		MaxConcurrency:   sub.MaxConcurrency,
+	}
+	if rp := sub.RetryPolicy; rp != nil {
+		s.RetryPolicy = &retryPolicy{
+			MinBackoff: duration(rp.MinBackoff),
+			MaxBackoff: duration(rp.MaxBackoff),
+			MaxRetries: rp.MaxRetries,
+		}
+	}
+	return s
+}
+
+// natsSubjects returns the NATS subjects subscribed to by the app.
+func natsSubjects(md *meta.Data) map[string]bool {
+	subjects := make(map[string]bool)
+	for _, svc := range md.Svcs {
+		for _, rpc := range svc.Rpcs {
+			if !slices.Contains(rpc.HttpMethods, "NATS") {
+				continue
+			}
+			for _, sel := range rpc.Tags {
+				if sel.Type == meta.Selector_TAG && strings.HasPrefix(sel.Value, natsSubjectTag) {
+					subjects[strings.TrimPrefix(sel.Value, natsSubjectTag)] = true
+				}
+			}
+		}
+	}
+	return subjects
+}
+
+func deliveryGuarantee(g meta.PubSubTopic_DeliveryGuarantee) string {
+	switch g {
+	case meta.PubSubTopic_EXACTLY_ONCE:
+		return "exactly-once"
+	default:
+		return "at-least-once"
+	}
+}
+
+func duration(nanos int64) string {
+	if nanos <= 0 {
+		return ""
+	}
+	return time.Duration(nanos).String()
+}
+
+var invalidIDChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// componentID returns a valid AsyncAPI component identifier for s.
+func componentID(s string) string {
+	return invalidIDChars.ReplaceAllString(s, "_")
+}
+
+func splitDoc(doc string) (summary, description string) {
+	doc = strings.TrimSpace(doc)
+	summary, description, _ = strings.Cut(doc, "\n")
+	return strings.TrimSpace(summary), strings.TrimSpace(description)
+}
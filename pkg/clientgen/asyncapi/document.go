@@ -0,0 +1,100 @@
+package asyncapi
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// document is an AsyncAPI 3 document.
+// See https://www.asyncapi.com/docs/reference/specification/v3.0.0.
+type document struct {
+	AsyncAPI           string                `json:"asyncapi"`
+	Info               info                  `json:"info"`
+	DefaultContentType string                `json:"defaultContentType"`
+	Channels           map[string]*channel   `json:"channels"`
+	Operations         map[string]*operation `json:"operations"`
+	Components         components            `json:"components"`
+}
+
+type info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+type channel struct {
+	Address     string                `json:"address"`
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Messages    map[string]*reference `json:"messages"`
+	Tags        []*tag                `json:"tags,omitempty"`
+	Bindings    map[string]any        `json:"bindings,omitempty"`
+
+	DeliveryGuarantee string `json:"x-encore-delivery-guarantee,omitempty"`
+	OrderingAttribute string `json:"x-encore-ordering-attribute,omitempty"`
+}
+
+type operation struct {
+	Action   string       `json:"action"`
+	Channel  *reference   `json:"channel"`
+	Title    string       `json:"title,omitempty"`
+	Messages []*reference `json:"messages"`
+	Tags     []*tag       `json:"tags,omitempty"`
+
+	Service      string        `json:"x-encore-service"`
+	Subscription *subscription `json:"x-encore-subscription,omitempty"`
+}
+
+type subscription struct {
+	AckDeadline      string       `json:"ackDeadline,omitempty"`
+	MessageRetention string       `json:"messageRetention,omitempty"`
+	MaxConcurrency   *int32       `json:"maxConcurrency,omitempty"`
+	RetryPolicy      *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+type retryPolicy struct {
+	MinBackoff string `json:"minBackoff,omitempty"`
+	MaxBackoff string `json:"maxBackoff,omitempty"`
+	MaxRetries int64  `json:"maxRetries"`
+}
+
+type message struct {
+	Name        string              `json:"name"`
+	Title       string              `json:"title,omitempty"`
+	ContentType string              `json:"contentType"`
+	Headers     *openapi3.SchemaRef `json:"headers,omitempty"`
+	Payload     *openapi3.SchemaRef `json:"payload"`
+}
+
+type components struct {
+	Messages map[string]*message `json:"messages"`
+	Schemas  openapi3.Schemas    `json:"schemas"`
+}
+
+type tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type reference struct {
+	Ref string `json:"$ref"`
+}
+
+func newDocument(appSlug string) *document {
+	return &document{
+		AsyncAPI: "3.0.0",
+		Info: info{
+			Title:       fmt.Sprintf("Events for %s", appSlug),
+			Version:     "1",
+			Description: "Generated by encore",
+		},
+		DefaultContentType: "application/json",
+		Channels:           make(map[string]*channel),
+		Operations:         make(map[string]*operation),
+		Components: components{
+			Messages: make(map[string]*message),
+			Schemas:  make(openapi3.Schemas),
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"encr.dev/pkg/clientgen/asyncapi"
 	"encr.dev/pkg/clientgen/clientgentypes"
 	"encr.dev/pkg/clientgen/openapi"
 	"encr.dev/pkg/errinsrc/srcerrors"
@@ -24,6 +25,8 @@ const (
 	LangJavascript Lang = "javascript"
 	LangGo         Lang = "go"
 	LangOpenAPI    Lang = "openapi"
+	LangPython     Lang = "python"
+	LangAsyncAPI   Lang = "asyncapi"
 )
 
 type generator interface {
@@ -44,6 +47,8 @@ func Detect(path string) (lang Lang, ok bool) {
 		return LangJavascript, true
 	case ".go":
 		return LangGo, true
+	case ".py":
+		return LangPython, true
 	default:
 		return LangUnknown, false
 	}
@@ -78,8 +83,12 @@ func Client(
 		gen = &javascript{generatorVersion: javascriptGenLatestVersion}
 	case LangGo:
 		gen = &golang{generatorVersion: goGenLatestVersion}
+	case LangPython:
+		gen = &python{generatorVersion: pythonGenLatestVersion}
 	case LangOpenAPI:
 		gen = openapi.New(openapi.LatestVersion)
+	case LangAsyncAPI:
+		gen = asyncapi.New(asyncapi.LatestVersion)
 	default:
 		return nil, ErrUnknownLang
 	}
@@ -109,8 +118,12 @@ func GetLang(lang string) (Lang, error) {
 		return LangJavascript, nil
 	case "go", "golang":
 		return LangGo, nil
+	case "python", "py":
+		return LangPython, nil
 	case "openapi", "swagger", "oas":
 		return LangOpenAPI, nil
+	case "asyncapi":
+		return LangAsyncAPI, nil
 	default:
 		return LangUnknown, ErrUnknownLang
 	}
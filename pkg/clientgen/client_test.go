@@ -76,6 +76,8 @@ func TestClientCodeGenerationFromGoApp(t *testing.T) {
 						language, ok := Detect(file.Name())
 						if strings.Contains(file.Name(), "openapi") {
 							language, ok = LangOpenAPI, true
+						} else if strings.Contains(file.Name(), "asyncapi") {
+							language, ok = LangAsyncAPI, true
 						}
 						c.Assert(ok, qt.IsTrue, qt.Commentf("Unable to detect language type for %s", file.Name()))
 
@@ -153,6 +155,8 @@ func TestClientCodeGenerationFromTSApp(t *testing.T) {
 						language, ok := Detect(file.Name())
 						if strings.Contains(file.Name(), "openapi") {
 							language, ok = LangOpenAPI, true
+						} else if strings.Contains(file.Name(), "asyncapi") {
+							language, ok = LangAsyncAPI, true
 						}
 						options := clientgentypes.Options{}
 						if strings.Contains(file.Name(), "shared") {
@@ -254,6 +254,86 @@ Defaults to http.DefaultClient`,
 		},
 	)
 
+	// The retry policy and interceptor types
+	file.Comment("RetryPolicy configures how failed API calls are retried.")
+	file.Comment("")
+	file.Comment("Only calls using idempotent HTTP methods (GET, HEAD, OPTIONS, PUT and DELETE) are retried,")
+	file.Comment("and only if the request could not be sent or the API responded with ErrUnavailable or ErrResourceExhausted.")
+	file.Type().Id("RetryPolicy").Struct(
+		Id("MaxAttempts").Int().Comment("The maximum number of attempts, including the first one"),
+		Id("MinBackoff").Qual("time", "Duration").Comment("The backoff before the first retry (defaults to 100ms)"),
+		Id("MaxBackoff").Qual("time", "Duration").Comment("The maximum backoff between retries (defaults to 5s)"),
+	)
+	file.Line()
+
+	file.Comment("RequestInterceptor is called before each request is sent, including retries.")
+	file.Comment("Returning an error aborts the API call.")
+	file.Type().Id("RequestInterceptor").Func().
+		Params(Id("req").Op("*").Qual("net/http", "Request")).Error()
+	file.Line()
+
+	file.Comment("ResponseInterceptor is called for each response received, before it is decoded.")
+	file.Comment("Returning an error aborts the API call.")
+	file.Type().Id("ResponseInterceptor").Func().
+		Params(
+			Id("req").Op("*").Qual("net/http", "Request"),
+			Id("resp").Op("*").Qual("net/http", "Response"),
+		).Error()
+	file.Line()
+
+	g.generateOptionFunc(
+		file,
+		"Retry",
+		`enables retrying failed API calls according to the given policy.
+
+By default API calls are not retried.`,
+		&Statement{Id("policy").Id("RetryPolicy")},
+		&Statement{
+			Id("base").Dot("retryPolicy").Op("=").Id("policy"),
+			Return(Nil()),
+		},
+	)
+
+	g.generateOptionFunc(
+		file,
+		"Timeout",
+		`sets the default timeout for each API call, including any retries.
+
+A per-call deadline can be set using the context passed to each call,
+in which case the earliest deadline applies.`,
+		&Statement{Id("timeout").Qual("time", "Duration")},
+		&Statement{
+			Id("base").Dot("timeout").Op("=").Id("timeout"),
+			Return(Nil()),
+		},
+	)
+
+	g.generateOptionFunc(
+		file,
+		"RequestInterceptor",
+		`adds a function which is called before each request is sent.
+
+Interceptors are called in the order they were added.`,
+		&Statement{Id("interceptor").Id("RequestInterceptor")},
+		&Statement{
+			Id("base").Dot("requestInterceptors").Op("=").Append(Id("base").Dot("requestInterceptors"), Id("interceptor")),
+			Return(Nil()),
+		},
+	)
+
+	g.generateOptionFunc(
+		file,
+		"ResponseInterceptor",
+		`adds a function which is called for each response received.
+
+Interceptors are called in the order they were added.`,
+		&Statement{Id("interceptor").Id("ResponseInterceptor")},
+		&Statement{
+			Id("base").Dot("responseInterceptors").Op("=").Append(Id("base").Dot("responseInterceptors"), Id("interceptor")),
+			Return(Nil()),
+		},
+	)
+
 	if g.md.AuthHandler != nil {
 		typ := g.getType(g.md.AuthHandler.Params)
 		rawType := typ
@@ -1125,11 +1205,24 @@ func (g *golang) generateBaseClient(file *File) (err error) {
 
 		grp.Id("userAgent").String().
 			Commentf("What user agent we will use in the API requests")
+
+		grp.Id("retryPolicy").Id("RetryPolicy").
+			Comment("How failed API calls are retried")
+
+		grp.Id("timeout").Qual("time", "Duration").
+			Comment("The default timeout for each API call")
+
+		grp.Id("requestInterceptors").Index().Id("RequestInterceptor").
+			Comment("Functions called before each request is sent")
+
+		grp.Id("responseInterceptors").Index().Id("ResponseInterceptor").
+			Comment("Functions called for each response received")
 	})
 
 	// Add the Do method for th base client
 	file.Line()
-	file.Comment("Do sends the req to the Encore application adding the authorization token as required.")
+	file.Comment("Do sends the req to the Encore application adding the authorization token as required,")
+	file.Comment("and retrying it according to the retry policy.")
 	file.Func().
 		Params(Id("b").Op("*").Id("baseClient")).
 		Id("Do").
@@ -1159,15 +1252,36 @@ func (g *golang) generateBaseClient(file *File) (err error) {
 			grp.Id("req").Dot("Host").Op("=").Id("req").Dot("URL").Dot("Host")
 			grp.Line()
 
+			grp.Comment("Apply the default timeout, which lasts until the response body is closed")
+			grp.Id("cancel").Op(":=").Qual("context", "CancelFunc").Call(Func().Params().Block())
+			grp.If(Id("b").Dot("timeout").Op(">").Lit(0)).Block(
+				Var().Id("ctx").Qual("context", "Context"),
+				List(Id("ctx"), Id("cancel")).Op("=").Qual("context", "WithTimeout").Call(
+					Id("req").Dot("Context").Call(),
+					Id("b").Dot("timeout"),
+				),
+				Id("req").Op("=").Id("req").Dot("WithContext").Call(Id("ctx")),
+			)
+			grp.Line()
+
 			grp.Comment("Finally, make the request via the configured HTTP Client")
-			grp.Return(
-				Id("b").Dot("httpClient").Dot("Do").Call(Id("req")),
+			grp.List(Id("resp"), Err()).Op(":=").Id("b").Dot("send").Call(Id("req"))
+			grp.If(Err().Op("!=").Nil()).Block(
+				Id("cancel").Call(),
+				Return(Nil(), Err()),
 			)
+			grp.Id("resp").Dot("Body").Op("=").Op("&").Id("cancelOnClose").Values(Dict{
+				Id("ReadCloser"): Id("resp").Dot("Body"),
+				Id("cancel"):     Id("cancel"),
+			})
+			grp.Return(Id("resp"), Nil())
 		})
 	if err != nil {
 		return
 	}
 
+	g.generateSendFunc(file)
+
 	// Add the call API function
 	file.Line()
 	file.Comment("callAPI is used by each generated API method to actually make request and decode the responses")
@@ -1282,6 +1396,200 @@ func (g *golang) generateBaseClient(file *File) (err error) {
 	return nil
 }
 
+// generateSendFunc creates the send method of the base client, which makes the actual
+// requests and implements retries, interceptors and trace propagation
+func (g *golang) generateSendFunc(file *File) {
+	file.Line()
+	file.Comment("send sends the request via the configured HTTP client, calling the interceptors")
+	file.Comment("and retrying it according to the retry policy.")
+	file.Func().
+		Params(Id("b").Op("*").Id("baseClient")).
+		Id("send").
+		Params(Id("req").Op("*").Qual("net/http", "Request")).
+		Params(Op("*").Qual("net/http", "Response"), Error()).
+		Block(
+			Id("ctx").Op(":=").Id("req").Dot("Context").Call(),
+			Line(),
+
+			Comment("Propagate the trace context, unless the caller has set one explicitly"),
+			Id("hasTraceparent").Op(":=").Id("req").Dot("Header").Dot("Get").Call(Lit("traceparent")).Op("!=").Lit(""),
+			List(Id("traceID"), Id("traceFlags")).Op(":=").Id("traceContext").Call(Id("ctx")),
+			Line(),
+
+			For(Id("attempt").Op(":=").Lit(1), Empty(), Id("attempt").Op("++")).Block(
+				Id("r").Op(":=").Id("req"),
+				If(Id("attempt").Op(">").Lit(1)).Block(
+					Comment("Rewind the body for the retry"),
+					Id("r").Op("=").Id("req").Dot("Clone").Call(Id("ctx")),
+					If(Id("req").Dot("GetBody").Op("!=").Nil()).Block(
+						List(Id("body"), Err()).Op(":=").Id("req").Dot("GetBody").Call(),
+						If(Err().Op("!=").Nil()).Block(
+							Return(Nil(), Qual("fmt", "Errorf").Call(Lit("unable to rewind request body: %w"), Err())),
+						),
+						Id("r").Dot("Body").Op("=").Id("body"),
+					),
+				),
+				If(Op("!").Id("hasTraceparent")).Block(
+					Id("r").Dot("Header").Dot("Set").Call(
+						Lit("traceparent"),
+						Qual("fmt", "Sprintf").Call(Lit("00-%s-%016x-%s"), Id("traceID"), Qual("math/rand", "Uint64").Call(), Id("traceFlags")),
+					),
+				),
+				Line(),
+
+				For(List(Id("_"), Id("interceptor")).Op(":=").Range().Id("b").Dot("requestInterceptors")).Block(
+					If(Err().Op(":=").Id("interceptor").Call(Id("r")), Err().Op("!=").Nil()).Block(
+						Return(Nil(), Err()),
+					),
+				),
+				List(Id("resp"), Err()).Op(":=").Id("b").Dot("httpClient").Dot("Do").Call(Id("r")),
+				If(Err().Op("==").Nil()).Block(
+					For(List(Id("_"), Id("interceptor")).Op(":=").Range().Id("b").Dot("responseInterceptors")).Block(
+						If(Err().Op(":=").Id("interceptor").Call(Id("r"), Id("resp")), Err().Op("!=").Nil()).Block(
+							Id("_").Op("=").Id("resp").Dot("Body").Dot("Close").Call(),
+							Return(Nil(), Err()),
+						),
+					),
+				),
+				Line(),
+
+				If(
+					Id("attempt").Op(">=").Id("b").Dot("retryPolicy").Dot("MaxAttempts").Op("||").
+						Op("!").Id("shouldRetry").Call(Id("r"), Id("resp"), Err()),
+				).Block(
+					Return(Id("resp"), Err()),
+				),
+				If(Id("resp").Op("!=").Nil()).Block(
+					Id("_").Op(",").Id("_").Op("=").Qual("io", "Copy").Call(Qual("io", "Discard"), Id("resp").Dot("Body")),
+					Id("_").Op("=").Id("resp").Dot("Body").Dot("Close").Call(),
+				),
+				Line(),
+
+				Comment("Wait before retrying, unless the context is done first"),
+				Id("timer").Op(":=").Qual("time", "NewTimer").Call(Id("b").Dot("retryPolicy").Dot("backoff").Call(Id("attempt"))),
+				Select().Block(
+					Case(Op("<-").Id("ctx").Dot("Done").Call()).Block(
+						Id("timer").Dot("Stop").Call(),
+						Return(Nil(), Id("ctx").Dot("Err").Call()),
+					),
+					Case(Op("<-").Id("timer").Dot("C")),
+				),
+			),
+		)
+
+	file.Line()
+	file.Comment("shouldRetry reports whether a request which resulted in the given response or error should be retried.")
+	file.Func().Id("shouldRetry").
+		Params(
+			Id("req").Op("*").Qual("net/http", "Request"),
+			Id("resp").Op("*").Qual("net/http", "Response"),
+			Err().Error(),
+		).
+		Bool().
+		Block(
+			Switch(Id("req").Dot("Method")).Block(
+				Case(
+					Qual("net/http", "MethodGet"),
+					Qual("net/http", "MethodHead"),
+					Qual("net/http", "MethodOptions"),
+					Qual("net/http", "MethodPut"),
+					Qual("net/http", "MethodDelete"),
+				).Block(),
+				Default().Block(Return(False())),
+			),
+			If(
+				Id("req").Dot("Body").Op("!=").Nil().Op("&&").
+					Id("req").Dot("Body").Op("!=").Qual("net/http", "NoBody").Op("&&").
+					Id("req").Dot("GetBody").Op("==").Nil(),
+			).Block(
+				Comment("The body cannot be sent again"),
+				Return(False()),
+			),
+			If(Err().Op("!=").Nil()).Block(
+				Return(Id("req").Dot("Context").Call().Dot("Err").Call().Op("==").Nil()),
+			),
+			Comment("Encore responds with these status codes for ErrUnavailable and ErrResourceExhausted"),
+			Return(
+				Id("resp").Dot("StatusCode").Op("==").Qual("net/http", "StatusServiceUnavailable").Op("||").
+					Id("resp").Dot("StatusCode").Op("==").Qual("net/http", "StatusTooManyRequests"),
+			),
+		)
+
+	file.Line()
+	file.Comment("backoff returns how long to wait before the given retry attempt, using exponential backoff with jitter.")
+	file.Func().
+		Params(Id("p").Id("RetryPolicy")).
+		Id("backoff").
+		Params(Id("attempt").Int()).
+		Qual("time", "Duration").
+		Block(
+			List(Id("minBackoff"), Id("maxBackoff")).Op(":=").List(Id("p").Dot("MinBackoff"), Id("p").Dot("MaxBackoff")),
+			If(Id("minBackoff").Op("<=").Lit(0)).Block(
+				Id("minBackoff").Op("=").Lit(100).Op("*").Qual("time", "Millisecond"),
+			),
+			If(Id("maxBackoff").Op("<=").Lit(0)).Block(
+				Id("maxBackoff").Op("=").Lit(5).Op("*").Qual("time", "Second"),
+			),
+			Line(),
+			Id("d").Op(":=").Id("minBackoff").Op("<<").Parens(Id("attempt").Op("-").Lit(1)),
+			If(Id("d").Op(">").Id("maxBackoff").Op("||").Id("d").Op("<=").Lit(0)).Block(
+				Id("d").Op("=").Id("maxBackoff"),
+			),
+			Return(Id("d").Op("/").Lit(2).Op("+").Qual("time", "Duration").Call(
+				Qual("math/rand", "Int63n").Call(Int64().Call(Id("d").Op("/").Lit(2)).Op("+").Lit(1)),
+			)),
+		)
+
+	file.Line()
+	file.Comment("traceparentKey is the context key for the trace context set by ContextWithTraceparent.")
+	file.Type().Id("traceparentKey").Struct()
+	file.Line()
+	file.Comment("ContextWithTraceparent returns a copy of ctx which makes API calls using it part of the trace")
+	file.Comment("identified by the given W3C traceparent header value.")
+	file.Comment("")
+	file.Comment("By default each API call starts a new trace.")
+	file.Func().Id("ContextWithTraceparent").
+		Params(Id("ctx").Qual("context", "Context"), Id("traceparent").String()).
+		Qual("context", "Context").
+		Block(
+			Return(Qual("context", "WithValue").Call(Id("ctx"), Id("traceparentKey").Values(), Id("traceparent"))),
+		)
+
+	file.Line()
+	file.Comment("traceContext returns the trace id and flags to use for requests made with ctx.")
+	file.Func().Id("traceContext").
+		Params(Id("ctx").Qual("context", "Context")).
+		Params(Id("traceID"), Id("flags").String()).
+		Block(
+			If(
+				List(Id("parent"), Id("ok")).Op(":=").Id("ctx").Dot("Value").Call(Id("traceparentKey").Values()).Assert(String()),
+				Id("ok"),
+			).Block(
+				Comment("The header is formatted as version-traceid-parentid-flags"),
+				If(
+					Id("parts").Op(":=").Qual("strings", "Split").Call(Id("parent"), Lit("-")),
+					Len(Id("parts")).Op("==").Lit(4).Op("&&").Len(Id("parts").Index(Lit(1))).Op("==").Lit(32),
+				).Block(
+					Return(Id("parts").Index(Lit(1)), Id("parts").Index(Lit(3))),
+				),
+			),
+			Return(Qual("fmt", "Sprintf").Call(Lit("%016x%016x"), Qual("math/rand", "Uint64").Call(), Qual("math/rand", "Uint64").Call()), Lit("01")),
+		)
+
+	file.Line()
+	file.Comment("cancelOnClose cancels the context of a request once its response body is closed.")
+	file.Type().Id("cancelOnClose").Struct(
+		Qual("io", "ReadCloser"),
+		Id("cancel").Qual("context", "CancelFunc"),
+	)
+	file.Line()
+	file.Func().Params(Id("c").Op("*").Id("cancelOnClose")).Id("Close").Params().Error().Block(
+		Err().Op(":=").Id("c").Dot("ReadCloser").Dot("Close").Call(),
+		Id("c").Dot("cancel").Call(),
+		Return(Err()),
+	)
+}
+
 func (g *golang) writeErrorType(file *File) {
 	const ErrPrefix = "Err"
 
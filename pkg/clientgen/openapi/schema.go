@@ -431,3 +431,42 @@ func (g *Generator) pathParamType(typ meta.PathSegment_ParamType) *openapi3.Sche
 		panic("unreachable")
 	}
 }
+
+// SchemaConverter converts Encore schema types to OpenAPI schemas, for use by
+// other generators. Named types are collected as reusable schemas and referenced
+// as "#/components/schemas/<name>".
+type SchemaConverter struct {
+	g *Generator
+}
+
+// NewSchemaConverter returns a SchemaConverter for the types in md.
+func NewSchemaConverter(md *meta.Data) *SchemaConverter {
+	g := New(LatestVersion)
+	g.md = md
+	g.spec = &openapi3.T{Components: &openapi3.Components{Schemas: make(openapi3.Schemas)}}
+	return &SchemaConverter{g: g}
+}
+
+// Convert converts typ to an OpenAPI schema.
+func (c *SchemaConverter) Convert(typ *schema.Type) (ref *openapi3.SchemaRef, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if b, ok := r.(bailout); ok {
+				err = b.err
+			} else {
+				panic(r)
+			}
+		}
+	}()
+	return c.g.schemaType(typ), nil
+}
+
+// DefinitionName returns the name to use for a schema definition of typ.
+func (c *SchemaConverter) DefinitionName(typ *schema.Type) string {
+	return c.g.typeToDefinitionName(typ)
+}
+
+// Schemas returns the schemas of the named types referenced by the converted types.
+func (c *SchemaConverter) Schemas() openapi3.Schemas {
+	return c.g.spec.Components.Schemas
+}
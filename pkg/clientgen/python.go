@@ -0,0 +1,1125 @@
+package clientgen
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cockroachdb/errors"
+
+	"encr.dev/internal/version"
+	"encr.dev/parser/encoding"
+	"encr.dev/pkg/clientgen/clientgentypes"
+	"encr.dev/pkg/idents"
+	meta "encr.dev/proto/encore/parser/meta/v1"
+	schema "encr.dev/proto/encore/parser/schema/v1"
+)
+
+/* The Python generator generates code that looks like this:
+class SvcRequest(TypedDict):
+    name: str
+
+class SvcServiceClient:
+    def dummy_api(self, params: SvcRequest) -> None:
+        # ...
+
+class AsyncSvcServiceClient:
+    async def dummy_api(self, params: SvcRequest) -> None:
+        # ...
+
+class Client:
+    def __init__(self, target: str = "prod") -> None:
+        base = _BaseClient(target)
+        self.svc = SvcServiceClient(base)
+*/
+
+// pyGenVersion allows us to introduce breaking changes in the generated code but behind a switch
+// meaning that people with client code reliant on the old behaviour can continue to generate the
+// old code.
+type pyGenVersion int
+
+const (
+	// PyInitial is the originally released python generator
+	PyInitial pyGenVersion = iota
+
+	// PyExperimental can be used to lock experimental or uncompleted features in the generated code
+	// It should always be the last item in the enum
+	PyExperimental
+)
+
+const pythonGenLatestVersion = PyExperimental - 1
+
+type python struct {
+	*bytes.Buffer
+	md               *meta.Data
+	appSlug          string
+	typs             *typeRegistry
+	generatorVersion pyGenVersion
+
+	hasAuth           bool // true if we've seen an authentication handler
+	authIsComplexType bool // true if the auth type is a complex type
+	typeParamsAsAny   bool // true while rendering types that cannot be generic
+}
+
+func (py *python) Version() int {
+	return int(py.generatorVersion)
+}
+
+func (py *python) Generate(p clientgentypes.GenerateParams) (err error) {
+	defer py.handleBailout(&err)
+
+	py.Buffer = p.Buf
+	py.md = p.Meta
+	py.appSlug = p.AppSlug
+	py.typs = getNamedTypes(p.Meta, p.Services)
+
+	if py.md.AuthHandler != nil {
+		py.hasAuth = true
+		py.authIsComplexType = py.md.AuthHandler.Params.GetBuiltin() != schema.Builtin_STRING
+	}
+
+	py.WriteString("# " + doNotEditHeader() + "\n")
+	py.WriteString("#\n# The client requires Python 3.11 or later and only uses the standard library.\n\n")
+	py.WriteString("# Disable linters for this file.\n")
+	py.WriteString("# ruff: noqa\n")
+	py.WriteString("# flake8: noqa\n")
+	py.WriteString("# type: ignore[misc]\n\n")
+
+	py.WriteString(`from __future__ import annotations
+
+import asyncio
+import inspect
+import json
+import urllib.error
+import urllib.parse
+import urllib.request
+from email.message import Message
+from http.cookies import SimpleCookie
+from typing import Any, Awaitable, Callable, Generic, Literal, NotRequired, TypeAlias, TypedDict, TypeVar
+`)
+
+	py.writeTypeVars()
+	py.writeClient(p.Services)
+
+	for _, ns := range py.typs.Namespaces() {
+		py.writeNamespace(ns)
+	}
+
+	for _, async := range []bool{false, true} {
+		for _, svc := range p.Meta.Svcs {
+			if err := py.writeService(svc, p.Services, p.Tags, async); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := py.writeBaseClient(p.AppSlug); err != nil {
+		return err
+	}
+	py.writeHelpers()
+	py.writeCustomErrorType()
+
+	return nil
+}
+
+// writeTypeVars writes the type variables used by the generic types.
+func (py *python) writeTypeVars() {
+	seen := make(map[string]bool)
+	var names []string
+	for _, ns := range py.typs.Namespaces() {
+		for _, decl := range py.typs.Decls(ns) {
+			for _, tp := range decl.TypeParams {
+				if !seen[tp.Name] {
+					seen[tp.Name] = true
+					names = append(names, tp.Name)
+				}
+			}
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+
+	sort.Strings(names)
+	py.WriteString("\n")
+	for _, name := range names {
+		fmt.Fprintf(py, "%s = TypeVar(%s)\n", name, py.Quote(name))
+	}
+}
+
+func (py *python) writeClient(set clientgentypes.ServiceSet) {
+	w := py.newIdentWriter(0)
+	w.WriteString(`
+
+LOCAL = "http://localhost:4000"
+"""LOCAL is the base URL for calling the Encore application's API when running locally."""
+
+
+def environment(name: str) -> str:
+    """Returns the base URL for calling the cloud environment with the given name."""
+    return f"https://{name}-` + py.appSlug + `.encr.app"
+
+
+def preview_env(pr: int | str) -> str:
+    """Returns the base URL for calling the preview environment with the given PR number."""
+    return environment(f"pr{pr}")
+`)
+
+	for _, async := range []bool{false, true} {
+		className, baseName, authGen := "Client", "_BaseClient", "Callable[[], %s | None]"
+		doc := "Client is an API client for the " + py.appSlug + " Encore application.\n\nUse AsyncClient for calling the APIs using asyncio."
+		if async {
+			className, baseName, authGen = "AsyncClient", "_AsyncBaseClient", "Callable[[], %s | None | Awaitable[%s | None]]"
+			doc = "AsyncClient is an asyncio API client for the " + py.appSlug + " Encore application."
+		}
+
+		w.WriteStringf("\n\nclass %s:\n", className)
+		w := w.Indent()
+		py.writeDocString(w, doc)
+		w.WriteString("\ndef __init__(\n")
+		{
+			w := w.Indent()
+			w.WriteString("self,\ntarget: str = \"prod\",\n*,\n")
+			if py.hasAuth {
+				authType := py.authType()
+				w.WriteStringf("auth: %s | %s | None = None,\n", authType, strings.ReplaceAll(authGen, "%s", authType))
+			}
+			w.WriteString("headers: dict[str, str] | None = None,\ntimeout: float | None = None,\n")
+		}
+		w.WriteString(") -> None:\n")
+		{
+			w := w.Indent()
+			doc := "Creates a client for calling the public and authenticated APIs of your Encore application.\n\n" +
+				":param target: The environment name or base URL the client should call. See LOCAL and environment for options.\n"
+			if py.hasAuth {
+				doc += ":param auth: The authentication data to send with each request, or a function returning it.\n"
+			}
+			doc += ":param headers: Additional headers to send with each request.\n" +
+				":param timeout: The timeout for each request, in seconds."
+			py.writeDocString(w, doc)
+
+			auth := "None"
+			if py.hasAuth {
+				auth = "auth"
+			}
+			w.WriteStringf("base = %s(target, %s, headers, timeout)\n", baseName, auth)
+			for _, svc := range py.md.Svcs {
+				if hasPublicRPC(svc) && set.Has(svc.Name) {
+					w.WriteStringf("self.%s = %s(base)\n", py.memberName(svc.Name), py.serviceClassName(svc.Name, async))
+				}
+			}
+		}
+	}
+}
+
+func (py *python) authType() string {
+	if !py.authIsComplexType {
+		return "str"
+	}
+	return py.renderType(py.md.AuthHandler.Params)
+}
+
+func (py *python) serviceClassName(svc string, async bool) string {
+	name := py.typeName(svc) + "ServiceClient"
+	if async {
+		name = "Async" + name
+	}
+	return name
+}
+
+func (py *python) writeNamespace(ns string) {
+	decls := slices.Clone(py.typs.Decls(ns))
+	sort.Slice(decls, func(i, j int) bool {
+		return decls[i].Name < decls[j].Name
+	})
+	for _, decl := range decls {
+		py.writeDeclDef(decl)
+	}
+}
+
+func (py *python) writeDeclDef(decl *schema.Decl) {
+	w := py.newIdentWriter(0)
+	name := py.declName(decl)
+	py.WriteString("\n\n")
+
+	st := decl.Type.GetStruct()
+	if st == nil {
+		// Other types are type aliases.
+		py.writeComment(w, decl.Doc)
+		w.WriteStringf("%s: TypeAlias = %s\n", name, py.Quote(py.renderType(decl.Type)))
+		return
+	}
+
+	fields := make([]*schema.Field, 0, len(st.Fields))
+	for _, f := range st.Fields {
+		if !encoding.IgnoreField(f) {
+			fields = append(fields, f)
+		}
+	}
+
+	// Fields that aren't valid identifiers require the functional syntax,
+	// which doesn't support generics.
+	if py.isFunctionalDecl(decl) {
+		py.typeParamsAsAny = true
+		defer func() { py.typeParamsAsAny = false }()
+
+		py.writeComment(w, decl.Doc)
+		w.WriteStringf("%s = TypedDict(%s, {\n", name, py.Quote(name))
+		{
+			w := w.Indent()
+			for _, f := range fields {
+				py.writeComment(w, f.Doc)
+				w.WriteStringf("%s: %s,\n", py.Quote(py.fieldNameInStruct(f)), py.Quote(py.fieldType(f)))
+			}
+		}
+		w.WriteString("})\n")
+		return
+	}
+
+	bases := "TypedDict"
+	if len(decl.TypeParams) > 0 {
+		params := make([]string, len(decl.TypeParams))
+		for i, tp := range decl.TypeParams {
+			params[i] = tp.Name
+		}
+		bases += ", Generic[" + strings.Join(params, ", ") + "]"
+	}
+	w.WriteStringf("class %s(%s):\n", name, bases)
+	w = w.Indent()
+	if decl.Doc != "" {
+		py.writeDocString(w, decl.Doc)
+		if len(fields) > 0 {
+			w.WriteString("\n")
+		}
+	} else if len(fields) == 0 {
+		w.WriteString("pass\n")
+	}
+
+	for i, f := range fields {
+		// Separate documented fields with an empty line.
+		if f.Doc != "" && i > 0 {
+			w.WriteString("\n")
+		}
+		py.writeComment(w, f.Doc)
+		w.WriteStringf("%s: %s\n", py.fieldNameInStruct(f), py.fieldType(f))
+	}
+}
+
+// isFunctionalDecl reports whether decl is a struct that must be declared
+// using the functional TypedDict syntax, due to field names that aren't valid identifiers.
+func (py *python) isFunctionalDecl(decl *schema.Decl) bool {
+	st := decl.Type.GetStruct()
+	if st == nil {
+		return false
+	}
+	for _, f := range st.Fields {
+		if !encoding.IgnoreField(f) && !isPythonIdentifier(py.fieldNameInStruct(f)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (py *python) fieldType(f *schema.Field) string {
+	typ := py.renderType(f.Typ)
+	if f.Optional {
+		typ = "NotRequired[" + typ + "]"
+	}
+	return typ
+}
+
+func (py *python) writeService(svc *meta.Service, set clientgentypes.ServiceSet, tags clientgentypes.TagSet, async bool) error {
+	// Determine if we have anything worth exposing.
+	isIncluded := hasPublicRPC(svc) && set.Has(svc.Name)
+	if !isIncluded {
+		return nil
+	}
+
+	baseName := "_BaseClient"
+	if async {
+		baseName = "_AsyncBaseClient"
+	}
+
+	w := py.newIdentWriter(0)
+	w.WriteStringf("\n\nclass %s:\n", py.serviceClassName(svc.Name, async))
+	w = w.Indent()
+	w.WriteStringf("def __init__(self, base: %s) -> None:\n", baseName)
+	w.Indent().WriteString("self._base = base\n")
+
+	for _, rpc := range svc.Rpcs {
+		if rpc.AccessType == meta.RPC_PRIVATE || !tags.IsRPCIncluded(rpc) {
+			continue
+		}
+
+		// streaming endpoints not supported yet
+		if rpc.StreamingRequest || rpc.StreamingResponse {
+			continue
+		}
+
+		w.WriteString("\n")
+		if async {
+			w.WriteString("async ")
+		}
+		w.WriteStringf("def %s(self", py.memberName(rpc.Name))
+
+		var rpcPath strings.Builder
+		hasPathParams := false
+		for _, s := range rpc.Path.Segments {
+			rpcPath.WriteByte('/')
+			if s.Type == meta.PathSegment_LITERAL {
+				rpcPath.WriteString(strings.NewReplacer("{", "{{", "}", "}}", "\\", "\\\\", "\"", "\\\"").Replace(s.Value))
+				continue
+			}
+
+			hasPathParams = true
+			id := py.nonReservedId(s.Value)
+			typ := py.pathParamType(s.ValueType)
+			if s.Type == meta.PathSegment_WILDCARD || s.Type == meta.PathSegment_FALLBACK {
+				w.WriteStringf(", %s: list[%s]", id, typ)
+				rpcPath.WriteString("{_quote_all(" + id + ")}")
+			} else {
+				w.WriteStringf(", %s: %s", id, typ)
+				rpcPath.WriteString("{_quote(" + id + ")}")
+			}
+		}
+
+		path := "\"" + rpcPath.String() + "\""
+		if hasPathParams {
+			path = "f" + path
+		}
+
+		if rpc.Proto == meta.RPC_RAW {
+			w.WriteString(", method: str, body: bytes | None = None, headers: dict[str, str] | None = None, query: dict[str, str | list[str]] | None = None) -> Response:\n")
+		} else {
+			if rpc.RequestSchema != nil {
+				w.WriteStringf(", params: %s", py.renderType(rpc.RequestSchema))
+			}
+			ret := "None"
+			if rpc.ResponseSchema != nil {
+				ret = py.renderType(rpc.ResponseSchema)
+			}
+			w.WriteStringf(") -> %s:\n", ret)
+		}
+
+		w := w.Indent()
+		if rpc.Doc != nil && strings.TrimSpace(*rpc.Doc) != "" {
+			py.writeDocString(w, strings.TrimSpace(*rpc.Doc))
+		}
+		if err := py.rpcCallSite(w, rpc, path, async); err != nil {
+			return errors.Wrapf(err, "unable to write RPC call site for %s.%s", rpc.ServiceName, rpc.Name)
+		}
+	}
+	return nil
+}
+
+func (py *python) rpcCallSite(w *indentWriter, rpc *meta.RPC, rpcPath string, async bool) error {
+	await := ""
+	if async {
+		await = "await "
+	}
+
+	// Raw end points just pass through the request
+	// and need no further code generation
+	if rpc.Proto == meta.RPC_RAW {
+		w.WriteStringf("return %sself._base.call_api(method, %s, body, headers=headers, query=query)\n", await, rpcPath)
+		return nil
+	}
+
+	// Work out how we're going to encode and call this RPC
+	rpcEncoding, err := encoding.DescribeRPC(py.md, rpc, &encoding.Options{SrcNameTag: "json"})
+	if err != nil {
+		return errors.Wrapf(err, "rpc %s", rpc.Name)
+	}
+
+	args := []string{py.Quote(rpcEncoding.DefaultMethod), rpcPath}
+	if rpc.RequestSchema != nil {
+		reqEnc := rpcEncoding.DefaultRequestEncoding
+
+		if len(reqEnc.HeaderParameters) > 0 || len(reqEnc.QueryParameters) > 0 || len(reqEnc.CookieParameters) > 0 {
+			w.WriteString("# Convert our params into the objects we need for the request\n")
+		}
+
+		body := ""
+		if len(reqEnc.BodyParameters) > 0 {
+			if len(reqEnc.HeaderParameters) == 0 && len(reqEnc.QueryParameters) == 0 && len(reqEnc.CookieParameters) == 0 {
+				// In the simple case we can just encode the params as the body directly
+				body = "params"
+			} else {
+				// Else pick the fields which we want encoded within the body
+				// (excluding query string, header or cookie fields)
+				body = "_pick(params, ("
+				for i, field := range reqEnc.BodyParameters {
+					if i > 0 {
+						body += ", "
+					}
+					body += py.Quote(field.SrcName)
+				}
+				if len(reqEnc.BodyParameters) == 1 {
+					body += ","
+				}
+				body += "))"
+			}
+		}
+		if body != "" {
+			args = append(args, "body="+body)
+		}
+
+		for _, loc := range []struct {
+			name   string
+			params []*encoding.ParameterEncoding
+		}{
+			{"headers", reqEnc.HeaderParameters},
+			{"query", reqEnc.QueryParameters},
+			{"cookies", reqEnc.CookieParameters},
+		} {
+			if len(loc.params) == 0 {
+				continue
+			}
+			w.WriteStringf("%s = _make_record(", loc.name)
+			py.Values(w, py.encodeParams("params", loc.params))
+			w.WriteString(")\n")
+			args = append(args, loc.name+"="+loc.name)
+		}
+	}
+
+	callAPI := fmt.Sprintf("%sself._base.call_typed_api(%s)", await, strings.Join(args, ", "))
+
+	// If there's no response schema, we can just make the call
+	if rpc.ResponseSchema == nil {
+		w.WriteStringf("%s\n", callAPI)
+		return nil
+	}
+
+	w.WriteStringf("# Now make the actual call to the API\nresp = %s\n", callAPI)
+
+	respEnc := rpcEncoding.ResponseEncoding
+
+	// If we don't need to do anything with the body, we can just return the response
+	if len(respEnc.HeaderParameters) == 0 && len(respEnc.CookieParameters) == 0 {
+		w.WriteString("return resp.json()\n")
+		return nil
+	}
+
+	// Otherwise, we need to add the header and cookie fields to the response
+	w.WriteString("\n# Populate the return object from the JSON body and received headers\nrtn = resp.json()\n")
+	for _, field := range respEnc.HeaderParameters {
+		value := fmt.Sprintf("resp.headers.get(%s)", py.Quote(field.WireFormat))
+		py.writeResponseField(w, field, fmt.Sprintf("Header `%s`", field.WireFormat), value)
+	}
+	for _, field := range respEnc.CookieParameters {
+		value := fmt.Sprintf("resp.cookie(%s)", py.Quote(field.WireFormat))
+		py.writeResponseField(w, field, fmt.Sprintf("Cookie `%s`", field.WireFormat), value)
+	}
+	w.WriteString("return rtn\n")
+	return nil
+}
+
+func (py *python) writeResponseField(w *indentWriter, field *encoding.ParameterEncoding, desc, value string) {
+	key := fmt.Sprintf("rtn[%s]", py.Quote(field.SrcName))
+	if field.Optional {
+		w.WriteStringf("if (value := %s) is not None:\n", value)
+		w.Indent().WriteStringf("%s = %s\n", key, py.convertStringToBuiltin(field.Type.GetBuiltin(), "value"))
+		return
+	}
+
+	value = fmt.Sprintf("_must_be_set(%s, %s)", py.Quote(desc), value)
+	w.WriteStringf("%s = %s\n", key, py.convertStringToBuiltin(field.Type.GetBuiltin(), value))
+}
+
+// encodeParams returns the string-encoded values of the given parameters,
+// keyed by their wire format, reading them from the dict with the given name.
+func (py *python) encodeParams(dict string, params []*encoding.ParameterEncoding) map[string]string {
+	values := make(map[string]string, len(params))
+	for _, field := range params {
+		ref := fmt.Sprintf("%s[%s]", dict, py.Quote(field.SrcName))
+		if field.Optional {
+			ref = fmt.Sprintf("%s.get(%s)", dict, py.Quote(field.SrcName))
+		}
+
+		builtin := field.Type.GetBuiltin()
+		if list := field.Type.GetList(); list != nil {
+			builtin = list.Elem.GetBuiltin()
+		} else if opt := field.Type.GetOption(); opt != nil {
+			builtin = opt.Value.GetBuiltin()
+		}
+		values[field.WireFormat] = py.convertBuiltinToString(builtin, ref)
+	}
+	return values
+}
+
+func (py *python) writeBaseClient(appSlug string) error {
+	userAgent := fmt.Sprintf("%s-Generated-Python-Client (Encore/%s)", appSlug, version.Version)
+
+	py.WriteString(`
+
+class Response:
+    """Response is the HTTP response of an API call."""
+
+    def __init__(self, status: int, headers: Message, body: bytes) -> None:
+        self.status = status
+        self.headers = headers
+        self.body = body
+
+    def json(self) -> Any:
+        """Decodes the response body as JSON."""
+        return json.loads(self.body) if self.body else None
+
+    def text(self) -> str:
+        """Decodes the response body as UTF-8 text."""
+        return self.body.decode("utf-8")
+
+    def cookie(self, name: str) -> str | None:
+        """Returns the value of the cookie with the given name set by the response, if any."""
+        cookies: SimpleCookie = SimpleCookie()
+        for header in self.headers.get_all("Set-Cookie") or []:
+            cookies.load(header)
+        morsel = cookies.get(name)
+        return None if morsel is None else morsel.value
+
+
+class _Transport:
+    """_Transport makes the HTTP requests to the Encore application."""
+
+    def __init__(self, target: str, headers: dict[str, str] | None, timeout: float | None) -> None:
+        if not target.startswith(("http://", "https://")):
+            target = environment(target)
+        self.base_url = target.rstrip("/")
+        self.headers = {"User-Agent": "` + userAgent + `", **(headers or {})}
+        self.timeout = timeout
+
+    def send(
+        self,
+        method: str,
+        path: str,
+        body: bytes | None,
+        headers: dict[str, str] | None,
+        query: dict[str, str | list[str]] | None,
+        cookies: dict[str, str] | None,
+        auth_data: Any,
+    ) -> Response:
+        headers = {**self.headers, **(headers or {})}
+        query = dict(query or {})
+        cookies = dict(cookies or {})
+`)
+
+	if py.hasAuth {
+		py.WriteString(`
+        # If we have authentication data, add it to the request
+        if auth_data is not None:
+            auth_headers, auth_query, auth_cookies = _encode_auth(auth_data)
+            headers.update(auth_headers)
+            query.update(auth_query)
+            cookies.update(auth_cookies)
+`)
+	}
+
+	py.WriteString(`
+        url = self.base_url + path
+        if query:
+            url += "?" + urllib.parse.urlencode(query, doseq=True)
+        if cookies:
+            headers["Cookie"] = "; ".join(f"{k}={v}" for k, v in cookies.items())
+
+        req = urllib.request.Request(url, data=body, headers=headers, method=method)
+        try:
+            if self.timeout is None:
+                resp = urllib.request.urlopen(req)
+            else:
+                resp = urllib.request.urlopen(req, timeout=self.timeout)
+            with resp:
+                return Response(resp.status, resp.headers, resp.read())
+        except urllib.error.HTTPError as e:
+            with e:
+                raise _api_error(e.code, e.read()) from None
+
+
+class _BaseClient:
+    """_BaseClient is the base client used by the generated service clients."""
+
+    def __init__(self, target: str, auth: Any, headers: dict[str, str] | None, timeout: float | None) -> None:
+        self._transport = _Transport(target, headers, timeout)
+        self._auth = auth
+
+    def call_typed_api(
+        self,
+        method: str,
+        path: str,
+        body: Any = None,
+        headers: dict[str, str] | None = None,
+        query: dict[str, str | list[str]] | None = None,
+        cookies: dict[str, str] | None = None,
+    ) -> Response:
+        """Makes an API call with a JSON request body."""
+        headers = {"Content-Type": "application/json", **(headers or {})}
+        data = None if body is None else json.dumps(body).encode("utf-8")
+        return self.call_api(method, path, data, headers=headers, query=query, cookies=cookies)
+
+    def call_api(
+        self,
+        method: str,
+        path: str,
+        body: bytes | None = None,
+        headers: dict[str, str] | None = None,
+        query: dict[str, str | list[str]] | None = None,
+        cookies: dict[str, str] | None = None,
+    ) -> Response:
+        """Makes an API call, raising an APIError if it fails."""
+        auth_data = self._auth() if callable(self._auth) else self._auth
+        return self._transport.send(method, path, body, headers, query, cookies, auth_data)
+
+
+class _AsyncBaseClient:
+    """_AsyncBaseClient is the base client used by the generated asyncio service clients.
+
+    The requests are made in a separate thread, so they don't block the event loop.
+    """
+
+    def __init__(self, target: str, auth: Any, headers: dict[str, str] | None, timeout: float | None) -> None:
+        self._transport = _Transport(target, headers, timeout)
+        self._auth = auth
+
+    async def call_typed_api(
+        self,
+        method: str,
+        path: str,
+        body: Any = None,
+        headers: dict[str, str] | None = None,
+        query: dict[str, str | list[str]] | None = None,
+        cookies: dict[str, str] | None = None,
+    ) -> Response:
+        """Makes an API call with a JSON request body."""
+        headers = {"Content-Type": "application/json", **(headers or {})}
+        data = None if body is None else json.dumps(body).encode("utf-8")
+        return await self.call_api(method, path, data, headers=headers, query=query, cookies=cookies)
+
+    async def call_api(
+        self,
+        method: str,
+        path: str,
+        body: bytes | None = None,
+        headers: dict[str, str] | None = None,
+        query: dict[str, str | list[str]] | None = None,
+        cookies: dict[str, str] | None = None,
+    ) -> Response:
+        """Makes an API call, raising an APIError if it fails."""
+        auth_data = self._auth() if callable(self._auth) else self._auth
+        if inspect.isawaitable(auth_data):
+            auth_data = await auth_data
+        return await asyncio.to_thread(self._transport.send, method, path, body, headers, query, cookies, auth_data)
+`)
+
+	if !py.hasAuth {
+		return nil
+	}
+
+	py.WriteString("\n\ndef _encode_auth(auth_data: Any) -> tuple[dict[str, str], dict[str, Any], dict[str, str]]:\n")
+	w := py.newIdentWriter(1)
+	py.writeDocString(w, "Encodes the authentication data into the headers, query string and cookies of a request.")
+	if !py.authIsComplexType {
+		w.WriteString("return {\"Authorization\": \"Bearer \" + auth_data}, {}, {}\n")
+		return nil
+	}
+
+	authData, err := encoding.DescribeAuth(py.md, py.md.AuthHandler.Params, &encoding.Options{SrcNameTag: "json"})
+	if err != nil {
+		return errors.Wrap(err, "unable to describe auth data")
+	}
+
+	var results []string
+	for _, loc := range []struct {
+		name   string
+		params []*encoding.ParameterEncoding
+	}{
+		{"headers", authData.HeaderParameters},
+		{"query", authData.QueryParameters},
+		{"cookies", authData.CookieParameters},
+	} {
+		if len(loc.params) == 0 {
+			results = append(results, "{}")
+			continue
+		}
+		w.WriteStringf("%s = _make_record(", loc.name)
+		py.Values(w, py.encodeParams("auth_data", loc.params))
+		w.WriteString(")\n")
+		results = append(results, loc.name)
+	}
+	w.WriteStringf("return %s\n", strings.Join(results, ", "))
+	return nil
+}
+
+func (py *python) writeHelpers() {
+	py.WriteString(`
+
+def _to_str(value: Any) -> Any:
+    """Converts a value to its string representation in headers, query strings and cookies."""
+    if value is None or isinstance(value, str):
+        return value
+    if isinstance(value, bool):
+        return "true" if value else "false"
+    if isinstance(value, (list, tuple)):
+        return [_to_str(v) for v in value]
+    return str(value)
+
+
+def _json_str(value: Any) -> str | None:
+    """Encodes a value as JSON, for use in headers, query strings and cookies."""
+    return None if value is None else json.dumps(value)
+
+
+def _make_record(record: dict[str, Any]) -> dict[str, Any]:
+    """Strips any None values from the record."""
+    return {k: v for k, v in record.items() if v is not None}
+
+
+def _pick(params: Any, keys: tuple[str, ...]) -> dict[str, Any]:
+    """Returns the fields of params with the given keys."""
+    return {k: params[k] for k in keys if k in params}
+
+
+def _quote(value: Any) -> str:
+    """Escapes a value for use as a path segment."""
+    return urllib.parse.quote(_to_str(value), safe="")
+
+
+def _quote_all(values: list[Any]) -> str:
+    """Escapes a list of values for use as a wildcard path parameter."""
+    return "/".join(_quote(v) for v in values)
+
+
+def _must_be_set(field: str, value: Any) -> Any:
+    """Raises an APIError with the DataLoss code if value is None."""
+    if value is None:
+        raise APIError(500, ErrCode.DATA_LOSS, f"{field} was unexpectedly None")
+    return value
+`)
+}
+
+func (py *python) writeCustomErrorType() {
+	py.WriteString(`
+
+class APIError(Exception):
+    """APIError represents a structured error as returned from an Encore application."""
+
+    def __init__(self, status: int, code: str, message: str, details: Any = None) -> None:
+        super().__init__(message)
+
+        self.status = status
+        """The HTTP status code associated with the error."""
+
+        self.code = code
+        """The Encore error code, one of the ErrCode values."""
+
+        self.message = message
+        """The error message."""
+
+        self.details = details
+        """The error details, if any."""
+
+    def __str__(self) -> str:
+        return f"{self.code}: {self.message}"
+
+
+def _api_error(status: int, body: bytes) -> APIError:
+    """Builds an APIError from an error response, making a best effort for unstructured errors."""
+    message = f"request failed: status {status}"
+    try:
+        data = json.loads(body)
+    except ValueError:
+        return APIError(status, ErrCode.UNKNOWN, message + ": " + body.decode("utf-8", "replace"))
+
+    if (
+        isinstance(data, dict)
+        and isinstance(data.get("code"), str)
+        and isinstance(data.get("message"), str)
+        and isinstance(data.get("details"), (dict, type(None)))
+    ):
+        return APIError(status, data["code"], data["message"], data.get("details"))
+    return APIError(status, ErrCode.UNKNOWN, message + ": " + json.dumps(data))
+
+
+class ErrCode:
+    """ErrCode holds the error codes an APIError can have."""
+`)
+
+	w := py.newIdentWriter(1)
+	for _, code := range errorCodes {
+		w.WriteStringf("\n%s = %s\n", idents.Convert(code.Name, idents.ScreamingSnakeCase), py.Quote(idents.Convert(code.Name, idents.SnakeCase)))
+		py.writeDocString(w, code.Comment)
+	}
+}
+
+func (py *python) pathParamType(typ meta.PathSegment_ParamType) string {
+	switch typ {
+	case meta.PathSegment_STRING, meta.PathSegment_UUID:
+		return "str"
+	case meta.PathSegment_BOOL:
+		return "bool"
+	case meta.PathSegment_INT8, meta.PathSegment_INT16, meta.PathSegment_INT32, meta.PathSegment_INT64, meta.PathSegment_INT,
+		meta.PathSegment_UINT8, meta.PathSegment_UINT16, meta.PathSegment_UINT32, meta.PathSegment_UINT64, meta.PathSegment_UINT:
+		return "int"
+	default:
+		py.errorf("unhandled PathSegment type %s", typ)
+		return "Any"
+	}
+}
+
+func (py *python) builtinType(typ schema.Builtin) string {
+	switch typ {
+	case schema.Builtin_ANY, schema.Builtin_JSON:
+		return "Any"
+	case schema.Builtin_BOOL:
+		return "bool"
+	case schema.Builtin_INT, schema.Builtin_INT8, schema.Builtin_INT16, schema.Builtin_INT32, schema.Builtin_INT64,
+		schema.Builtin_UINT, schema.Builtin_UINT8, schema.Builtin_UINT16, schema.Builtin_UINT32, schema.Builtin_UINT64:
+		return "int"
+	case schema.Builtin_FLOAT32, schema.Builtin_FLOAT64:
+		return "float"
+	case schema.Builtin_STRING, schema.Builtin_BYTES, schema.Builtin_TIME, schema.Builtin_UUID,
+		schema.Builtin_USER_ID, schema.Builtin_DECIMAL:
+		return "str"
+	default:
+		py.errorf("unknown builtin type %v", typ)
+		return "Any"
+	}
+}
+
+func (py *python) convertBuiltinToString(typ schema.Builtin, val string) string {
+	if typ == schema.Builtin_JSON {
+		return fmt.Sprintf("_json_str(%s)", val)
+	}
+	return fmt.Sprintf("_to_str(%s)", val)
+}
+
+func (py *python) convertStringToBuiltin(typ schema.Builtin, val string) string {
+	switch typ {
+	case schema.Builtin_BOOL:
+		return fmt.Sprintf("%s.lower() == \"true\"", val)
+	case schema.Builtin_INT, schema.Builtin_INT8, schema.Builtin_INT16, schema.Builtin_INT32, schema.Builtin_INT64,
+		schema.Builtin_UINT, schema.Builtin_UINT8, schema.Builtin_UINT16, schema.Builtin_UINT32, schema.Builtin_UINT64:
+		return fmt.Sprintf("int(%s)", val)
+	case schema.Builtin_FLOAT32, schema.Builtin_FLOAT64:
+		return fmt.Sprintf("float(%s)", val)
+	case schema.Builtin_JSON:
+		return fmt.Sprintf("json.loads(%s)", val)
+	default:
+		return val
+	}
+}
+
+func (py *python) renderType(typ *schema.Type) string {
+	switch t := typ.Typ.(type) {
+	case *schema.Type_Named:
+		decl := py.md.Decls[t.Named.Id]
+		name := py.declName(decl)
+		if len(t.Named.TypeArguments) > 0 && !py.isFunctionalDecl(decl) {
+			args := make([]string, len(t.Named.TypeArguments))
+			for i, arg := range t.Named.TypeArguments {
+				args[i] = py.renderType(arg)
+			}
+			name += "[" + strings.Join(args, ", ") + "]"
+		}
+		return name
+
+	case *schema.Type_List:
+		return "list[" + py.renderType(t.List.Elem) + "]"
+
+	case *schema.Type_Map:
+		return "dict[" + py.renderType(t.Map.Key) + ", " + py.renderType(t.Map.Value) + "]"
+
+	case *schema.Type_Builtin:
+		return py.builtinType(t.Builtin)
+
+	case *schema.Type_Literal:
+		switch lit := t.Literal.Value.(type) {
+		case *schema.Literal_Str:
+			return "Literal[" + py.Quote(lit.Str) + "]"
+		case *schema.Literal_Int:
+			return "Literal[" + strconv.FormatInt(lit.Int, 10) + "]"
+		case *schema.Literal_Float:
+			// Python doesn't support float literal types.
+			return "float"
+		case *schema.Literal_Boolean:
+			if lit.Boolean {
+				return "Literal[True]"
+			}
+			return "Literal[False]"
+		case *schema.Literal_Null:
+			return "None"
+		default:
+			py.errorf("unknown literal type %T", lit)
+			return "Any"
+		}
+
+	case *schema.Type_Pointer:
+		// We do not treat pointers as nullable, as we have the Option type for that.
+		return py.renderType(t.Pointer.Base)
+
+	case *schema.Type_Option:
+		return py.renderUnion(append(slices.Clone([]*schema.Type{t.Option.Value}), &schema.Type{
+			Typ: &schema.Type_Literal{Literal: &schema.Literal{Value: &schema.Literal_Null{Null: true}}},
+		}))
+
+	case *schema.Type_Union:
+		return py.renderUnion(t.Union.Types)
+
+	case *schema.Type_Struct:
+		// Anonymous structs can't be expressed as TypedDicts.
+		return "dict[str, Any]"
+
+	case *schema.Type_TypeParameter:
+		if py.typeParamsAsAny {
+			return "Any"
+		}
+		decl := py.md.Decls[t.TypeParameter.DeclId]
+		return decl.TypeParams[t.TypeParameter.ParamIdx].Name
+
+	case *schema.Type_Config:
+		// Config type is transparent
+		return py.renderType(t.Config.Elem)
+
+	default:
+		py.errorf("unknown type %+v", reflect.TypeOf(typ.Typ))
+		return "Any"
+	}
+}
+
+func (py *python) renderUnion(types []*schema.Type) string {
+	var cases []string
+	for _, typ := range types {
+		if c := py.renderType(typ); !slices.Contains(cases, c) {
+			cases = append(cases, c)
+		}
+	}
+	return strings.Join(cases, " | ")
+}
+
+func (py *python) writeDocString(w *indentWriter, doc string) {
+	doc = strings.NewReplacer(`\`, `\\`, `"""`, `\"\"\"`).Replace(strings.TrimSpace(doc))
+	lines := strings.Split(doc, "\n")
+	if len(lines) == 1 {
+		w.WriteStringf("\"\"\"%s\"\"\"\n", lines[0])
+		return
+	}
+
+	w.WriteStringf("\"\"\"%s\n", lines[0])
+	for _, line := range lines[1:] {
+		w.WriteString(strings.TrimRightFunc(line, unicode.IsSpace) + "\n")
+	}
+	w.WriteString("\"\"\"\n")
+}
+
+func (py *python) writeComment(w *indentWriter, doc string) {
+	doc = strings.TrimSpace(doc)
+	if doc == "" {
+		return
+	}
+	for _, line := range strings.Split(doc, "\n") {
+		w.WriteString(strings.TrimRightFunc("# "+line, unicode.IsSpace) + "\n")
+	}
+}
+
+// nonReservedId returns the given ID, unless we have it a reserved within the client function _or_ it's a reserved Python keyword
+func (py *python) nonReservedId(id string) string {
+	switch id {
+	// our reserved keywords (or ID's we use within the generated client functions)
+	case "self", "params", "headers", "query", "cookies", "body", "method", "resp", "rtn", "value":
+		return "_" + id
+	}
+	if isPythonKeyword(id) {
+		return "_" + id
+	}
+	return id
+}
+
+func (py *python) errorf(format string, args ...interface{}) {
+	panic(bailout{fmt.Errorf(format, args...)})
+}
+
+func (py *python) handleBailout(dst *error) {
+	if err := recover(); err != nil {
+		if bail, ok := err.(bailout); ok {
+			*dst = bail.err
+		} else {
+			panic(err)
+		}
+	}
+}
+
+func (py *python) newIdentWriter(indent int) *indentWriter {
+	return &indentWriter{
+		w:                py.Buffer,
+		depth:            indent,
+		indent:           "    ",
+		firstWriteOnLine: true,
+	}
+}
+
+func (py *python) Quote(s string) string {
+	return strconv.Quote(s)
+}
+
+func (py *python) Values(w *indentWriter, dict map[string]string) {
+	keys := make([]string, 0, len(dict))
+	for key := range dict {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	w.WriteString("{\n")
+	{
+		w := w.Indent()
+		for _, key := range keys {
+			w.WriteStringf("%s: %s,\n", py.Quote(key), dict[key])
+		}
+	}
+	w.WriteString("}")
+}
+
+func (py *python) declName(decl *schema.Decl) string {
+	return py.typeName(decl.Loc.PkgName) + py.typeName(decl.Name)
+}
+
+func (py *python) typeName(identifier string) string {
+	return idents.Convert(identifier, idents.PascalCase)
+}
+
+func (py *python) memberName(identifier string) string {
+	return py.nonReservedId(idents.Convert(identifier, idents.SnakeCase))
+}
+
+func (py *python) fieldNameInStruct(field *schema.Field) string {
+	name := field.Name
+	if field.JsonName != "" {
+		name = field.JsonName
+	}
+	return name
+}
+
+// isPythonIdentifier reports whether s can be used as an identifier in Python.
+func isPythonIdentifier(s string) bool {
+	if s == "" || isPythonKeyword(s) {
+		return false
+	}
+	for i, r := range s {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+func isPythonKeyword(s string) bool {
+	switch s {
+	case "False", "None", "True", "and", "as", "assert", "async", "await", "break", "class", "continue",
+		"def", "del", "elif", "else", "except", "finally", "for", "from", "global", "if", "import",
+		"in", "is", "lambda", "nonlocal", "not", "or", "pass", "raise", "return", "try", "while", "with", "yield":
+		return true
+	default:
+		return false
+	}
+}
@@ -8,8 +8,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 )
 
 // Client is an API client for the app Encore application.
@@ -71,6 +74,65 @@ func WithHTTPClient(client HTTPDoer) Option {
 	}
 }
 
+// RetryPolicy configures how failed API calls are retried.
+//
+// Only calls using idempotent HTTP methods (GET, HEAD, OPTIONS, PUT and DELETE) are retried,
+// and only if the request could not be sent or the API responded with ErrUnavailable or ErrResourceExhausted.
+type RetryPolicy struct {
+	MaxAttempts int           // The maximum number of attempts, including the first one
+	MinBackoff  time.Duration // The backoff before the first retry (defaults to 100ms)
+	MaxBackoff  time.Duration // The maximum backoff between retries (defaults to 5s)
+}
+
+// RequestInterceptor is called before each request is sent, including retries.
+// Returning an error aborts the API call.
+type RequestInterceptor func(req *http.Request) error
+
+// ResponseInterceptor is called for each response received, before it is decoded.
+// Returning an error aborts the API call.
+type ResponseInterceptor func(req *http.Request, resp *http.Response) error
+
+// WithRetry enables retrying failed API calls according to the given policy.
+//
+// By default API calls are not retried.
+func WithRetry(policy RetryPolicy) Option {
+	return func(base *baseClient) error {
+		base.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithTimeout sets the default timeout for each API call, including any retries.
+//
+// A per-call deadline can be set using the context passed to each call,
+// in which case the earliest deadline applies.
+func WithTimeout(timeout time.Duration) Option {
+	return func(base *baseClient) error {
+		base.timeout = timeout
+		return nil
+	}
+}
+
+// WithRequestInterceptor adds a function which is called before each request is sent.
+//
+// Interceptors are called in the order they were added.
+func WithRequestInterceptor(interceptor RequestInterceptor) Option {
+	return func(base *baseClient) error {
+		base.requestInterceptors = append(base.requestInterceptors, interceptor)
+		return nil
+	}
+}
+
+// WithResponseInterceptor adds a function which is called for each response received.
+//
+// Interceptors are called in the order they were added.
+func WithResponseInterceptor(interceptor ResponseInterceptor) Option {
+	return func(base *baseClient) error {
+		base.responseInterceptors = append(base.responseInterceptors, interceptor)
+		return nil
+	}
+}
+
 type SvcResponse struct {
 	Message string
 }
@@ -108,12 +170,17 @@ type HTTPDoer interface {
 
 // baseClient holds all the information we need to make requests to an Encore application
 type baseClient struct {
-	httpClient HTTPDoer // The HTTP client which will be used for all API requests
-	baseURL    *url.URL // The base URL which API requests will be made against
-	userAgent  string   // What user agent we will use in the API requests
+	httpClient           HTTPDoer              // The HTTP client which will be used for all API requests
+	baseURL              *url.URL              // The base URL which API requests will be made against
+	userAgent            string                // What user agent we will use in the API requests
+	retryPolicy          RetryPolicy           // How failed API calls are retried
+	timeout              time.Duration         // The default timeout for each API call
+	requestInterceptors  []RequestInterceptor  // Functions called before each request is sent
+	responseInterceptors []ResponseInterceptor // Functions called for each response received
 }
 
-// Do sends the req to the Encore application adding the authorization token as required.
+// Do sends the req to the Encore application adding the authorization token as required,
+// and retrying it according to the retry policy.
 func (b *baseClient) Do(req *http.Request) (*http.Response, error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", b.userAgent)
@@ -122,8 +189,154 @@ func (b *baseClient) Do(req *http.Request) (*http.Response, error) {
 	req.URL = b.baseURL.ResolveReference(req.URL)
 	req.Host = req.URL.Host
 
+	// Apply the default timeout, which lasts until the response body is closed
+	cancel := context.CancelFunc(func() {})
+	if b.timeout > 0 {
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(req.Context(), b.timeout)
+		req = req.WithContext(ctx)
+	}
+
 	// Finally, make the request via the configured HTTP Client
-	return b.httpClient.Do(req)
+	resp, err := b.send(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{
+		ReadCloser: resp.Body,
+		cancel:     cancel,
+	}
+	return resp, nil
+}
+
+// send sends the request via the configured HTTP client, calling the interceptors
+// and retrying it according to the retry policy.
+func (b *baseClient) send(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	// Propagate the trace context, unless the caller has set one explicitly
+	hasTraceparent := req.Header.Get("traceparent") != ""
+	traceID, traceFlags := traceContext(ctx)
+
+	for attempt := 1; ; attempt++ {
+		r := req
+		if attempt > 1 {
+			// Rewind the body for the retry
+			r = req.Clone(ctx)
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, fmt.Errorf("unable to rewind request body: %w", err)
+				}
+				r.Body = body
+			}
+		}
+		if !hasTraceparent {
+			r.Header.Set("traceparent", fmt.Sprintf("00-%s-%016x-%s", traceID, rand.Uint64(), traceFlags))
+		}
+
+		for _, interceptor := range b.requestInterceptors {
+			if err := interceptor(r); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := b.httpClient.Do(r)
+		if err == nil {
+			for _, interceptor := range b.responseInterceptors {
+				if err := interceptor(r, resp); err != nil {
+					_ = resp.Body.Close()
+					return nil, err
+				}
+			}
+		}
+
+		if attempt >= b.retryPolicy.MaxAttempts || !shouldRetry(r, resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		// Wait before retrying, unless the context is done first
+		timer := time.NewTimer(b.retryPolicy.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether a request which resulted in the given response or error should be retried.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body cannot be sent again
+		return false
+	}
+	if err != nil {
+		return req.Context().Err() == nil
+	}
+	// Encore responds with these status codes for ErrUnavailable and ErrResourceExhausted
+	return resp.StatusCode == http.StatusServiceUnavailable || resp.StatusCode == http.StatusTooManyRequests
+}
+
+// backoff returns how long to wait before the given retry attempt, using exponential backoff with jitter.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = 100 * time.Millisecond
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = 5 * time.Second
+	}
+
+	d := minBackoff << (attempt - 1)
+	if d > maxBackoff || d <= 0 {
+		d = maxBackoff
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// traceparentKey is the context key for the trace context set by ContextWithTraceparent.
+type traceparentKey struct{}
+
+// ContextWithTraceparent returns a copy of ctx which makes API calls using it part of the trace
+// identified by the given W3C traceparent header value.
+//
+// By default each API call starts a new trace.
+func ContextWithTraceparent(ctx context.Context, traceparent string) context.Context {
+	return context.WithValue(ctx, traceparentKey{}, traceparent)
+}
+
+// traceContext returns the trace id and flags to use for requests made with ctx.
+func traceContext(ctx context.Context) (traceID, flags string) {
+	if parent, ok := ctx.Value(traceparentKey{}).(string); ok {
+		// The header is formatted as version-traceid-parentid-flags
+		if parts := strings.Split(parent, "-"); len(parts) == 4 && len(parts[1]) == 32 {
+			return parts[1], parts[3]
+		}
+	}
+	return fmt.Sprintf("%016x%016x", rand.Uint64(), rand.Uint64()), "01"
+}
+
+// cancelOnClose cancels the context of a request once its response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
 }
 
 // callAPI is used by each generated API method to actually make request and decode the responses
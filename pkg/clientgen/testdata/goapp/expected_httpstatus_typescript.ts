@@ -76,6 +76,21 @@ export interface ClientOptions {
 
     /** Default RequestInit to be used for the client */
     requestInit?: Omit<RequestInit, "headers"> & { headers?: Record<string, string> }
+
+    /** Retry failed API calls according to the given options. By default API calls are not retried. */
+    retry?: RetryOptions
+
+    /**
+     * The default timeout in milliseconds for each API call, including any retries.
+     * It can be overridden for each call using the timeout call parameter.
+     */
+    timeout?: number
+
+    /** Functions called before each request is sent, in the order given */
+    requestInterceptors?: RequestInterceptor[]
+
+    /** Functions called for each response received, in the order given */
+    responseInterceptors?: ResponseInterceptor[]
 }
 
 export namespace svc {
@@ -115,6 +130,75 @@ function encodeQuery(parts: Record<string, string | string[]>): string {
     return pairs.join("&")
 }
 
+// withTimeout returns a signal which aborts when the given signal does,
+// or once the timeout in milliseconds has passed.
+function withTimeout(signal: AbortSignal | null | undefined, timeout: number | undefined): AbortSignal | null {
+    if (timeout === undefined) {
+        return signal ?? null
+    }
+    const timeoutSignal = AbortSignal.timeout(timeout)
+    if (!signal) {
+        return timeoutSignal
+    }
+
+    const controller = new AbortController()
+    for (const s of [signal, timeoutSignal]) {
+        if (s.aborted) {
+            controller.abort(s.reason)
+            break
+        }
+        s.addEventListener("abort", () => controller.abort(s.reason), { once: true })
+    }
+    return controller.signal
+}
+
+// isRetryable reports whether the request can safely be retried.
+function isRetryable(init: RequestInit): boolean {
+    const method = (init.method ?? "GET").toUpperCase()
+    if (!["GET", "HEAD", "OPTIONS", "PUT", "DELETE"].includes(method)) {
+        return false
+    }
+    // Streamed bodies cannot be sent again
+    return !(typeof ReadableStream !== "undefined" && init.body instanceof ReadableStream)
+}
+
+// retryBackoff returns how long to wait before the given retry attempt,
+// using exponential backoff with jitter.
+function retryBackoff(options: RetryOptions, attempt: number): number {
+    const min = options.minBackoff ?? 100
+    const max = options.maxBackoff ?? 5000
+    const backoff = Math.min(min * Math.pow(2, attempt - 1), max)
+    return backoff / 2 + Math.random() * (backoff / 2)
+}
+
+// sleep waits for the given number of milliseconds, unless the signal aborts first.
+function sleep(ms: number, signal: AbortSignal | null | undefined): Promise<void> {
+    return new Promise((resolve, reject) => {
+        if (signal?.aborted) {
+            reject(signal.reason)
+            return
+        }
+        const onAbort = () => {
+            clearTimeout(timer)
+            reject(signal!.reason)
+        }
+        const timer = setTimeout(() => {
+            signal?.removeEventListener("abort", onAbort)
+            resolve()
+        }, ms)
+        signal?.addEventListener("abort", onAbort, { once: true })
+    })
+}
+
+// randomHex returns a random hex string of the given number of bytes.
+function randomHex(bytes: number): string {
+    let hex = ""
+    for (let i = 0; i < bytes; i++) {
+        hex += Math.floor(Math.random() * 256).toString(16).padStart(2, "0")
+    }
+    return hex
+}
+
 // makeRecord takes a record and strips any undefined values from it,
 // and returns the same record with a narrower type.
 // @ts-ignore - TS ignore because makeRecord is not always used
@@ -304,12 +388,41 @@ type CallParameters = Omit<RequestInit, "method" | "body" | "headers"> & {
 
     /** Query parameters to be sent with the request */
     query?: Record<string, string | string[]>
+
+    /** Timeout in milliseconds for the call, overriding the client's default timeout */
+    timeout?: number
 }
 
 
 // A fetcher is the prototype for the inbuilt Fetch function
 export type Fetcher = typeof fetch;
 
+/**
+ * RetryOptions configures how failed API calls are retried.
+ *
+ * Only calls using idempotent HTTP methods (GET, HEAD, OPTIONS, PUT and DELETE) are retried,
+ * and only if the request could not be sent or the API responded with
+ * ErrCode.Unavailable or ErrCode.ResourceExhausted.
+ */
+export interface RetryOptions {
+    /** The maximum number of attempts, including the first one */
+    maxAttempts: number
+
+    /** The backoff in milliseconds before the first retry (defaults to 100) */
+    minBackoff?: number
+
+    /** The maximum backoff in milliseconds between retries (defaults to 5000) */
+    maxBackoff?: number
+}
+
+// RequestInterceptor is called before each request is sent, including retries.
+// It may modify the request, and throwing an error aborts the API call.
+export type RequestInterceptor = (url: string, init: RequestInit & { headers: Record<string, string> }) => void | Promise<void>
+
+// ResponseInterceptor is called for each response received, before it is decoded.
+// Throwing an error aborts the API call.
+export type ResponseInterceptor = (url: string, init: RequestInit, response: Response) => void | Promise<void>
+
 const boundFetch = fetch.bind(this);
 
 class BaseClient {
@@ -317,6 +430,10 @@ class BaseClient {
     readonly fetcher: Fetcher
     readonly headers: Record<string, string>
     readonly requestInit: Omit<RequestInit, "headers"> & { headers?: Record<string, string> }
+    readonly retry?: RetryOptions
+    readonly timeout?: number
+    readonly requestInterceptors: RequestInterceptor[]
+    readonly responseInterceptors: ResponseInterceptor[]
 
     constructor(baseURL: string, options: ClientOptions) {
         this.baseURL = baseURL
@@ -329,6 +446,10 @@ class BaseClient {
         }
 
         this.requestInit = options.requestInit ?? {};
+        this.retry = options.retry
+        this.timeout = options.timeout
+        this.requestInterceptors = options.requestInterceptors ?? []
+        this.responseInterceptors = options.responseInterceptors ?? []
 
         // Setup what fetch function we'll be using in the base client
         if (options.fetcher !== undefined) {
@@ -415,7 +536,7 @@ class BaseClient {
 
     // callAPI is used by each generated API method to actually make the request
     public async callAPI(method: string, path: string, body?: RequestInit["body"], params?: CallParameters): Promise<Response> {
-        let { query, headers, ...rest } = params ?? {}
+        let { query, headers, timeout, ...rest } = params ?? {}
         const init = {
             ...this.requestInit,
             ...rest,
@@ -439,9 +560,12 @@ class BaseClient {
             }
         }
 
+        // Apply the timeout, if any
+        init.signal = withTimeout(init.signal, timeout ?? this.timeout)
+
         // Make the actual request
         const queryString = query ? '?' + encodeQuery(query) : ''
-        const response = await this.fetcher(this.baseURL+path+queryString, init)
+        const response = await this.send(this.baseURL+path+queryString, init)
 
         // handle any error responses
         if (!response.ok) {
@@ -472,6 +596,47 @@ class BaseClient {
 
         return response
     }
+
+    // send makes the request, calling the interceptors and retrying it according to the retry options
+    private async send(url: string, init: RequestInit & { headers?: Record<string, string> }): Promise<Response> {
+        // Propagate the trace context, unless the caller has set one explicitly
+        const hasTraceparent = init.headers?.["traceparent"] !== undefined
+        const traceID = randomHex(16)
+
+        const maxAttempts = this.retry?.maxAttempts ?? 1
+        for (let attempt = 1; ; attempt++) {
+            const attemptInit = { ...init, headers: { ...init.headers } }
+            if (!hasTraceparent) {
+                attemptInit.headers["traceparent"] = `00-${traceID}-${randomHex(8)}-01`
+            }
+            for (const interceptor of this.requestInterceptors) {
+                await interceptor(url, attemptInit)
+            }
+
+            let response: Response
+            try {
+                response = await this.fetcher(url, attemptInit)
+            } catch (err) {
+                if (attempt >= maxAttempts || !isRetryable(attemptInit) || init.signal?.aborted) {
+                    throw err
+                }
+                await sleep(retryBackoff(this.retry!, attempt), init.signal)
+                continue
+            }
+
+            for (const interceptor of this.responseInterceptors) {
+                await interceptor(url, attemptInit, response)
+            }
+
+            // Encore responds with these status codes for ErrCode.Unavailable and ErrCode.ResourceExhausted
+            const retryableStatus = response.status === 503 || response.status === 429
+            if (attempt >= maxAttempts || !retryableStatus || !isRetryable(attemptInit)) {
+                return response
+            }
+            await response.body?.cancel()
+            await sleep(retryBackoff(this.retry!, attempt), init.signal)
+        }
+    }
 }
 
 /**
@@ -282,6 +282,75 @@ function encodeQuery(parts) {
     return pairs.join("&")
 }
 
+// withTimeout returns a signal which aborts when the given signal does,
+// or once the timeout in milliseconds has passed.
+function withTimeout(signal, timeout) {
+    if (timeout === undefined) {
+        return signal ?? null
+    }
+    const timeoutSignal = AbortSignal.timeout(timeout)
+    if (!signal) {
+        return timeoutSignal
+    }
+
+    const controller = new AbortController()
+    for (const s of [signal, timeoutSignal]) {
+        if (s.aborted) {
+            controller.abort(s.reason)
+            break
+        }
+        s.addEventListener("abort", () => controller.abort(s.reason), { once: true })
+    }
+    return controller.signal
+}
+
+// isRetryable reports whether the request can safely be retried.
+function isRetryable(init) {
+    const method = (init.method ?? "GET").toUpperCase()
+    if (!["GET", "HEAD", "OPTIONS", "PUT", "DELETE"].includes(method)) {
+        return false
+    }
+    // Streamed bodies cannot be sent again
+    return !(typeof ReadableStream !== "undefined" && init.body instanceof ReadableStream)
+}
+
+// retryBackoff returns how long to wait before the given retry attempt,
+// using exponential backoff with jitter.
+function retryBackoff(options, attempt) {
+    const min = options.minBackoff ?? 100
+    const max = options.maxBackoff ?? 5000
+    const backoff = Math.min(min * Math.pow(2, attempt - 1), max)
+    return backoff / 2 + Math.random() * (backoff / 2)
+}
+
+// sleep waits for the given number of milliseconds, unless the signal aborts first.
+function sleep(ms, signal) {
+    return new Promise((resolve, reject) => {
+        if (signal?.aborted) {
+            reject(signal.reason)
+            return
+        }
+        const onAbort = () => {
+            clearTimeout(timer)
+            reject(signal.reason)
+        }
+        const timer = setTimeout(() => {
+            signal?.removeEventListener("abort", onAbort)
+            resolve()
+        }, ms)
+        signal?.addEventListener("abort", onAbort, { once: true })
+    })
+}
+
+// randomHex returns a random hex string of the given number of bytes.
+function randomHex(bytes) {
+    let hex = ""
+    for (let i = 0; i < bytes; i++) {
+        hex += Math.floor(Math.random() * 256).toString(16).padStart(2, "0")
+    }
+    return hex
+}
+
 // makeRecord takes a record and strips any undefined values from it,
 // and returns the same record with a narrower type.
 function makeRecord(record) {
@@ -484,6 +553,10 @@ class BaseClient {
         }
 
         this.requestInit = options.requestInit ?? {}
+        this.retry = options.retry
+        this.timeout = options.timeout
+        this.requestInterceptors = options.requestInterceptors ?? []
+        this.responseInterceptors = options.responseInterceptors ?? []
 
         // Setup what fetch function we'll be using in the base client
         if (options.fetcher !== undefined) {
@@ -604,7 +677,7 @@ class BaseClient {
 
     // callAPI is used by each generated API method to actually make the request
     async callAPI(method, path, body, params) {
-        let { query, headers, ...rest } = params ?? {}
+        let { query, headers, timeout, ...rest } = params ?? {}
         const init = {
             ...this.requestInit,
             ...rest,
@@ -628,9 +701,12 @@ class BaseClient {
             }
         }
 
+        // Apply the timeout, if any
+        init.signal = withTimeout(init.signal, timeout ?? this.timeout)
+
         // Make the actual request
         const queryString = query ? '?' + encodeQuery(query) : ''
-        const response = await this.fetcher(this.baseURL+path+queryString, init)
+        const response = await this.send(this.baseURL+path+queryString, init)
 
         // handle any error responses
         if (!response.ok) {
@@ -661,6 +737,47 @@ class BaseClient {
 
         return response
     }
+
+    // send makes the request, calling the interceptors and retrying it according to the retry options
+    async send(url, init) {
+        // Propagate the trace context, unless the caller has set one explicitly
+        const hasTraceparent = init.headers?.["traceparent"] !== undefined
+        const traceID = randomHex(16)
+
+        const maxAttempts = this.retry?.maxAttempts ?? 1
+        for (let attempt = 1; ; attempt++) {
+            const attemptInit = { ...init, headers: { ...init.headers } }
+            if (!hasTraceparent) {
+                attemptInit.headers["traceparent"] = `00-${traceID}-${randomHex(8)}-01`
+            }
+            for (const interceptor of this.requestInterceptors) {
+                await interceptor(url, attemptInit)
+            }
+
+            let response
+            try {
+                response = await this.fetcher(url, attemptInit)
+            } catch (err) {
+                if (attempt >= maxAttempts || !isRetryable(attemptInit) || init.signal?.aborted) {
+                    throw err
+                }
+                await sleep(retryBackoff(this.retry, attempt), init.signal)
+                continue
+            }
+
+            for (const interceptor of this.responseInterceptors) {
+                await interceptor(url, attemptInit, response)
+            }
+
+            // Encore responds with these status codes for ErrCode.Unavailable and ErrCode.ResourceExhausted
+            const retryableStatus = response.status === 503 || response.status === 429
+            if (attempt >= maxAttempts || !retryableStatus || !isRetryable(attemptInit)) {
+                return response
+            }
+            await response.body?.cancel()
+            await sleep(retryBackoff(this.retry, attempt), init.signal)
+        }
+    }
 }
 
 function isAPIErrorResponse(err) {
@@ -0,0 +1,75 @@
+-- go.mod --
+module app
+
+-- encore.app --
+{"id": ""}
+
+-- orders/orders.go --
+package orders
+
+import (
+    "context"
+
+    "encore.dev/pubsub"
+)
+
+// OrderCreated is published when an order is placed.
+type OrderCreated struct {
+    // ID is the unique id of the order.
+    ID       int64
+    Customer string `pubsub-attr:"customer"`
+    Lines    []*OrderLine
+}
+
+type OrderLine struct {
+    SKU      string `json:"sku"`
+    Quantity int    `json:"quantity"`
+}
+
+// Orders receives an event for every new order.
+//
+// Events are ordered per customer.
+var Orders = pubsub.NewTopic[*OrderCreated]("orders", pubsub.TopicConfig{
+    DeliveryGuarantee: pubsub.AtLeastOnce,
+    OrderingAttribute: "customer",
+})
+
+//encore:api public
+func Place(ctx context.Context) error {
+    _, err := Orders.Publish(ctx, &OrderCreated{})
+    return err
+}
+
+-- shipping/shipping.go --
+package shipping
+
+import (
+    "context"
+
+    "encore.dev/pubsub"
+
+    "app/orders"
+)
+
+var _ = pubsub.NewSubscription(orders.Orders, "ship-order", pubsub.SubscriptionConfig[*orders.OrderCreated]{
+    Handler:        ship,
+    MaxConcurrency: 10,
+    RetryPolicy:    &pubsub.RetryPolicy{MaxRetries: 5},
+})
+
+func ship(ctx context.Context, event *orders.OrderCreated) error {
+    return nil
+}
+
+//encore:api private
+func Ping(ctx context.Context) error { return nil }
+
+// Shipped is received when the carrier has picked up an order.
+type Shipped struct {
+    OrderID int64 `json:"order_id"`
+}
+
+//encore:nats orders.shipped
+func OnShipped(ctx context.Context, event *Shipped) error {
+    return nil
+}
@@ -0,0 +1,61 @@
+package gcsemu
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestObjectEvents(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		events []ObjectEvent
+	)
+	gcsEmu := NewGcsEmu(Options{
+		OnObjectEvent: func(ev ObjectEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, ev)
+		},
+	})
+	mux := http.NewServeMux()
+	gcsEmu.Register(mux)
+	svr := httptest.NewServer(mux)
+	t.Cleanup(svr.Close)
+
+	ctx := context.Background()
+	gcsClient, err := NewTestClientWithHost(ctx, svr.URL)
+	assert.NilError(t, err)
+	t.Cleanup(func() {
+		_ = gcsClient.Close()
+	})
+	assert.NilError(t, gcsEmu.InitBucket("events-bucket"))
+	bh := gcsClient.Bucket("events-bucket")
+
+	w := bh.Object("a.txt").NewWriter(ctx)
+	assert.NilError(t, write(w, v1))
+	_, err = bh.Object("b.txt").CopierFrom(bh.Object("a.txt")).Run(ctx)
+	assert.NilError(t, err)
+	assert.NilError(t, bh.Object("a.txt").Delete(ctx))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, len(events), 3)
+	for i, want := range []struct {
+		typ  ObjectEventType
+		name string
+	}{
+		{ObjectFinalized, "a.txt"},
+		{ObjectFinalized, "b.txt"},
+		{ObjectDeleted, "a.txt"},
+	} {
+		assert.Equal(t, events[i].Type, want.typ)
+		assert.Equal(t, events[i].Bucket, "events-bucket")
+		assert.Equal(t, events[i].Object.Name, want.name)
+		assert.Equal(t, events[i].Object.Size, uint64(len(v1)))
+	}
+}
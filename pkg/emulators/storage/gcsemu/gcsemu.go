@@ -35,6 +35,26 @@ type Options struct {
 
 	// Optional log function. `err` will be `nil` for informational/debug messages.
 	Log func(err error, fmt string, args ...interface{})
+
+	// Optional function called after an object has been created or deleted.
+	OnObjectEvent func(ev ObjectEvent)
+}
+
+// ObjectEventType is the type of an ObjectEvent.
+type ObjectEventType string
+
+const (
+	// ObjectFinalized is the event type for an object being created or overwritten.
+	ObjectFinalized ObjectEventType = "OBJECT_FINALIZE"
+	// ObjectDeleted is the event type for an object being deleted.
+	ObjectDeleted ObjectEventType = "OBJECT_DELETE"
+)
+
+// ObjectEvent describes a change to an object.
+type ObjectEvent struct {
+	Type   ObjectEventType
+	Bucket string
+	Object *storage.Object
 }
 
 // GcsEmu is a Google Cloud Storage emulator for development.
@@ -46,8 +66,9 @@ type GcsEmu struct {
 	uploadIds gcache.Cache
 	idCounter int32
 
-	verbose bool
-	log     func(err error, fmt string, args ...interface{})
+	verbose       bool
+	log           func(err error, fmt string, args ...interface{})
+	onObjectEvent func(ev ObjectEvent)
 }
 
 // NewGcsEmu creates a new Google Cloud Storage emulator.
@@ -58,12 +79,16 @@ func NewGcsEmu(opts Options) *GcsEmu {
 	if opts.Log == nil {
 		opts.Log = func(_ error, _ string, _ ...interface{}) {}
 	}
+	if opts.OnObjectEvent == nil {
+		opts.OnObjectEvent = func(ObjectEvent) {}
+	}
 	return &GcsEmu{
-		store:     opts.Store,
-		locks:     gcsutil.NewTransientLockMap(),
-		uploadIds: gcache.New(1024).LRU().Build(),
-		verbose:   opts.Verbose,
-		log:       opts.Log,
+		store:         opts.Store,
+		locks:         gcsutil.NewTransientLockMap(),
+		uploadIds:     gcache.New(1024).LRU().Build(),
+		verbose:       opts.Verbose,
+		log:           opts.Log,
+		onObjectEvent: opts.OnObjectEvent,
 	}
 }
 
@@ -133,7 +158,7 @@ func (g *GcsEmu) Handler(w http.ResponseWriter, r *http.Request) {
 		} else {
 			alt := r.URL.Query().Get("alt")
 			if alt == "media" || (p.IsPublic && alt == "") {
-				g.handleGcsMediaRequest(baseUrl, w, r.Header.Get("Accept-Encoding"), bucket, object)
+				g.handleGcsMediaRequest(baseUrl, w, r.Header.Get("Accept-Encoding"), r.Header.Get("Range"), bucket, object)
 			} else if alt == "json" || (!p.IsPublic && alt == "") {
 				g.handleGcsMetadataRequest(baseUrl, w, bucket, object)
 			} else {
@@ -158,7 +183,7 @@ func (g *GcsEmu) Handler(w http.ResponseWriter, r *http.Request) {
 			// TODO: enforce other conditions outside of generation
 			g.handleGcsCompose(ctx, baseUrl, w, r, bucket, object, conds)
 		} else if strings.Contains(object, "/rewriteTo/") {
-			g.handleGcsCopy(ctx, baseUrl, w, bucket, object)
+			g.handleGcsCopy(ctx, baseUrl, w, bucket, object, conds)
 		} else if r.Form.Get("upload_id") != "" {
 			g.handleGcsNewObjectResume(ctx, baseUrl, w, r, r.Form.Get("upload_id"))
 		} else {
@@ -216,6 +241,7 @@ func (g *GcsEmu) handleGcsCompose(ctx context.Context, baseUrl HttpBaseUrl, w ht
 		g.gapiError(w, httpStatusCodeOf(err), fmt.Sprintf("failed to compose objects: %s", err))
 		return
 	}
+	g.onObjectEvent(ObjectEvent{Type: ObjectFinalized, Bucket: bucket, Object: obj})
 	g.jsonRespond(w, &obj)
 }
 
@@ -249,9 +275,11 @@ func (g *GcsEmu) handleGcsListBucket(ctx context.Context, baseUrl HttpBaseUrl, w
 }
 
 func (g *GcsEmu) handleGcsDelete(ctx context.Context, w http.ResponseWriter, bucket string, filename string, conds cloudstorage.Conditions) {
+	var obj *storage.Object
 	err := g.locks.Run(ctx, lockName(bucket, filename), func(ctx context.Context) error {
 		// Find the existing file / meta.
-		obj, err := g.store.GetMeta(dontNeedUrls, bucket, filename)
+		var err error
+		obj, err = g.store.GetMeta(dontNeedUrls, bucket, filename)
 		if err != nil {
 			return fmt.Errorf("failed to check existence of %s/%s: %w", bucket, filename, err)
 		}
@@ -274,10 +302,11 @@ func (g *GcsEmu) handleGcsDelete(ctx context.Context, w http.ResponseWriter, buc
 		return
 	}
 
+	g.onObjectEvent(ObjectEvent{Type: ObjectDeleted, Bucket: bucket, Object: obj})
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func (g *GcsEmu) handleGcsMediaRequest(baseUrl HttpBaseUrl, w http.ResponseWriter, acceptEncoding, bucket, filename string) {
+func (g *GcsEmu) handleGcsMediaRequest(baseUrl HttpBaseUrl, w http.ResponseWriter, acceptEncoding, rangeHeader, bucket, filename string) {
 	obj, contents, err := g.store.Get(baseUrl, bucket, filename)
 	if err != nil {
 		g.gapiError(w, http.StatusInternalServerError, fmt.Sprintf("failed to check existence of %s/%s: %s", bucket, filename, err))
@@ -294,6 +323,9 @@ func (g *GcsEmu) handleGcsMediaRequest(baseUrl HttpBaseUrl, w http.ResponseWrite
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 	w.Header().Set("Access-Control-Expose-Headers", "Content-Type, Content-Length, Content-Encoding, Date, X-Goog-Generation, X-Goog-Metageneration")
 	w.Header().Set("Content-Disposition", obj.ContentDisposition)
+	if obj.CacheControl != "" {
+		w.Header().Set("Cache-Control", obj.CacheControl)
+	}
 
 	if obj.ContentEncoding == "gzip" {
 		if strings.Contains(acceptEncoding, "gzip") {
@@ -315,8 +347,22 @@ func (g *GcsEmu) handleGcsMediaRequest(baseUrl HttpBaseUrl, w http.ResponseWrite
 		}
 	}
 
+	// Serve the requested byte range, if any.
+	status := http.StatusOK
+	size := len(contents)
+	if rng, ok := parseRangeHeader(rangeHeader, int64(size)); !ok {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+		g.gapiError(w, http.StatusRequestedRangeNotSatisfiable, fmt.Sprintf("invalid range %q for %s/%s", rangeHeader, bucket, filename))
+		return
+	} else if rng != nil {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", rng.lo, rng.hi, size))
+		contents = contents[rng.lo : rng.hi+1]
+		status = http.StatusPartialContent
+	}
+
 	// Just write the contents
 	w.Header().Set("Content-Length", strconv.Itoa(len(contents)))
+	w.WriteHeader(status)
 	if _, err := w.Write(contents); err != nil {
 		g.gapiError(w, http.StatusInternalServerError, fmt.Sprintf("failed to copy from %s/%s: %s", bucket, filename, err))
 	}
@@ -400,8 +446,8 @@ func (g *GcsEmu) handleGcsUpdateMetadataRequest(ctx context.Context, baseUrl Htt
 	g.jsonRespond(w, obj)
 }
 
-func (g *GcsEmu) handleGcsCopy(ctx context.Context, baseUrl HttpBaseUrl, w http.ResponseWriter, b1 string, objectPaths string) {
-	// TODO(dk): this operation supports conditionals and metadata rewriting, but the emulator implementation currently does not.
+func (g *GcsEmu) handleGcsCopy(ctx context.Context, baseUrl HttpBaseUrl, w http.ResponseWriter, b1 string, objectPaths string, conds cloudstorage.Conditions) {
+	// TODO(dk): this operation supports metadata rewriting, but the emulator implementation currently does not.
 	// See https://cloud.google.com/storage/docs/json_api/v1/objects/rewrite
 	parts := strings.Split(objectPaths, "/rewriteTo/b/")
 	// Copy is implemented using the Rewrite API, with object strings of format /o/sourceObject/rewriteTo/b/destinationBucket/o/destinationObject
@@ -421,6 +467,15 @@ func (g *GcsEmu) handleGcsCopy(ctx context.Context, baseUrl HttpBaseUrl, w http.
 	// Must lock the destination object.
 	var obj *storage.Object
 	err := g.locks.Run(ctx, lockName(b2, f2), func(ctx context.Context) error {
+		// The conditions apply to the destination object.
+		existing, err := g.store.GetMeta(baseUrl, b2, f2)
+		if err != nil {
+			return fmt.Errorf("failed to check existence of %s/%s: %w", b2, f2, err)
+		}
+		if err := validateConds(existing, conds); err != nil {
+			return err
+		}
+
 		if ok, err := g.store.Copy(b1, f1, b2, f2); err != nil {
 			return err
 		} else if !ok {
@@ -438,6 +493,7 @@ func (g *GcsEmu) handleGcsCopy(ctx context.Context, baseUrl HttpBaseUrl, w http.
 		g.gapiError(w, http.StatusNotFound, fmt.Sprintf("%s not found", b1+"/"+f1))
 		return
 	}
+	g.onObjectEvent(ObjectEvent{Type: ObjectFinalized, Bucket: b2, Object: obj})
 
 	rr := storage.RewriteResponse{
 		Kind:                "storage#rewriteResponse",
@@ -681,6 +737,7 @@ func (g *GcsEmu) finishUpload(ctx context.Context, baseUrl HttpBaseUrl, obj *sto
 	}
 
 	meta.Id = fmt.Sprintf("%s/%s/%d", bucket, filename, meta.Generation)
+	g.onObjectEvent(ObjectEvent{Type: ObjectFinalized, Bucket: bucket, Object: meta})
 	return meta, nil
 }
 
@@ -36,6 +36,7 @@ var (
 		{"Compose", testCompose},
 		{"CopyMetadata", testCopyMetadata},
 		{"CopyConditionals", testCopyConditionals},
+		{"RangeReads", testRangeReads},
 	}
 )
 
@@ -577,8 +578,51 @@ func testCopyMetadata(t *testing.T, bh BucketHandle) {
 }
 
 func testCopyConditionals(t *testing.T, bh BucketHandle) {
-	// TODO(dk): Conditional support for copy is not currently implemented.
-	t.Skip()
+	ctx := context.Background()
+
+	src := bh.Object("copy-cond-src")
+	dest := bh.Object("copy-cond-dest")
+	_ = src.Delete(ctx)
+	_ = dest.Delete(ctx)
+
+	assert.NilError(t, write(src.NewWriter(ctx), v1), "failed")
+
+	// Copying to a destination that doesn't exist should succeed.
+	_, err := dest.If(storage.Conditions{DoesNotExist: true}).CopierFrom(src).Run(ctx)
+	assert.NilError(t, err, "failed to copy")
+
+	// Copying again should fail, since the destination exists now.
+	_, err = dest.If(storage.Conditions{DoesNotExist: true}).CopierFrom(src).Run(ctx)
+	assert.Equal(t, http.StatusPreconditionFailed, httpStatusCodeOf(err), "wrong error %T: %s", err, err)
+
+	assert.NilError(t, src.Delete(ctx), "failed")
+	assert.NilError(t, dest.Delete(ctx), "failed")
+}
+
+func testRangeReads(t *testing.T, bh BucketHandle) {
+	ctx := context.Background()
+
+	obj := bh.Object("range-reads")
+	_ = obj.Delete(ctx)
+	assert.NilError(t, write(obj.NewWriter(ctx), v1), "failed")
+
+	for _, tc := range []struct {
+		offset, length int64
+		want           string
+	}{
+		{offset: 0, length: 4, want: v1[:4]},
+		{offset: 5, length: 4, want: v1[5:9]},
+		{offset: 10, length: -1, want: v1[10:]},
+	} {
+		r, err := obj.NewRangeReader(ctx, tc.offset, tc.length)
+		assert.NilError(t, err, "failed")
+		data, err := io.ReadAll(r)
+		assert.NilError(t, err, "failed")
+		assert.NilError(t, r.Close(), "failed")
+		assert.Equal(t, string(data), tc.want, "wrong data")
+	}
+
+	assert.NilError(t, obj.Delete(ctx), "failed")
 }
 
 func write(w *storage.Writer, content string) error {
@@ -50,3 +50,49 @@ func parseByteRange(in string) *byteRange {
 
 	return &ret
 }
+
+// parseRangeHeader parses an HTTP Range header for an object of the given size.
+// Only a single range is supported, such as "bytes=0-99", "bytes=100-" or "bytes=-100".
+//
+// It returns nil if the header is missing or can't be parsed, in which case
+// the whole object should be served, and false if the range can't be satisfied.
+func parseRangeHeader(in string, size int64) (*byteRange, bool) {
+	spec, ok := strings.CutPrefix(in, "bytes=")
+	if !ok || strings.Contains(spec, ",") {
+		return nil, true
+	}
+	loStr, hiStr, ok := strings.Cut(spec, "-")
+	if !ok {
+		return nil, true
+	}
+
+	ret := byteRange{lo: 0, hi: size - 1, sz: size}
+	if loStr == "" {
+		// A suffix range, for the last n bytes.
+		n, err := strconv.ParseInt(hiStr, 10, 64)
+		if err != nil {
+			return nil, true
+		} else if n == 0 {
+			return nil, false
+		}
+		ret.lo = max(size-n, 0)
+		return &ret, true
+	}
+
+	lo, err := strconv.ParseInt(loStr, 10, 64)
+	if err != nil {
+		return nil, true
+	} else if lo >= size {
+		return nil, false
+	}
+	ret.lo = lo
+
+	if hiStr != "" {
+		hi, err := strconv.ParseInt(hiStr, 10, 64)
+		if err != nil || hi < lo {
+			return nil, true
+		}
+		ret.hi = min(hi, size-1)
+	}
+	return &ret, true
+}
@@ -3,6 +3,7 @@ package gcsemu
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"gotest.tools/v3/assert"
 )
 
@@ -21,3 +22,26 @@ func TestParseByteRange(t *testing.T) {
 		assert.Equal(t, tc.expect, *parseByteRange(tc.in))
 	}
 }
+
+func TestParseRangeHeader(t *testing.T) {
+	tcs := []struct {
+		in     string
+		expect *byteRange
+		ok     bool
+	}{
+		{in: "", expect: nil, ok: true},
+		{in: "bytes=0-9", expect: &byteRange{lo: 0, hi: 9, sz: 100}, ok: true},
+		{in: "bytes=90-", expect: &byteRange{lo: 90, hi: 99, sz: 100}, ok: true},
+		{in: "bytes=90-200", expect: &byteRange{lo: 90, hi: 99, sz: 100}, ok: true},
+		{in: "bytes=-10", expect: &byteRange{lo: 90, hi: 99, sz: 100}, ok: true},
+		{in: "bytes=100-", expect: nil, ok: false},
+		{in: "bytes=0-1,5-6", expect: nil, ok: true},
+	}
+
+	for _, tc := range tcs {
+		t.Logf("test case: %s", tc.in)
+		got, ok := parseRangeHeader(tc.in, 100)
+		assert.Equal(t, tc.ok, ok)
+		assert.DeepEqual(t, tc.expect, got, cmp.AllowUnexported(byteRange{}))
+	}
+}
@@ -0,0 +1,439 @@
+// Package externalgen generates typed Go clients for external HTTP APIs
+// from their OpenAPI 3 specifications.
+//
+// The generated clients bind their credentials to Encore secrets,
+// trace each request with the OpenAPI operation id, and can be mocked
+// in tests using et.MockExternal.
+package externalgen
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/cockroachdb/errors"
+	. "github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"encr.dev/pkg/idents"
+)
+
+const externalPkg = "encore.dev/beta/external"
+
+// GenerateParams are the parameters for generating an external API client.
+type GenerateParams struct {
+	// Name is the name of the external API.
+	// It determines the package name and the names of the secrets
+	// holding the API credentials.
+	Name string
+
+	// Spec is the OpenAPI 3 specification of the API, in JSON or YAML format.
+	Spec []byte
+}
+
+// Result is the result of generating an external API client.
+type Result struct {
+	// Code is the source code of the generated package.
+	Code []byte
+
+	// Skipped describes the operations of the spec that could not be
+	// generated, and why.
+	Skipped []string
+}
+
+// PackageName returns the name of the package generated for the external API with the given name.
+func PackageName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+		}
+	}
+	pkg := b.String()
+	if pkg == "" || unicode.IsDigit(rune(pkg[0])) {
+		pkg = "api" + pkg
+	}
+	return pkg
+}
+
+// Generate generates a Go client package for the external API.
+func Generate(p GenerateParams) (*Result, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(p.Spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse OpenAPI spec")
+	}
+	if err := doc.Validate(loader.Context); err != nil {
+		return nil, errors.Wrap(err, "invalid OpenAPI spec")
+	}
+
+	g := &generator{
+		doc:         doc,
+		name:        p.Name,
+		pkgName:     PackageName(p.Name),
+		typeNames:   make(map[string]string),
+		usedNames:   make(map[string]bool),
+		inlineNames: make(map[*openapi3.Schema]string),
+		methodNames: make(map[string]bool),
+	}
+	return g.generate()
+}
+
+type generator struct {
+	doc     *openapi3.T
+	name    string
+	pkgName string
+	file    *File
+
+	typeNames   map[string]string           // component schema name -> Go type name
+	usedNames   map[string]bool             // package-level Go identifiers in use
+	inlineNames map[*openapi3.Schema]string // inline schema -> Go type name
+	methodNames map[string]bool             // Client method names in use
+	pending     []pendingType               // inline schemas that need a named type
+	skipped     []string
+}
+
+// pendingType is an inline object schema that is generated as a named type.
+type pendingType struct {
+	name   string
+	schema *openapi3.Schema
+}
+
+// reservedNames are the package-level identifiers of the generated code.
+var reservedNames = []string{
+	"Name", "DefaultBaseURL", "Client", "Option", "WithBaseURL", "WithHTTPClient",
+	"New", "Interface", "Error", "formatParam", "secrets",
+}
+
+func (g *generator) generate() (*Result, error) {
+	for _, name := range reservedNames {
+		g.usedNames[name] = true
+	}
+
+	// Allocate the names of the component schemas up front, so they can be referenced in any order.
+	schemaNames := sortedKeys(g.doc.Components.Schemas)
+	for _, name := range schemaNames {
+		g.typeNames[name] = g.allocName(name, "")
+	}
+
+	ops, err := g.operations()
+	if err != nil {
+		return nil, err
+	}
+
+	g.file = NewFile(g.pkgName)
+	g.file.ImportName(externalPkg, "external")
+	g.file.HeaderComment("Code generated by encore gen external. DO NOT EDIT.")
+	g.file.PackageComment(g.packageDoc())
+
+	g.writeClient()
+	if err := g.writeOperations(ops); err != nil {
+		return nil, err
+	}
+
+	for _, name := range schemaNames {
+		g.writeNamedSchema(g.typeNames[name], g.doc.Components.Schemas[name])
+	}
+	for len(g.pending) > 0 {
+		t := g.pending[0]
+		g.pending = g.pending[1:]
+		g.writeNamedSchema(t.name, &openapi3.SchemaRef{Value: t.schema})
+	}
+
+	var buf bytes.Buffer
+	if err := g.file.Render(&buf); err != nil {
+		return nil, errors.Wrap(err, "render client")
+	}
+	return &Result{Code: buf.Bytes(), Skipped: g.skipped}, nil
+}
+
+func (g *generator) packageDoc() string {
+	title := g.name
+	if info := g.doc.Info; info != nil && info.Title != "" {
+		title = info.Title
+	}
+	doc := fmt.Sprintf("Package %s is a client for the %s API,\ngenerated from its OpenAPI specification.", g.pkgName, title)
+	if info := g.doc.Info; info != nil && info.Description != "" {
+		doc += "\n\n" + strings.TrimSpace(info.Description)
+	}
+	return doc
+}
+
+// writeClient writes the Client type, its options and the helpers used by the operations.
+func (g *generator) writeClient() {
+	f := g.file
+
+	f.Comment("Name is the name of the external API. It identifies the API in traces,")
+	f.Comment("and is used for mocking it in tests with et.MockExternal.")
+	f.Const().Id("Name").Op("=").Lit(g.name)
+	f.Line()
+
+	baseURL := ""
+	if len(g.doc.Servers) > 0 {
+		baseURL = strings.TrimSuffix(g.doc.Servers[0].URL, "/")
+	}
+	f.Comment("DefaultBaseURL is the base URL of the API given by its OpenAPI specification.")
+	f.Const().Id("DefaultBaseURL").Op("=").Lit(baseURL)
+	f.Line()
+
+	auth := g.authScheme()
+	if auth != nil {
+		f.Var().Id("secrets").Struct(
+			Comment(auth.secretDoc),
+			Id(auth.secret).String(),
+		)
+		f.Line()
+	}
+
+	f.Commentf("Client is a client for the %s API.", g.name)
+	f.Comment("It implements Interface.")
+	f.Type().Id("Client").Struct(
+		Id("baseURL").String(),
+		Id("httpClient").Op("*").Qual("net/http", "Client"),
+	)
+	f.Line()
+
+	f.Comment("Option configures a Client.")
+	f.Type().Id("Option").Func().Params(Op("*").Id("Client"))
+	f.Line()
+
+	f.Comment("WithBaseURL overrides the base URL of the API, for example with a value from")
+	f.Comment("the service's config. Defaults to DefaultBaseURL.")
+	f.Func().Id("WithBaseURL").Params(Id("baseURL").String()).Id("Option").Block(
+		Return(Func().Params(Id("c").Op("*").Id("Client")).Block(
+			Id("c").Dot("baseURL").Op("=").Id("baseURL"),
+		)),
+	)
+	f.Line()
+
+	f.Comment("WithHTTPClient sets the HTTP client used to make requests. Defaults to http.DefaultClient.")
+	f.Func().Id("WithHTTPClient").Params(Id("httpClient").Op("*").Qual("net/http", "Client")).Id("Option").Block(
+		Return(Func().Params(Id("c").Op("*").Id("Client")).Block(
+			Id("c").Dot("httpClient").Op("=").Id("httpClient"),
+		)),
+	)
+	f.Line()
+
+	f.Commentf("New returns a new client for the %s API.", g.name)
+	f.Func().Id("New").Params(Id("opts").Op("...").Id("Option")).Op("*").Id("Client").Block(
+		Id("c").Op(":=").Op("&").Id("Client").Values(Dict{
+			Id("baseURL"):    Id("DefaultBaseURL"),
+			Id("httpClient"): Qual("net/http", "DefaultClient"),
+		}),
+		For(List(Id("_"), Id("opt")).Op(":=").Range().Id("opts")).Block(
+			Id("opt").Call(Id("c")),
+		),
+		Return(Id("c")),
+	)
+	f.Line()
+
+	f.Comment("Error is the error returned when the API responds with an unsuccessful status code.")
+	f.Type().Id("Error").Struct(
+		Id("Operation").String().Comment("The OpenAPI operation id"),
+		Id("StatusCode").Int().Comment("The HTTP status code of the response"),
+		Id("Body").Index().Byte().Comment("The response body"),
+	)
+	f.Line()
+	f.Func().Params(Id("e").Op("*").Id("Error")).Id("Error").Params().String().Block(
+		Return(Qual("fmt", "Sprintf").Call(
+			Lit("%s: %s: %d %s: %s"),
+			Id("Name"), Id("e").Dot("Operation"), Id("e").Dot("StatusCode"),
+			Qual("net/http", "StatusText").Call(Id("e").Dot("StatusCode")),
+			Qual("bytes", "TrimSpace").Call(Id("e").Dot("Body")),
+		)),
+	)
+	f.Line()
+
+	f.Comment("do sends a request to the API as part of the given operation,")
+	f.Comment("and decodes the response into resp unless it's nil.")
+	f.Func().Params(Id("c").Op("*").Id("Client")).Id("do").Params(
+		Id("ctx").Qual("context", "Context"),
+		List(Id("operationID"), Id("method"), Id("path")).String(),
+		Id("query").Qual("net/url", "Values"),
+		Id("header").Qual("net/http", "Header"),
+		List(Id("body"), Id("resp")).Any(),
+	).Error().BlockFunc(func(grp *Group) {
+		grp.Comment("Trace the request as a call to the operation")
+		grp.Id("ctx").Op("=").Qual(externalPkg, "WithOperation").Call(Id("ctx"), Id("Name"), Id("operationID"))
+		grp.Line()
+
+		grp.Var().Id("bodyReader").Qual("io", "Reader")
+		grp.If(Id("body").Op("!=").Nil()).Block(
+			List(Id("data"), Err()).Op(":=").Qual("encoding/json", "Marshal").Call(Id("body")),
+			If(Err().Op("!=").Nil()).Block(
+				Return(Qual("fmt", "Errorf").Call(Lit("%s: marshal request: %w"), Id("operationID"), Err())),
+			),
+			Id("bodyReader").Op("=").Qual("bytes", "NewReader").Call(Id("data")),
+			Id("header").Dot("Set").Call(Lit("Content-Type"), Lit("application/json")),
+		)
+		grp.Line()
+
+		grp.Id("u").Op(":=").Id("c").Dot("baseURL").Op("+").Id("path")
+		grp.If(Len(Id("query")).Op(">").Lit(0)).Block(
+			Id("u").Op("+=").Lit("?").Op("+").Id("query").Dot("Encode").Call(),
+		)
+		grp.List(Id("req"), Err()).Op(":=").Qual("net/http", "NewRequestWithContext").Call(
+			Id("ctx"), Id("method"), Id("u"), Id("bodyReader"),
+		)
+		grp.If(Err().Op("!=").Nil()).Block(
+			Return(Qual("fmt", "Errorf").Call(Lit("%s: create request: %w"), Id("operationID"), Err())),
+		)
+		grp.Id("req").Dot("Header").Op("=").Id("header")
+		grp.Id("req").Dot("Header").Dot("Set").Call(Lit("Accept"), Lit("application/json"))
+		if auth != nil {
+			grp.Line()
+			grp.Comment("Authenticate the request using the credentials from the secret")
+			grp.If(Id("key").Op(":=").Id("secrets").Dot(auth.secret), Id("key").Op("!=").Lit("")).Block(auth.apply...)
+		}
+		grp.Line()
+
+		grp.List(Id("httpResp"), Err()).Op(":=").Id("c").Dot("httpClient").Dot("Do").Call(Id("req"))
+		grp.If(Err().Op("!=").Nil()).Block(
+			Return(Qual("fmt", "Errorf").Call(Lit("%s: %w"), Id("operationID"), Err())),
+		)
+		grp.Defer().Func().Params().Block(
+			Id("_").Op("=").Id("httpResp").Dot("Body").Dot("Close").Call(),
+		).Call()
+		grp.Line()
+
+		grp.If(Id("httpResp").Dot("StatusCode").Op("<").Lit(200).Op("||").Id("httpResp").Dot("StatusCode").Op(">=").Lit(300)).Block(
+			List(Id("data"), Id("_")).Op(":=").Qual("io", "ReadAll").Call(
+				Qual("io", "LimitReader").Call(Id("httpResp").Dot("Body"), Lit(1<<20)),
+			),
+			Return(Op("&").Id("Error").Values(Dict{
+				Id("Operation"):  Id("operationID"),
+				Id("StatusCode"): Id("httpResp").Dot("StatusCode"),
+				Id("Body"):       Id("data"),
+			})),
+		)
+		grp.If(Id("resp").Op("!=").Nil()).Block(
+			If(
+				Err().Op(":=").Qual("encoding/json", "NewDecoder").Call(Id("httpResp").Dot("Body")).Dot("Decode").Call(Id("resp")),
+				Err().Op("!=").Nil(),
+			).Block(
+				Return(Qual("fmt", "Errorf").Call(Lit("%s: decode response: %w"), Id("operationID"), Err())),
+			),
+		)
+		grp.Return(Nil())
+	})
+	f.Line()
+
+	f.Comment("formatParam formats a parameter value for use in a path, query string or header.")
+	f.Func().Id("formatParam").Params(Id("v").Any()).String().Block(
+		If(List(Id("t"), Id("ok")).Op(":=").Id("v").Assert(Qual("time", "Time")), Id("ok")).Block(
+			Return(Id("t").Dot("Format").Call(Qual("time", "RFC3339"))),
+		),
+		Return(Qual("fmt", "Sprint").Call(Id("v"))),
+	)
+	f.Line()
+}
+
+// authentication describes how requests are authenticated.
+type authentication struct {
+	secret    string // the name of the secret holding the credentials
+	secretDoc string
+	apply     []Code // statements applying the credentials in key to req
+}
+
+// authScheme returns how requests are authenticated, or nil if the API
+// does not use a supported security scheme.
+//
+// Only a single scheme is supported, preferring the first one used
+// by the top-level security requirements of the spec.
+func (g *generator) authScheme() *authentication {
+	schemes := g.doc.Components.SecuritySchemes
+	var names []string
+	for _, req := range g.doc.Security {
+		names = append(names, sortedKeys(req)...)
+	}
+	names = append(names, sortedKeys(schemes)...)
+
+	prefix := idents.Convert(g.name, idents.PascalCase)
+	for _, name := range names {
+		ref := schemes[name]
+		if ref == nil || ref.Value == nil {
+			continue
+		}
+		s := ref.Value
+		switch {
+		case s.Type == "apiKey":
+			auth := &authentication{
+				secret:    prefix + "APIKey",
+				secretDoc: fmt.Sprintf("%sAPIKey is the API key for the %s API.", prefix, g.name),
+			}
+			switch s.In {
+			case "header":
+				auth.apply = []Code{Id("req").Dot("Header").Dot("Set").Call(Lit(s.Name), Id("key"))}
+			case "query":
+				auth.apply = []Code{
+					Id("q").Op(":=").Id("req").Dot("URL").Dot("Query").Call(),
+					Id("q").Dot("Set").Call(Lit(s.Name), Id("key")),
+					Id("req").Dot("URL").Dot("RawQuery").Op("=").Id("q").Dot("Encode").Call(),
+				}
+			case "cookie":
+				auth.apply = []Code{Id("req").Dot("AddCookie").Call(Op("&").Qual("net/http", "Cookie").Values(Dict{
+					Id("Name"):  Lit(s.Name),
+					Id("Value"): Id("key"),
+				}))}
+			default:
+				continue
+			}
+			return auth
+
+		case s.Type == "http" && strings.EqualFold(s.Scheme, "basic"):
+			return &authentication{
+				secret: prefix + "Credentials",
+				secretDoc: fmt.Sprintf("%sCredentials are the credentials for the %s API,\n"+
+					"formatted as \"username:password\".", prefix, g.name),
+				apply: []Code{
+					List(Id("username"), Id("password"), Id("_")).Op(":=").Qual("strings", "Cut").Call(Id("key"), Lit(":")),
+					Id("req").Dot("SetBasicAuth").Call(Id("username"), Id("password")),
+				},
+			}
+
+		case s.Type == "http" && strings.EqualFold(s.Scheme, "bearer"), s.Type == "oauth2", s.Type == "openIdConnect":
+			return &authentication{
+				secret:    prefix + "Token",
+				secretDoc: fmt.Sprintf("%sToken is the bearer token for the %s API.", prefix, g.name),
+				apply: []Code{
+					Id("req").Dot("Header").Dot("Set").Call(Lit("Authorization"), Lit("Bearer ").Op("+").Id("key")),
+				},
+			}
+		}
+	}
+	return nil
+}
+
+// allocName allocates a unique package-level Go identifier based on the given name.
+func (g *generator) allocName(name, suffix string) string {
+	base := goIdent(name) + suffix
+	ident := base
+	for i := 2; g.usedNames[ident]; i++ {
+		ident = fmt.Sprintf("%s%d", base, i)
+	}
+	g.usedNames[ident] = true
+	return ident
+}
+
+// goIdent converts s to an exported Go identifier.
+func goIdent(s string) string {
+	ident := idents.Convert(s, idents.PascalCase)
+	if ident == "" {
+		return "X"
+	}
+	if unicode.IsDigit(rune(ident[0])) {
+		ident = "X" + ident
+	}
+	return ident
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,34 @@
+package externalgen
+
+import (
+	"os"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"encr.dev/pkg/golden"
+)
+
+func TestMain(m *testing.M) {
+	golden.TestMain(m)
+}
+
+func TestGenerate(t *testing.T) {
+	c := qt.New(t)
+	spec, err := os.ReadFile("testdata/petstore.yaml")
+	c.Assert(err, qt.IsNil)
+
+	res, err := Generate(GenerateParams{Name: "petstore", Spec: spec})
+	c.Assert(err, qt.IsNil)
+	c.Assert(res.Skipped, qt.DeepEquals, []string{
+		"uploadPhoto (PUT /pets/{petId}/photo): unsupported request body content type",
+	})
+	golden.TestAgainst(c, "petstore.go.golden", string(res.Code))
+}
+
+func TestPackageName(t *testing.T) {
+	c := qt.New(t)
+	c.Assert(PackageName("Petstore"), qt.Equals, "petstore")
+	c.Assert(PackageName("open-ai"), qt.Equals, "openai")
+	c.Assert(PackageName("1password"), qt.Equals, "api1password")
+}
@@ -0,0 +1,384 @@
+package externalgen
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	. "github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// operation is an API operation to generate a method for.
+type operation struct {
+	id     string // the OpenAPI operation id
+	method string
+	path   string
+	op     *openapi3.Operation
+
+	name       string // the Go method name
+	paramsType string // the Go type name of the parameters, or "" if there are none
+	params     []*param
+	body       *openapi3.SchemaRef // the JSON request body, or nil
+	bodyReq    bool                // whether the request body is required
+	resp       *openapi3.SchemaRef // the JSON response body, or nil
+}
+
+// param is a path, query or header parameter of an operation.
+type param struct {
+	name     string // the Go field name
+	in       string
+	wireName string
+	required bool
+	schema   *openapi3.SchemaRef
+	doc      string
+}
+
+// methodOrder is the order in which the operations of a path are generated.
+var methodOrder = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "TRACE"}
+
+// operations returns the operations of the spec, in a deterministic order.
+func (g *generator) operations() ([]*operation, error) {
+	var ops []*operation
+	usedIDs := make(map[string]bool)
+	for _, path := range sortedKeys(g.doc.Paths) {
+		item := g.doc.Paths[path]
+		for _, method := range methodOrder {
+			op := item.GetOperation(method)
+			if op == nil {
+				continue
+			}
+
+			id := op.OperationID
+			if id == "" {
+				id = strings.ToLower(method) + goIdent(path)
+			}
+			if usedIDs[id] {
+				return nil, fmt.Errorf("duplicate operation id %q", id)
+			}
+			usedIDs[id] = true
+
+			o := &operation{id: id, method: method, path: path, op: op}
+			if reason := g.resolveOperation(o, item); reason != "" {
+				g.skipped = append(g.skipped, fmt.Sprintf("%s (%s %s): %s", id, method, path, reason))
+				continue
+			}
+			ops = append(ops, o)
+		}
+	}
+	return ops, nil
+}
+
+// resolveOperation resolves the parameters and request and response bodies of the operation.
+// It returns a non-empty reason if the operation is not supported.
+func (g *generator) resolveOperation(o *operation, item *openapi3.PathItem) (skipReason string) {
+	o.name = goIdent(o.id)
+	for i := 2; g.methodNames[o.name]; i++ {
+		o.name = fmt.Sprintf("%s%d", goIdent(o.id), i)
+	}
+	g.methodNames[o.name] = true
+
+	// Operation parameters override the path item parameters with the same name and location.
+	byKey := make(map[string]*openapi3.Parameter)
+	var keys []string
+	for _, params := range []openapi3.Parameters{item.Parameters, o.op.Parameters} {
+		for _, ref := range params {
+			if ref == nil || ref.Value == nil {
+				continue
+			}
+			key := ref.Value.In + ":" + ref.Value.Name
+			if _, ok := byKey[key]; !ok {
+				keys = append(keys, key)
+			}
+			byKey[key] = ref.Value
+		}
+	}
+
+	fieldNames := map[string]bool{"Body": true}
+	for _, key := range keys {
+		p := byKey[key]
+		switch p.In {
+		case openapi3.ParameterInPath, openapi3.ParameterInQuery, openapi3.ParameterInHeader:
+		default:
+			return fmt.Sprintf("unsupported %s parameter %q", p.In, p.Name)
+		}
+		if p.Schema == nil {
+			return fmt.Sprintf("parameter %q has no schema", p.Name)
+		}
+
+		name := goIdent(p.Name)
+		for i := 2; fieldNames[name]; i++ {
+			name = fmt.Sprintf("%s%d", goIdent(p.Name), i)
+		}
+		fieldNames[name] = true
+		o.params = append(o.params, &param{
+			name:     name,
+			in:       p.In,
+			wireName: p.Name,
+			required: p.Required || p.In == openapi3.ParameterInPath,
+			schema:   p.Schema,
+			doc:      p.Description,
+		})
+	}
+
+	if ref := o.op.RequestBody; ref != nil && ref.Value != nil {
+		media := jsonContent(ref.Value.Content)
+		if media == nil {
+			return "unsupported request body content type"
+		}
+		o.body = media.Schema
+		o.bodyReq = ref.Value.Required
+	}
+
+	codes := sortedKeys(o.op.Responses)
+	for _, code := range codes {
+		if !strings.HasPrefix(code, "2") {
+			continue
+		}
+		if ref := o.op.Responses[code]; ref != nil && ref.Value != nil {
+			if media := jsonContent(ref.Value.Content); media != nil {
+				o.resp = media.Schema
+			}
+		}
+		break
+	}
+
+	if len(o.params) > 0 || o.body != nil {
+		o.paramsType = g.allocName(o.id, "Params")
+	}
+	return ""
+}
+
+// jsonContent returns the JSON media type of the content, if any.
+func jsonContent(content openapi3.Content) *openapi3.MediaType {
+	keys := sortedKeys(content)
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i] == "application/json" && keys[j] != "application/json"
+	})
+	for _, key := range keys {
+		mediaType, _, _ := strings.Cut(key, ";")
+		if mediaType == "application/json" || strings.HasSuffix(mediaType, "+json") {
+			return content[key]
+		}
+	}
+	return nil
+}
+
+// writeOperations writes the Interface type, and the method and parameter type of each operation.
+func (g *generator) writeOperations(ops []*operation) error {
+	f := g.file
+
+	f.Comment("Interface is the interface implemented by Client. A mock implementation can be")
+	f.Comment("set for the API in tests using et.MockExternal:")
+	f.Comment("")
+	f.Commentf("\tet.MockExternal[%s.Interface](%s.Name, &myMock{})", g.pkgName, g.pkgName)
+	f.Type().Id("Interface").InterfaceFunc(func(grp *Group) {
+		for _, o := range ops {
+			if summary := g.opSummary(o); summary != "" {
+				grp.Comment(summary)
+			}
+			grp.Id(o.name).Add(g.signature(o))
+		}
+	})
+	f.Line()
+	f.Var().Id("_").Id("Interface").Op("=").Parens(Op("*").Id("Client")).Parens(Nil())
+	f.Line()
+
+	for _, o := range ops {
+		if o.paramsType != "" {
+			g.writeParamsType(o)
+		}
+		g.writeMethod(o)
+	}
+	return nil
+}
+
+func (g *generator) opSummary(o *operation) string {
+	summary := strings.TrimSpace(o.op.Summary)
+	if summary == "" {
+		summary, _, _ = strings.Cut(strings.TrimSpace(o.op.Description), "\n")
+	}
+	return summary
+}
+
+// signature returns the parameters and results of the operation's method.
+func (g *generator) signature(o *operation) *Statement {
+	params := []Code{Id("ctx").Qual("context", "Context")}
+	if o.paramsType != "" {
+		params = append(params, Id("params").Id(o.paramsType))
+	}
+	if o.resp == nil {
+		return Params(params...).Error()
+	}
+	return Params(params...).Params(g.respType(o), Error())
+}
+
+// respType returns the type the operation's method returns its response as.
+func (g *generator) respType(o *operation) Code {
+	typ := g.goType(o.resp, o.name+"Response")
+	if g.nilable(o.resp) {
+		return typ
+	}
+	return Op("*").Add(typ)
+}
+
+func (g *generator) writeParamsType(o *operation) {
+	f := g.file
+	f.Commentf("%s are the parameters for %s.", o.paramsType, o.name)
+	f.Type().Id(o.paramsType).StructFunc(func(grp *Group) {
+		for _, p := range o.params {
+			doc := strings.TrimSpace(p.doc)
+			if doc == "" {
+				doc = fmt.Sprintf("%s is the %q %s parameter.", p.name, p.wireName, p.in)
+			} else {
+				doc = fmt.Sprintf("%s is the %q %s parameter: %s", p.name, p.wireName, p.in, doc)
+			}
+			comment(grp, doc)
+
+			typ := g.goType(p.schema, o.name+p.name)
+			if !p.required && !g.nilable(p.schema) {
+				typ = Op("*").Add(typ)
+			}
+			grp.Id(p.name).Add(typ)
+		}
+		if o.body != nil {
+			if len(o.params) > 0 {
+				grp.Line()
+			}
+			grp.Comment("Body is the request body.")
+			typ := g.goType(o.body, o.name+"Request")
+			if !o.bodyReq && !g.nilable(o.body) {
+				typ = Op("*").Add(typ)
+			}
+			grp.Id("Body").Add(typ)
+		}
+	})
+	f.Line()
+}
+
+var pathParamRegexp = regexp.MustCompile(`\{([^}]+)\}`)
+
+func (g *generator) writeMethod(o *operation) {
+	f := g.file
+
+	f.Commentf("%s calls %s %s.", o.name, o.method, o.path)
+	if summary := g.opSummary(o); summary != "" {
+		f.Comment("")
+		f.Comment(summary)
+	}
+	if o.op.Deprecated {
+		f.Comment("")
+		f.Comment("Deprecated: this operation is deprecated by the API.")
+	}
+
+	f.Func().Params(Id("c").Op("*").Id("Client")).Id(o.name).Add(g.signature(o)).BlockFunc(func(grp *Group) {
+		// Route the call to the mock in tests.
+		args := []Code{Id("ctx")}
+		if o.paramsType != "" {
+			args = append(args, Id("params"))
+		}
+		grp.If(
+			List(Id("mock"), Id("ok")).Op(":=").Qual(externalPkg, "Mock").Types(Id("Interface")).Call(Id("Name")),
+			Id("ok"),
+		).Block(
+			Return(Id("mock").Dot(o.name).Call(args...)),
+		)
+		grp.Line()
+
+		// Build the path from the path parameters.
+		byWireName := make(map[string]*param)
+		for _, p := range o.params {
+			byWireName[p.in+":"+p.wireName] = p
+		}
+		var path *Statement
+		add := func(c Code) {
+			if path == nil {
+				path = Add(c)
+			} else {
+				path = path.Op("+").Add(c)
+			}
+		}
+		last := 0
+		for _, m := range pathParamRegexp.FindAllStringSubmatchIndex(o.path, -1) {
+			if m[0] > last {
+				add(Lit(o.path[last:m[0]]))
+			}
+			if p := byWireName["path:"+o.path[m[2]:m[3]]]; p != nil {
+				add(Qual("net/url", "PathEscape").Call(Id("formatParam").Call(Id("params").Dot(p.name))))
+			} else {
+				add(Lit(o.path[m[0]:m[1]]))
+			}
+			last = m[1]
+		}
+		if last < len(o.path) || path == nil {
+			add(Lit(o.path[last:]))
+		}
+		grp.Id("path").Op(":=").Add(path)
+
+		grp.Id("query").Op(":=").Make(Qual("net/url", "Values"))
+		grp.Id("header").Op(":=").Make(Qual("net/http", "Header"))
+		for _, p := range o.params {
+			var set func(value Code) Code
+			switch p.in {
+			case openapi3.ParameterInQuery:
+				set = func(value Code) Code {
+					return Id("query").Dot("Add").Call(Lit(p.wireName), Id("formatParam").Call(value))
+				}
+			case openapi3.ParameterInHeader:
+				set = func(value Code) Code {
+					return Id("header").Dot("Add").Call(Lit(p.wireName), Id("formatParam").Call(value))
+				}
+			default:
+				continue
+			}
+
+			field := Id("params").Dot(p.name)
+			switch {
+			case g.isArray(p.schema):
+				grp.For(List(Id("_"), Id("v")).Op(":=").Range().Add(field)).Block(set(Id("v")))
+			case !p.required:
+				grp.If(field.Clone().Op("!=").Nil()).Block(set(Op("*").Add(field)))
+			default:
+				grp.Add(set(field))
+			}
+		}
+		grp.Line()
+
+		body := Nil()
+		if o.body != nil {
+			if o.bodyReq || g.nilable(o.body) {
+				body = Id("params").Dot("Body")
+			} else {
+				grp.Var().Id("body").Any()
+				grp.If(Id("params").Dot("Body").Op("!=").Nil()).Block(
+					Id("body").Op("=").Id("params").Dot("Body"),
+				)
+				body = Id("body")
+			}
+		}
+
+		if o.resp == nil {
+			grp.Return(Id("c").Dot("do").Call(
+				Id("ctx"), Lit(o.id), Lit(o.method), Id("path"), Id("query"), Id("header"), body, Nil(),
+			))
+			return
+		}
+
+		grp.Var().Id("resp").Add(g.goType(o.resp, o.name+"Response"))
+		grp.If(
+			Err().Op(":=").Id("c").Dot("do").Call(
+				Id("ctx"), Lit(o.id), Lit(o.method), Id("path"), Id("query"), Id("header"), body, Op("&").Id("resp"),
+			),
+			Err().Op("!=").Nil(),
+		).Block(
+			Return(Nil(), Err()),
+		)
+		if g.nilable(o.resp) {
+			grp.Return(Id("resp"), Nil())
+		} else {
+			grp.Return(Op("&").Id("resp"), Nil())
+		}
+	})
+	f.Line()
+}
@@ -0,0 +1,209 @@
+package externalgen
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/dave/jennifer/jen"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const componentSchemaPrefix = "#/components/schemas/"
+
+// goType returns the Go type for the schema.
+// Inline object schemas are generated as named types, named after nameHint.
+func (g *generator) goType(ref *openapi3.SchemaRef, nameHint string) Code {
+	if ref == nil || ref.Value == nil {
+		return Any()
+	}
+	if name, ok := g.typeNames[strings.TrimPrefix(ref.Ref, componentSchemaPrefix)]; ok && ref.Ref != "" {
+		return Id(name)
+	}
+
+	s := ref.Value
+	if len(s.OneOf) > 0 || len(s.AnyOf) > 0 {
+		return Qual("encoding/json", "RawMessage")
+	}
+	if isStruct(s) {
+		name, ok := g.inlineNames[s]
+		if !ok {
+			name = g.allocName(nameHint, "")
+			g.inlineNames[s] = name
+			g.pending = append(g.pending, pendingType{name: name, schema: s})
+		}
+		return Id(name)
+	}
+
+	switch s.Type {
+	case openapi3.TypeString:
+		switch s.Format {
+		case "date-time":
+			return Qual("time", "Time")
+		case "byte":
+			return Index().Byte()
+		}
+		return String()
+	case openapi3.TypeInteger:
+		if s.Format == "int32" {
+			return Int32()
+		}
+		return Int64()
+	case openapi3.TypeNumber:
+		if s.Format == "float" {
+			return Float32()
+		}
+		return Float64()
+	case openapi3.TypeBoolean:
+		return Bool()
+	case openapi3.TypeArray:
+		return Index().Add(g.goType(s.Items, nameHint+"Item"))
+	case openapi3.TypeObject:
+		if ap := s.AdditionalProperties.Schema; ap != nil {
+			return Map(String()).Add(g.goType(ap, nameHint+"Value"))
+		}
+		return Map(String()).Any()
+	}
+	return Any()
+}
+
+// nilable reports whether the Go type of the schema can be nil,
+// in which case it's not wrapped in a pointer when optional.
+func (g *generator) nilable(ref *openapi3.SchemaRef) bool {
+	if ref == nil || ref.Value == nil {
+		return true
+	}
+	s := ref.Value
+	switch {
+	case len(s.OneOf) > 0 || len(s.AnyOf) > 0:
+		return true
+	case isStruct(s) || isEnum(s):
+		return false
+	}
+	switch s.Type {
+	case openapi3.TypeString:
+		return s.Format == "byte"
+	case openapi3.TypeInteger, openapi3.TypeNumber, openapi3.TypeBoolean:
+		return false
+	}
+	return true
+}
+
+// isArray reports whether the schema is an array.
+func (g *generator) isArray(ref *openapi3.SchemaRef) bool {
+	return ref != nil && ref.Value != nil && ref.Value.Type == openapi3.TypeArray
+}
+
+// isStruct reports whether the schema is generated as a struct.
+func isStruct(s *openapi3.Schema) bool {
+	return (s.Type == openapi3.TypeObject || s.Type == "") && (len(s.Properties) > 0 || len(s.AllOf) > 0)
+}
+
+// isEnum reports whether the schema is generated as a string enum.
+func isEnum(s *openapi3.Schema) bool {
+	if s.Type != openapi3.TypeString || s.Format != "" || len(s.Enum) == 0 {
+		return false
+	}
+	for _, v := range s.Enum {
+		if _, ok := v.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// writeNamedSchema writes the named type for the schema.
+func (g *generator) writeNamedSchema(name string, ref *openapi3.SchemaRef) {
+	f := g.file
+	s := ref.Value
+	if s == nil {
+		return
+	}
+
+	doc := strings.TrimSpace(s.Description)
+	if doc == "" {
+		doc = strings.TrimSpace(s.Title)
+	}
+	if doc != "" {
+		comment(f.Group, doc)
+	}
+
+	switch {
+	case ref.Ref != "":
+		// The schema is a reference to another schema.
+		f.Type().Id(name).Op("=").Add(g.goType(ref, name))
+
+	case isStruct(s):
+		f.Type().Id(name).StructFunc(func(grp *Group) {
+			g.writeFields(grp, name, s)
+		})
+
+	case isEnum(s):
+		f.Type().Id(name).String()
+		f.Line()
+		f.Const().DefsFunc(func(grp *Group) {
+			for _, v := range s.Enum {
+				grp.Id(g.allocName(name+"_"+v.(string), "")).Id(name).Op("=").Lit(v)
+			}
+		})
+
+	default:
+		f.Type().Id(name).Op("=").Add(g.goType(&openapi3.SchemaRef{Value: s}, name))
+	}
+	f.Line()
+}
+
+// writeFields writes the struct fields for the properties of the schema,
+// including those of the schemas it's composed of with allOf.
+func (g *generator) writeFields(grp *Group, typeName string, s *openapi3.Schema) {
+	props := make(openapi3.Schemas)
+	required := make(map[string]bool)
+	var collect func(s *openapi3.Schema)
+	collect = func(s *openapi3.Schema) {
+		for _, sub := range s.AllOf {
+			if sub != nil && sub.Value != nil {
+				collect(sub.Value)
+			}
+		}
+		for name, prop := range s.Properties {
+			props[name] = prop
+		}
+		for _, name := range s.Required {
+			required[name] = true
+		}
+	}
+	collect(s)
+
+	fieldNames := make(map[string]bool)
+	for _, prop := range sortedKeys(props) {
+		ref := props[prop]
+		field := goIdent(prop)
+		for i := 2; fieldNames[field]; i++ {
+			field = fmt.Sprintf("%s%d", goIdent(prop), i)
+		}
+		fieldNames[field] = true
+
+		if ref.Ref == "" && ref.Value != nil {
+			if doc := strings.TrimSpace(ref.Value.Description); doc != "" {
+				comment(grp, doc)
+			}
+		}
+
+		typ := g.goType(ref, typeName+field)
+		tag := prop
+		optional := !required[prop] || (ref.Value != nil && ref.Value.Nullable)
+		if optional {
+			if !g.nilable(ref) {
+				typ = Op("*").Add(typ)
+			}
+			tag += ",omitempty"
+		}
+		grp.Id(field).Add(typ).Tag(map[string]string{"json": tag})
+	}
+}
+
+// comment writes a comment with the given text, which may span multiple lines.
+func comment(grp *Group, text string) {
+	for _, line := range strings.Split(text, "\n") {
+		grp.Comment(strings.TrimRight(line, " \t"))
+	}
+}
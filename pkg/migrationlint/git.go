@@ -0,0 +1,66 @@
+package migrationlint
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// ChangedFiles returns the files in dir that have been added or modified
+// compared to the git revision base, including untracked files.
+// The paths are relative to dir.
+//
+// If dir is not within a git repository it reports ok == false.
+// It reports an error if git is not installed.
+func ChangedFiles(dir, base string) (files map[string]bool, ok bool, err error) {
+	git := func(args ...string) ([]string, error) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.Output()
+		if err != nil {
+			if ee, ok := err.(*exec.ExitError); ok && len(ee.Stderr) > 0 {
+				return nil, fmt.Errorf("git %s: %s", args[0], bytes.TrimSpace(ee.Stderr))
+			}
+			return nil, fmt.Errorf("git %s: %v", args[0], err)
+		}
+		var lines []string
+		for _, line := range strings.Split(string(bytes.TrimSpace(out)), "\n") {
+			if line != "" {
+				lines = append(lines, filepath.FromSlash(line))
+			}
+		}
+		return lines, nil
+	}
+
+	if _, err := exec.LookPath("git"); err != nil {
+		return nil, false, fmt.Errorf("git not found: %v", err)
+	}
+	if _, err := git("rev-parse", "--is-inside-work-tree"); err != nil {
+		return nil, false, nil
+	}
+
+	files = make(map[string]bool)
+	var changed []string
+	if _, err := git("rev-parse", "--verify", "--quiet", "HEAD"); err != nil && base == "HEAD" {
+		// The repository has no commits yet, so all files are new.
+		changed, err = git("ls-files", ".")
+		if err != nil {
+			return nil, true, err
+		}
+	} else {
+		changed, err = git("diff", "--name-only", "--relative", "--diff-filter=AM", base, "--", ".")
+		if err != nil {
+			return nil, true, err
+		}
+	}
+	untracked, err := git("ls-files", "--others", "--exclude-standard", ".")
+	if err != nil {
+		return nil, true, err
+	}
+	for _, f := range append(changed, untracked...) {
+		files[f] = true
+	}
+	return files, true, nil
+}
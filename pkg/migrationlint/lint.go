@@ -0,0 +1,411 @@
+// Package migrationlint statically analyzes SQL database migrations
+// for operations that are unsafe to run against a production database:
+// operations that take heavy locks on existing tables, that break
+// backward compatibility with the running code, or that can't be reverted.
+package migrationlint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	pg_query "github.com/pganalyze/pg_query_go/v6"
+)
+
+// Severity is the severity of a finding.
+type Severity int
+
+const (
+	// Error findings are operations that are unsafe to run against a production database.
+	Error Severity = iota
+	// Warning findings are worth reviewing, but don't make the migration unsafe.
+	Warning
+)
+
+// Rule identifies the kind of problem a finding reports.
+// It's used to suppress findings with an "encore-lint-ignore" comment.
+type Rule string
+
+const (
+	RuleCreateIndex          Rule = "create-index-non-concurrent"
+	RuleAddColumnVolatile    Rule = "add-column-volatile-default"
+	RuleAddColumnNotNull     Rule = "add-column-not-null"
+	RuleAlterColumnType      Rule = "alter-column-type"
+	RuleSetNotNull           Rule = "set-not-null"
+	RuleAddConstraint        Rule = "add-constraint"
+	RuleDropColumn           Rule = "drop-column"
+	RuleDropTable            Rule = "drop-table"
+	RuleRename               Rule = "rename"
+	RuleTruncate             Rule = "truncate"
+	RuleMissingDownMigration Rule = "missing-down"
+	RuleSyntax               Rule = "syntax"
+)
+
+// Finding is a problem found in a migration.
+type Finding struct {
+	Rule     Rule
+	Severity Severity
+	Title    string // short description of the kind of problem
+	Message  string // what the problem is
+	Help     string // how to address it, if known
+
+	// File is the path to the migration file.
+	File string
+	// Line and Column are the 1-based position of the statement in the file,
+	// and EndLine and EndColumn the position of its end.
+	// They're all zero for findings that concern the file as a whole.
+	Line, Column       int
+	EndLine, EndColumn int
+}
+
+// Migration is an up migration file.
+type Migration struct {
+	Number   uint64
+	Filename string // the filename of the .up.sql file, relative to the migration dir
+}
+
+// Config configures what to lint.
+type Config struct {
+	// Dir is the path to the migration directory.
+	Dir string
+
+	// Migrations are the up migrations in the directory, in the order they're applied.
+	Migrations []Migration
+
+	// Lint reports whether the given migration should be linted.
+	// The other migrations are only used to compute the schema the linted migrations apply to.
+	// If nil all migrations are linted.
+	Lint func(m Migration) bool
+
+	// Queries are paths to SQL files with queries against the database,
+	// such as the queries of sqlc. They're used to report dropped or renamed
+	// columns that are still referenced.
+	Queries []string
+}
+
+// Lint lints the migrations given by cfg.
+// The findings are ordered by migration and position.
+func Lint(cfg Config) ([]*Finding, error) {
+	queries, err := parseQueries(cfg.Queries)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := slices.Clone(cfg.Migrations)
+	slices.SortStableFunc(migrations, func(a, b Migration) int {
+		switch {
+		case a.Number < b.Number:
+			return -1
+		case a.Number > b.Number:
+			return 1
+		}
+		return 0
+	})
+
+	sch := newSchema()
+	var findings []*Finding
+	for _, m := range migrations {
+		path := filepath.Join(cfg.Dir, m.Filename)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		lint := cfg.Lint == nil || cfg.Lint(m)
+
+		l := &linter{
+			path:    path,
+			src:     string(data),
+			schema:  sch,
+			queries: queries,
+			lint:    lint,
+			created: make(map[string]bool),
+		}
+		l.run()
+		if lint {
+			findings = append(findings, l.findings...)
+			if f := checkDownMigration(cfg.Dir, m, l.src, path); f != nil {
+				findings = append(findings, f)
+			}
+		}
+	}
+	return findings, nil
+}
+
+// checkDownMigration reports a missing .down.sql counterpart of the migration.
+func checkDownMigration(dir string, m Migration, src, path string) *Finding {
+	down := strings.TrimSuffix(m.Filename, ".up.sql") + ".down.sql"
+	if _, err := os.Stat(filepath.Join(dir, down)); err == nil {
+		return nil
+	}
+	if slices.Contains(suppressedRules(src), RuleMissingDownMigration) {
+		return nil
+	}
+	return &Finding{
+		Rule:     RuleMissingDownMigration,
+		Severity: Warning,
+		Title:    "Missing down migration",
+		Message:  fmt.Sprintf("The migration has no %s file, so it can't be reverted with 'encore db migrate down'.", down),
+		Help:     fmt.Sprintf("Add %s to revert the changes of the migration.", down),
+		File:     path,
+	}
+}
+
+// linter lints a single migration file and applies it to the schema.
+type linter struct {
+	path    string
+	src     string
+	schema  *schema
+	queries []*query
+	lint    bool
+
+	// created are the tables created by the migration,
+	// which aren't in use yet and can be changed safely.
+	created map[string]bool
+
+	findings []*Finding
+	stmt     *pg_query.RawStmt // the statement being linted
+}
+
+func (l *linter) run() {
+	res, err := pg_query.Parse(l.src)
+	if err != nil {
+		if l.lint {
+			l.findings = append(l.findings, &Finding{
+				Rule:     RuleSyntax,
+				Severity: Warning,
+				Title:    "Unable to analyze migration",
+				Message:  fmt.Sprintf("The migration could not be parsed, so it was not checked for unsafe operations: %v.", err),
+				File:     l.path,
+			})
+		}
+		return
+	}
+
+	for _, stmt := range res.Stmts {
+		l.stmt = stmt
+		l.statement(stmt.Stmt)
+	}
+}
+
+// existing reports whether the table existed before the migration.
+func (l *linter) existing(rel *pg_query.RangeVar) bool {
+	return rel != nil && !l.created[tableName(rel)]
+}
+
+func (l *linter) statement(n *pg_query.Node) {
+	switch {
+	case n.GetCreateStmt() != nil:
+		s := n.GetCreateStmt()
+		name := tableName(s.Relation)
+		l.created[name] = true
+		t := l.schema.create(name)
+		for _, elt := range s.TableElts {
+			if col := elt.GetColumnDef(); col != nil {
+				t.columns[col.Colname] = true
+			}
+		}
+
+	case n.GetIndexStmt() != nil:
+		s := n.GetIndexStmt()
+		if !s.Concurrent && l.existing(s.Relation) {
+			l.report(RuleCreateIndex, Error, "Lock-heavy migration",
+				fmt.Sprintf("CREATE INDEX without CONCURRENTLY blocks all writes to table %q while the index is built.", tableName(s.Relation)),
+				"Use CREATE INDEX CONCURRENTLY, in a migration of its own since it can't run inside a transaction.")
+		}
+
+	case n.GetAlterTableStmt() != nil:
+		s := n.GetAlterTableStmt()
+		if s.Objtype != pg_query.ObjectType_OBJECT_TABLE {
+			return
+		}
+		for _, cmd := range s.Cmds {
+			if c := cmd.GetAlterTableCmd(); c != nil {
+				l.alterTable(s.Relation, c)
+			}
+		}
+
+	case n.GetDropStmt() != nil:
+		s := n.GetDropStmt()
+		if s.RemoveType != pg_query.ObjectType_OBJECT_TABLE {
+			return
+		}
+		for _, obj := range s.Objects {
+			name := objectName(obj)
+			if !l.created[name] {
+				l.report(RuleDropTable, Error, "Irreversible migration",
+					fmt.Sprintf("Dropping table %q permanently deletes its data, and breaks any running code still using it.", name),
+					"Stop using the table in a previous deploy, and back up the data if you may need it.")
+			}
+			l.schema.drop(name)
+		}
+
+	case n.GetRenameStmt() != nil:
+		s := n.GetRenameStmt()
+		switch s.RenameType {
+		case pg_query.ObjectType_OBJECT_TABLE:
+			name := tableName(s.Relation)
+			if l.existing(s.Relation) {
+				l.report(RuleRename, Error, "Backward-incompatible migration",
+					fmt.Sprintf("Renaming table %q to %q breaks the running code still using the old name.%s", name, s.Newname, l.references(name, "")),
+					"Create a view with the old name, or migrate the code to the new table over multiple deploys.")
+			}
+			l.schema.rename(name, s.Newname)
+		case pg_query.ObjectType_OBJECT_COLUMN:
+			name := tableName(s.Relation)
+			if l.existing(s.Relation) {
+				l.report(RuleRename, Error, "Backward-incompatible migration",
+					fmt.Sprintf("Renaming column %q of table %q breaks the running code still using the old name.%s", s.Subname, name, l.references(name, s.Subname)),
+					"Add a new column and migrate the code to it over multiple deploys.")
+			}
+			if t := l.schema.table(name); t != nil {
+				delete(t.columns, s.Subname)
+				t.columns[s.Newname] = true
+			}
+		}
+
+	case n.GetTruncateStmt() != nil:
+		for _, rel := range n.GetTruncateStmt().Relations {
+			if rv := rel.GetRangeVar(); l.existing(rv) {
+				l.report(RuleTruncate, Error, "Irreversible migration",
+					fmt.Sprintf("Truncating table %q permanently deletes its data.", tableName(rv)), "")
+			}
+		}
+	}
+}
+
+func (l *linter) alterTable(rel *pg_query.RangeVar, c *pg_query.AlterTableCmd) {
+	name := tableName(rel)
+	existing := l.existing(rel)
+	t := l.schema.table(name)
+
+	switch c.Subtype {
+	case pg_query.AlterTableType_AT_AddColumn:
+		col := c.Def.GetColumnDef()
+		if col == nil {
+			return
+		}
+		if t != nil {
+			t.columns[col.Colname] = true
+		}
+		if !existing {
+			return
+		}
+
+		def, notNull := col.RawDefault, col.IsNotNull
+		for _, cons := range col.Constraints {
+			switch cn := cons.GetConstraint(); {
+			case cn == nil:
+			case cn.Contype == pg_query.ConstrType_CONSTR_DEFAULT:
+				def = cn.RawExpr
+			case cn.Contype == pg_query.ConstrType_CONSTR_NOTNULL:
+				notNull = true
+			case cn.Contype == pg_query.ConstrType_CONSTR_IDENTITY, cn.Contype == pg_query.ConstrType_CONSTR_GENERATED:
+				// The column is computed, so it always has a value.
+				def = cons
+			}
+		}
+
+		switch {
+		case def != nil && isVolatile(def):
+			l.report(RuleAddColumnVolatile, Error, "Lock-heavy migration",
+				fmt.Sprintf("Adding column %q with a volatile default rewrites the whole table %q, blocking all reads and writes while it runs.", col.Colname, name),
+				"Add the column without a default, set the default in a separate statement, and backfill the existing rows in batches.")
+		case def == nil && notNull:
+			l.report(RuleAddColumnNotNull, Error, "Backward-incompatible migration",
+				fmt.Sprintf("Adding column %q as NOT NULL without a default fails if table %q has any rows, and breaks inserts from running code that doesn't set it.", col.Colname, name),
+				"Give the column a default, or add it as nullable and make it NOT NULL once it's backfilled.")
+		}
+
+	case pg_query.AlterTableType_AT_DropColumn:
+		if t != nil {
+			delete(t.columns, c.Name)
+		}
+		if existing {
+			l.report(RuleDropColumn, Error, "Backward-incompatible migration",
+				fmt.Sprintf("Dropping column %q of table %q permanently deletes its data, and breaks running code still using it.%s", c.Name, name, l.references(name, c.Name)),
+				"Stop using the column in a previous deploy before dropping it.")
+		}
+
+	case pg_query.AlterTableType_AT_AlterColumnType:
+		if existing {
+			l.report(RuleAlterColumnType, Error, "Lock-heavy migration",
+				fmt.Sprintf("Changing the type of column %q typically rewrites the whole table %q, blocking all reads and writes while it runs.", c.Name, name),
+				"Add a new column with the new type and backfill it in batches instead.")
+		}
+
+	case pg_query.AlterTableType_AT_SetNotNull:
+		if existing {
+			l.report(RuleSetNotNull, Error, "Lock-heavy migration",
+				fmt.Sprintf("Making column %q NOT NULL scans the whole table %q while blocking all reads and writes.", c.Name, name),
+				fmt.Sprintf("Add a CHECK (%s IS NOT NULL) NOT VALID constraint, validate it in a separate migration, and then set NOT NULL.", c.Name))
+		}
+
+	case pg_query.AlterTableType_AT_AddConstraint:
+		cn := c.Def.GetConstraint()
+		if cn == nil || !existing {
+			return
+		}
+		switch cn.Contype {
+		case pg_query.ConstrType_CONSTR_FOREIGN, pg_query.ConstrType_CONSTR_CHECK:
+			if !cn.SkipValidation {
+				l.report(RuleAddConstraint, Error, "Lock-heavy migration",
+					fmt.Sprintf("Adding a constraint to table %q validates all existing rows while blocking writes.", name),
+					"Add the constraint with NOT VALID, and run VALIDATE CONSTRAINT in a separate migration.")
+			}
+		case pg_query.ConstrType_CONSTR_PRIMARY, pg_query.ConstrType_CONSTR_UNIQUE:
+			if cn.Indexname == "" {
+				l.report(RuleAddConstraint, Error, "Lock-heavy migration",
+					fmt.Sprintf("Adding a unique constraint to table %q builds an index while blocking all writes.", name),
+					"Create a unique index CONCURRENTLY first, and add the constraint with USING INDEX.")
+			}
+		}
+	}
+}
+
+// report reports a finding for the current statement, unless it's suppressed.
+func (l *linter) report(rule Rule, sev Severity, title, msg, help string) {
+	if !l.lint {
+		return
+	}
+	start, end := int(l.stmt.StmtLocation), int(l.stmt.StmtLocation+l.stmt.StmtLen)
+	if l.stmt.StmtLen == 0 {
+		end = len(l.src) // the last statement extends to the end of the file
+	}
+	text := l.src[start:end]
+	if slices.Contains(suppressedRules(text), rule) {
+		return
+	}
+
+	start = skipSpaceAndComments(l.src, start, end)
+	f := &Finding{
+		Rule:     rule,
+		Severity: sev,
+		Title:    title,
+		Message:  msg,
+		Help:     help,
+		File:     l.path,
+	}
+	f.Line, f.Column = position(l.src, start)
+	end = start + len(strings.TrimRightFunc(l.src[start:end], isSpace))
+	f.EndLine, f.EndColumn = position(l.src, end)
+	l.findings = append(l.findings, f)
+}
+
+// ignoreRe matches comments suppressing findings for a statement, like:
+//
+//	-- encore-lint-ignore: drop-column, rename
+var ignoreRe = regexp.MustCompile(`--\s*encore-lint-ignore:?([^\n]*)`)
+
+// suppressedRules returns the rules suppressed by comments in the given text.
+func suppressedRules(text string) []Rule {
+	var rules []Rule
+	for _, m := range ignoreRe.FindAllStringSubmatch(text, -1) {
+		for _, r := range strings.FieldsFunc(m[1], func(r rune) bool { return r == ',' || isSpace(r) }) {
+			rules = append(rules, Rule(r))
+		}
+	}
+	return rules
+}
@@ -0,0 +1,168 @@
+package migrationlint
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+const baseMigration = `
+CREATE TABLE users (
+	id BIGSERIAL PRIMARY KEY,
+	email TEXT NOT NULL,
+	name TEXT
+);
+`
+
+func TestLint(t *testing.T) {
+	c := qt.New(t)
+
+	tests := []struct {
+		name  string
+		sql   string
+		rules []Rule
+		line  int // line of the first finding, if non-zero
+	}{
+		{
+			name:  "create_index",
+			sql:   "\n-- comment\nCREATE INDEX users_email ON users (email);",
+			rules: []Rule{RuleCreateIndex},
+			line:  3,
+		},
+		{
+			name: "create_index_concurrently",
+			sql:  "CREATE INDEX CONCURRENTLY users_email ON users (email);",
+		},
+		{
+			name: "new_table",
+			sql:  "CREATE TABLE posts (id BIGINT);\nCREATE INDEX posts_id ON posts (id);\nALTER TABLE posts ADD COLUMN body TEXT NOT NULL;",
+		},
+		{
+			name:  "volatile_default",
+			sql:   "ALTER TABLE users ADD COLUMN token UUID DEFAULT gen_random_uuid();",
+			rules: []Rule{RuleAddColumnVolatile},
+		},
+		{
+			name: "stable_default",
+			sql:  "ALTER TABLE users ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();",
+		},
+		{
+			name:  "not_null_without_default",
+			sql:   "ALTER TABLE users ADD COLUMN age INT NOT NULL;",
+			rules: []Rule{RuleAddColumnNotNull},
+		},
+		{
+			name:  "drop_column",
+			sql:   "ALTER TABLE users DROP COLUMN name;",
+			rules: []Rule{RuleDropColumn},
+		},
+		{
+			name: "suppressed",
+			sql:  "SELECT 1;\n-- encore-lint-ignore: drop-column\nALTER TABLE users DROP COLUMN name;",
+		},
+		{
+			name:  "suppressed_other_rule",
+			sql:   "-- encore-lint-ignore: rename\nALTER TABLE users DROP COLUMN name;",
+			rules: []Rule{RuleDropColumn},
+		},
+		{
+			name:  "constraints",
+			sql:   "ALTER TABLE users ADD CONSTRAINT a CHECK (id > 0);\nALTER TABLE users ADD CONSTRAINT b CHECK (id > 0) NOT VALID;\nALTER TABLE users ADD CONSTRAINT c UNIQUE (email);",
+			rules: []Rule{RuleAddConstraint, RuleAddConstraint},
+		},
+		{
+			name:  "misc",
+			sql:   "ALTER TABLE users ALTER COLUMN name TYPE VARCHAR(10), ALTER COLUMN name SET NOT NULL;\nALTER TABLE users RENAME TO people;\nTRUNCATE people;\nDROP TABLE people;",
+			rules: []Rule{RuleAlterColumnType, RuleSetNotNull, RuleRename, RuleTruncate, RuleDropTable},
+		},
+	}
+
+	for _, tt := range tests {
+		c.Run(tt.name, func(c *qt.C) {
+			dir := c.TempDir()
+			write := func(name, contents string) {
+				err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644)
+				c.Assert(err, qt.IsNil)
+			}
+			write("1_base.up.sql", baseMigration)
+			write("2_change.up.sql", tt.sql)
+			write("2_change.down.sql", "")
+
+			findings, err := Lint(Config{
+				Dir: dir,
+				Migrations: []Migration{
+					{Number: 1, Filename: "1_base.up.sql"},
+					{Number: 2, Filename: "2_change.up.sql"},
+				},
+				Lint: func(m Migration) bool { return m.Number == 2 },
+			})
+			c.Assert(err, qt.IsNil)
+
+			var rules []Rule
+			for _, f := range findings {
+				rules = append(rules, f.Rule)
+			}
+			c.Assert(rules, qt.DeepEquals, tt.rules)
+			if tt.line != 0 {
+				c.Assert(findings[0].Line, qt.Equals, tt.line)
+				c.Assert(findings[0].Column, qt.Equals, 1)
+			}
+		})
+	}
+}
+
+func TestLintMissingDown(t *testing.T) {
+	c := qt.New(t)
+	dir := c.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "1_base.up.sql"), []byte(baseMigration), 0644)
+	c.Assert(err, qt.IsNil)
+
+	findings, err := Lint(Config{
+		Dir:        dir,
+		Migrations: []Migration{{Number: 1, Filename: "1_base.up.sql"}},
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(findings, qt.HasLen, 1)
+	c.Assert(findings[0].Rule, qt.Equals, RuleMissingDownMigration)
+	c.Assert(findings[0].Severity, qt.Equals, Warning)
+}
+
+func TestLintQueryReferences(t *testing.T) {
+	c := qt.New(t)
+	dir := c.TempDir()
+	migrations := filepath.Join(dir, "migrations")
+	queries := filepath.Join(dir, "queries")
+	c.Assert(os.MkdirAll(migrations, 0755), qt.IsNil)
+	c.Assert(os.MkdirAll(queries, 0755), qt.IsNil)
+
+	files := map[string]string{
+		"sqlc.yaml":                       "version: \"2\"\nsql:\n  - engine: postgresql\n    schema: migrations\n    queries: queries\n",
+		"migrations/1_base.up.sql":        baseMigration,
+		"migrations/2_drop_name.up.sql":   "ALTER TABLE users DROP COLUMN name;",
+		"migrations/2_drop_name.down.sql": "",
+		"queries/users.sql":               "-- name: GetUser :one\nSELECT id, email FROM users WHERE id = $1;\n\n-- name: GetName :one\nSELECT name FROM users WHERE id = $1;\n",
+	}
+	for name, contents := range files {
+		c.Assert(os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644), qt.IsNil)
+	}
+
+	paths, err := SQLCQueries(dir, migrations)
+	c.Assert(err, qt.IsNil)
+	c.Assert(paths, qt.DeepEquals, []string{filepath.Join(queries, "users.sql")})
+
+	findings, err := Lint(Config{
+		Dir: migrations,
+		Migrations: []Migration{
+			{Number: 1, Filename: "1_base.up.sql"},
+			{Number: 2, Filename: "2_drop_name.up.sql"},
+		},
+		Lint:    func(m Migration) bool { return m.Number == 2 },
+		Queries: paths,
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(findings, qt.HasLen, 1)
+	c.Assert(strings.Contains(findings[0].Message, "users.sql:5"), qt.IsTrue, qt.Commentf("message: %s", findings[0].Message))
+}
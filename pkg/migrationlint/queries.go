@@ -0,0 +1,181 @@
+package migrationlint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	pg_query "github.com/pganalyze/pg_query_go/v6"
+	"sigs.k8s.io/yaml"
+)
+
+// query is a statement in a query file.
+type query struct {
+	file    string
+	line    int
+	tables  map[string]bool
+	columns map[string]bool
+}
+
+func parseQueries(paths []string) ([]*query, error) {
+	var queries []*query
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		src := string(data)
+		res, err := pg_query.Parse(src)
+		if err != nil {
+			// Invalid queries are reported by sqlc; skip them here.
+			continue
+		}
+		for _, stmt := range res.Stmts {
+			q := &query{
+				file:    path,
+				tables:  make(map[string]bool),
+				columns: make(map[string]bool),
+			}
+			start := skipSpaceAndComments(src, int(stmt.StmtLocation), int(stmt.StmtLocation+stmt.StmtLen))
+			if stmt.StmtLen == 0 {
+				start = skipSpaceAndComments(src, int(stmt.StmtLocation), len(src))
+			}
+			q.line, _ = position(src, start)
+
+			walk(stmt.Stmt, func(n *pg_query.Node) {
+				switch {
+				case n.GetRangeVar() != nil:
+					q.tables[tableName(n.GetRangeVar())] = true
+				case n.GetColumnRef() != nil:
+					fields := n.GetColumnRef().Fields
+					if len(fields) > 0 {
+						q.columns[fields[len(fields)-1].GetString_().GetSval()] = true
+					}
+				case n.GetResTarget() != nil && n.GetResTarget().Name != "":
+					// Column names in INSERT and UPDATE statements.
+					q.columns[n.GetResTarget().Name] = true
+				}
+			})
+			queries = append(queries, q)
+		}
+	}
+	return queries, nil
+}
+
+// references describes the queries that reference the given table,
+// or the given column of it if column is non-empty.
+// It returns the empty string if there are none.
+func (l *linter) references(tbl, column string) string {
+	var refs []string
+	for _, q := range l.queries {
+		if q.tables[tbl] && (column == "" || q.columns[column]) {
+			refs = append(refs, fmt.Sprintf("%s:%d", q.file, q.line))
+		}
+	}
+	if len(refs) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" It's still referenced by queries at %s.", strings.Join(refs, ", "))
+}
+
+// SQLCQueries returns the query files of the sqlc configuration in appRoot
+// whose schema is the given migration directory.
+// It returns nil if the app doesn't use sqlc.
+func SQLCQueries(appRoot, migrationDir string) ([]string, error) {
+	var (
+		cfgPath string
+		data    []byte
+	)
+	for _, name := range []string{"sqlc.yaml", "sqlc.yml", "sqlc.json"} {
+		var err error
+		cfgPath = filepath.Join(appRoot, name)
+		if data, err = os.ReadFile(cfgPath); err == nil {
+			break
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	// The schema and queries are either a single path or a list of paths.
+	var cfg struct {
+		SQL []struct {
+			Schema  any `json:"schema"`
+			Queries any `json:"queries"`
+		} `json:"sql"`
+		Packages []struct {
+			Schema  any `json:"schema"`
+			Queries any `json:"queries"`
+		} `json:"packages"`
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %v", cfgPath, err)
+	}
+	entries := cfg.SQL
+	for _, p := range cfg.Packages {
+		entries = append(entries, p)
+	}
+
+	migrationDir = filepath.Clean(migrationDir)
+	var files []string
+	for _, e := range entries {
+		matches := slices.ContainsFunc(toPaths(e.Schema), func(p string) bool {
+			return filepath.Clean(filepath.Join(appRoot, p)) == migrationDir
+		})
+		if !matches {
+			continue
+		}
+		for _, p := range toPaths(e.Queries) {
+			found, err := sqlFiles(filepath.Join(appRoot, p))
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, found...)
+		}
+	}
+	return files, nil
+}
+
+// toPaths converts a sqlc path value, which is either a string or a list of strings.
+func toPaths(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var paths []string
+		for _, p := range v {
+			if s, ok := p.(string); ok {
+				paths = append(paths, s)
+			}
+		}
+		return paths
+	}
+	return nil
+}
+
+// sqlFiles returns path if it's a file, or the .sql files in it if it's a directory.
+func sqlFiles(path string) ([]string, error) {
+	fi, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	} else if !fi.IsDir() {
+		return []string{path}, nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".sql") {
+			files = append(files, filepath.Join(path, e.Name()))
+		}
+	}
+	return files, nil
+}
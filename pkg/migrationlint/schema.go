@@ -0,0 +1,182 @@
+package migrationlint
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	pg_query "github.com/pganalyze/pg_query_go/v6"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// schema is the database schema as created by the migrations linted so far.
+// It's intentionally approximate: it only tracks tables and their columns.
+type schema struct {
+	tables map[string]*table
+}
+
+type table struct {
+	columns map[string]bool
+}
+
+func newSchema() *schema {
+	return &schema{tables: make(map[string]*table)}
+}
+
+func (s *schema) create(name string) *table {
+	t := &table{columns: make(map[string]bool)}
+	s.tables[name] = t
+	return t
+}
+
+// table returns the table with the given name, or nil if it's not known.
+func (s *schema) table(name string) *table {
+	return s.tables[name]
+}
+
+func (s *schema) drop(name string) {
+	delete(s.tables, name)
+}
+
+func (s *schema) rename(from, to string) {
+	if t, ok := s.tables[from]; ok {
+		delete(s.tables, from)
+		s.tables[to] = t
+	}
+}
+
+// tableName returns the name of the table, without the "public" schema.
+func tableName(rel *pg_query.RangeVar) string {
+	if rel == nil {
+		return ""
+	}
+	if rel.Schemaname != "" && rel.Schemaname != "public" {
+		return rel.Schemaname + "." + rel.Relname
+	}
+	return rel.Relname
+}
+
+// objectName returns the name of a table referenced by a DROP statement.
+func objectName(n *pg_query.Node) string {
+	var parts []string
+	for _, item := range n.GetList().GetItems() {
+		parts = append(parts, item.GetString_().GetSval())
+	}
+	if len(parts) > 1 && parts[0] == "public" {
+		parts = parts[1:]
+	}
+	return strings.Join(parts, ".")
+}
+
+// stableFuncs are functions that return the same value for all rows
+// of a statement, so they can be used as a default without rewriting the table.
+var stableFuncs = map[string]bool{
+	"now":                   true,
+	"current_timestamp":     true,
+	"transaction_timestamp": true,
+	"statement_timestamp":   true,
+	"current_date":          true,
+	"current_time":          true,
+	"localtimestamp":        true,
+	"localtime":             true,
+	"current_user":          true,
+	"current_setting":       true,
+}
+
+// isVolatile reports whether the default expression may evaluate to a different
+// value for each row, which forces Postgres to rewrite the table.
+// Any function call not known to be stable is considered volatile.
+func isVolatile(n *pg_query.Node) bool {
+	if c := n.GetConstraint(); c != nil {
+		// Generated and identity columns are computed for every row.
+		return c.Contype == pg_query.ConstrType_CONSTR_GENERATED || c.Contype == pg_query.ConstrType_CONSTR_IDENTITY
+	}
+
+	volatile := false
+	walk(n, func(n *pg_query.Node) {
+		if fc := n.GetFuncCall(); fc != nil {
+			var name string
+			if len(fc.Funcname) > 0 {
+				name = fc.Funcname[len(fc.Funcname)-1].GetString_().GetSval()
+			}
+			if !stableFuncs[name] {
+				volatile = true
+			}
+		}
+	})
+	return volatile
+}
+
+// walk calls fn for n and every node nested within it.
+func walk(n *pg_query.Node, fn func(n *pg_query.Node)) {
+	if n == nil {
+		return
+	}
+	walkMessage(n.ProtoReflect(), fn)
+}
+
+func walkMessage(m protoreflect.Message, fn func(n *pg_query.Node)) {
+	if n, ok := m.Interface().(*pg_query.Node); ok {
+		fn(n)
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				walkMessage(list.Get(i).Message(), fn)
+			}
+		} else if !fd.IsMap() {
+			walkMessage(v.Message(), fn)
+		}
+		return true
+	})
+}
+
+// position returns the 1-based line and column of the byte offset in src.
+func position(src string, offset int) (line, col int) {
+	line, col = 1, 1
+	for _, r := range src[:offset] {
+		if r == '\n' {
+			line++
+			col = 1
+		} else {
+			col++
+		}
+	}
+	return line, col
+}
+
+// skipSpaceAndComments returns the offset of the first byte in src[start:end]
+// that is not whitespace or part of a comment.
+func skipSpaceAndComments(src string, start, end int) int {
+	i := start
+	for i < end {
+		r, size := utf8.DecodeRuneInString(src[i:])
+		switch {
+		case isSpace(r):
+			i += size
+		case strings.HasPrefix(src[i:end], "--"):
+			if nl := strings.IndexByte(src[i:end], '\n'); nl >= 0 {
+				i += nl + 1
+			} else {
+				return end
+			}
+		case strings.HasPrefix(src[i:end], "/*"):
+			if c := strings.Index(src[i:end], "*/"); c >= 0 {
+				i += c + 2
+			} else {
+				return end
+			}
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+func isSpace(r rune) bool {
+	return unicode.IsSpace(r)
+}
@@ -0,0 +1,229 @@
+package pgschema
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Diff returns the SQL statements that migrate a database with the schema from
+// to the schema to. It returns the empty string if the schemas are the same.
+//
+// The statements are ordered so that objects are created before they're referenced:
+// enums first, then tables and columns, then indexes and constraints,
+// and finally the dropped objects.
+func Diff(from, to *Schema) string {
+	var (
+		create, alter, dropConstraints, addConstraints, foreignKeys, drop, dropEnums []string
+	)
+
+	// Enums
+	fromEnums := byName(from.Enums, func(e *Enum) string { return e.Name })
+	toEnums := byName(to.Enums, func(e *Enum) string { return e.Name })
+	for _, e := range to.Enums {
+		prev, ok := fromEnums[e.Name]
+		if !ok {
+			create = append(create, fmt.Sprintf("CREATE TYPE %s AS ENUM (%s);", e.Name, quoteLiterals(e.Values)))
+			continue
+		}
+		for _, v := range e.Values {
+			if !slices.Contains(prev.Values, v) {
+				create = append(create, fmt.Sprintf("ALTER TYPE %s ADD VALUE %s;", e.Name, quoteLiteral(v)))
+			}
+		}
+		for _, v := range prev.Values {
+			if !slices.Contains(e.Values, v) {
+				create = append(create, fmt.Sprintf("-- Postgres can't remove enum values: recreate the type %s to remove %s.", e.Name, quoteLiteral(v)))
+			}
+		}
+	}
+	for _, e := range from.Enums {
+		if _, ok := toEnums[e.Name]; !ok {
+			dropEnums = append(dropEnums, fmt.Sprintf("DROP TYPE %s;", e.Name))
+		}
+	}
+
+	// Tables
+	fromTables := byName(from.Tables, func(t *Table) string { return t.Name })
+	toTables := byName(to.Tables, func(t *Table) string { return t.Name })
+	for _, t := range to.Tables {
+		prev, ok := fromTables[t.Name]
+		if !ok {
+			create = append(create, createTable(t))
+			for _, idx := range t.Indexes {
+				addConstraints = append(addConstraints, idx.Def+";")
+			}
+			for _, c := range t.Constraints {
+				if c.ForeignKey {
+					foreignKeys = append(foreignKeys, addConstraint(t, c))
+				}
+			}
+			continue
+		}
+
+		// Columns
+		prevCols := byName(prev.Columns, func(c *Column) string { return c.Name })
+		cols := byName(t.Columns, func(c *Column) string { return c.Name })
+		for _, col := range t.Columns {
+			prevCol, ok := prevCols[col.Name]
+			if !ok {
+				alter = append(alter, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", t.Name, columnDef(t, col)))
+				continue
+			}
+			if col.Type != prevCol.Type {
+				alter = append(alter, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s USING %s::%s;", t.Name, col.Name, col.Type, col.Name, col.Type))
+			}
+			if col.Default != prevCol.Default {
+				if col.Default == "" {
+					alter = append(alter, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT;", t.Name, col.Name))
+				} else {
+					alter = append(alter, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET DEFAULT %s;", t.Name, col.Name, col.Default))
+				}
+			}
+			if col.NotNull != prevCol.NotNull {
+				if col.NotNull {
+					alter = append(alter, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET NOT NULL;", t.Name, col.Name))
+				} else {
+					alter = append(alter, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP NOT NULL;", t.Name, col.Name))
+				}
+			}
+		}
+		for _, col := range prev.Columns {
+			if _, ok := cols[col.Name]; !ok {
+				drop = append(drop, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", t.Name, col.Name))
+			}
+		}
+
+		// Constraints
+		prevCons := byName(prev.Constraints, func(c *Constraint) string { return c.Name })
+		cons := byName(t.Constraints, func(c *Constraint) string { return c.Name })
+		for _, c := range prev.Constraints {
+			if cur, ok := cons[c.Name]; !ok || cur.Def != c.Def {
+				dropConstraints = append(dropConstraints, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;", t.Name, c.Name))
+			}
+		}
+		for _, c := range t.Constraints {
+			if p, ok := prevCons[c.Name]; ok && p.Def == c.Def {
+				continue
+			}
+			if c.ForeignKey {
+				foreignKeys = append(foreignKeys, addConstraint(t, c))
+			} else {
+				addConstraints = append(addConstraints, addConstraint(t, c))
+			}
+		}
+
+		// Indexes
+		prevIdx := byName(prev.Indexes, func(i *Index) string { return i.Name })
+		idxs := byName(t.Indexes, func(i *Index) string { return i.Name })
+		for _, idx := range prev.Indexes {
+			if cur, ok := idxs[idx.Name]; !ok || cur.Def != idx.Def {
+				dropConstraints = append(dropConstraints, fmt.Sprintf("DROP INDEX %s;", idx.Name))
+			}
+		}
+		for _, idx := range t.Indexes {
+			if p, ok := prevIdx[idx.Name]; !ok || p.Def != idx.Def {
+				addConstraints = append(addConstraints, idx.Def+";")
+			}
+		}
+	}
+	for _, t := range from.Tables {
+		if _, ok := toTables[t.Name]; !ok {
+			drop = append(drop, fmt.Sprintf("DROP TABLE %s;", t.Name))
+		}
+	}
+
+	var stmts []string
+	for _, group := range [][]string{create, alter, dropConstraints, addConstraints, foreignKeys, drop, dropEnums} {
+		stmts = append(stmts, group...)
+	}
+	if len(stmts) == 0 {
+		return ""
+	}
+	return strings.Join(stmts, "\n") + "\n"
+}
+
+// createTable returns the CREATE TABLE statement for t, including
+// all constraints but foreign keys, which are added separately
+// since they may reference tables that are created later.
+func createTable(t *Table) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CREATE TABLE %s (", t.Name)
+	var elems []string
+	for _, col := range t.Columns {
+		elems = append(elems, columnDef(t, col))
+	}
+	for _, c := range t.Constraints {
+		if !c.ForeignKey {
+			elems = append(elems, fmt.Sprintf("CONSTRAINT %s %s", c.Name, c.Def))
+		}
+	}
+	for i, e := range elems {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString("\n    ")
+		b.WriteString(e)
+	}
+	b.WriteString("\n);")
+	return b.String()
+}
+
+func addConstraint(t *Table, c *Constraint) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s %s;", t.Name, c.Name, c.Def)
+}
+
+// serialTypes maps integer types to their serial counterparts.
+var serialTypes = map[string]string{
+	"smallint": "smallserial",
+	"integer":  "serial",
+	"bigint":   "bigserial",
+}
+
+var nextvalRe = regexp.MustCompile(`^nextval\('([^']+)'::regclass\)$`)
+
+// columnDef returns the definition of the column, as used by
+// CREATE TABLE and ALTER TABLE ADD COLUMN.
+func columnDef(t *Table, col *Column) string {
+	typ, def := col.Type, col.Default
+
+	// Columns using the sequence created by a serial type are defined as serial,
+	// since the sequence doesn't exist yet.
+	if m := nextvalRe.FindStringSubmatch(def); m != nil {
+		table := t.Name[strings.LastIndexByte(t.Name, '.')+1:]
+		seq := strings.Trim(table, `"`) + "_" + strings.Trim(col.Name, `"`) + "_seq"
+		if serial, ok := serialTypes[typ]; ok && strings.Trim(m[1][strings.LastIndexByte(m[1], '.')+1:], `"`) == seq {
+			typ, def = serial, ""
+		}
+	}
+
+	s := col.Name + " " + typ
+	if col.NotNull {
+		s += " NOT NULL"
+	}
+	if def != "" {
+		s += " DEFAULT " + def
+	}
+	return s
+}
+
+func byName[T any](items []T, name func(T) string) map[string]T {
+	m := make(map[string]T, len(items))
+	for _, it := range items {
+		m[name(it)] = it
+	}
+	return m
+}
+
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func quoteLiterals(vals []string) string {
+	quoted := make([]string, len(vals))
+	for i, v := range vals {
+		quoted[i] = quoteLiteral(v)
+	}
+	return strings.Join(quoted, ", ")
+}
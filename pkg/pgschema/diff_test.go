@@ -0,0 +1,109 @@
+package pgschema
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestDiff(t *testing.T) {
+	users := func(cols ...*Column) *Table {
+		return &Table{
+			Name: "users",
+			Columns: append([]*Column{
+				{Name: "id", Type: "bigint", NotNull: true, Default: "nextval('users_id_seq'::regclass)"},
+			}, cols...),
+			Constraints: []*Constraint{{Name: "users_pkey", Def: "PRIMARY KEY (id)"}},
+		}
+	}
+	posts := &Table{
+		Name: "posts",
+		Columns: []*Column{
+			{Name: "id", Type: "integer", NotNull: true, Default: "nextval('posts_id_seq'::regclass)"},
+			{Name: "author_id", Type: "bigint", NotNull: true},
+		},
+		Constraints: []*Constraint{
+			{Name: "posts_author_id_fkey", Def: "FOREIGN KEY (author_id) REFERENCES users(id)", ForeignKey: true},
+		},
+		Indexes: []*Index{{Name: "posts_author_idx", Def: "CREATE INDEX posts_author_idx ON public.posts USING btree (author_id)"}},
+	}
+
+	tests := []struct {
+		name     string
+		from, to *Schema
+		want     string
+	}{
+		{
+			name: "same",
+			from: &Schema{Tables: []*Table{users()}},
+			to:   &Schema{Tables: []*Table{users()}},
+			want: "",
+		},
+		{
+			name: "create_table",
+			from: &Schema{Tables: []*Table{users()}},
+			to:   &Schema{Tables: []*Table{users(), posts}},
+			want: `CREATE TABLE posts (
+    id serial NOT NULL,
+    author_id bigint NOT NULL
+);
+CREATE INDEX posts_author_idx ON public.posts USING btree (author_id);
+ALTER TABLE posts ADD CONSTRAINT posts_author_id_fkey FOREIGN KEY (author_id) REFERENCES users(id);
+`,
+		},
+		{
+			name: "drop_table",
+			from: &Schema{Tables: []*Table{users(), posts}},
+			to:   &Schema{Tables: []*Table{users()}},
+			want: "DROP TABLE posts;\n",
+		},
+		{
+			name: "columns",
+			from: &Schema{Tables: []*Table{users(
+				&Column{Name: "name", Type: "text"},
+				&Column{Name: "age", Type: "integer"},
+			)}},
+			to: &Schema{Tables: []*Table{users(
+				&Column{Name: "name", Type: "character varying(100)", NotNull: true, Default: "''::character varying"},
+				&Column{Name: "email", Type: "text"},
+			)}},
+			want: `ALTER TABLE users ALTER COLUMN name TYPE character varying(100) USING name::character varying(100);
+ALTER TABLE users ALTER COLUMN name SET DEFAULT ''::character varying;
+ALTER TABLE users ALTER COLUMN name SET NOT NULL;
+ALTER TABLE users ADD COLUMN email text;
+ALTER TABLE users DROP COLUMN age;
+`,
+		},
+		{
+			name: "enums",
+			from: &Schema{Enums: []*Enum{{Name: "mood", Values: []string{"sad", "ok"}}, {Name: "old", Values: []string{"a"}}}},
+			to:   &Schema{Enums: []*Enum{{Name: "mood", Values: []string{"sad", "ok", "happy"}}, {Name: "color", Values: []string{"red", "it's"}}}},
+			want: `ALTER TYPE mood ADD VALUE 'happy';
+CREATE TYPE color AS ENUM ('red', 'it''s');
+DROP TYPE old;
+`,
+		},
+		{
+			name: "changed_constraint",
+			from: &Schema{Tables: []*Table{{
+				Name:        "t",
+				Columns:     []*Column{{Name: "n", Type: "integer"}},
+				Constraints: []*Constraint{{Name: "t_n_check", Def: "CHECK ((n > 0))"}},
+			}}},
+			to: &Schema{Tables: []*Table{{
+				Name:        "t",
+				Columns:     []*Column{{Name: "n", Type: "integer"}},
+				Constraints: []*Constraint{{Name: "t_n_check", Def: "CHECK ((n >= 0))"}},
+			}}},
+			want: `ALTER TABLE t DROP CONSTRAINT t_n_check;
+ALTER TABLE t ADD CONSTRAINT t_n_check CHECK ((n >= 0));
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := qt.New(t)
+			c.Assert(Diff(tt.from, tt.to), qt.Equals, tt.want)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Package pgschema loads the schema of a Postgres database
+// and computes the DDL statements to migrate between two schemas.
+package pgschema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Schema is the schema of a database.
+//
+// It's limited to what's commonly created by migrations:
+// tables, their columns, constraints and indexes, and enum types.
+type Schema struct {
+	Tables []*Table
+	Enums  []*Enum
+}
+
+// Table is a table in the database.
+type Table struct {
+	Name        string // the quoted, schema-qualified name ("public" is omitted)
+	Columns     []*Column
+	Constraints []*Constraint
+	Indexes     []*Index
+}
+
+// Column is a column of a table.
+type Column struct {
+	Name    string // the quoted name
+	Type    string
+	NotNull bool
+	Default string // empty if the column has no default
+}
+
+// Constraint is a table constraint, like a primary or foreign key.
+type Constraint struct {
+	Name       string // the quoted name
+	Def        string // the definition, as reported by pg_get_constraintdef
+	ForeignKey bool
+}
+
+// Index is an index that doesn't back a constraint.
+type Index struct {
+	Name string // the quoted, schema-qualified name
+	Def  string // the definition, as reported by pg_get_indexdef
+}
+
+// Enum is an enum type.
+type Enum struct {
+	Name   string // the quoted, schema-qualified name
+	Values []string
+}
+
+// ignoredTables are the tables used to track the applied migrations,
+// which are not part of an app's schema.
+var ignoredTables = []string{"schema_migrations", "encore_data_migrations"}
+
+// qualifiedName returns the SQL expression for the quoted name of an object,
+// omitting the "public" schema.
+func qualifiedName(nsp, name string) string {
+	return fmt.Sprintf("CASE WHEN %[1]s = 'public' THEN quote_ident(%[2]s) ELSE quote_ident(%[1]s) || '.' || quote_ident(%[2]s) END", nsp, name)
+}
+
+const userSchemas = `n.nspname NOT IN ('pg_catalog', 'information_schema') AND n.nspname NOT LIKE 'pg\_toast%' AND n.nspname NOT LIKE 'pg\_temp%'`
+
+// Load loads the schema of the database conn is connected to.
+func Load(ctx context.Context, conn *pgx.Conn) (*Schema, error) {
+	s := &Schema{}
+
+	// Tables
+	rows, err := conn.Query(ctx, `
+		SELECT c.oid, `+qualifiedName("n.nspname", "c.relname")+`, c.relname
+		FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE c.relkind IN ('r', 'p') AND NOT c.relispartition AND `+userSchemas+`
+		ORDER BY 2`)
+	if err != nil {
+		return nil, fmt.Errorf("query tables: %v", err)
+	}
+	tables := make(map[uint32]*Table)
+	var oids []uint32
+	err = forEachRow(rows, func() error {
+		var (
+			oid        uint32
+			name, base string
+		)
+		if err := rows.Scan(&oid, &name, &base); err != nil {
+			return err
+		}
+		if slices.Contains(ignoredTables, base) {
+			return nil
+		}
+		t := &Table{Name: name}
+		s.Tables = append(s.Tables, t)
+		tables[oid] = t
+		oids = append(oids, oid)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query tables: %v", err)
+	}
+
+	// Columns
+	rows, err = conn.Query(ctx, `
+		SELECT a.attrelid, quote_ident(a.attname), format_type(a.atttypid, a.atttypmod),
+			a.attnotnull, coalesce(pg_get_expr(d.adbin, d.adrelid), '')
+		FROM pg_attribute a
+		LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+		WHERE a.attrelid = ANY($1) AND a.attnum > 0 AND NOT a.attisdropped
+		ORDER BY a.attrelid, a.attnum`, oids)
+	if err != nil {
+		return nil, fmt.Errorf("query columns: %v", err)
+	}
+	err = forEachRow(rows, func() error {
+		var (
+			oid uint32
+			col Column
+		)
+		if err := rows.Scan(&oid, &col.Name, &col.Type, &col.NotNull, &col.Default); err != nil {
+			return err
+		}
+		tables[oid].Columns = append(tables[oid].Columns, &col)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query columns: %v", err)
+	}
+
+	// Constraints. NOT NULL constraints are part of the columns.
+	rows, err = conn.Query(ctx, `
+		SELECT conrelid, quote_ident(conname), pg_get_constraintdef(oid), contype = 'f'
+		FROM pg_constraint
+		WHERE conrelid = ANY($1) AND contype IN ('p', 'u', 'f', 'c', 'x')
+		ORDER BY conrelid, conname`, oids)
+	if err != nil {
+		return nil, fmt.Errorf("query constraints: %v", err)
+	}
+	err = forEachRow(rows, func() error {
+		var (
+			oid uint32
+			c   Constraint
+		)
+		if err := rows.Scan(&oid, &c.Name, &c.Def, &c.ForeignKey); err != nil {
+			return err
+		}
+		tables[oid].Constraints = append(tables[oid].Constraints, &c)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query constraints: %v", err)
+	}
+
+	// Indexes, except the ones created by constraints.
+	rows, err = conn.Query(ctx, `
+		SELECT i.indrelid, `+qualifiedName("n.nspname", "c.relname")+`, pg_get_indexdef(i.indexrelid)
+		FROM pg_index i
+		JOIN pg_class c ON c.oid = i.indexrelid
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE i.indrelid = ANY($1) AND NOT EXISTS (
+			SELECT 1 FROM pg_constraint con
+			WHERE con.conindid = i.indexrelid AND con.contype IN ('p', 'u', 'x')
+		)
+		ORDER BY i.indrelid, 2`, oids)
+	if err != nil {
+		return nil, fmt.Errorf("query indexes: %v", err)
+	}
+	err = forEachRow(rows, func() error {
+		var (
+			oid uint32
+			idx Index
+		)
+		if err := rows.Scan(&oid, &idx.Name, &idx.Def); err != nil {
+			return err
+		}
+		tables[oid].Indexes = append(tables[oid].Indexes, &idx)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query indexes: %v", err)
+	}
+
+	// Enums
+	rows, err = conn.Query(ctx, `
+		SELECT `+qualifiedName("n.nspname", "t.typname")+`, array_agg(e.enumlabel ORDER BY e.enumsortorder)
+		FROM pg_type t
+		JOIN pg_namespace n ON n.oid = t.typnamespace
+		JOIN pg_enum e ON e.enumtypid = t.oid
+		WHERE `+userSchemas+`
+		GROUP BY 1
+		ORDER BY 1`)
+	if err != nil {
+		return nil, fmt.Errorf("query enums: %v", err)
+	}
+	err = forEachRow(rows, func() error {
+		var e Enum
+		if err := rows.Scan(&e.Name, &e.Values); err != nil {
+			return err
+		}
+		s.Enums = append(s.Enums, &e)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query enums: %v", err)
+	}
+
+	return s, nil
+}
+
+func forEachRow(rows pgx.Rows, fn func() error) error {
+	defer rows.Close()
+	for rows.Next() {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
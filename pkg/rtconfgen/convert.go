@@ -203,12 +203,18 @@ func (c *legacyConverter) Convert() (*config.Runtime, error) {
 					if primary.TlsConfig != nil {
 						candidateServer.ServerCACert = primary.TlsConfig.GetServerCaCert()
 					}
+					for _, srv := range cluster.Servers {
+						if srv.Kind == runtimev1.ServerKind_SERVER_KIND_READ_REPLICA {
+							candidateServer.ReadReplicas = append(candidateServer.ReadReplicas, srv.Host)
+						}
+					}
 
 					serverIdx := slices.IndexFunc(cfg.SQLServers, func(s *config.SQLServer) bool {
 						return s.Host == candidateServer.Host &&
 							s.ServerCACert == candidateServer.ServerCACert &&
 							s.ClientCert == candidateServer.ClientCert &&
-							s.ClientKey == candidateServer.ClientKey
+							s.ClientKey == candidateServer.ClientKey &&
+							slices.Equal(s.ReadReplicas, candidateServer.ReadReplicas)
 					})
 					if serverIdx == -1 {
 						serverIdx = len(cfg.SQLServers)
@@ -379,6 +385,7 @@ func (c *legacyConverter) Convert() (*config.Runtime, error) {
 						EncoreName:   sub.SubscriptionEncoreName,
 						ProviderName: sub.SubscriptionCloudName,
 						PushOnly:     sub.PushOnly,
+						DeadLetter:   sub.GetDeadLetterCloudName(),
 						GCP: func() *config.PubsubSubscriptionGCPData {
 							switch pc := sub.ProviderConfig.(type) {
 							case *runtimev1.PubSubSubscription_GcpConfig:
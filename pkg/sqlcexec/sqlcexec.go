@@ -0,0 +1,75 @@
+// Package sqlcexec runs sqlc through the hidden 'encore sqlc' command.
+//
+// sqlc exits the process when it fails, so it can't run within the daemon.
+// Instead it's run in a child process of the encore binary.
+package sqlcexec
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"encr.dev/internal/env"
+)
+
+// BinaryEnv is the environment variable that overrides
+// the encore binary used to run sqlc.
+const BinaryEnv = "ENCORE_BINARY"
+
+// encoreBinary is the path to the encore binary, as set by SetEncoreBinary.
+var encoreBinary string
+
+// SetEncoreBinary sets the path to the encore binary used to run sqlc.
+// It must be called before Run, and is set by the encore command to its own executable.
+func SetEncoreBinary(path string) {
+	encoreBinary = path
+}
+
+// Run runs sqlc with the given arguments in a child process.
+// It reports what sqlc wrote to stderr and its exit code.
+func Run(ctx context.Context, args ...string) (stderr []byte, code int, err error) {
+	exe, err := binary()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var buf bytes.Buffer
+	cmd := exec.CommandContext(ctx, exe, append([]string{"sqlc"}, args...)...)
+	cmd.Stderr = &buf
+	err = cmd.Run()
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return buf.Bytes(), exitErr.ExitCode(), nil
+	} else if err != nil {
+		return nil, 0, fmt.Errorf("run sqlc: %v", err)
+	}
+	return buf.Bytes(), 0, nil
+}
+
+// binary reports the path to the encore binary to run sqlc with.
+func binary() (string, error) {
+	if p := os.Getenv(BinaryEnv); p != "" {
+		return p, nil
+	} else if encoreBinary != "" {
+		return encoreBinary, nil
+	}
+
+	// Check the encore bin directory.
+	if bin, ok := env.EncoreBin().Get(); ok {
+		candidate := filepath.Join(bin, "encore")
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+
+	// Now default to the path.
+	if p, err := exec.LookPath("encore"); err == nil {
+		return p, nil
+	}
+	return "", fmt.Errorf("could not find the encore binary to run sqlc with; set %s to its path", BinaryEnv)
+}
@@ -86,6 +86,13 @@ func (p *SvcProxy) RegisterService(name string, addr netip.AddrPort) string {
 
 	p.services[name] = p.createReverseProxy("service", name, addr)
 
+	return p.ServiceBaseURL(name)
+}
+
+// ServiceBaseURL returns the BaseURL to be used to access the service.
+// Registering the service again with a new address keeps the BaseURL the same,
+// so existing clients switch over to the new address.
+func (p *SvcProxy) ServiceBaseURL(name string) string {
 	return fmt.Sprintf("http://%s/service/%s", p.listener.Addr().String(), name)
 }
 
@@ -206,3 +206,10 @@ func (tr versionFilterReader) Bool(defaultForOlderVersions bool) bool {
 	}
 	return tr.traceReader.Bool()
 }
+
+func (tr versionFilterReader) String(defaultForOlderVersions string) string {
+	if tr.filtered {
+		return defaultForOlderVersions
+	}
+	return tr.traceReader.String()
+}
@@ -276,6 +276,16 @@ func (tp *traceParser) spanEvent(eventType trace2.EventType) *tracepb2.SpanEvent
 		ev.Data = &tracepb2.SpanEvent_BucketDeleteObjectsStart{BucketDeleteObjectsStart: tp.bucketDeleteObjectsStart()}
 	case trace2.BucketDeleteObjectsEnd:
 		ev.Data = &tracepb2.SpanEvent_BucketDeleteObjectsEnd{BucketDeleteObjectsEnd: tp.bucketDeleteObjectsEnd()}
+	case trace2.FaultInjected:
+		ev.Data = &tracepb2.SpanEvent_FaultInjected{FaultInjected: tp.faultInjected()}
+	case trace2.BucketObjectCopyStart:
+		ev.Data = &tracepb2.SpanEvent_BucketObjectCopyStart{BucketObjectCopyStart: tp.bucketObjectCopyStart()}
+	case trace2.BucketObjectCopyEnd:
+		ev.Data = &tracepb2.SpanEvent_BucketObjectCopyEnd{BucketObjectCopyEnd: tp.bucketObjectCopyEnd()}
+	case trace2.BucketObjectMoveStart:
+		ev.Data = &tracepb2.SpanEvent_BucketObjectMoveStart{BucketObjectMoveStart: tp.bucketObjectMoveStart()}
+	case trace2.BucketObjectMoveEnd:
+		ev.Data = &tracepb2.SpanEvent_BucketObjectMoveEnd{BucketObjectMoveEnd: tp.bucketObjectMoveEnd()}
 
 	default:
 		tp.bailout(fmt.Errorf("unknown event %v", eventType))
@@ -512,6 +522,15 @@ func (tp *traceParser) dbQueryStart() *tracepb2.DBQueryStart {
 	return &tracepb2.DBQueryStart{
 		Query: tp.String(),
 		Stack: tp.stack(),
+		Host: (func() *string {
+			if tp.version >= 18 {
+				if host := tp.String(); host != "" {
+					return &host
+				}
+			}
+			return nil
+		})(),
+		Replica: tp.FromVer(18).Bool(false),
 	}
 }
 
@@ -575,6 +594,8 @@ func (tp *traceParser) httpCallStart() *tracepb2.HTTPCallStart {
 		Url:                     tp.String(),
 		Stack:                   tp.stack(),
 		StartNanotime:           tp.Int64(),
+		ExternalApi:             tp.FromVer(19).String(""),
+		ExternalOperation:       tp.FromVer(19).String(""),
 	}
 }
 
@@ -697,6 +718,18 @@ func (tp *traceParser) bucketDeleteObjectsEnd() *tracepb2.BucketDeleteObjectsEnd
 	}
 }
 
+func (tp *traceParser) faultInjected() *tracepb2.FaultInjected {
+	return &tracepb2.FaultInjected{
+		Kind:         tp.String(),
+		Service:      tp.String(),
+		Endpoint:     tp.String(),
+		Resource:     tp.String(),
+		LatencyNanos: uint64(tp.Duration()),
+		Err:          tp.errWithStack(),
+		Stack:        tp.stack(),
+	}
+}
+
 func (tp *traceParser) bucketListObjectsStart() *tracepb2.BucketListObjectsStart {
 	return &tracepb2.BucketListObjectsStart{
 		Bucket: tp.String(),
@@ -734,6 +767,50 @@ func (tp *traceParser) bucketObjectGetAttrsEnd() *tracepb2.BucketObjectGetAttrsE
 	return ev
 }
 
+func (tp *traceParser) bucketObjectCopyStart() *tracepb2.BucketObjectCopyStart {
+	return &tracepb2.BucketObjectCopyStart{
+		Bucket:     tp.String(),
+		Src:        tp.String(),
+		Dst:        tp.String(),
+		SrcVersion: tp.OptString(),
+		Stack:      tp.stack(),
+	}
+}
+
+func (tp *traceParser) bucketObjectCopyEnd() *tracepb2.BucketObjectCopyEnd {
+	ev := &tracepb2.BucketObjectCopyEnd{
+		Err: tp.errWithStack(),
+	}
+
+	if ev.Err == nil {
+		ev.Attrs = tp.bucketObjectAttrs()
+	}
+
+	return ev
+}
+
+func (tp *traceParser) bucketObjectMoveStart() *tracepb2.BucketObjectMoveStart {
+	return &tracepb2.BucketObjectMoveStart{
+		Bucket:     tp.String(),
+		Src:        tp.String(),
+		Dst:        tp.String(),
+		SrcVersion: tp.OptString(),
+		Stack:      tp.stack(),
+	}
+}
+
+func (tp *traceParser) bucketObjectMoveEnd() *tracepb2.BucketObjectMoveEnd {
+	ev := &tracepb2.BucketObjectMoveEnd{
+		Err: tp.errWithStack(),
+	}
+
+	if ev.Err == nil {
+		ev.Attrs = tp.bucketObjectAttrs()
+	}
+
+	return ev
+}
+
 func (tp *traceParser) bodyStream() *tracepb2.BodyStream {
 	flags := tp.Byte()
 	data := tp.ByteString()
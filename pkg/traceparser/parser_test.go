@@ -377,6 +377,33 @@ func TestParse(t *testing.T) {
 			},
 		},
 
+		{
+			Name: "DBQueryStartReplica",
+			Emit: func(l *trace2.Log) {
+				l.DBQueryStart(trace2.DBQueryStartParams{
+					EventParams: ep,
+					Query:       "query",
+					Host:        "replica-1:5432",
+					Replica:     true,
+				})
+			},
+			Want: &tracepb2.TraceEvent{
+				TraceId: pbTraceID,
+				SpanId:  pbSpanID,
+				Event: &tracepb2.TraceEvent_SpanEvent{SpanEvent: &tracepb2.SpanEvent{
+					Goid:   goid,
+					DefLoc: &udefLoc,
+					Data: &tracepb2.SpanEvent_DbQueryStart{
+						DbQueryStart: &tracepb2.DBQueryStart{
+							Query:   "query",
+							Host:    ptr("replica-1:5432"),
+							Replica: true,
+						},
+					},
+				}},
+			},
+		},
+
 		{
 			Name: "DBQueryEnd",
 			Emit: func(l *trace2.Log) {
@@ -601,6 +628,118 @@ func TestParse(t *testing.T) {
 			},
 		},
 
+		{
+			Name: "BucketObjectCopyStart",
+			Emit: func(l *trace2.Log) {
+				l.BucketObjectCopyStart(trace2.BucketObjectCopyStartParams{
+					EventParams: ep,
+					Bucket:      "bucket",
+					Src:         "src",
+					Dst:         "dst",
+					SrcVersion:  ptr("v1"),
+				})
+			},
+			Want: &tracepb2.TraceEvent{
+				TraceId: pbTraceID,
+				SpanId:  pbSpanID,
+				Event: &tracepb2.TraceEvent_SpanEvent{SpanEvent: &tracepb2.SpanEvent{
+					Goid:   goid,
+					DefLoc: &udefLoc,
+					Data: &tracepb2.SpanEvent_BucketObjectCopyStart{
+						BucketObjectCopyStart: &tracepb2.BucketObjectCopyStart{
+							Bucket:     "bucket",
+							Src:        "src",
+							Dst:        "dst",
+							SrcVersion: ptr("v1"),
+						},
+					},
+				}},
+			},
+		},
+
+		{
+			Name: "BucketObjectCopyEnd",
+			Emit: func(l *trace2.Log) {
+				l.BucketObjectCopyEnd(trace2.BucketObjectCopyEndParams{
+					EventParams: ep,
+					StartID:     1,
+					Attrs: &trace2.BucketObjectAttributes{
+						Size: ptr[uint64](5),
+						ETag: ptr("etag"),
+					},
+				})
+			},
+			Want: &tracepb2.TraceEvent{
+				TraceId: pbTraceID,
+				SpanId:  pbSpanID,
+				Event: &tracepb2.TraceEvent_SpanEvent{SpanEvent: &tracepb2.SpanEvent{
+					Goid:               goid,
+					DefLoc:             &udefLoc,
+					CorrelationEventId: ptr[uint64](1),
+					Data: &tracepb2.SpanEvent_BucketObjectCopyEnd{
+						BucketObjectCopyEnd: &tracepb2.BucketObjectCopyEnd{
+							Attrs: &tracepb2.BucketObjectAttributes{
+								Size: ptr[uint64](5),
+								Etag: ptr("etag"),
+							},
+						},
+					},
+				}},
+			},
+		},
+
+		{
+			Name: "BucketObjectMoveStart",
+			Emit: func(l *trace2.Log) {
+				l.BucketObjectMoveStart(trace2.BucketObjectMoveStartParams{
+					EventParams: ep,
+					Bucket:      "bucket",
+					Src:         "src",
+					Dst:         "dst",
+				})
+			},
+			Want: &tracepb2.TraceEvent{
+				TraceId: pbTraceID,
+				SpanId:  pbSpanID,
+				Event: &tracepb2.TraceEvent_SpanEvent{SpanEvent: &tracepb2.SpanEvent{
+					Goid:   goid,
+					DefLoc: &udefLoc,
+					Data: &tracepb2.SpanEvent_BucketObjectMoveStart{
+						BucketObjectMoveStart: &tracepb2.BucketObjectMoveStart{
+							Bucket: "bucket",
+							Src:    "src",
+							Dst:    "dst",
+						},
+					},
+				}},
+			},
+		},
+
+		{
+			Name: "BucketObjectMoveEnd",
+			Emit: func(l *trace2.Log) {
+				l.BucketObjectMoveEnd(trace2.BucketObjectMoveEndParams{
+					EventParams: ep,
+					StartID:     1,
+					Err:         err,
+				})
+			},
+			Want: &tracepb2.TraceEvent{
+				TraceId: pbTraceID,
+				SpanId:  pbSpanID,
+				Event: &tracepb2.TraceEvent_SpanEvent{SpanEvent: &tracepb2.SpanEvent{
+					Goid:               goid,
+					DefLoc:             &udefLoc,
+					CorrelationEventId: ptr[uint64](1),
+					Data: &tracepb2.SpanEvent_BucketObjectMoveEnd{
+						BucketObjectMoveEnd: &tracepb2.BucketObjectMoveEnd{
+							Err: pbErr,
+						},
+					},
+				}},
+			},
+		},
+
 		{
 			Name: "LogMessage",
 			Emit: func(l *trace2.Log) {
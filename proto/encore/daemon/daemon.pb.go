@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -227,6 +228,107 @@ func (RunRequest_DebugMode) EnumDescriptor() ([]byte, []int) {
 	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{6, 1}
 }
 
+type DBMigrateRequest_Action int32
+
+const (
+	DBMigrateRequest_ACTION_STATUS DBMigrateRequest_Action = 0
+	DBMigrateRequest_ACTION_UP     DBMigrateRequest_Action = 1
+	DBMigrateRequest_ACTION_DOWN   DBMigrateRequest_Action = 2
+	DBMigrateRequest_ACTION_FORCE  DBMigrateRequest_Action = 3
+)
+
+// Enum value maps for DBMigrateRequest_Action.
+var (
+	DBMigrateRequest_Action_name = map[int32]string{
+		0: "ACTION_STATUS",
+		1: "ACTION_UP",
+		2: "ACTION_DOWN",
+		3: "ACTION_FORCE",
+	}
+	DBMigrateRequest_Action_value = map[string]int32{
+		"ACTION_STATUS": 0,
+		"ACTION_UP":     1,
+		"ACTION_DOWN":   2,
+		"ACTION_FORCE":  3,
+	}
+)
+
+func (x DBMigrateRequest_Action) Enum() *DBMigrateRequest_Action {
+	p := new(DBMigrateRequest_Action)
+	*p = x
+	return p
+}
+
+func (x DBMigrateRequest_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DBMigrateRequest_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_encore_daemon_daemon_proto_enumTypes[4].Descriptor()
+}
+
+func (DBMigrateRequest_Action) Type() protoreflect.EnumType {
+	return &file_encore_daemon_daemon_proto_enumTypes[4]
+}
+
+func (x DBMigrateRequest_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DBMigrateRequest_Action.Descriptor instead.
+func (DBMigrateRequest_Action) EnumDescriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{21, 0}
+}
+
+type DBSnapshotRequest_Action int32
+
+const (
+	DBSnapshotRequest_ACTION_LIST    DBSnapshotRequest_Action = 0
+	DBSnapshotRequest_ACTION_SAVE    DBSnapshotRequest_Action = 1
+	DBSnapshotRequest_ACTION_RESTORE DBSnapshotRequest_Action = 2
+)
+
+// Enum value maps for DBSnapshotRequest_Action.
+var (
+	DBSnapshotRequest_Action_name = map[int32]string{
+		0: "ACTION_LIST",
+		1: "ACTION_SAVE",
+		2: "ACTION_RESTORE",
+	}
+	DBSnapshotRequest_Action_value = map[string]int32{
+		"ACTION_LIST":    0,
+		"ACTION_SAVE":    1,
+		"ACTION_RESTORE": 2,
+	}
+)
+
+func (x DBSnapshotRequest_Action) Enum() *DBSnapshotRequest_Action {
+	p := new(DBSnapshotRequest_Action)
+	*p = x
+	return p
+}
+
+func (x DBSnapshotRequest_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DBSnapshotRequest_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_encore_daemon_daemon_proto_enumTypes[5].Descriptor()
+}
+
+func (DBSnapshotRequest_Action) Type() protoreflect.EnumType {
+	return &file_encore_daemon_daemon_proto_enumTypes[5]
+}
+
+func (x DBSnapshotRequest_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DBSnapshotRequest_Action.Descriptor instead.
+func (DBSnapshotRequest_Action) EnumDescriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{26, 0}
+}
+
 type DumpMetaRequest_Format int32
 
 const (
@@ -260,11 +362,11 @@ func (x DumpMetaRequest_Format) String() string {
 }
 
 func (DumpMetaRequest_Format) Descriptor() protoreflect.EnumDescriptor {
-	return file_encore_daemon_daemon_proto_enumTypes[4].Descriptor()
+	return file_encore_daemon_daemon_proto_enumTypes[6].Descriptor()
 }
 
 func (DumpMetaRequest_Format) Type() protoreflect.EnumType {
-	return &file_encore_daemon_daemon_proto_enumTypes[4]
+	return &file_encore_daemon_daemon_proto_enumTypes[6]
 }
 
 func (x DumpMetaRequest_Format) Number() protoreflect.EnumNumber {
@@ -273,7 +375,7 @@ func (x DumpMetaRequest_Format) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DumpMetaRequest_Format.Descriptor instead.
 func (DumpMetaRequest_Format) EnumDescriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32, 0}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{67, 0}
 }
 
 type CommandMessage struct {
@@ -775,7 +877,9 @@ type TestRequest struct {
 	CodegenDebug bool `protobuf:"varint,7,opt,name=codegen_debug,json=codegenDebug,proto3" json:"codegen_debug,omitempty"`
 	// temp_dir is a temp dir that will be cleaned up after tests have been executed
 	// to write things like app meta and runtime config etc.
-	TempDir       string `protobuf:"bytes,8,opt,name=temp_dir,json=tempDir,proto3" json:"temp_dir,omitempty"`
+	TempDir string `protobuf:"bytes,8,opt,name=temp_dir,json=tempDir,proto3" json:"temp_dir,omitempty"`
+	// chaos, if true, applies the app's fault injection rules for tests.
+	Chaos         bool `protobuf:"varint,9,opt,name=chaos,proto3" json:"chaos,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -859,6 +963,212 @@ func (x *TestRequest) GetTempDir() string {
 	return ""
 }
 
+func (x *TestRequest) GetChaos() bool {
+	if x != nil {
+		return x.Chaos
+	}
+	return false
+}
+
+type TestTracesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	// since, if set, only includes tests that started at or after this time.
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestTracesRequest) Reset() {
+	*x = TestTracesRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestTracesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestTracesRequest) ProtoMessage() {}
+
+func (x *TestTracesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestTracesRequest.ProtoReflect.Descriptor instead.
+func (*TestTracesRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TestTracesRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *TestTracesRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+type TestTracesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Traces        []*TestTrace           `protobuf:"bytes,1,rep,name=traces,proto3" json:"traces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestTracesResponse) Reset() {
+	*x = TestTracesResponse{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestTracesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestTracesResponse) ProtoMessage() {}
+
+func (x *TestTracesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestTracesResponse.ProtoReflect.Descriptor instead.
+func (*TestTracesResponse) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TestTracesResponse) GetTraces() []*TestTrace {
+	if x != nil {
+		return x.Traces
+	}
+	return nil
+}
+
+// TestTrace is the trace recorded by a top-level test.
+// Subtests are recorded as part of their parent test's trace.
+type TestTrace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TraceId       string                 `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	TestName      string                 `protobuf:"bytes,2,opt,name=test_name,json=testName,proto3" json:"test_name,omitempty"`
+	TestFile      string                 `protobuf:"bytes,3,opt,name=test_file,json=testFile,proto3" json:"test_file,omitempty"` // relative to the app root
+	Service       string                 `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	Failed        bool                   `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped       bool                   `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	TraceUrl      string                 `protobuf:"bytes,8,opt,name=trace_url,json=traceUrl,proto3" json:"trace_url,omitempty"` // url to the trace in the local development dashboard
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestTrace) Reset() {
+	*x = TestTrace{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestTrace) ProtoMessage() {}
+
+func (x *TestTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestTrace.ProtoReflect.Descriptor instead.
+func (*TestTrace) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TestTrace) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *TestTrace) GetTestName() string {
+	if x != nil {
+		return x.TestName
+	}
+	return ""
+}
+
+func (x *TestTrace) GetTestFile() string {
+	if x != nil {
+		return x.TestFile
+	}
+	return ""
+}
+
+func (x *TestTrace) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *TestTrace) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+func (x *TestTrace) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *TestTrace) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *TestTrace) GetTraceUrl() string {
+	if x != nil {
+		return x.TraceUrl
+	}
+	return ""
+}
+
 type TestSpecRequest struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
 	AppRoot    string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
@@ -869,14 +1179,16 @@ type TestSpecRequest struct {
 	Environ []string `protobuf:"bytes,4,rep,name=environ,proto3" json:"environ,omitempty"`
 	// temp_dir is a temp dir that will be cleaned up after tests have been executed
 	// to write things like app meta and runtime config etc.
-	TempDir       string `protobuf:"bytes,5,opt,name=temp_dir,json=tempDir,proto3" json:"temp_dir,omitempty"`
+	TempDir string `protobuf:"bytes,5,opt,name=temp_dir,json=tempDir,proto3" json:"temp_dir,omitempty"`
+	// chaos, if true, applies the app's fault injection rules for tests.
+	Chaos         bool `protobuf:"varint,6,opt,name=chaos,proto3" json:"chaos,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TestSpecRequest) Reset() {
 	*x = TestSpecRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[8]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -888,7 +1200,7 @@ func (x *TestSpecRequest) String() string {
 func (*TestSpecRequest) ProtoMessage() {}
 
 func (x *TestSpecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[8]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,7 +1213,7 @@ func (x *TestSpecRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestSpecRequest.ProtoReflect.Descriptor instead.
 func (*TestSpecRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{8}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TestSpecRequest) GetAppRoot() string {
@@ -939,6 +1251,13 @@ func (x *TestSpecRequest) GetTempDir() string {
 	return ""
 }
 
+func (x *TestSpecRequest) GetChaos() bool {
+	if x != nil {
+		return x.Chaos
+	}
+	return false
+}
+
 type TestSpecResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Command       string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
@@ -950,7 +1269,7 @@ type TestSpecResponse struct {
 
 func (x *TestSpecResponse) Reset() {
 	*x = TestSpecResponse{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[9]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -962,7 +1281,7 @@ func (x *TestSpecResponse) String() string {
 func (*TestSpecResponse) ProtoMessage() {}
 
 func (x *TestSpecResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[9]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,7 +1294,7 @@ func (x *TestSpecResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestSpecResponse.ProtoReflect.Descriptor instead.
 func (*TestSpecResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{9}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TestSpecResponse) GetCommand() string {
@@ -1019,7 +1338,7 @@ type ExecScriptRequest struct {
 
 func (x *ExecScriptRequest) Reset() {
 	*x = ExecScriptRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[10]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1031,7 +1350,7 @@ func (x *ExecScriptRequest) String() string {
 func (*ExecScriptRequest) ProtoMessage() {}
 
 func (x *ExecScriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[10]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,7 +1363,7 @@ func (x *ExecScriptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecScriptRequest.ProtoReflect.Descriptor instead.
 func (*ExecScriptRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{10}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ExecScriptRequest) GetAppRoot() string {
@@ -1099,14 +1418,20 @@ type CheckRequest struct {
 	ParseTests bool `protobuf:"varint,4,opt,name=parse_tests,json=parseTests,proto3" json:"parse_tests,omitempty"`
 	// environ is the environment to set for the running command.
 	// Each entry is a string in the format "KEY=VALUE", identical to os.Environ().
-	Environ       []string `protobuf:"bytes,5,rep,name=environ,proto3" json:"environ,omitempty"`
+	Environ []string `protobuf:"bytes,5,rep,name=environ,proto3" json:"environ,omitempty"`
+	// migrations_base is the git revision to compare database migrations against.
+	// Migrations added or modified since then are checked for unsafe operations.
+	MigrationsBase string `protobuf:"bytes,6,opt,name=migrations_base,json=migrationsBase,proto3" json:"migrations_base,omitempty"`
+	// api_compat_base, if set, is the git revision to compare the app's API against.
+	// Changes that break existing clients are reported as errors.
+	ApiCompatBase string `protobuf:"bytes,7,opt,name=api_compat_base,json=apiCompatBase,proto3" json:"api_compat_base,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CheckRequest) Reset() {
 	*x = CheckRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[11]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1118,7 +1443,7 @@ func (x *CheckRequest) String() string {
 func (*CheckRequest) ProtoMessage() {}
 
 func (x *CheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[11]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1456,7 @@ func (x *CheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
 func (*CheckRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{11}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CheckRequest) GetAppRoot() string {
@@ -1169,6 +1494,20 @@ func (x *CheckRequest) GetEnviron() []string {
 	return nil
 }
 
+func (x *CheckRequest) GetMigrationsBase() string {
+	if x != nil {
+		return x.MigrationsBase
+	}
+	return ""
+}
+
+func (x *CheckRequest) GetApiCompatBase() string {
+	if x != nil {
+		return x.ApiCompatBase
+	}
+	return ""
+}
+
 type ExportRequest struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	AppRoot string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
@@ -1199,7 +1538,7 @@ type ExportRequest struct {
 
 func (x *ExportRequest) Reset() {
 	*x = ExportRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[12]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1211,7 +1550,7 @@ func (x *ExportRequest) String() string {
 func (*ExportRequest) ProtoMessage() {}
 
 func (x *ExportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[12]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1224,7 +1563,7 @@ func (x *ExportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
 func (*ExportRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{12}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ExportRequest) GetAppRoot() string {
@@ -1342,7 +1681,7 @@ type DockerExportParams struct {
 
 func (x *DockerExportParams) Reset() {
 	*x = DockerExportParams{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[13]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1354,7 +1693,7 @@ func (x *DockerExportParams) String() string {
 func (*DockerExportParams) ProtoMessage() {}
 
 func (x *DockerExportParams) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[13]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1367,7 +1706,7 @@ func (x *DockerExportParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerExportParams.ProtoReflect.Descriptor instead.
 func (*DockerExportParams) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{13}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DockerExportParams) GetLocalDaemonTag() string {
@@ -1407,7 +1746,7 @@ type DBConnectRequest struct {
 
 func (x *DBConnectRequest) Reset() {
 	*x = DBConnectRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[14]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1419,7 +1758,7 @@ func (x *DBConnectRequest) String() string {
 func (*DBConnectRequest) ProtoMessage() {}
 
 func (x *DBConnectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[14]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1432,7 +1771,7 @@ func (x *DBConnectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DBConnectRequest.ProtoReflect.Descriptor instead.
 func (*DBConnectRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{14}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DBConnectRequest) GetAppRoot() string {
@@ -1486,7 +1825,7 @@ type DBConnectResponse struct {
 
 func (x *DBConnectResponse) Reset() {
 	*x = DBConnectResponse{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[15]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1498,7 +1837,7 @@ func (x *DBConnectResponse) String() string {
 func (*DBConnectResponse) ProtoMessage() {}
 
 func (x *DBConnectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[15]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1511,7 +1850,7 @@ func (x *DBConnectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DBConnectResponse.ProtoReflect.Descriptor instead.
 func (*DBConnectResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{15}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DBConnectResponse) GetDsn() string {
@@ -1537,7 +1876,7 @@ type DBProxyRequest struct {
 
 func (x *DBProxyRequest) Reset() {
 	*x = DBProxyRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[16]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1549,7 +1888,7 @@ func (x *DBProxyRequest) String() string {
 func (*DBProxyRequest) ProtoMessage() {}
 
 func (x *DBProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[16]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1562,7 +1901,7 @@ func (x *DBProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DBProxyRequest.ProtoReflect.Descriptor instead.
 func (*DBProxyRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{16}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DBProxyRequest) GetAppRoot() string {
@@ -1579,61 +1918,2166 @@ func (x *DBProxyRequest) GetEnvName() string {
 	return ""
 }
 
-func (x *DBProxyRequest) GetPort() int32 {
+func (x *DBProxyRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *DBProxyRequest) GetClusterType() DBClusterType {
+	if x != nil {
+		return x.ClusterType
+	}
+	return DBClusterType_DB_CLUSTER_TYPE_UNSPECIFIED
+}
+
+func (x *DBProxyRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *DBProxyRequest) GetRole() DBRole {
+	if x != nil {
+		return x.Role
+	}
+	return DBRole_DB_ROLE_UNSPECIFIED
+}
+
+type DBResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot       string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	DatabaseNames []string               `protobuf:"bytes,2,rep,name=database_names,json=databaseNames,proto3" json:"database_names,omitempty"` // database names to reset
+	ClusterType   DBClusterType          `protobuf:"varint,3,opt,name=cluster_type,json=clusterType,proto3,enum=encore.daemon.DBClusterType" json:"cluster_type,omitempty"`
+	// namespace is the infrastructure namespace to use.
+	// If empty the active namespace is used.
+	Namespace *string `protobuf:"bytes,4,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	// skip_seeds, if true, skips inserting the seed data
+	// of the databases after they've been migrated.
+	SkipSeeds bool `protobuf:"varint,5,opt,name=skip_seeds,json=skipSeeds,proto3" json:"skip_seeds,omitempty"`
+	// environ is the environment to set for Go seed programs.
+	// Each entry is a string in the format "KEY=VALUE", identical to os.Environ().
+	Environ       []string `protobuf:"bytes,6,rep,name=environ,proto3" json:"environ,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBResetRequest) Reset() {
+	*x = DBResetRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBResetRequest) ProtoMessage() {}
+
+func (x *DBResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBResetRequest.ProtoReflect.Descriptor instead.
+func (*DBResetRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DBResetRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *DBResetRequest) GetDatabaseNames() []string {
+	if x != nil {
+		return x.DatabaseNames
+	}
+	return nil
+}
+
+func (x *DBResetRequest) GetClusterType() DBClusterType {
+	if x != nil {
+		return x.ClusterType
+	}
+	return DBClusterType_DB_CLUSTER_TYPE_UNSPECIFIED
+}
+
+func (x *DBResetRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *DBResetRequest) GetSkipSeeds() bool {
+	if x != nil {
+		return x.SkipSeeds
+	}
+	return false
+}
+
+func (x *DBResetRequest) GetEnviron() []string {
+	if x != nil {
+		return x.Environ
+	}
+	return nil
+}
+
+type DBMigrateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot     string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	DbName      string                 `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	ClusterType DBClusterType          `protobuf:"varint,3,opt,name=cluster_type,json=clusterType,proto3,enum=encore.daemon.DBClusterType" json:"cluster_type,omitempty"`
+	// namespace is the infrastructure namespace to use.
+	// If empty the active namespace is used.
+	Namespace *string                 `protobuf:"bytes,4,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Action    DBMigrateRequest_Action `protobuf:"varint,5,opt,name=action,proto3,enum=encore.daemon.DBMigrateRequest_Action" json:"action,omitempty"`
+	// to is the migration to migrate up to, inclusive, for ACTION_UP.
+	// If unset all pending migrations are applied.
+	To *uint64 `protobuf:"varint,6,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	// steps is the number of migrations to revert for ACTION_DOWN.
+	Steps uint32 `protobuf:"varint,7,opt,name=steps,proto3" json:"steps,omitempty"`
+	// version is the migration to mark as the current version for ACTION_FORCE.
+	// Zero marks no migrations as applied.
+	Version       uint64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBMigrateRequest) Reset() {
+	*x = DBMigrateRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBMigrateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBMigrateRequest) ProtoMessage() {}
+
+func (x *DBMigrateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBMigrateRequest.ProtoReflect.Descriptor instead.
+func (*DBMigrateRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DBMigrateRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *DBMigrateRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *DBMigrateRequest) GetClusterType() DBClusterType {
+	if x != nil {
+		return x.ClusterType
+	}
+	return DBClusterType_DB_CLUSTER_TYPE_UNSPECIFIED
+}
+
+func (x *DBMigrateRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *DBMigrateRequest) GetAction() DBMigrateRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return DBMigrateRequest_ACTION_STATUS
+}
+
+func (x *DBMigrateRequest) GetTo() uint64 {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return 0
+}
+
+func (x *DBMigrateRequest) GetSteps() uint32 {
+	if x != nil {
+		return x.Steps
+	}
+	return 0
+}
+
+func (x *DBMigrateRequest) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DBMigrateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// migrations are the migrations of the database, after the action.
+	Migrations []*DBMigrationStatus `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	// changed are the numbers of the migrations that were applied or reverted.
+	Changed       []uint64 `protobuf:"varint,2,rep,packed,name=changed,proto3" json:"changed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBMigrateResponse) Reset() {
+	*x = DBMigrateResponse{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBMigrateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBMigrateResponse) ProtoMessage() {}
+
+func (x *DBMigrateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBMigrateResponse.ProtoReflect.Descriptor instead.
+func (*DBMigrateResponse) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DBMigrateResponse) GetMigrations() []*DBMigrationStatus {
+	if x != nil {
+		return x.Migrations
+	}
+	return nil
+}
+
+func (x *DBMigrateResponse) GetChanged() []uint64 {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+type DBMigrationStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        uint64                 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"` // empty if the migration is applied but its file no longer exists
+	Applied       bool                   `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
+	Dirty         bool                   `protobuf:"varint,5,opt,name=dirty,proto3" json:"dirty,omitempty"`                                      // the migration failed to apply
+	HasDown       bool                   `protobuf:"varint,6,opt,name=has_down,json=hasDown,proto3" json:"has_down,omitempty"`                   // whether there is a .down.sql file to revert the migration
+	DataMigration bool                   `protobuf:"varint,7,opt,name=data_migration,json=dataMigration,proto3" json:"data_migration,omitempty"` // the migration is a data migration written in Go, run by the app
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBMigrationStatus) Reset() {
+	*x = DBMigrationStatus{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBMigrationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBMigrationStatus) ProtoMessage() {}
+
+func (x *DBMigrationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBMigrationStatus.ProtoReflect.Descriptor instead.
+func (*DBMigrationStatus) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DBMigrationStatus) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *DBMigrationStatus) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DBMigrationStatus) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DBMigrationStatus) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *DBMigrationStatus) GetDirty() bool {
+	if x != nil {
+		return x.Dirty
+	}
+	return false
+}
+
+func (x *DBMigrationStatus) GetHasDown() bool {
+	if x != nil {
+		return x.HasDown
+	}
+	return false
+}
+
+func (x *DBMigrationStatus) GetDataMigration() bool {
+	if x != nil {
+		return x.DataMigration
+	}
+	return false
+}
+
+type DBDiffRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	DbName  string                 `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// namespace is the infrastructure namespace to use.
+	// If empty the active namespace is used.
+	Namespace     *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBDiffRequest) Reset() {
+	*x = DBDiffRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBDiffRequest) ProtoMessage() {}
+
+func (x *DBDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBDiffRequest.ProtoReflect.Descriptor instead.
+func (*DBDiffRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DBDiffRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *DBDiffRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *DBDiffRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+type DBDiffResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// up is the SQL to migrate the schema produced by the migrations
+	// to the schema of the database. It's empty if there are no differences.
+	Up string `protobuf:"bytes,1,opt,name=up,proto3" json:"up,omitempty"`
+	// down is the SQL to revert up.
+	Down string `protobuf:"bytes,2,opt,name=down,proto3" json:"down,omitempty"`
+	// next_number is the number to use for a new migration.
+	NextNumber uint64 `protobuf:"varint,3,opt,name=next_number,json=nextNumber,proto3" json:"next_number,omitempty"`
+	// migration_rel_path is the slash-separated path to the migrations,
+	// relative to the app root.
+	MigrationRelPath string `protobuf:"bytes,4,opt,name=migration_rel_path,json=migrationRelPath,proto3" json:"migration_rel_path,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DBDiffResponse) Reset() {
+	*x = DBDiffResponse{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBDiffResponse) ProtoMessage() {}
+
+func (x *DBDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBDiffResponse.ProtoReflect.Descriptor instead.
+func (*DBDiffResponse) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DBDiffResponse) GetUp() string {
+	if x != nil {
+		return x.Up
+	}
+	return ""
+}
+
+func (x *DBDiffResponse) GetDown() string {
+	if x != nil {
+		return x.Down
+	}
+	return ""
+}
+
+func (x *DBDiffResponse) GetNextNumber() uint64 {
+	if x != nil {
+		return x.NextNumber
+	}
+	return 0
+}
+
+func (x *DBDiffResponse) GetMigrationRelPath() string {
+	if x != nil {
+		return x.MigrationRelPath
+	}
+	return ""
+}
+
+type DBSnapshotRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	// namespace is the infrastructure namespace to use.
+	// If empty the active namespace is used.
+	Namespace *string                  `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Action    DBSnapshotRequest_Action `protobuf:"varint,3,opt,name=action,proto3,enum=encore.daemon.DBSnapshotRequest_Action" json:"action,omitempty"`
+	// name is the name of the snapshot to save or restore.
+	Name          string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBSnapshotRequest) Reset() {
+	*x = DBSnapshotRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBSnapshotRequest) ProtoMessage() {}
+
+func (x *DBSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DBSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DBSnapshotRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *DBSnapshotRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *DBSnapshotRequest) GetAction() DBSnapshotRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return DBSnapshotRequest_ACTION_LIST
+}
+
+func (x *DBSnapshotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DBSnapshotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// snapshots are the saved snapshots for ACTION_LIST,
+	// and the saved or restored snapshot otherwise.
+	Snapshots []*DBSnapshotResponse_Snapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// restored are the names of the databases that were restored, for ACTION_RESTORE.
+	Restored      []string `protobuf:"bytes,2,rep,name=restored,proto3" json:"restored,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBSnapshotResponse) Reset() {
+	*x = DBSnapshotResponse{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBSnapshotResponse) ProtoMessage() {}
+
+func (x *DBSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DBSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DBSnapshotResponse) GetSnapshots() []*DBSnapshotResponse_Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *DBSnapshotResponse) GetRestored() []string {
+	if x != nil {
+		return x.Restored
+	}
+	return nil
+}
+
+type CacheFlushRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	// namespace is the infrastructure namespace to use.
+	// If empty the active namespace is used.
+	Namespace     *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheFlushRequest) Reset() {
+	*x = CacheFlushRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheFlushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheFlushRequest) ProtoMessage() {}
+
+func (x *CacheFlushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheFlushRequest.ProtoReflect.Descriptor instead.
+func (*CacheFlushRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CacheFlushRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *CacheFlushRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+type CacheDumpRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	// namespace is the infrastructure namespace to use.
+	// If empty the active namespace is used.
+	Namespace     *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheDumpRequest) Reset() {
+	*x = CacheDumpRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheDumpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheDumpRequest) ProtoMessage() {}
+
+func (x *CacheDumpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheDumpRequest.ProtoReflect.Descriptor instead.
+func (*CacheDumpRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CacheDumpRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *CacheDumpRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+type CacheDumpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      []byte                 `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"` // JSON-encoded snapshot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheDumpResponse) Reset() {
+	*x = CacheDumpResponse{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheDumpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheDumpResponse) ProtoMessage() {}
+
+func (x *CacheDumpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheDumpResponse.ProtoReflect.Descriptor instead.
+func (*CacheDumpResponse) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CacheDumpResponse) GetSnapshot() []byte {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type CacheRestoreRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	// namespace is the infrastructure namespace to use.
+	// If empty the active namespace is used.
+	Namespace     *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Snapshot      []byte  `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"` // JSON-encoded snapshot, as returned by CacheDump
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheRestoreRequest) Reset() {
+	*x = CacheRestoreRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheRestoreRequest) ProtoMessage() {}
+
+func (x *CacheRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheRestoreRequest.ProtoReflect.Descriptor instead.
+func (*CacheRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CacheRestoreRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *CacheRestoreRequest) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+func (x *CacheRestoreRequest) GetSnapshot() []byte {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type PubSubPublishRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot       string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Message       []byte                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // JSON-encoded message
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PubSubPublishRequest) Reset() {
+	*x = PubSubPublishRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PubSubPublishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubPublishRequest) ProtoMessage() {}
+
+func (x *PubSubPublishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubPublishRequest.ProtoReflect.Descriptor instead.
+func (*PubSubPublishRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PubSubPublishRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *PubSubPublishRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *PubSubPublishRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type PubSubPublishResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PubSubPublishResponse) Reset() {
+	*x = PubSubPublishResponse{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PubSubPublishResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubPublishResponse) ProtoMessage() {}
+
+func (x *PubSubPublishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubPublishResponse.ProtoReflect.Descriptor instead.
+func (*PubSubPublishResponse) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PubSubPublishResponse) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type PubSubStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot       string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PubSubStatsRequest) Reset() {
+	*x = PubSubStatsRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PubSubStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubStatsRequest) ProtoMessage() {}
+
+func (x *PubSubStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubStatsRequest.ProtoReflect.Descriptor instead.
+func (*PubSubStatsRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PubSubStatsRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+type PubSubStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topics        []*PubSubTopicStats    `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PubSubStatsResponse) Reset() {
+	*x = PubSubStatsResponse{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PubSubStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubStatsResponse) ProtoMessage() {}
+
+func (x *PubSubStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubStatsResponse.ProtoReflect.Descriptor instead.
+func (*PubSubStatsResponse) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PubSubStatsResponse) GetTopics() []*PubSubTopicStats {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type PubSubTopicStats struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Topic         string                     `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Depth         int64                      `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`       // messages not yet delivered to any subscription
+	Messages      uint64                     `protobuf:"varint,3,opt,name=messages,proto3" json:"messages,omitempty"` // total number of messages published
+	Subscriptions []*PubSubSubscriptionStats `protobuf:"bytes,4,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PubSubTopicStats) Reset() {
+	*x = PubSubTopicStats{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PubSubTopicStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubTopicStats) ProtoMessage() {}
+
+func (x *PubSubTopicStats) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubTopicStats.ProtoReflect.Descriptor instead.
+func (*PubSubTopicStats) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PubSubTopicStats) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *PubSubTopicStats) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *PubSubTopicStats) GetMessages() uint64 {
+	if x != nil {
+		return x.Messages
+	}
+	return 0
+}
+
+func (x *PubSubTopicStats) GetSubscriptions() []*PubSubSubscriptionStats {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type PubSubSubscriptionStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  string                 `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Connected     bool                   `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`               // whether the subscriber is connected
+	Depth         int64                  `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`                       // messages waiting to be delivered
+	InFlight      int32                  `protobuf:"varint,4,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"` // messages delivered but not yet acknowledged
+	Deferred      int32                  `protobuf:"varint,5,opt,name=deferred,proto3" json:"deferred,omitempty"`                 // messages waiting to be retried
+	Messages      uint64                 `protobuf:"varint,6,opt,name=messages,proto3" json:"messages,omitempty"`                 // total number of messages received
+	Requeued      uint64                 `protobuf:"varint,7,opt,name=requeued,proto3" json:"requeued,omitempty"`                 // total number of messages requeued for a retry
+	TimedOut      uint64                 `protobuf:"varint,8,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"` // total number of messages not acknowledged in time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PubSubSubscriptionStats) Reset() {
+	*x = PubSubSubscriptionStats{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PubSubSubscriptionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubSubscriptionStats) ProtoMessage() {}
+
+func (x *PubSubSubscriptionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubSubscriptionStats.ProtoReflect.Descriptor instead.
+func (*PubSubSubscriptionStats) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PubSubSubscriptionStats) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *PubSubSubscriptionStats) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *PubSubSubscriptionStats) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *PubSubSubscriptionStats) GetInFlight() int32 {
+	if x != nil {
+		return x.InFlight
+	}
+	return 0
+}
+
+func (x *PubSubSubscriptionStats) GetDeferred() int32 {
+	if x != nil {
+		return x.Deferred
+	}
+	return 0
+}
+
+func (x *PubSubSubscriptionStats) GetMessages() uint64 {
+	if x != nil {
+		return x.Messages
+	}
+	return 0
+}
+
+func (x *PubSubSubscriptionStats) GetRequeued() uint64 {
+	if x != nil {
+		return x.Requeued
+	}
+	return 0
+}
+
+func (x *PubSubSubscriptionStats) GetTimedOut() uint64 {
+	if x != nil {
+		return x.TimedOut
+	}
+	return 0
+}
+
+// PubSubDeadLetterFilter selects dead-lettered messages.
+// Unset fields match all messages.
+type PubSubDeadLetterFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot       string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	Topic         *string                `protobuf:"bytes,2,opt,name=topic,proto3,oneof" json:"topic,omitempty"`
+	Subscription  *string                `protobuf:"bytes,3,opt,name=subscription,proto3,oneof" json:"subscription,omitempty"`
+	Ids           []string               `protobuf:"bytes,4,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PubSubDeadLetterFilter) Reset() {
+	*x = PubSubDeadLetterFilter{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PubSubDeadLetterFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubDeadLetterFilter) ProtoMessage() {}
+
+func (x *PubSubDeadLetterFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubDeadLetterFilter.ProtoReflect.Descriptor instead.
+func (*PubSubDeadLetterFilter) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PubSubDeadLetterFilter) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *PubSubDeadLetterFilter) GetTopic() string {
+	if x != nil && x.Topic != nil {
+		return *x.Topic
+	}
+	return ""
+}
+
+func (x *PubSubDeadLetterFilter) GetSubscription() string {
+	if x != nil && x.Subscription != nil {
+		return *x.Subscription
+	}
+	return ""
+}
+
+func (x *PubSubDeadLetterFilter) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type PubSubListDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*PubSubDeadLetter    `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PubSubListDeadLettersResponse) Reset() {
+	*x = PubSubListDeadLettersResponse{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PubSubListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubListDeadLettersResponse) ProtoMessage() {}
+
+func (x *PubSubListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*PubSubListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PubSubListDeadLettersResponse) GetMessages() []*PubSubDeadLetter {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type PubSubDeadLetter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Topic          string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Subscription   string                 `protobuf:"bytes,3,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	MessageId      string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Attributes     map[string]string      `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Data           []byte                 `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"` // JSON-encoded message
+	PublishTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+	Attempts       int32                  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"` // number of delivery attempts
+	LastError      string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	TraceId        *string                `protobuf:"bytes,10,opt,name=trace_id,json=traceId,proto3,oneof" json:"trace_id,omitempty"` // trace of the last delivery attempt
+	DeadLetteredAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=dead_lettered_at,json=deadLetteredAt,proto3" json:"dead_lettered_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PubSubDeadLetter) Reset() {
+	*x = PubSubDeadLetter{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PubSubDeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubDeadLetter) ProtoMessage() {}
+
+func (x *PubSubDeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubDeadLetter.ProtoReflect.Descriptor instead.
+func (*PubSubDeadLetter) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PubSubDeadLetter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PubSubDeadLetter) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *PubSubDeadLetter) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *PubSubDeadLetter) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *PubSubDeadLetter) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *PubSubDeadLetter) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PubSubDeadLetter) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
+func (x *PubSubDeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *PubSubDeadLetter) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *PubSubDeadLetter) GetTraceId() string {
+	if x != nil && x.TraceId != nil {
+		return *x.TraceId
+	}
+	return ""
+}
+
+func (x *PubSubDeadLetter) GetDeadLetteredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadLetteredAt
+	}
+	return nil
+}
+
+type PubSubDeadLettersCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PubSubDeadLettersCount) Reset() {
+	*x = PubSubDeadLettersCount{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PubSubDeadLettersCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubDeadLettersCount) ProtoMessage() {}
+
+func (x *PubSubDeadLettersCount) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubDeadLettersCount.ProtoReflect.Descriptor instead.
+func (*PubSubDeadLettersCount) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PubSubDeadLettersCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type TrafficRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot       string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	Session       string                 `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"` // name of the session to record into
+	Append        bool                   `protobuf:"varint,3,opt,name=append,proto3" json:"append,omitempty"`  // append to an existing session instead of replacing it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrafficRecordRequest) Reset() {
+	*x = TrafficRecordRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRecordRequest) ProtoMessage() {}
+
+func (x *TrafficRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRecordRequest.ProtoReflect.Descriptor instead.
+func (*TrafficRecordRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TrafficRecordRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *TrafficRecordRequest) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *TrafficRecordRequest) GetAppend() bool {
+	if x != nil {
+		return x.Append
+	}
+	return false
+}
+
+// TrafficExchange describes a recorded request.
+type TrafficExchange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // index of the request within the session
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"` // request path, including the query string
+	StatusCode    int32                  `protobuf:"varint,4,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrafficExchange) Reset() {
+	*x = TrafficExchange{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficExchange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficExchange) ProtoMessage() {}
+
+func (x *TrafficExchange) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficExchange.ProtoReflect.Descriptor instead.
+func (*TrafficExchange) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TrafficExchange) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TrafficExchange) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *TrafficExchange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TrafficExchange) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *TrafficExchange) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type TrafficReplayRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	Session string                 `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	// speed is the replay speed relative to the recording.
+	// 0 sends each request as soon as the previous one has completed.
+	Speed float64 `protobuf:"fixed64,3,opt,name=speed,proto3" json:"speed,omitempty"`
+	// ignore_fields are the names of JSON object fields to ignore
+	// when comparing the recorded and replayed response bodies.
+	IgnoreFields  []string `protobuf:"bytes,4,rep,name=ignore_fields,json=ignoreFields,proto3" json:"ignore_fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrafficReplayRequest) Reset() {
+	*x = TrafficReplayRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficReplayRequest) ProtoMessage() {}
+
+func (x *TrafficReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficReplayRequest.ProtoReflect.Descriptor instead.
+func (*TrafficReplayRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TrafficReplayRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *TrafficReplayRequest) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *TrafficReplayRequest) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *TrafficReplayRequest) GetIgnoreFields() []string {
+	if x != nil {
+		return x.IgnoreFields
+	}
+	return nil
+}
+
+type TrafficReplayResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Index              int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // index of the request within the session
+	Method             string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Path               string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	RecordedStatusCode int32                  `protobuf:"varint,4,opt,name=recorded_status_code,json=recordedStatusCode,proto3" json:"recorded_status_code,omitempty"`
+	StatusCode         int32                  `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"` // status code of the replayed request, or 0 if it failed
+	DurationMs         int64                  `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Error              string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"` // why the request failed, if it did
+	Diff               string                 `protobuf:"bytes,8,opt,name=diff,proto3" json:"diff,omitempty"`   // difference between the recorded and replayed responses, if any
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TrafficReplayResult) Reset() {
+	*x = TrafficReplayResult{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficReplayResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficReplayResult) ProtoMessage() {}
+
+func (x *TrafficReplayResult) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficReplayResult.ProtoReflect.Descriptor instead.
+func (*TrafficReplayResult) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TrafficReplayResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TrafficReplayResult) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *TrafficReplayResult) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TrafficReplayResult) GetRecordedStatusCode() int32 {
+	if x != nil {
+		return x.RecordedStatusCode
+	}
+	return 0
+}
+
+func (x *TrafficReplayResult) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *TrafficReplayResult) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *TrafficReplayResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TrafficReplayResult) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type TrafficSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot       string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrafficSessionsRequest) Reset() {
+	*x = TrafficSessionsRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficSessionsRequest) ProtoMessage() {}
+
+func (x *TrafficSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficSessionsRequest.ProtoReflect.Descriptor instead.
+func (*TrafficSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TrafficSessionsRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+type TrafficSessionsResponse struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	Sessions      []*TrafficSessionsResponse_Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrafficSessionsResponse) Reset() {
+	*x = TrafficSessionsResponse{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficSessionsResponse) ProtoMessage() {}
+
+func (x *TrafficSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficSessionsResponse.ProtoReflect.Descriptor instead.
+func (*TrafficSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TrafficSessionsResponse) GetSessions() []*TrafficSessionsResponse_Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// ChaosRule injects latency and errors into the operations it matches.
+// Empty matchers match anything.
+type ChaosRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // "api", "sqldb", "cache", "bucket" or "pubsub"; empty for all
+	Service       string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Endpoint      string                 `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"` // only for "api" rules
+	Resource      string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"` // database, cache cluster, bucket or topic name
+	LatencyMs     int64                  `protobuf:"varint,6,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	ErrorRate     float64                `protobuf:"fixed64,7,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"` // between 0 and 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChaosRule) Reset() {
+	*x = ChaosRule{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaosRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaosRule) ProtoMessage() {}
+
+func (x *ChaosRule) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaosRule.ProtoReflect.Descriptor instead.
+func (*ChaosRule) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ChaosRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChaosRule) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ChaosRule) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ChaosRule) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ChaosRule) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ChaosRule) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *ChaosRule) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+type ChaosRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot       string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	Env           string                 `protobuf:"bytes,2,opt,name=env,proto3" json:"env,omitempty"` // "run" or "test"; empty for "run"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChaosRulesRequest) Reset() {
+	*x = ChaosRulesRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaosRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaosRulesRequest) ProtoMessage() {}
+
+func (x *ChaosRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaosRulesRequest.ProtoReflect.Descriptor instead.
+func (*ChaosRulesRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ChaosRulesRequest) GetAppRoot() string {
+	if x != nil {
+		return x.AppRoot
+	}
+	return ""
+}
+
+func (x *ChaosRulesRequest) GetEnv() string {
+	if x != nil {
+		return x.Env
+	}
+	return ""
+}
+
+type ChaosRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*ChaosRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChaosRulesResponse) Reset() {
+	*x = ChaosRulesResponse{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaosRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaosRulesResponse) ProtoMessage() {}
+
+func (x *ChaosRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaosRulesResponse.ProtoReflect.Descriptor instead.
+func (*ChaosRulesResponse) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ChaosRulesResponse) GetRules() []*ChaosRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ChaosAddRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppRoot       string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
+	Rule          *ChaosRule             `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"` // the id is assigned by the daemon
+	Env           string                 `protobuf:"bytes,3,opt,name=env,proto3" json:"env,omitempty"`   // "run" or "test"; empty for "run"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChaosAddRuleRequest) Reset() {
+	*x = ChaosAddRuleRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaosAddRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaosAddRuleRequest) ProtoMessage() {}
+
+func (x *ChaosAddRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[51]
 	if x != nil {
-		return x.Port
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DBProxyRequest) GetClusterType() DBClusterType {
+// Deprecated: Use ChaosAddRuleRequest.ProtoReflect.Descriptor instead.
+func (*ChaosAddRuleRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ChaosAddRuleRequest) GetAppRoot() string {
 	if x != nil {
-		return x.ClusterType
+		return x.AppRoot
 	}
-	return DBClusterType_DB_CLUSTER_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *DBProxyRequest) GetNamespace() string {
-	if x != nil && x.Namespace != nil {
-		return *x.Namespace
+func (x *ChaosAddRuleRequest) GetRule() *ChaosRule {
+	if x != nil {
+		return x.Rule
 	}
-	return ""
+	return nil
 }
 
-func (x *DBProxyRequest) GetRole() DBRole {
+func (x *ChaosAddRuleRequest) GetEnv() string {
 	if x != nil {
-		return x.Role
+		return x.Env
 	}
-	return DBRole_DB_ROLE_UNSPECIFIED
+	return ""
 }
 
-type DBResetRequest struct {
+type ChaosRemoveRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AppRoot       string                 `protobuf:"bytes,1,opt,name=app_root,json=appRoot,proto3" json:"app_root,omitempty"`
-	DatabaseNames []string               `protobuf:"bytes,2,rep,name=database_names,json=databaseNames,proto3" json:"database_names,omitempty"` // database names to reset
-	ClusterType   DBClusterType          `protobuf:"varint,3,opt,name=cluster_type,json=clusterType,proto3,enum=encore.daemon.DBClusterType" json:"cluster_type,omitempty"`
-	// namespace is the infrastructure namespace to use.
-	// If empty the active namespace is used.
-	Namespace     *string `protobuf:"bytes,4,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"` // the rules to remove, or all rules if empty
+	Env           string                 `protobuf:"bytes,3,opt,name=env,proto3" json:"env,omitempty"` // "run" or "test"; empty for "run"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DBResetRequest) Reset() {
-	*x = DBResetRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[17]
+func (x *ChaosRemoveRulesRequest) Reset() {
+	*x = ChaosRemoveRulesRequest{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DBResetRequest) String() string {
+func (x *ChaosRemoveRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DBResetRequest) ProtoMessage() {}
+func (*ChaosRemoveRulesRequest) ProtoMessage() {}
 
-func (x *DBResetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[17]
+func (x *ChaosRemoveRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1644,35 +4088,28 @@ func (x *DBResetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DBResetRequest.ProtoReflect.Descriptor instead.
-func (*DBResetRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ChaosRemoveRulesRequest.ProtoReflect.Descriptor instead.
+func (*ChaosRemoveRulesRequest) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *DBResetRequest) GetAppRoot() string {
+func (x *ChaosRemoveRulesRequest) GetAppRoot() string {
 	if x != nil {
 		return x.AppRoot
 	}
 	return ""
 }
 
-func (x *DBResetRequest) GetDatabaseNames() []string {
+func (x *ChaosRemoveRulesRequest) GetIds() []string {
 	if x != nil {
-		return x.DatabaseNames
+		return x.Ids
 	}
 	return nil
 }
 
-func (x *DBResetRequest) GetClusterType() DBClusterType {
+func (x *ChaosRemoveRulesRequest) GetEnv() string {
 	if x != nil {
-		return x.ClusterType
-	}
-	return DBClusterType_DB_CLUSTER_TYPE_UNSPECIFIED
-}
-
-func (x *DBResetRequest) GetNamespace() string {
-	if x != nil && x.Namespace != nil {
-		return *x.Namespace
+		return x.Env
 	}
 	return ""
 }
@@ -1714,7 +4151,7 @@ type GenClientRequest struct {
 
 func (x *GenClientRequest) Reset() {
 	*x = GenClientRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[18]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1726,7 +4163,7 @@ func (x *GenClientRequest) String() string {
 func (*GenClientRequest) ProtoMessage() {}
 
 func (x *GenClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[18]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1739,7 +4176,7 @@ func (x *GenClientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenClientRequest.ProtoReflect.Descriptor instead.
 func (*GenClientRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{18}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GenClientRequest) GetAppId() string {
@@ -1828,7 +4265,7 @@ type GenClientResponse struct {
 
 func (x *GenClientResponse) Reset() {
 	*x = GenClientResponse{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[19]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1840,7 +4277,7 @@ func (x *GenClientResponse) String() string {
 func (*GenClientResponse) ProtoMessage() {}
 
 func (x *GenClientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[19]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1853,7 +4290,7 @@ func (x *GenClientResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenClientResponse.ProtoReflect.Descriptor instead.
 func (*GenClientResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{19}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *GenClientResponse) GetCode() []byte {
@@ -1872,7 +4309,7 @@ type GenWrappersRequest struct {
 
 func (x *GenWrappersRequest) Reset() {
 	*x = GenWrappersRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[20]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1884,7 +4321,7 @@ func (x *GenWrappersRequest) String() string {
 func (*GenWrappersRequest) ProtoMessage() {}
 
 func (x *GenWrappersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[20]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1897,7 +4334,7 @@ func (x *GenWrappersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenWrappersRequest.ProtoReflect.Descriptor instead.
 func (*GenWrappersRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{20}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GenWrappersRequest) GetAppRoot() string {
@@ -1915,7 +4352,7 @@ type GenWrappersResponse struct {
 
 func (x *GenWrappersResponse) Reset() {
 	*x = GenWrappersResponse{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[21]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1927,7 +4364,7 @@ func (x *GenWrappersResponse) String() string {
 func (*GenWrappersResponse) ProtoMessage() {}
 
 func (x *GenWrappersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[21]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1940,7 +4377,7 @@ func (x *GenWrappersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenWrappersResponse.ProtoReflect.Descriptor instead.
 func (*GenWrappersResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{21}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{56}
 }
 
 type SecretsRefreshRequest struct {
@@ -1954,7 +4391,7 @@ type SecretsRefreshRequest struct {
 
 func (x *SecretsRefreshRequest) Reset() {
 	*x = SecretsRefreshRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[22]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1966,7 +4403,7 @@ func (x *SecretsRefreshRequest) String() string {
 func (*SecretsRefreshRequest) ProtoMessage() {}
 
 func (x *SecretsRefreshRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[22]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1979,7 +4416,7 @@ func (x *SecretsRefreshRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretsRefreshRequest.ProtoReflect.Descriptor instead.
 func (*SecretsRefreshRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{22}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *SecretsRefreshRequest) GetAppRoot() string {
@@ -2011,7 +4448,7 @@ type SecretsRefreshResponse struct {
 
 func (x *SecretsRefreshResponse) Reset() {
 	*x = SecretsRefreshResponse{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[23]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2023,7 +4460,7 @@ func (x *SecretsRefreshResponse) String() string {
 func (*SecretsRefreshResponse) ProtoMessage() {}
 
 func (x *SecretsRefreshResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[23]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2036,7 +4473,7 @@ func (x *SecretsRefreshResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretsRefreshResponse.ProtoReflect.Descriptor instead.
 func (*SecretsRefreshResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{23}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{58}
 }
 
 type VersionResponse struct {
@@ -2049,7 +4486,7 @@ type VersionResponse struct {
 
 func (x *VersionResponse) Reset() {
 	*x = VersionResponse{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[24]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2061,7 +4498,7 @@ func (x *VersionResponse) String() string {
 func (*VersionResponse) ProtoMessage() {}
 
 func (x *VersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[24]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2074,7 +4511,7 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{24}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *VersionResponse) GetVersion() string {
@@ -2104,7 +4541,7 @@ type Namespace struct {
 
 func (x *Namespace) Reset() {
 	*x = Namespace{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[25]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2116,7 +4553,7 @@ func (x *Namespace) String() string {
 func (*Namespace) ProtoMessage() {}
 
 func (x *Namespace) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[25]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2129,7 +4566,7 @@ func (x *Namespace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Namespace.ProtoReflect.Descriptor instead.
 func (*Namespace) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{25}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *Namespace) GetId() string {
@@ -2177,7 +4614,7 @@ type CreateNamespaceRequest struct {
 
 func (x *CreateNamespaceRequest) Reset() {
 	*x = CreateNamespaceRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[26]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2189,7 +4626,7 @@ func (x *CreateNamespaceRequest) String() string {
 func (*CreateNamespaceRequest) ProtoMessage() {}
 
 func (x *CreateNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[26]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2202,7 +4639,7 @@ func (x *CreateNamespaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNamespaceRequest.ProtoReflect.Descriptor instead.
 func (*CreateNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{26}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *CreateNamespaceRequest) GetAppRoot() string {
@@ -2230,7 +4667,7 @@ type SwitchNamespaceRequest struct {
 
 func (x *SwitchNamespaceRequest) Reset() {
 	*x = SwitchNamespaceRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[27]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2242,7 +4679,7 @@ func (x *SwitchNamespaceRequest) String() string {
 func (*SwitchNamespaceRequest) ProtoMessage() {}
 
 func (x *SwitchNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[27]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2255,7 +4692,7 @@ func (x *SwitchNamespaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwitchNamespaceRequest.ProtoReflect.Descriptor instead.
 func (*SwitchNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{27}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *SwitchNamespaceRequest) GetAppRoot() string {
@@ -2288,7 +4725,7 @@ type ListNamespacesRequest struct {
 
 func (x *ListNamespacesRequest) Reset() {
 	*x = ListNamespacesRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[28]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2300,7 +4737,7 @@ func (x *ListNamespacesRequest) String() string {
 func (*ListNamespacesRequest) ProtoMessage() {}
 
 func (x *ListNamespacesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[28]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2313,7 +4750,7 @@ func (x *ListNamespacesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNamespacesRequest.ProtoReflect.Descriptor instead.
 func (*ListNamespacesRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{28}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *ListNamespacesRequest) GetAppRoot() string {
@@ -2333,7 +4770,7 @@ type DeleteNamespaceRequest struct {
 
 func (x *DeleteNamespaceRequest) Reset() {
 	*x = DeleteNamespaceRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[29]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2345,7 +4782,7 @@ func (x *DeleteNamespaceRequest) String() string {
 func (*DeleteNamespaceRequest) ProtoMessage() {}
 
 func (x *DeleteNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[29]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2358,7 +4795,7 @@ func (x *DeleteNamespaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNamespaceRequest.ProtoReflect.Descriptor instead.
 func (*DeleteNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{29}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *DeleteNamespaceRequest) GetAppRoot() string {
@@ -2384,7 +4821,7 @@ type ListNamespacesResponse struct {
 
 func (x *ListNamespacesResponse) Reset() {
 	*x = ListNamespacesResponse{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[30]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2396,7 +4833,7 @@ func (x *ListNamespacesResponse) String() string {
 func (*ListNamespacesResponse) ProtoMessage() {}
 
 func (x *ListNamespacesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[30]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2409,7 +4846,7 @@ func (x *ListNamespacesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNamespacesResponse.ProtoReflect.Descriptor instead.
 func (*ListNamespacesResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{30}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ListNamespacesResponse) GetNamespaces() []*Namespace {
@@ -2430,7 +4867,7 @@ type TelemetryConfig struct {
 
 func (x *TelemetryConfig) Reset() {
 	*x = TelemetryConfig{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[31]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2442,7 +4879,7 @@ func (x *TelemetryConfig) String() string {
 func (*TelemetryConfig) ProtoMessage() {}
 
 func (x *TelemetryConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[31]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2455,7 +4892,7 @@ func (x *TelemetryConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TelemetryConfig.ProtoReflect.Descriptor instead.
 func (*TelemetryConfig) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{31}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *TelemetryConfig) GetAnonId() string {
@@ -2495,7 +4932,7 @@ type DumpMetaRequest struct {
 
 func (x *DumpMetaRequest) Reset() {
 	*x = DumpMetaRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[32]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2507,7 +4944,7 @@ func (x *DumpMetaRequest) String() string {
 func (*DumpMetaRequest) ProtoMessage() {}
 
 func (x *DumpMetaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[32]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2520,7 +4957,7 @@ func (x *DumpMetaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DumpMetaRequest.ProtoReflect.Descriptor instead.
 func (*DumpMetaRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{32}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *DumpMetaRequest) GetAppRoot() string {
@@ -2567,7 +5004,7 @@ type DumpMetaResponse struct {
 
 func (x *DumpMetaResponse) Reset() {
 	*x = DumpMetaResponse{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[33]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2579,7 +5016,7 @@ func (x *DumpMetaResponse) String() string {
 func (*DumpMetaResponse) ProtoMessage() {}
 
 func (x *DumpMetaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[33]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2592,7 +5029,7 @@ func (x *DumpMetaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DumpMetaResponse.ProtoReflect.Descriptor instead.
 func (*DumpMetaResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{33}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *DumpMetaResponse) GetMeta() []byte {
@@ -2611,7 +5048,7 @@ type SQLCPlugin struct {
 
 func (x *SQLCPlugin) Reset() {
 	*x = SQLCPlugin{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[34]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2623,7 +5060,7 @@ func (x *SQLCPlugin) String() string {
 func (*SQLCPlugin) ProtoMessage() {}
 
 func (x *SQLCPlugin) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[34]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2636,7 +5073,135 @@ func (x *SQLCPlugin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69}
+}
+
+type DBSnapshotResponse_Snapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // the namespace the snapshot was saved from
+	Databases     []string               `protobuf:"bytes,3,rep,name=databases,proto3" json:"databases,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBSnapshotResponse_Snapshot) Reset() {
+	*x = DBSnapshotResponse_Snapshot{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBSnapshotResponse_Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBSnapshotResponse_Snapshot) ProtoMessage() {}
+
+func (x *DBSnapshotResponse_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBSnapshotResponse_Snapshot.ProtoReflect.Descriptor instead.
+func (*DBSnapshotResponse_Snapshot) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *DBSnapshotResponse_Snapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DBSnapshotResponse_Snapshot) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DBSnapshotResponse_Snapshot) GetDatabases() []string {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+func (x *DBSnapshotResponse_Snapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type TrafficSessionsResponse_Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Requests      int32                  `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	RecordedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrafficSessionsResponse_Session) Reset() {
+	*x = TrafficSessionsResponse_Session{}
+	mi := &file_encore_daemon_daemon_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficSessionsResponse_Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficSessionsResponse_Session) ProtoMessage() {}
+
+func (x *TrafficSessionsResponse_Session) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_daemon_daemon_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficSessionsResponse_Session.ProtoReflect.Descriptor instead.
+func (*TrafficSessionsResponse_Session) Descriptor() ([]byte, []int) {
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *TrafficSessionsResponse_Session) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrafficSessionsResponse_Session) GetRequests() int32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *TrafficSessionsResponse_Session) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
 }
 
 type SQLCPlugin_File struct {
@@ -2649,7 +5214,7 @@ type SQLCPlugin_File struct {
 
 func (x *SQLCPlugin_File) Reset() {
 	*x = SQLCPlugin_File{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[35]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2661,7 +5226,7 @@ func (x *SQLCPlugin_File) String() string {
 func (*SQLCPlugin_File) ProtoMessage() {}
 
 func (x *SQLCPlugin_File) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[35]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2674,7 +5239,7 @@ func (x *SQLCPlugin_File) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_File.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_File) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 0}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 0}
 }
 
 func (x *SQLCPlugin_File) GetName() string {
@@ -2704,7 +5269,7 @@ type SQLCPlugin_Settings struct {
 
 func (x *SQLCPlugin_Settings) Reset() {
 	*x = SQLCPlugin_Settings{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[36]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2716,7 +5281,7 @@ func (x *SQLCPlugin_Settings) String() string {
 func (*SQLCPlugin_Settings) ProtoMessage() {}
 
 func (x *SQLCPlugin_Settings) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[36]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2729,7 +5294,7 @@ func (x *SQLCPlugin_Settings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Settings.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Settings) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 1}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 1}
 }
 
 func (x *SQLCPlugin_Settings) GetVersion() string {
@@ -2781,7 +5346,7 @@ type SQLCPlugin_Codegen struct {
 
 func (x *SQLCPlugin_Codegen) Reset() {
 	*x = SQLCPlugin_Codegen{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[37]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2793,7 +5358,7 @@ func (x *SQLCPlugin_Codegen) String() string {
 func (*SQLCPlugin_Codegen) ProtoMessage() {}
 
 func (x *SQLCPlugin_Codegen) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[37]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2806,7 +5371,7 @@ func (x *SQLCPlugin_Codegen) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Codegen.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Codegen) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 2}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 2}
 }
 
 func (x *SQLCPlugin_Codegen) GetOut() string {
@@ -2863,7 +5428,7 @@ type SQLCPlugin_Catalog struct {
 
 func (x *SQLCPlugin_Catalog) Reset() {
 	*x = SQLCPlugin_Catalog{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[38]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2875,7 +5440,7 @@ func (x *SQLCPlugin_Catalog) String() string {
 func (*SQLCPlugin_Catalog) ProtoMessage() {}
 
 func (x *SQLCPlugin_Catalog) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[38]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2888,7 +5453,7 @@ func (x *SQLCPlugin_Catalog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Catalog.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Catalog) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 3}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 3}
 }
 
 func (x *SQLCPlugin_Catalog) GetComment() string {
@@ -2932,7 +5497,7 @@ type SQLCPlugin_Schema struct {
 
 func (x *SQLCPlugin_Schema) Reset() {
 	*x = SQLCPlugin_Schema{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[39]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2944,7 +5509,7 @@ func (x *SQLCPlugin_Schema) String() string {
 func (*SQLCPlugin_Schema) ProtoMessage() {}
 
 func (x *SQLCPlugin_Schema) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[39]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2957,7 +5522,7 @@ func (x *SQLCPlugin_Schema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Schema.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Schema) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 4}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 4}
 }
 
 func (x *SQLCPlugin_Schema) GetComment() string {
@@ -3005,7 +5570,7 @@ type SQLCPlugin_CompositeType struct {
 
 func (x *SQLCPlugin_CompositeType) Reset() {
 	*x = SQLCPlugin_CompositeType{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[40]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3017,7 +5582,7 @@ func (x *SQLCPlugin_CompositeType) String() string {
 func (*SQLCPlugin_CompositeType) ProtoMessage() {}
 
 func (x *SQLCPlugin_CompositeType) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[40]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3030,7 +5595,7 @@ func (x *SQLCPlugin_CompositeType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_CompositeType.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_CompositeType) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 5}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 5}
 }
 
 func (x *SQLCPlugin_CompositeType) GetName() string {
@@ -3058,7 +5623,7 @@ type SQLCPlugin_Enum struct {
 
 func (x *SQLCPlugin_Enum) Reset() {
 	*x = SQLCPlugin_Enum{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[41]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3070,7 +5635,7 @@ func (x *SQLCPlugin_Enum) String() string {
 func (*SQLCPlugin_Enum) ProtoMessage() {}
 
 func (x *SQLCPlugin_Enum) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[41]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3083,7 +5648,7 @@ func (x *SQLCPlugin_Enum) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Enum.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Enum) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 6}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 6}
 }
 
 func (x *SQLCPlugin_Enum) GetName() string {
@@ -3118,7 +5683,7 @@ type SQLCPlugin_Table struct {
 
 func (x *SQLCPlugin_Table) Reset() {
 	*x = SQLCPlugin_Table{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[42]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3130,7 +5695,7 @@ func (x *SQLCPlugin_Table) String() string {
 func (*SQLCPlugin_Table) ProtoMessage() {}
 
 func (x *SQLCPlugin_Table) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[42]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3143,7 +5708,7 @@ func (x *SQLCPlugin_Table) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Table.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Table) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 7}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 7}
 }
 
 func (x *SQLCPlugin_Table) GetRel() *SQLCPlugin_Identifier {
@@ -3178,7 +5743,7 @@ type SQLCPlugin_Identifier struct {
 
 func (x *SQLCPlugin_Identifier) Reset() {
 	*x = SQLCPlugin_Identifier{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[43]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3190,7 +5755,7 @@ func (x *SQLCPlugin_Identifier) String() string {
 func (*SQLCPlugin_Identifier) ProtoMessage() {}
 
 func (x *SQLCPlugin_Identifier) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[43]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3203,7 +5768,7 @@ func (x *SQLCPlugin_Identifier) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Identifier.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Identifier) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 8}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 8}
 }
 
 func (x *SQLCPlugin_Identifier) GetCatalog() string {
@@ -3252,7 +5817,7 @@ type SQLCPlugin_Column struct {
 
 func (x *SQLCPlugin_Column) Reset() {
 	*x = SQLCPlugin_Column{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[44]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3264,7 +5829,7 @@ func (x *SQLCPlugin_Column) String() string {
 func (*SQLCPlugin_Column) ProtoMessage() {}
 
 func (x *SQLCPlugin_Column) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[44]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3277,7 +5842,7 @@ func (x *SQLCPlugin_Column) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Column.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Column) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 9}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 9}
 }
 
 func (x *SQLCPlugin_Column) GetName() string {
@@ -3408,7 +5973,7 @@ type SQLCPlugin_Query struct {
 
 func (x *SQLCPlugin_Query) Reset() {
 	*x = SQLCPlugin_Query{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[45]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3420,7 +5985,7 @@ func (x *SQLCPlugin_Query) String() string {
 func (*SQLCPlugin_Query) ProtoMessage() {}
 
 func (x *SQLCPlugin_Query) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[45]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3433,7 +5998,7 @@ func (x *SQLCPlugin_Query) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Query.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Query) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 10}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 10}
 }
 
 func (x *SQLCPlugin_Query) GetText() string {
@@ -3502,7 +6067,7 @@ type SQLCPlugin_Parameter struct {
 
 func (x *SQLCPlugin_Parameter) Reset() {
 	*x = SQLCPlugin_Parameter{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[46]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3514,7 +6079,7 @@ func (x *SQLCPlugin_Parameter) String() string {
 func (*SQLCPlugin_Parameter) ProtoMessage() {}
 
 func (x *SQLCPlugin_Parameter) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[46]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3527,7 +6092,7 @@ func (x *SQLCPlugin_Parameter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Parameter.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Parameter) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 11}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 11}
 }
 
 func (x *SQLCPlugin_Parameter) GetNumber() int32 {
@@ -3558,7 +6123,7 @@ type SQLCPlugin_GenerateRequest struct {
 
 func (x *SQLCPlugin_GenerateRequest) Reset() {
 	*x = SQLCPlugin_GenerateRequest{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[47]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3570,7 +6135,7 @@ func (x *SQLCPlugin_GenerateRequest) String() string {
 func (*SQLCPlugin_GenerateRequest) ProtoMessage() {}
 
 func (x *SQLCPlugin_GenerateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[47]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3583,7 +6148,7 @@ func (x *SQLCPlugin_GenerateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_GenerateRequest.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_GenerateRequest) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 12}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 12}
 }
 
 func (x *SQLCPlugin_GenerateRequest) GetSettings() *SQLCPlugin_Settings {
@@ -3637,7 +6202,7 @@ type SQLCPlugin_GenerateResponse struct {
 
 func (x *SQLCPlugin_GenerateResponse) Reset() {
 	*x = SQLCPlugin_GenerateResponse{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[48]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3649,7 +6214,7 @@ func (x *SQLCPlugin_GenerateResponse) String() string {
 func (*SQLCPlugin_GenerateResponse) ProtoMessage() {}
 
 func (x *SQLCPlugin_GenerateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[48]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3662,7 +6227,7 @@ func (x *SQLCPlugin_GenerateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_GenerateResponse.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_GenerateResponse) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 13}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 13}
 }
 
 func (x *SQLCPlugin_GenerateResponse) GetFiles() []*SQLCPlugin_File {
@@ -3681,7 +6246,7 @@ type SQLCPlugin_Codegen_Process struct {
 
 func (x *SQLCPlugin_Codegen_Process) Reset() {
 	*x = SQLCPlugin_Codegen_Process{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[49]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3693,7 +6258,7 @@ func (x *SQLCPlugin_Codegen_Process) String() string {
 func (*SQLCPlugin_Codegen_Process) ProtoMessage() {}
 
 func (x *SQLCPlugin_Codegen_Process) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[49]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3706,7 +6271,7 @@ func (x *SQLCPlugin_Codegen_Process) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Codegen_Process.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Codegen_Process) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 2, 0}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 2, 0}
 }
 
 func (x *SQLCPlugin_Codegen_Process) GetCmd() string {
@@ -3726,7 +6291,7 @@ type SQLCPlugin_Codegen_WASM struct {
 
 func (x *SQLCPlugin_Codegen_WASM) Reset() {
 	*x = SQLCPlugin_Codegen_WASM{}
-	mi := &file_encore_daemon_daemon_proto_msgTypes[50]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3738,7 +6303,7 @@ func (x *SQLCPlugin_Codegen_WASM) String() string {
 func (*SQLCPlugin_Codegen_WASM) ProtoMessage() {}
 
 func (x *SQLCPlugin_Codegen_WASM) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_daemon_daemon_proto_msgTypes[50]
+	mi := &file_encore_daemon_daemon_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3751,7 +6316,7 @@ func (x *SQLCPlugin_Codegen_WASM) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLCPlugin_Codegen_WASM.ProtoReflect.Descriptor instead.
 func (*SQLCPlugin_Codegen_WASM) Descriptor() ([]byte, []int) {
-	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{34, 2, 1}
+	return file_encore_daemon_daemon_proto_rawDescGZIP(), []int{69, 2, 1}
 }
 
 func (x *SQLCPlugin_Codegen_WASM) GetUrl() string {
@@ -3772,7 +6337,7 @@ var File_encore_daemon_daemon_proto protoreflect.FileDescriptor
 
 const file_encore_daemon_daemon_proto_rawDesc = "" +
 	"\n" +
-	"\x1aencore/daemon/daemon.proto\x12\rencore.daemon\x1a\x1bgoogle/protobuf/empty.proto\"\xc0\x01\n" +
+	"\x1aencore/daemon/daemon.proto\x12\rencore.daemon\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc0\x01\n" +
 	"\x0eCommandMessage\x126\n" +
 	"\x06output\x18\x01 \x01(\v2\x1c.encore.daemon.CommandOutputH\x00R\x06output\x120\n" +
 	"\x04exit\x18\x02 \x01(\v2\x1a.encore.daemon.CommandExitH\x00R\x04exit\x12=\n" +
@@ -3821,7 +6386,7 @@ const file_encore_daemon_daemon_proto_rawDesc = "" +
 	"\n" +
 	"_namespaceB\f\n" +
 	"\n" +
-	"_log_level\"\xf0\x01\n" +
+	"_log_level\"\x86\x02\n" +
 	"\vTestRequest\x12\x19\n" +
 	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12\x1f\n" +
 	"\vworking_dir\x18\x02 \x01(\tR\n" +
@@ -3831,15 +6396,32 @@ const file_encore_daemon_daemon_proto_rawDesc = "" +
 	"\n" +
 	"trace_file\x18\x06 \x01(\tH\x00R\ttraceFile\x88\x01\x01\x12#\n" +
 	"\rcodegen_debug\x18\a \x01(\bR\fcodegenDebug\x12\x19\n" +
-	"\btemp_dir\x18\b \x01(\tR\atempDirB\r\n" +
-	"\v_trace_fileJ\x04\b\x05\x10\x06\"\x96\x01\n" +
+	"\btemp_dir\x18\b \x01(\tR\atempDir\x12\x14\n" +
+	"\x05chaos\x18\t \x01(\bR\x05chaosB\r\n" +
+	"\v_trace_fileJ\x04\b\x05\x10\x06\"`\n" +
+	"\x11TestTracesRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"F\n" +
+	"\x12TestTracesResponse\x120\n" +
+	"\x06traces\x18\x01 \x03(\v2\x18.encore.daemon.TestTraceR\x06traces\"\x84\x02\n" +
+	"\tTestTrace\x12\x19\n" +
+	"\btrace_id\x18\x01 \x01(\tR\atraceId\x12\x1b\n" +
+	"\ttest_name\x18\x02 \x01(\tR\btestName\x12\x1b\n" +
+	"\ttest_file\x18\x03 \x01(\tR\btestFile\x12\x18\n" +
+	"\aservice\x18\x04 \x01(\tR\aservice\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\bR\x06failed\x12\x18\n" +
+	"\askipped\x18\x06 \x01(\bR\askipped\x129\n" +
+	"\n" +
+	"started_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12\x1b\n" +
+	"\ttrace_url\x18\b \x01(\tR\btraceUrl\"\xac\x01\n" +
 	"\x0fTestSpecRequest\x12\x19\n" +
 	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12\x1f\n" +
 	"\vworking_dir\x18\x02 \x01(\tR\n" +
 	"workingDir\x12\x12\n" +
 	"\x04args\x18\x03 \x03(\tR\x04args\x12\x18\n" +
 	"\aenviron\x18\x04 \x03(\tR\aenviron\x12\x19\n" +
-	"\btemp_dir\x18\x05 \x01(\tR\atempDir\"Z\n" +
+	"\btemp_dir\x18\x05 \x01(\tR\atempDir\x12\x14\n" +
+	"\x05chaos\x18\x06 \x01(\bR\x05chaos\"Z\n" +
 	"\x10TestSpecResponse\x12\x18\n" +
 	"\acommand\x18\x01 \x01(\tR\acommand\x12\x12\n" +
 	"\x04args\x18\x02 \x03(\tR\x04args\x12\x18\n" +
@@ -3856,7 +6438,7 @@ const file_encore_daemon_daemon_proto_rawDesc = "" +
 	"\tnamespace\x18\a \x01(\tH\x01R\tnamespace\x88\x01\x01B\r\n" +
 	"\v_trace_fileB\f\n" +
 	"\n" +
-	"_namespace\"\xaa\x01\n" +
+	"_namespace\"\xfb\x01\n" +
 	"\fCheckRequest\x12\x19\n" +
 	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12\x1f\n" +
 	"\vworking_dir\x18\x02 \x01(\tR\n" +
@@ -3864,7 +6446,9 @@ const file_encore_daemon_daemon_proto_rawDesc = "" +
 	"\rcodegen_debug\x18\x03 \x01(\bR\fcodegenDebug\x12\x1f\n" +
 	"\vparse_tests\x18\x04 \x01(\bR\n" +
 	"parseTests\x12\x18\n" +
-	"\aenviron\x18\x05 \x03(\tR\aenviron\"\x87\x03\n" +
+	"\aenviron\x18\x05 \x03(\tR\aenviron\x12'\n" +
+	"\x0fmigrations_base\x18\x06 \x01(\tR\x0emigrationsBase\x12&\n" +
+	"\x0fapi_compat_base\x18\a \x01(\tR\rapiCompatBase\"\x87\x03\n" +
 	"\rExportRequest\x12\x19\n" +
 	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12\x12\n" +
 	"\x04goos\x18\x02 \x01(\tR\x04goos\x12\x16\n" +
@@ -3903,14 +6487,214 @@ const file_encore_daemon_daemon_proto_rawDesc = "" +
 	"\tnamespace\x18\x05 \x01(\tH\x00R\tnamespace\x88\x01\x01\x12)\n" +
 	"\x04role\x18\x06 \x01(\x0e2\x15.encore.daemon.DBRoleR\x04roleB\f\n" +
 	"\n" +
-	"_namespace\"\xc4\x01\n" +
+	"_namespace\"\xfd\x01\n" +
 	"\x0eDBResetRequest\x12\x19\n" +
 	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12%\n" +
 	"\x0edatabase_names\x18\x02 \x03(\tR\rdatabaseNames\x12?\n" +
 	"\fcluster_type\x18\x03 \x01(\x0e2\x1c.encore.daemon.DBClusterTypeR\vclusterType\x12!\n" +
-	"\tnamespace\x18\x04 \x01(\tH\x00R\tnamespace\x88\x01\x01B\f\n" +
+	"\tnamespace\x18\x04 \x01(\tH\x00R\tnamespace\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"skip_seeds\x18\x05 \x01(\bR\tskipSeeds\x12\x18\n" +
+	"\aenviron\x18\x06 \x03(\tR\aenvironB\f\n" +
+	"\n" +
+	"_namespace\"\x93\x03\n" +
+	"\x10DBMigrateRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12\x17\n" +
+	"\adb_name\x18\x02 \x01(\tR\x06dbName\x12?\n" +
+	"\fcluster_type\x18\x03 \x01(\x0e2\x1c.encore.daemon.DBClusterTypeR\vclusterType\x12!\n" +
+	"\tnamespace\x18\x04 \x01(\tH\x00R\tnamespace\x88\x01\x01\x12>\n" +
+	"\x06action\x18\x05 \x01(\x0e2&.encore.daemon.DBMigrateRequest.ActionR\x06action\x12\x13\n" +
+	"\x02to\x18\x06 \x01(\x04H\x01R\x02to\x88\x01\x01\x12\x14\n" +
+	"\x05steps\x18\a \x01(\rR\x05steps\x12\x18\n" +
+	"\aversion\x18\b \x01(\x04R\aversion\"M\n" +
+	"\x06Action\x12\x11\n" +
+	"\rACTION_STATUS\x10\x00\x12\r\n" +
+	"\tACTION_UP\x10\x01\x12\x0f\n" +
+	"\vACTION_DOWN\x10\x02\x12\x10\n" +
+	"\fACTION_FORCE\x10\x03B\f\n" +
+	"\n" +
+	"_namespaceB\x05\n" +
+	"\x03_to\"o\n" +
+	"\x11DBMigrateResponse\x12@\n" +
+	"\n" +
+	"migrations\x18\x01 \x03(\v2 .encore.daemon.DBMigrationStatusR\n" +
+	"migrations\x12\x18\n" +
+	"\achanged\x18\x02 \x03(\x04R\achanged\"\xdb\x01\n" +
+	"\x11DBMigrationStatus\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x04R\x06number\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x18\n" +
+	"\aapplied\x18\x04 \x01(\bR\aapplied\x12\x14\n" +
+	"\x05dirty\x18\x05 \x01(\bR\x05dirty\x12\x19\n" +
+	"\bhas_down\x18\x06 \x01(\bR\ahasDown\x12%\n" +
+	"\x0edata_migration\x18\a \x01(\bR\rdataMigration\"t\n" +
+	"\rDBDiffRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12\x17\n" +
+	"\adb_name\x18\x02 \x01(\tR\x06dbName\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x00R\tnamespace\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespace\"\x83\x01\n" +
+	"\x0eDBDiffResponse\x12\x0e\n" +
+	"\x02up\x18\x01 \x01(\tR\x02up\x12\x12\n" +
+	"\x04down\x18\x02 \x01(\tR\x04down\x12\x1f\n" +
+	"\vnext_number\x18\x03 \x01(\x04R\n" +
+	"nextNumber\x12,\n" +
+	"\x12migration_rel_path\x18\x04 \x01(\tR\x10migrationRelPath\"\xf4\x01\n" +
+	"\x11DBSnapshotRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x12?\n" +
+	"\x06action\x18\x03 \x01(\x0e2'.encore.daemon.DBSnapshotRequest.ActionR\x06action\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\">\n" +
+	"\x06Action\x12\x0f\n" +
+	"\vACTION_LIST\x10\x00\x12\x0f\n" +
+	"\vACTION_SAVE\x10\x01\x12\x12\n" +
+	"\x0eACTION_RESTORE\x10\x02B\f\n" +
+	"\n" +
+	"_namespace\"\x92\x02\n" +
+	"\x12DBSnapshotResponse\x12H\n" +
+	"\tsnapshots\x18\x01 \x03(\v2*.encore.daemon.DBSnapshotResponse.SnapshotR\tsnapshots\x12\x1a\n" +
+	"\brestored\x18\x02 \x03(\tR\brestored\x1a\x95\x01\n" +
+	"\bSnapshot\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1c\n" +
+	"\tdatabases\x18\x03 \x03(\tR\tdatabases\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"_\n" +
+	"\x11CacheFlushRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespace\"^\n" +
+	"\x10CacheDumpRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01B\f\n" +
 	"\n" +
-	"_namespace\"\x93\x04\n" +
+	"_namespace\"/\n" +
+	"\x11CacheDumpResponse\x12\x1a\n" +
+	"\bsnapshot\x18\x01 \x01(\fR\bsnapshot\"}\n" +
+	"\x13CacheRestoreRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x12\x1a\n" +
+	"\bsnapshot\x18\x03 \x01(\fR\bsnapshotB\f\n" +
+	"\n" +
+	"_namespace\"a\n" +
+	"\x14PubSubPublishRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\fR\amessage\"6\n" +
+	"\x15PubSubPublishResponse\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\"/\n" +
+	"\x12PubSubStatsRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\"N\n" +
+	"\x13PubSubStatsResponse\x127\n" +
+	"\x06topics\x18\x01 \x03(\v2\x1f.encore.daemon.PubSubTopicStatsR\x06topics\"\xa8\x01\n" +
+	"\x10PubSubTopicStats\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x03R\x05depth\x12\x1a\n" +
+	"\bmessages\x18\x03 \x01(\x04R\bmessages\x12L\n" +
+	"\rsubscriptions\x18\x04 \x03(\v2&.encore.daemon.PubSubSubscriptionStatsR\rsubscriptions\"\xff\x01\n" +
+	"\x17PubSubSubscriptionStats\x12\"\n" +
+	"\fsubscription\x18\x01 \x01(\tR\fsubscription\x12\x1c\n" +
+	"\tconnected\x18\x02 \x01(\bR\tconnected\x12\x14\n" +
+	"\x05depth\x18\x03 \x01(\x03R\x05depth\x12\x1b\n" +
+	"\tin_flight\x18\x04 \x01(\x05R\binFlight\x12\x1a\n" +
+	"\bdeferred\x18\x05 \x01(\x05R\bdeferred\x12\x1a\n" +
+	"\bmessages\x18\x06 \x01(\x04R\bmessages\x12\x1a\n" +
+	"\brequeued\x18\a \x01(\x04R\brequeued\x12\x1b\n" +
+	"\ttimed_out\x18\b \x01(\x04R\btimedOut\"\xa4\x01\n" +
+	"\x16PubSubDeadLetterFilter\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12\x19\n" +
+	"\x05topic\x18\x02 \x01(\tH\x00R\x05topic\x88\x01\x01\x12'\n" +
+	"\fsubscription\x18\x03 \x01(\tH\x01R\fsubscription\x88\x01\x01\x12\x10\n" +
+	"\x03ids\x18\x04 \x03(\tR\x03idsB\b\n" +
+	"\x06_topicB\x0f\n" +
+	"\r_subscription\"\\\n" +
+	"\x1dPubSubListDeadLettersResponse\x12;\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1f.encore.daemon.PubSubDeadLetterR\bmessages\"\x8c\x04\n" +
+	"\x10PubSubDeadLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\"\n" +
+	"\fsubscription\x18\x03 \x01(\tR\fsubscription\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x04 \x01(\tR\tmessageId\x12O\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v2/.encore.daemon.PubSubDeadLetter.AttributesEntryR\n" +
+	"attributes\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\x12=\n" +
+	"\fpublish_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vpublishTime\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12\x1e\n" +
+	"\btrace_id\x18\n" +
+	" \x01(\tH\x00R\atraceId\x88\x01\x01\x12D\n" +
+	"\x10dead_lettered_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0edeadLetteredAt\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\v\n" +
+	"\t_trace_id\".\n" +
+	"\x16PubSubDeadLettersCount\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"c\n" +
+	"\x14TrafficRecordRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12\x18\n" +
+	"\asession\x18\x02 \x01(\tR\asession\x12\x16\n" +
+	"\x06append\x18\x03 \x01(\bR\x06append\"\x95\x01\n" +
+	"\x0fTrafficExchange\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x1f\n" +
+	"\vstatus_code\x18\x04 \x01(\x05R\n" +
+	"statusCode\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x03R\n" +
+	"durationMs\"\x86\x01\n" +
+	"\x14TrafficReplayRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12\x18\n" +
+	"\asession\x18\x02 \x01(\tR\asession\x12\x14\n" +
+	"\x05speed\x18\x03 \x01(\x01R\x05speed\x12#\n" +
+	"\rignore_fields\x18\x04 \x03(\tR\fignoreFields\"\xf5\x01\n" +
+	"\x13TrafficReplayResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x120\n" +
+	"\x14recorded_status_code\x18\x04 \x01(\x05R\x12recordedStatusCode\x12\x1f\n" +
+	"\vstatus_code\x18\x05 \x01(\x05R\n" +
+	"statusCode\x12\x1f\n" +
+	"\vduration_ms\x18\x06 \x01(\x03R\n" +
+	"durationMs\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12\x12\n" +
+	"\x04diff\x18\b \x01(\tR\x04diff\"3\n" +
+	"\x16TrafficSessionsRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\"\xdd\x01\n" +
+	"\x17TrafficSessionsResponse\x12J\n" +
+	"\bsessions\x18\x01 \x03(\v2..encore.daemon.TrafficSessionsResponse.SessionR\bsessions\x1av\n" +
+	"\aSession\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\brequests\x18\x02 \x01(\x05R\brequests\x12;\n" +
+	"\vrecorded_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAt\"\xbf\x01\n" +
+	"\tChaosRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x1a\n" +
+	"\bendpoint\x18\x04 \x01(\tR\bendpoint\x12\x1a\n" +
+	"\bresource\x18\x05 \x01(\tR\bresource\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x06 \x01(\x03R\tlatencyMs\x12\x1d\n" +
+	"\n" +
+	"error_rate\x18\a \x01(\x01R\terrorRate\"@\n" +
+	"\x11ChaosRulesRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12\x10\n" +
+	"\x03env\x18\x02 \x01(\tR\x03env\"D\n" +
+	"\x12ChaosRulesResponse\x12.\n" +
+	"\x05rules\x18\x01 \x03(\v2\x18.encore.daemon.ChaosRuleR\x05rules\"p\n" +
+	"\x13ChaosAddRuleRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12,\n" +
+	"\x04rule\x18\x02 \x01(\v2\x18.encore.daemon.ChaosRuleR\x04rule\x12\x10\n" +
+	"\x03env\x18\x03 \x01(\tR\x03env\"X\n" +
+	"\x17ChaosRemoveRulesRequest\x12\x19\n" +
+	"\bapp_root\x18\x01 \x01(\tR\aappRoot\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\tR\x03ids\x12\x10\n" +
+	"\x03env\x18\x03 \x01(\tR\x03env\"\x93\x04\n" +
 	"\x10GenClientRequest\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x19\n" +
 	"\benv_name\x18\x02 \x01(\tR\aenvName\x12\x12\n" +
@@ -4089,11 +6873,13 @@ const file_encore_daemon_daemon_proto_rawDesc = "" +
 	"\x1bDB_CLUSTER_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13DB_CLUSTER_TYPE_RUN\x10\x01\x12\x18\n" +
 	"\x14DB_CLUSTER_TYPE_TEST\x10\x02\x12\x1a\n" +
-	"\x16DB_CLUSTER_TYPE_SHADOW\x10\x032\xa7\f\n" +
+	"\x16DB_CLUSTER_TYPE_SHADOW\x10\x032\xc4\x18\n" +
 	"\x06Daemon\x12A\n" +
 	"\x03Run\x12\x19.encore.daemon.RunRequest\x1a\x1d.encore.daemon.CommandMessage0\x01\x12C\n" +
 	"\x04Test\x12\x1a.encore.daemon.TestRequest\x1a\x1d.encore.daemon.CommandMessage0\x01\x12K\n" +
-	"\bTestSpec\x12\x1e.encore.daemon.TestSpecRequest\x1a\x1f.encore.daemon.TestSpecResponse\x12O\n" +
+	"\bTestSpec\x12\x1e.encore.daemon.TestSpecRequest\x1a\x1f.encore.daemon.TestSpecResponse\x12Q\n" +
+	"\n" +
+	"TestTraces\x12 .encore.daemon.TestTracesRequest\x1a!.encore.daemon.TestTracesResponse\x12O\n" +
 	"\n" +
 	"ExecScript\x12 .encore.daemon.ExecScriptRequest\x1a\x1d.encore.daemon.CommandMessage0\x01\x12E\n" +
 	"\x05Check\x12\x1b.encore.daemon.CheckRequest\x1a\x1d.encore.daemon.CommandMessage0\x01\x12G\n" +
@@ -4101,6 +6887,26 @@ const file_encore_daemon_daemon_proto_rawDesc = "" +
 	"\tDBConnect\x12\x1f.encore.daemon.DBConnectRequest\x1a .encore.daemon.DBConnectResponse\x12I\n" +
 	"\aDBProxy\x12\x1d.encore.daemon.DBProxyRequest\x1a\x1d.encore.daemon.CommandMessage0\x01\x12I\n" +
 	"\aDBReset\x12\x1d.encore.daemon.DBResetRequest\x1a\x1d.encore.daemon.CommandMessage0\x01\x12N\n" +
+	"\tDBMigrate\x12\x1f.encore.daemon.DBMigrateRequest\x1a .encore.daemon.DBMigrateResponse\x12Q\n" +
+	"\n" +
+	"DBSnapshot\x12 .encore.daemon.DBSnapshotRequest\x1a!.encore.daemon.DBSnapshotResponse\x12E\n" +
+	"\x06DBDiff\x12\x1c.encore.daemon.DBDiffRequest\x1a\x1d.encore.daemon.DBDiffResponse\x12F\n" +
+	"\n" +
+	"CacheFlush\x12 .encore.daemon.CacheFlushRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\tCacheDump\x12\x1f.encore.daemon.CacheDumpRequest\x1a .encore.daemon.CacheDumpResponse\x12J\n" +
+	"\fCacheRestore\x12\".encore.daemon.CacheRestoreRequest\x1a\x16.google.protobuf.Empty\x12Z\n" +
+	"\rPubSubPublish\x12#.encore.daemon.PubSubPublishRequest\x1a$.encore.daemon.PubSubPublishResponse\x12T\n" +
+	"\vPubSubStats\x12!.encore.daemon.PubSubStatsRequest\x1a\".encore.daemon.PubSubStatsResponse\x12l\n" +
+	"\x15PubSubListDeadLetters\x12%.encore.daemon.PubSubDeadLetterFilter\x1a,.encore.daemon.PubSubListDeadLettersResponse\x12g\n" +
+	"\x17PubSubReplayDeadLetters\x12%.encore.daemon.PubSubDeadLetterFilter\x1a%.encore.daemon.PubSubDeadLettersCount\x12f\n" +
+	"\x16PubSubPurgeDeadLetters\x12%.encore.daemon.PubSubDeadLetterFilter\x1a%.encore.daemon.PubSubDeadLettersCount\x12V\n" +
+	"\rTrafficRecord\x12#.encore.daemon.TrafficRecordRequest\x1a\x1e.encore.daemon.TrafficExchange0\x01\x12Z\n" +
+	"\rTrafficReplay\x12#.encore.daemon.TrafficReplayRequest\x1a\".encore.daemon.TrafficReplayResult0\x01\x12`\n" +
+	"\x0fTrafficSessions\x12%.encore.daemon.TrafficSessionsRequest\x1a&.encore.daemon.TrafficSessionsResponse\x12Q\n" +
+	"\n" +
+	"ChaosRules\x12 .encore.daemon.ChaosRulesRequest\x1a!.encore.daemon.ChaosRulesResponse\x12L\n" +
+	"\fChaosAddRule\x12\".encore.daemon.ChaosAddRuleRequest\x1a\x18.encore.daemon.ChaosRule\x12R\n" +
+	"\x10ChaosRemoveRules\x12&.encore.daemon.ChaosRemoveRulesRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
 	"\tGenClient\x12\x1f.encore.daemon.GenClientRequest\x1a .encore.daemon.GenClientResponse\x12T\n" +
 	"\vGenWrappers\x12!.encore.daemon.GenWrappersRequest\x1a\".encore.daemon.GenWrappersResponse\x12]\n" +
 	"\x0eSecretsRefresh\x12$.encore.daemon.SecretsRefreshRequest\x1a%.encore.daemon.SecretsRefreshResponse\x12A\n" +
@@ -4125,146 +6931,242 @@ func file_encore_daemon_daemon_proto_rawDescGZIP() []byte {
 	return file_encore_daemon_daemon_proto_rawDescData
 }
 
-var file_encore_daemon_daemon_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_encore_daemon_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_encore_daemon_daemon_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_encore_daemon_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
 var file_encore_daemon_daemon_proto_goTypes = []any{
-	(DBRole)(0),                         // 0: encore.daemon.DBRole
-	(DBClusterType)(0),                  // 1: encore.daemon.DBClusterType
-	(RunRequest_BrowserMode)(0),         // 2: encore.daemon.RunRequest.BrowserMode
-	(RunRequest_DebugMode)(0),           // 3: encore.daemon.RunRequest.DebugMode
-	(DumpMetaRequest_Format)(0),         // 4: encore.daemon.DumpMetaRequest.Format
-	(*CommandMessage)(nil),              // 5: encore.daemon.CommandMessage
-	(*CommandOutput)(nil),               // 6: encore.daemon.CommandOutput
-	(*CommandExit)(nil),                 // 7: encore.daemon.CommandExit
-	(*CommandDisplayErrors)(nil),        // 8: encore.daemon.CommandDisplayErrors
-	(*CreateAppRequest)(nil),            // 9: encore.daemon.CreateAppRequest
-	(*CreateAppResponse)(nil),           // 10: encore.daemon.CreateAppResponse
-	(*RunRequest)(nil),                  // 11: encore.daemon.RunRequest
-	(*TestRequest)(nil),                 // 12: encore.daemon.TestRequest
-	(*TestSpecRequest)(nil),             // 13: encore.daemon.TestSpecRequest
-	(*TestSpecResponse)(nil),            // 14: encore.daemon.TestSpecResponse
-	(*ExecScriptRequest)(nil),           // 15: encore.daemon.ExecScriptRequest
-	(*CheckRequest)(nil),                // 16: encore.daemon.CheckRequest
-	(*ExportRequest)(nil),               // 17: encore.daemon.ExportRequest
-	(*DockerExportParams)(nil),          // 18: encore.daemon.DockerExportParams
-	(*DBConnectRequest)(nil),            // 19: encore.daemon.DBConnectRequest
-	(*DBConnectResponse)(nil),           // 20: encore.daemon.DBConnectResponse
-	(*DBProxyRequest)(nil),              // 21: encore.daemon.DBProxyRequest
-	(*DBResetRequest)(nil),              // 22: encore.daemon.DBResetRequest
-	(*GenClientRequest)(nil),            // 23: encore.daemon.GenClientRequest
-	(*GenClientResponse)(nil),           // 24: encore.daemon.GenClientResponse
-	(*GenWrappersRequest)(nil),          // 25: encore.daemon.GenWrappersRequest
-	(*GenWrappersResponse)(nil),         // 26: encore.daemon.GenWrappersResponse
-	(*SecretsRefreshRequest)(nil),       // 27: encore.daemon.SecretsRefreshRequest
-	(*SecretsRefreshResponse)(nil),      // 28: encore.daemon.SecretsRefreshResponse
-	(*VersionResponse)(nil),             // 29: encore.daemon.VersionResponse
-	(*Namespace)(nil),                   // 30: encore.daemon.Namespace
-	(*CreateNamespaceRequest)(nil),      // 31: encore.daemon.CreateNamespaceRequest
-	(*SwitchNamespaceRequest)(nil),      // 32: encore.daemon.SwitchNamespaceRequest
-	(*ListNamespacesRequest)(nil),       // 33: encore.daemon.ListNamespacesRequest
-	(*DeleteNamespaceRequest)(nil),      // 34: encore.daemon.DeleteNamespaceRequest
-	(*ListNamespacesResponse)(nil),      // 35: encore.daemon.ListNamespacesResponse
-	(*TelemetryConfig)(nil),             // 36: encore.daemon.TelemetryConfig
-	(*DumpMetaRequest)(nil),             // 37: encore.daemon.DumpMetaRequest
-	(*DumpMetaResponse)(nil),            // 38: encore.daemon.DumpMetaResponse
-	(*SQLCPlugin)(nil),                  // 39: encore.daemon.SQLCPlugin
-	(*SQLCPlugin_File)(nil),             // 40: encore.daemon.SQLCPlugin.File
-	(*SQLCPlugin_Settings)(nil),         // 41: encore.daemon.SQLCPlugin.Settings
-	(*SQLCPlugin_Codegen)(nil),          // 42: encore.daemon.SQLCPlugin.Codegen
-	(*SQLCPlugin_Catalog)(nil),          // 43: encore.daemon.SQLCPlugin.Catalog
-	(*SQLCPlugin_Schema)(nil),           // 44: encore.daemon.SQLCPlugin.Schema
-	(*SQLCPlugin_CompositeType)(nil),    // 45: encore.daemon.SQLCPlugin.CompositeType
-	(*SQLCPlugin_Enum)(nil),             // 46: encore.daemon.SQLCPlugin.Enum
-	(*SQLCPlugin_Table)(nil),            // 47: encore.daemon.SQLCPlugin.Table
-	(*SQLCPlugin_Identifier)(nil),       // 48: encore.daemon.SQLCPlugin.Identifier
-	(*SQLCPlugin_Column)(nil),           // 49: encore.daemon.SQLCPlugin.Column
-	(*SQLCPlugin_Query)(nil),            // 50: encore.daemon.SQLCPlugin.Query
-	(*SQLCPlugin_Parameter)(nil),        // 51: encore.daemon.SQLCPlugin.Parameter
-	(*SQLCPlugin_GenerateRequest)(nil),  // 52: encore.daemon.SQLCPlugin.GenerateRequest
-	(*SQLCPlugin_GenerateResponse)(nil), // 53: encore.daemon.SQLCPlugin.GenerateResponse
-	(*SQLCPlugin_Codegen_Process)(nil),  // 54: encore.daemon.SQLCPlugin.Codegen.Process
-	(*SQLCPlugin_Codegen_WASM)(nil),     // 55: encore.daemon.SQLCPlugin.Codegen.WASM
-	(*emptypb.Empty)(nil),               // 56: google.protobuf.Empty
+	(DBRole)(0),                             // 0: encore.daemon.DBRole
+	(DBClusterType)(0),                      // 1: encore.daemon.DBClusterType
+	(RunRequest_BrowserMode)(0),             // 2: encore.daemon.RunRequest.BrowserMode
+	(RunRequest_DebugMode)(0),               // 3: encore.daemon.RunRequest.DebugMode
+	(DBMigrateRequest_Action)(0),            // 4: encore.daemon.DBMigrateRequest.Action
+	(DBSnapshotRequest_Action)(0),           // 5: encore.daemon.DBSnapshotRequest.Action
+	(DumpMetaRequest_Format)(0),             // 6: encore.daemon.DumpMetaRequest.Format
+	(*CommandMessage)(nil),                  // 7: encore.daemon.CommandMessage
+	(*CommandOutput)(nil),                   // 8: encore.daemon.CommandOutput
+	(*CommandExit)(nil),                     // 9: encore.daemon.CommandExit
+	(*CommandDisplayErrors)(nil),            // 10: encore.daemon.CommandDisplayErrors
+	(*CreateAppRequest)(nil),                // 11: encore.daemon.CreateAppRequest
+	(*CreateAppResponse)(nil),               // 12: encore.daemon.CreateAppResponse
+	(*RunRequest)(nil),                      // 13: encore.daemon.RunRequest
+	(*TestRequest)(nil),                     // 14: encore.daemon.TestRequest
+	(*TestTracesRequest)(nil),               // 15: encore.daemon.TestTracesRequest
+	(*TestTracesResponse)(nil),              // 16: encore.daemon.TestTracesResponse
+	(*TestTrace)(nil),                       // 17: encore.daemon.TestTrace
+	(*TestSpecRequest)(nil),                 // 18: encore.daemon.TestSpecRequest
+	(*TestSpecResponse)(nil),                // 19: encore.daemon.TestSpecResponse
+	(*ExecScriptRequest)(nil),               // 20: encore.daemon.ExecScriptRequest
+	(*CheckRequest)(nil),                    // 21: encore.daemon.CheckRequest
+	(*ExportRequest)(nil),                   // 22: encore.daemon.ExportRequest
+	(*DockerExportParams)(nil),              // 23: encore.daemon.DockerExportParams
+	(*DBConnectRequest)(nil),                // 24: encore.daemon.DBConnectRequest
+	(*DBConnectResponse)(nil),               // 25: encore.daemon.DBConnectResponse
+	(*DBProxyRequest)(nil),                  // 26: encore.daemon.DBProxyRequest
+	(*DBResetRequest)(nil),                  // 27: encore.daemon.DBResetRequest
+	(*DBMigrateRequest)(nil),                // 28: encore.daemon.DBMigrateRequest
+	(*DBMigrateResponse)(nil),               // 29: encore.daemon.DBMigrateResponse
+	(*DBMigrationStatus)(nil),               // 30: encore.daemon.DBMigrationStatus
+	(*DBDiffRequest)(nil),                   // 31: encore.daemon.DBDiffRequest
+	(*DBDiffResponse)(nil),                  // 32: encore.daemon.DBDiffResponse
+	(*DBSnapshotRequest)(nil),               // 33: encore.daemon.DBSnapshotRequest
+	(*DBSnapshotResponse)(nil),              // 34: encore.daemon.DBSnapshotResponse
+	(*CacheFlushRequest)(nil),               // 35: encore.daemon.CacheFlushRequest
+	(*CacheDumpRequest)(nil),                // 36: encore.daemon.CacheDumpRequest
+	(*CacheDumpResponse)(nil),               // 37: encore.daemon.CacheDumpResponse
+	(*CacheRestoreRequest)(nil),             // 38: encore.daemon.CacheRestoreRequest
+	(*PubSubPublishRequest)(nil),            // 39: encore.daemon.PubSubPublishRequest
+	(*PubSubPublishResponse)(nil),           // 40: encore.daemon.PubSubPublishResponse
+	(*PubSubStatsRequest)(nil),              // 41: encore.daemon.PubSubStatsRequest
+	(*PubSubStatsResponse)(nil),             // 42: encore.daemon.PubSubStatsResponse
+	(*PubSubTopicStats)(nil),                // 43: encore.daemon.PubSubTopicStats
+	(*PubSubSubscriptionStats)(nil),         // 44: encore.daemon.PubSubSubscriptionStats
+	(*PubSubDeadLetterFilter)(nil),          // 45: encore.daemon.PubSubDeadLetterFilter
+	(*PubSubListDeadLettersResponse)(nil),   // 46: encore.daemon.PubSubListDeadLettersResponse
+	(*PubSubDeadLetter)(nil),                // 47: encore.daemon.PubSubDeadLetter
+	(*PubSubDeadLettersCount)(nil),          // 48: encore.daemon.PubSubDeadLettersCount
+	(*TrafficRecordRequest)(nil),            // 49: encore.daemon.TrafficRecordRequest
+	(*TrafficExchange)(nil),                 // 50: encore.daemon.TrafficExchange
+	(*TrafficReplayRequest)(nil),            // 51: encore.daemon.TrafficReplayRequest
+	(*TrafficReplayResult)(nil),             // 52: encore.daemon.TrafficReplayResult
+	(*TrafficSessionsRequest)(nil),          // 53: encore.daemon.TrafficSessionsRequest
+	(*TrafficSessionsResponse)(nil),         // 54: encore.daemon.TrafficSessionsResponse
+	(*ChaosRule)(nil),                       // 55: encore.daemon.ChaosRule
+	(*ChaosRulesRequest)(nil),               // 56: encore.daemon.ChaosRulesRequest
+	(*ChaosRulesResponse)(nil),              // 57: encore.daemon.ChaosRulesResponse
+	(*ChaosAddRuleRequest)(nil),             // 58: encore.daemon.ChaosAddRuleRequest
+	(*ChaosRemoveRulesRequest)(nil),         // 59: encore.daemon.ChaosRemoveRulesRequest
+	(*GenClientRequest)(nil),                // 60: encore.daemon.GenClientRequest
+	(*GenClientResponse)(nil),               // 61: encore.daemon.GenClientResponse
+	(*GenWrappersRequest)(nil),              // 62: encore.daemon.GenWrappersRequest
+	(*GenWrappersResponse)(nil),             // 63: encore.daemon.GenWrappersResponse
+	(*SecretsRefreshRequest)(nil),           // 64: encore.daemon.SecretsRefreshRequest
+	(*SecretsRefreshResponse)(nil),          // 65: encore.daemon.SecretsRefreshResponse
+	(*VersionResponse)(nil),                 // 66: encore.daemon.VersionResponse
+	(*Namespace)(nil),                       // 67: encore.daemon.Namespace
+	(*CreateNamespaceRequest)(nil),          // 68: encore.daemon.CreateNamespaceRequest
+	(*SwitchNamespaceRequest)(nil),          // 69: encore.daemon.SwitchNamespaceRequest
+	(*ListNamespacesRequest)(nil),           // 70: encore.daemon.ListNamespacesRequest
+	(*DeleteNamespaceRequest)(nil),          // 71: encore.daemon.DeleteNamespaceRequest
+	(*ListNamespacesResponse)(nil),          // 72: encore.daemon.ListNamespacesResponse
+	(*TelemetryConfig)(nil),                 // 73: encore.daemon.TelemetryConfig
+	(*DumpMetaRequest)(nil),                 // 74: encore.daemon.DumpMetaRequest
+	(*DumpMetaResponse)(nil),                // 75: encore.daemon.DumpMetaResponse
+	(*SQLCPlugin)(nil),                      // 76: encore.daemon.SQLCPlugin
+	(*DBSnapshotResponse_Snapshot)(nil),     // 77: encore.daemon.DBSnapshotResponse.Snapshot
+	nil,                                     // 78: encore.daemon.PubSubDeadLetter.AttributesEntry
+	(*TrafficSessionsResponse_Session)(nil), // 79: encore.daemon.TrafficSessionsResponse.Session
+	(*SQLCPlugin_File)(nil),                 // 80: encore.daemon.SQLCPlugin.File
+	(*SQLCPlugin_Settings)(nil),             // 81: encore.daemon.SQLCPlugin.Settings
+	(*SQLCPlugin_Codegen)(nil),              // 82: encore.daemon.SQLCPlugin.Codegen
+	(*SQLCPlugin_Catalog)(nil),              // 83: encore.daemon.SQLCPlugin.Catalog
+	(*SQLCPlugin_Schema)(nil),               // 84: encore.daemon.SQLCPlugin.Schema
+	(*SQLCPlugin_CompositeType)(nil),        // 85: encore.daemon.SQLCPlugin.CompositeType
+	(*SQLCPlugin_Enum)(nil),                 // 86: encore.daemon.SQLCPlugin.Enum
+	(*SQLCPlugin_Table)(nil),                // 87: encore.daemon.SQLCPlugin.Table
+	(*SQLCPlugin_Identifier)(nil),           // 88: encore.daemon.SQLCPlugin.Identifier
+	(*SQLCPlugin_Column)(nil),               // 89: encore.daemon.SQLCPlugin.Column
+	(*SQLCPlugin_Query)(nil),                // 90: encore.daemon.SQLCPlugin.Query
+	(*SQLCPlugin_Parameter)(nil),            // 91: encore.daemon.SQLCPlugin.Parameter
+	(*SQLCPlugin_GenerateRequest)(nil),      // 92: encore.daemon.SQLCPlugin.GenerateRequest
+	(*SQLCPlugin_GenerateResponse)(nil),     // 93: encore.daemon.SQLCPlugin.GenerateResponse
+	(*SQLCPlugin_Codegen_Process)(nil),      // 94: encore.daemon.SQLCPlugin.Codegen.Process
+	(*SQLCPlugin_Codegen_WASM)(nil),         // 95: encore.daemon.SQLCPlugin.Codegen.WASM
+	(*timestamppb.Timestamp)(nil),           // 96: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                   // 97: google.protobuf.Empty
 }
 var file_encore_daemon_daemon_proto_depIdxs = []int32{
-	6,  // 0: encore.daemon.CommandMessage.output:type_name -> encore.daemon.CommandOutput
-	7,  // 1: encore.daemon.CommandMessage.exit:type_name -> encore.daemon.CommandExit
-	8,  // 2: encore.daemon.CommandMessage.errors:type_name -> encore.daemon.CommandDisplayErrors
+	8,  // 0: encore.daemon.CommandMessage.output:type_name -> encore.daemon.CommandOutput
+	9,  // 1: encore.daemon.CommandMessage.exit:type_name -> encore.daemon.CommandExit
+	10, // 2: encore.daemon.CommandMessage.errors:type_name -> encore.daemon.CommandDisplayErrors
 	2,  // 3: encore.daemon.RunRequest.browser:type_name -> encore.daemon.RunRequest.BrowserMode
 	3,  // 4: encore.daemon.RunRequest.debug_mode:type_name -> encore.daemon.RunRequest.DebugMode
-	18, // 5: encore.daemon.ExportRequest.docker:type_name -> encore.daemon.DockerExportParams
-	1,  // 6: encore.daemon.DBConnectRequest.cluster_type:type_name -> encore.daemon.DBClusterType
-	0,  // 7: encore.daemon.DBConnectRequest.role:type_name -> encore.daemon.DBRole
-	1,  // 8: encore.daemon.DBProxyRequest.cluster_type:type_name -> encore.daemon.DBClusterType
-	0,  // 9: encore.daemon.DBProxyRequest.role:type_name -> encore.daemon.DBRole
-	1,  // 10: encore.daemon.DBResetRequest.cluster_type:type_name -> encore.daemon.DBClusterType
-	30, // 11: encore.daemon.ListNamespacesResponse.namespaces:type_name -> encore.daemon.Namespace
-	4,  // 12: encore.daemon.DumpMetaRequest.format:type_name -> encore.daemon.DumpMetaRequest.Format
-	42, // 13: encore.daemon.SQLCPlugin.Settings.codegen:type_name -> encore.daemon.SQLCPlugin.Codegen
-	54, // 14: encore.daemon.SQLCPlugin.Codegen.process:type_name -> encore.daemon.SQLCPlugin.Codegen.Process
-	55, // 15: encore.daemon.SQLCPlugin.Codegen.wasm:type_name -> encore.daemon.SQLCPlugin.Codegen.WASM
-	44, // 16: encore.daemon.SQLCPlugin.Catalog.schemas:type_name -> encore.daemon.SQLCPlugin.Schema
-	47, // 17: encore.daemon.SQLCPlugin.Schema.tables:type_name -> encore.daemon.SQLCPlugin.Table
-	46, // 18: encore.daemon.SQLCPlugin.Schema.enums:type_name -> encore.daemon.SQLCPlugin.Enum
-	45, // 19: encore.daemon.SQLCPlugin.Schema.composite_types:type_name -> encore.daemon.SQLCPlugin.CompositeType
-	48, // 20: encore.daemon.SQLCPlugin.Table.rel:type_name -> encore.daemon.SQLCPlugin.Identifier
-	49, // 21: encore.daemon.SQLCPlugin.Table.columns:type_name -> encore.daemon.SQLCPlugin.Column
-	48, // 22: encore.daemon.SQLCPlugin.Column.table:type_name -> encore.daemon.SQLCPlugin.Identifier
-	48, // 23: encore.daemon.SQLCPlugin.Column.type:type_name -> encore.daemon.SQLCPlugin.Identifier
-	48, // 24: encore.daemon.SQLCPlugin.Column.embed_table:type_name -> encore.daemon.SQLCPlugin.Identifier
-	49, // 25: encore.daemon.SQLCPlugin.Query.columns:type_name -> encore.daemon.SQLCPlugin.Column
-	51, // 26: encore.daemon.SQLCPlugin.Query.params:type_name -> encore.daemon.SQLCPlugin.Parameter
-	48, // 27: encore.daemon.SQLCPlugin.Query.insert_into_table:type_name -> encore.daemon.SQLCPlugin.Identifier
-	49, // 28: encore.daemon.SQLCPlugin.Parameter.column:type_name -> encore.daemon.SQLCPlugin.Column
-	41, // 29: encore.daemon.SQLCPlugin.GenerateRequest.settings:type_name -> encore.daemon.SQLCPlugin.Settings
-	43, // 30: encore.daemon.SQLCPlugin.GenerateRequest.catalog:type_name -> encore.daemon.SQLCPlugin.Catalog
-	50, // 31: encore.daemon.SQLCPlugin.GenerateRequest.queries:type_name -> encore.daemon.SQLCPlugin.Query
-	40, // 32: encore.daemon.SQLCPlugin.GenerateResponse.files:type_name -> encore.daemon.SQLCPlugin.File
-	11, // 33: encore.daemon.Daemon.Run:input_type -> encore.daemon.RunRequest
-	12, // 34: encore.daemon.Daemon.Test:input_type -> encore.daemon.TestRequest
-	13, // 35: encore.daemon.Daemon.TestSpec:input_type -> encore.daemon.TestSpecRequest
-	15, // 36: encore.daemon.Daemon.ExecScript:input_type -> encore.daemon.ExecScriptRequest
-	16, // 37: encore.daemon.Daemon.Check:input_type -> encore.daemon.CheckRequest
-	17, // 38: encore.daemon.Daemon.Export:input_type -> encore.daemon.ExportRequest
-	19, // 39: encore.daemon.Daemon.DBConnect:input_type -> encore.daemon.DBConnectRequest
-	21, // 40: encore.daemon.Daemon.DBProxy:input_type -> encore.daemon.DBProxyRequest
-	22, // 41: encore.daemon.Daemon.DBReset:input_type -> encore.daemon.DBResetRequest
-	23, // 42: encore.daemon.Daemon.GenClient:input_type -> encore.daemon.GenClientRequest
-	25, // 43: encore.daemon.Daemon.GenWrappers:input_type -> encore.daemon.GenWrappersRequest
-	27, // 44: encore.daemon.Daemon.SecretsRefresh:input_type -> encore.daemon.SecretsRefreshRequest
-	56, // 45: encore.daemon.Daemon.Version:input_type -> google.protobuf.Empty
-	31, // 46: encore.daemon.Daemon.CreateNamespace:input_type -> encore.daemon.CreateNamespaceRequest
-	32, // 47: encore.daemon.Daemon.SwitchNamespace:input_type -> encore.daemon.SwitchNamespaceRequest
-	33, // 48: encore.daemon.Daemon.ListNamespaces:input_type -> encore.daemon.ListNamespacesRequest
-	34, // 49: encore.daemon.Daemon.DeleteNamespace:input_type -> encore.daemon.DeleteNamespaceRequest
-	37, // 50: encore.daemon.Daemon.DumpMeta:input_type -> encore.daemon.DumpMetaRequest
-	36, // 51: encore.daemon.Daemon.Telemetry:input_type -> encore.daemon.TelemetryConfig
-	9,  // 52: encore.daemon.Daemon.CreateApp:input_type -> encore.daemon.CreateAppRequest
-	5,  // 53: encore.daemon.Daemon.Run:output_type -> encore.daemon.CommandMessage
-	5,  // 54: encore.daemon.Daemon.Test:output_type -> encore.daemon.CommandMessage
-	14, // 55: encore.daemon.Daemon.TestSpec:output_type -> encore.daemon.TestSpecResponse
-	5,  // 56: encore.daemon.Daemon.ExecScript:output_type -> encore.daemon.CommandMessage
-	5,  // 57: encore.daemon.Daemon.Check:output_type -> encore.daemon.CommandMessage
-	5,  // 58: encore.daemon.Daemon.Export:output_type -> encore.daemon.CommandMessage
-	20, // 59: encore.daemon.Daemon.DBConnect:output_type -> encore.daemon.DBConnectResponse
-	5,  // 60: encore.daemon.Daemon.DBProxy:output_type -> encore.daemon.CommandMessage
-	5,  // 61: encore.daemon.Daemon.DBReset:output_type -> encore.daemon.CommandMessage
-	24, // 62: encore.daemon.Daemon.GenClient:output_type -> encore.daemon.GenClientResponse
-	26, // 63: encore.daemon.Daemon.GenWrappers:output_type -> encore.daemon.GenWrappersResponse
-	28, // 64: encore.daemon.Daemon.SecretsRefresh:output_type -> encore.daemon.SecretsRefreshResponse
-	29, // 65: encore.daemon.Daemon.Version:output_type -> encore.daemon.VersionResponse
-	30, // 66: encore.daemon.Daemon.CreateNamespace:output_type -> encore.daemon.Namespace
-	30, // 67: encore.daemon.Daemon.SwitchNamespace:output_type -> encore.daemon.Namespace
-	35, // 68: encore.daemon.Daemon.ListNamespaces:output_type -> encore.daemon.ListNamespacesResponse
-	56, // 69: encore.daemon.Daemon.DeleteNamespace:output_type -> google.protobuf.Empty
-	38, // 70: encore.daemon.Daemon.DumpMeta:output_type -> encore.daemon.DumpMetaResponse
-	56, // 71: encore.daemon.Daemon.Telemetry:output_type -> google.protobuf.Empty
-	10, // 72: encore.daemon.Daemon.CreateApp:output_type -> encore.daemon.CreateAppResponse
-	53, // [53:73] is the sub-list for method output_type
-	33, // [33:53] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	96, // 5: encore.daemon.TestTracesRequest.since:type_name -> google.protobuf.Timestamp
+	17, // 6: encore.daemon.TestTracesResponse.traces:type_name -> encore.daemon.TestTrace
+	96, // 7: encore.daemon.TestTrace.started_at:type_name -> google.protobuf.Timestamp
+	23, // 8: encore.daemon.ExportRequest.docker:type_name -> encore.daemon.DockerExportParams
+	1,  // 9: encore.daemon.DBConnectRequest.cluster_type:type_name -> encore.daemon.DBClusterType
+	0,  // 10: encore.daemon.DBConnectRequest.role:type_name -> encore.daemon.DBRole
+	1,  // 11: encore.daemon.DBProxyRequest.cluster_type:type_name -> encore.daemon.DBClusterType
+	0,  // 12: encore.daemon.DBProxyRequest.role:type_name -> encore.daemon.DBRole
+	1,  // 13: encore.daemon.DBResetRequest.cluster_type:type_name -> encore.daemon.DBClusterType
+	1,  // 14: encore.daemon.DBMigrateRequest.cluster_type:type_name -> encore.daemon.DBClusterType
+	4,  // 15: encore.daemon.DBMigrateRequest.action:type_name -> encore.daemon.DBMigrateRequest.Action
+	30, // 16: encore.daemon.DBMigrateResponse.migrations:type_name -> encore.daemon.DBMigrationStatus
+	5,  // 17: encore.daemon.DBSnapshotRequest.action:type_name -> encore.daemon.DBSnapshotRequest.Action
+	77, // 18: encore.daemon.DBSnapshotResponse.snapshots:type_name -> encore.daemon.DBSnapshotResponse.Snapshot
+	43, // 19: encore.daemon.PubSubStatsResponse.topics:type_name -> encore.daemon.PubSubTopicStats
+	44, // 20: encore.daemon.PubSubTopicStats.subscriptions:type_name -> encore.daemon.PubSubSubscriptionStats
+	47, // 21: encore.daemon.PubSubListDeadLettersResponse.messages:type_name -> encore.daemon.PubSubDeadLetter
+	78, // 22: encore.daemon.PubSubDeadLetter.attributes:type_name -> encore.daemon.PubSubDeadLetter.AttributesEntry
+	96, // 23: encore.daemon.PubSubDeadLetter.publish_time:type_name -> google.protobuf.Timestamp
+	96, // 24: encore.daemon.PubSubDeadLetter.dead_lettered_at:type_name -> google.protobuf.Timestamp
+	79, // 25: encore.daemon.TrafficSessionsResponse.sessions:type_name -> encore.daemon.TrafficSessionsResponse.Session
+	55, // 26: encore.daemon.ChaosRulesResponse.rules:type_name -> encore.daemon.ChaosRule
+	55, // 27: encore.daemon.ChaosAddRuleRequest.rule:type_name -> encore.daemon.ChaosRule
+	67, // 28: encore.daemon.ListNamespacesResponse.namespaces:type_name -> encore.daemon.Namespace
+	6,  // 29: encore.daemon.DumpMetaRequest.format:type_name -> encore.daemon.DumpMetaRequest.Format
+	96, // 30: encore.daemon.DBSnapshotResponse.Snapshot.created_at:type_name -> google.protobuf.Timestamp
+	96, // 31: encore.daemon.TrafficSessionsResponse.Session.recorded_at:type_name -> google.protobuf.Timestamp
+	82, // 32: encore.daemon.SQLCPlugin.Settings.codegen:type_name -> encore.daemon.SQLCPlugin.Codegen
+	94, // 33: encore.daemon.SQLCPlugin.Codegen.process:type_name -> encore.daemon.SQLCPlugin.Codegen.Process
+	95, // 34: encore.daemon.SQLCPlugin.Codegen.wasm:type_name -> encore.daemon.SQLCPlugin.Codegen.WASM
+	84, // 35: encore.daemon.SQLCPlugin.Catalog.schemas:type_name -> encore.daemon.SQLCPlugin.Schema
+	87, // 36: encore.daemon.SQLCPlugin.Schema.tables:type_name -> encore.daemon.SQLCPlugin.Table
+	86, // 37: encore.daemon.SQLCPlugin.Schema.enums:type_name -> encore.daemon.SQLCPlugin.Enum
+	85, // 38: encore.daemon.SQLCPlugin.Schema.composite_types:type_name -> encore.daemon.SQLCPlugin.CompositeType
+	88, // 39: encore.daemon.SQLCPlugin.Table.rel:type_name -> encore.daemon.SQLCPlugin.Identifier
+	89, // 40: encore.daemon.SQLCPlugin.Table.columns:type_name -> encore.daemon.SQLCPlugin.Column
+	88, // 41: encore.daemon.SQLCPlugin.Column.table:type_name -> encore.daemon.SQLCPlugin.Identifier
+	88, // 42: encore.daemon.SQLCPlugin.Column.type:type_name -> encore.daemon.SQLCPlugin.Identifier
+	88, // 43: encore.daemon.SQLCPlugin.Column.embed_table:type_name -> encore.daemon.SQLCPlugin.Identifier
+	89, // 44: encore.daemon.SQLCPlugin.Query.columns:type_name -> encore.daemon.SQLCPlugin.Column
+	91, // 45: encore.daemon.SQLCPlugin.Query.params:type_name -> encore.daemon.SQLCPlugin.Parameter
+	88, // 46: encore.daemon.SQLCPlugin.Query.insert_into_table:type_name -> encore.daemon.SQLCPlugin.Identifier
+	89, // 47: encore.daemon.SQLCPlugin.Parameter.column:type_name -> encore.daemon.SQLCPlugin.Column
+	81, // 48: encore.daemon.SQLCPlugin.GenerateRequest.settings:type_name -> encore.daemon.SQLCPlugin.Settings
+	83, // 49: encore.daemon.SQLCPlugin.GenerateRequest.catalog:type_name -> encore.daemon.SQLCPlugin.Catalog
+	90, // 50: encore.daemon.SQLCPlugin.GenerateRequest.queries:type_name -> encore.daemon.SQLCPlugin.Query
+	80, // 51: encore.daemon.SQLCPlugin.GenerateResponse.files:type_name -> encore.daemon.SQLCPlugin.File
+	13, // 52: encore.daemon.Daemon.Run:input_type -> encore.daemon.RunRequest
+	14, // 53: encore.daemon.Daemon.Test:input_type -> encore.daemon.TestRequest
+	18, // 54: encore.daemon.Daemon.TestSpec:input_type -> encore.daemon.TestSpecRequest
+	15, // 55: encore.daemon.Daemon.TestTraces:input_type -> encore.daemon.TestTracesRequest
+	20, // 56: encore.daemon.Daemon.ExecScript:input_type -> encore.daemon.ExecScriptRequest
+	21, // 57: encore.daemon.Daemon.Check:input_type -> encore.daemon.CheckRequest
+	22, // 58: encore.daemon.Daemon.Export:input_type -> encore.daemon.ExportRequest
+	24, // 59: encore.daemon.Daemon.DBConnect:input_type -> encore.daemon.DBConnectRequest
+	26, // 60: encore.daemon.Daemon.DBProxy:input_type -> encore.daemon.DBProxyRequest
+	27, // 61: encore.daemon.Daemon.DBReset:input_type -> encore.daemon.DBResetRequest
+	28, // 62: encore.daemon.Daemon.DBMigrate:input_type -> encore.daemon.DBMigrateRequest
+	33, // 63: encore.daemon.Daemon.DBSnapshot:input_type -> encore.daemon.DBSnapshotRequest
+	31, // 64: encore.daemon.Daemon.DBDiff:input_type -> encore.daemon.DBDiffRequest
+	35, // 65: encore.daemon.Daemon.CacheFlush:input_type -> encore.daemon.CacheFlushRequest
+	36, // 66: encore.daemon.Daemon.CacheDump:input_type -> encore.daemon.CacheDumpRequest
+	38, // 67: encore.daemon.Daemon.CacheRestore:input_type -> encore.daemon.CacheRestoreRequest
+	39, // 68: encore.daemon.Daemon.PubSubPublish:input_type -> encore.daemon.PubSubPublishRequest
+	41, // 69: encore.daemon.Daemon.PubSubStats:input_type -> encore.daemon.PubSubStatsRequest
+	45, // 70: encore.daemon.Daemon.PubSubListDeadLetters:input_type -> encore.daemon.PubSubDeadLetterFilter
+	45, // 71: encore.daemon.Daemon.PubSubReplayDeadLetters:input_type -> encore.daemon.PubSubDeadLetterFilter
+	45, // 72: encore.daemon.Daemon.PubSubPurgeDeadLetters:input_type -> encore.daemon.PubSubDeadLetterFilter
+	49, // 73: encore.daemon.Daemon.TrafficRecord:input_type -> encore.daemon.TrafficRecordRequest
+	51, // 74: encore.daemon.Daemon.TrafficReplay:input_type -> encore.daemon.TrafficReplayRequest
+	53, // 75: encore.daemon.Daemon.TrafficSessions:input_type -> encore.daemon.TrafficSessionsRequest
+	56, // 76: encore.daemon.Daemon.ChaosRules:input_type -> encore.daemon.ChaosRulesRequest
+	58, // 77: encore.daemon.Daemon.ChaosAddRule:input_type -> encore.daemon.ChaosAddRuleRequest
+	59, // 78: encore.daemon.Daemon.ChaosRemoveRules:input_type -> encore.daemon.ChaosRemoveRulesRequest
+	60, // 79: encore.daemon.Daemon.GenClient:input_type -> encore.daemon.GenClientRequest
+	62, // 80: encore.daemon.Daemon.GenWrappers:input_type -> encore.daemon.GenWrappersRequest
+	64, // 81: encore.daemon.Daemon.SecretsRefresh:input_type -> encore.daemon.SecretsRefreshRequest
+	97, // 82: encore.daemon.Daemon.Version:input_type -> google.protobuf.Empty
+	68, // 83: encore.daemon.Daemon.CreateNamespace:input_type -> encore.daemon.CreateNamespaceRequest
+	69, // 84: encore.daemon.Daemon.SwitchNamespace:input_type -> encore.daemon.SwitchNamespaceRequest
+	70, // 85: encore.daemon.Daemon.ListNamespaces:input_type -> encore.daemon.ListNamespacesRequest
+	71, // 86: encore.daemon.Daemon.DeleteNamespace:input_type -> encore.daemon.DeleteNamespaceRequest
+	74, // 87: encore.daemon.Daemon.DumpMeta:input_type -> encore.daemon.DumpMetaRequest
+	73, // 88: encore.daemon.Daemon.Telemetry:input_type -> encore.daemon.TelemetryConfig
+	11, // 89: encore.daemon.Daemon.CreateApp:input_type -> encore.daemon.CreateAppRequest
+	7,  // 90: encore.daemon.Daemon.Run:output_type -> encore.daemon.CommandMessage
+	7,  // 91: encore.daemon.Daemon.Test:output_type -> encore.daemon.CommandMessage
+	19, // 92: encore.daemon.Daemon.TestSpec:output_type -> encore.daemon.TestSpecResponse
+	16, // 93: encore.daemon.Daemon.TestTraces:output_type -> encore.daemon.TestTracesResponse
+	7,  // 94: encore.daemon.Daemon.ExecScript:output_type -> encore.daemon.CommandMessage
+	7,  // 95: encore.daemon.Daemon.Check:output_type -> encore.daemon.CommandMessage
+	7,  // 96: encore.daemon.Daemon.Export:output_type -> encore.daemon.CommandMessage
+	25, // 97: encore.daemon.Daemon.DBConnect:output_type -> encore.daemon.DBConnectResponse
+	7,  // 98: encore.daemon.Daemon.DBProxy:output_type -> encore.daemon.CommandMessage
+	7,  // 99: encore.daemon.Daemon.DBReset:output_type -> encore.daemon.CommandMessage
+	29, // 100: encore.daemon.Daemon.DBMigrate:output_type -> encore.daemon.DBMigrateResponse
+	34, // 101: encore.daemon.Daemon.DBSnapshot:output_type -> encore.daemon.DBSnapshotResponse
+	32, // 102: encore.daemon.Daemon.DBDiff:output_type -> encore.daemon.DBDiffResponse
+	97, // 103: encore.daemon.Daemon.CacheFlush:output_type -> google.protobuf.Empty
+	37, // 104: encore.daemon.Daemon.CacheDump:output_type -> encore.daemon.CacheDumpResponse
+	97, // 105: encore.daemon.Daemon.CacheRestore:output_type -> google.protobuf.Empty
+	40, // 106: encore.daemon.Daemon.PubSubPublish:output_type -> encore.daemon.PubSubPublishResponse
+	42, // 107: encore.daemon.Daemon.PubSubStats:output_type -> encore.daemon.PubSubStatsResponse
+	46, // 108: encore.daemon.Daemon.PubSubListDeadLetters:output_type -> encore.daemon.PubSubListDeadLettersResponse
+	48, // 109: encore.daemon.Daemon.PubSubReplayDeadLetters:output_type -> encore.daemon.PubSubDeadLettersCount
+	48, // 110: encore.daemon.Daemon.PubSubPurgeDeadLetters:output_type -> encore.daemon.PubSubDeadLettersCount
+	50, // 111: encore.daemon.Daemon.TrafficRecord:output_type -> encore.daemon.TrafficExchange
+	52, // 112: encore.daemon.Daemon.TrafficReplay:output_type -> encore.daemon.TrafficReplayResult
+	54, // 113: encore.daemon.Daemon.TrafficSessions:output_type -> encore.daemon.TrafficSessionsResponse
+	57, // 114: encore.daemon.Daemon.ChaosRules:output_type -> encore.daemon.ChaosRulesResponse
+	55, // 115: encore.daemon.Daemon.ChaosAddRule:output_type -> encore.daemon.ChaosRule
+	97, // 116: encore.daemon.Daemon.ChaosRemoveRules:output_type -> google.protobuf.Empty
+	61, // 117: encore.daemon.Daemon.GenClient:output_type -> encore.daemon.GenClientResponse
+	63, // 118: encore.daemon.Daemon.GenWrappers:output_type -> encore.daemon.GenWrappersResponse
+	65, // 119: encore.daemon.Daemon.SecretsRefresh:output_type -> encore.daemon.SecretsRefreshResponse
+	66, // 120: encore.daemon.Daemon.Version:output_type -> encore.daemon.VersionResponse
+	67, // 121: encore.daemon.Daemon.CreateNamespace:output_type -> encore.daemon.Namespace
+	67, // 122: encore.daemon.Daemon.SwitchNamespace:output_type -> encore.daemon.Namespace
+	72, // 123: encore.daemon.Daemon.ListNamespaces:output_type -> encore.daemon.ListNamespacesResponse
+	97, // 124: encore.daemon.Daemon.DeleteNamespace:output_type -> google.protobuf.Empty
+	75, // 125: encore.daemon.Daemon.DumpMeta:output_type -> encore.daemon.DumpMetaResponse
+	97, // 126: encore.daemon.Daemon.Telemetry:output_type -> google.protobuf.Empty
+	12, // 127: encore.daemon.Daemon.CreateApp:output_type -> encore.daemon.CreateAppResponse
+	90, // [90:128] is the sub-list for method output_type
+	52, // [52:90] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_encore_daemon_daemon_proto_init() }
@@ -4279,22 +7181,30 @@ func file_encore_daemon_daemon_proto_init() {
 	}
 	file_encore_daemon_daemon_proto_msgTypes[6].OneofWrappers = []any{}
 	file_encore_daemon_daemon_proto_msgTypes[7].OneofWrappers = []any{}
-	file_encore_daemon_daemon_proto_msgTypes[10].OneofWrappers = []any{}
-	file_encore_daemon_daemon_proto_msgTypes[12].OneofWrappers = []any{
+	file_encore_daemon_daemon_proto_msgTypes[13].OneofWrappers = []any{}
+	file_encore_daemon_daemon_proto_msgTypes[15].OneofWrappers = []any{
 		(*ExportRequest_Docker)(nil),
 	}
-	file_encore_daemon_daemon_proto_msgTypes[14].OneofWrappers = []any{}
-	file_encore_daemon_daemon_proto_msgTypes[16].OneofWrappers = []any{}
 	file_encore_daemon_daemon_proto_msgTypes[17].OneofWrappers = []any{}
-	file_encore_daemon_daemon_proto_msgTypes[18].OneofWrappers = []any{}
-	file_encore_daemon_daemon_proto_msgTypes[25].OneofWrappers = []any{}
+	file_encore_daemon_daemon_proto_msgTypes[19].OneofWrappers = []any{}
+	file_encore_daemon_daemon_proto_msgTypes[20].OneofWrappers = []any{}
+	file_encore_daemon_daemon_proto_msgTypes[21].OneofWrappers = []any{}
+	file_encore_daemon_daemon_proto_msgTypes[24].OneofWrappers = []any{}
+	file_encore_daemon_daemon_proto_msgTypes[26].OneofWrappers = []any{}
+	file_encore_daemon_daemon_proto_msgTypes[28].OneofWrappers = []any{}
+	file_encore_daemon_daemon_proto_msgTypes[29].OneofWrappers = []any{}
+	file_encore_daemon_daemon_proto_msgTypes[31].OneofWrappers = []any{}
+	file_encore_daemon_daemon_proto_msgTypes[38].OneofWrappers = []any{}
+	file_encore_daemon_daemon_proto_msgTypes[40].OneofWrappers = []any{}
+	file_encore_daemon_daemon_proto_msgTypes[53].OneofWrappers = []any{}
+	file_encore_daemon_daemon_proto_msgTypes[60].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_encore_daemon_daemon_proto_rawDesc), len(file_encore_daemon_daemon_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   51,
+			NumEnums:      7,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,26 +20,44 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Daemon_Run_FullMethodName             = "/encore.daemon.Daemon/Run"
-	Daemon_Test_FullMethodName            = "/encore.daemon.Daemon/Test"
-	Daemon_TestSpec_FullMethodName        = "/encore.daemon.Daemon/TestSpec"
-	Daemon_ExecScript_FullMethodName      = "/encore.daemon.Daemon/ExecScript"
-	Daemon_Check_FullMethodName           = "/encore.daemon.Daemon/Check"
-	Daemon_Export_FullMethodName          = "/encore.daemon.Daemon/Export"
-	Daemon_DBConnect_FullMethodName       = "/encore.daemon.Daemon/DBConnect"
-	Daemon_DBProxy_FullMethodName         = "/encore.daemon.Daemon/DBProxy"
-	Daemon_DBReset_FullMethodName         = "/encore.daemon.Daemon/DBReset"
-	Daemon_GenClient_FullMethodName       = "/encore.daemon.Daemon/GenClient"
-	Daemon_GenWrappers_FullMethodName     = "/encore.daemon.Daemon/GenWrappers"
-	Daemon_SecretsRefresh_FullMethodName  = "/encore.daemon.Daemon/SecretsRefresh"
-	Daemon_Version_FullMethodName         = "/encore.daemon.Daemon/Version"
-	Daemon_CreateNamespace_FullMethodName = "/encore.daemon.Daemon/CreateNamespace"
-	Daemon_SwitchNamespace_FullMethodName = "/encore.daemon.Daemon/SwitchNamespace"
-	Daemon_ListNamespaces_FullMethodName  = "/encore.daemon.Daemon/ListNamespaces"
-	Daemon_DeleteNamespace_FullMethodName = "/encore.daemon.Daemon/DeleteNamespace"
-	Daemon_DumpMeta_FullMethodName        = "/encore.daemon.Daemon/DumpMeta"
-	Daemon_Telemetry_FullMethodName       = "/encore.daemon.Daemon/Telemetry"
-	Daemon_CreateApp_FullMethodName       = "/encore.daemon.Daemon/CreateApp"
+	Daemon_Run_FullMethodName                     = "/encore.daemon.Daemon/Run"
+	Daemon_Test_FullMethodName                    = "/encore.daemon.Daemon/Test"
+	Daemon_TestSpec_FullMethodName                = "/encore.daemon.Daemon/TestSpec"
+	Daemon_TestTraces_FullMethodName              = "/encore.daemon.Daemon/TestTraces"
+	Daemon_ExecScript_FullMethodName              = "/encore.daemon.Daemon/ExecScript"
+	Daemon_Check_FullMethodName                   = "/encore.daemon.Daemon/Check"
+	Daemon_Export_FullMethodName                  = "/encore.daemon.Daemon/Export"
+	Daemon_DBConnect_FullMethodName               = "/encore.daemon.Daemon/DBConnect"
+	Daemon_DBProxy_FullMethodName                 = "/encore.daemon.Daemon/DBProxy"
+	Daemon_DBReset_FullMethodName                 = "/encore.daemon.Daemon/DBReset"
+	Daemon_DBMigrate_FullMethodName               = "/encore.daemon.Daemon/DBMigrate"
+	Daemon_DBSnapshot_FullMethodName              = "/encore.daemon.Daemon/DBSnapshot"
+	Daemon_DBDiff_FullMethodName                  = "/encore.daemon.Daemon/DBDiff"
+	Daemon_CacheFlush_FullMethodName              = "/encore.daemon.Daemon/CacheFlush"
+	Daemon_CacheDump_FullMethodName               = "/encore.daemon.Daemon/CacheDump"
+	Daemon_CacheRestore_FullMethodName            = "/encore.daemon.Daemon/CacheRestore"
+	Daemon_PubSubPublish_FullMethodName           = "/encore.daemon.Daemon/PubSubPublish"
+	Daemon_PubSubStats_FullMethodName             = "/encore.daemon.Daemon/PubSubStats"
+	Daemon_PubSubListDeadLetters_FullMethodName   = "/encore.daemon.Daemon/PubSubListDeadLetters"
+	Daemon_PubSubReplayDeadLetters_FullMethodName = "/encore.daemon.Daemon/PubSubReplayDeadLetters"
+	Daemon_PubSubPurgeDeadLetters_FullMethodName  = "/encore.daemon.Daemon/PubSubPurgeDeadLetters"
+	Daemon_TrafficRecord_FullMethodName           = "/encore.daemon.Daemon/TrafficRecord"
+	Daemon_TrafficReplay_FullMethodName           = "/encore.daemon.Daemon/TrafficReplay"
+	Daemon_TrafficSessions_FullMethodName         = "/encore.daemon.Daemon/TrafficSessions"
+	Daemon_ChaosRules_FullMethodName              = "/encore.daemon.Daemon/ChaosRules"
+	Daemon_ChaosAddRule_FullMethodName            = "/encore.daemon.Daemon/ChaosAddRule"
+	Daemon_ChaosRemoveRules_FullMethodName        = "/encore.daemon.Daemon/ChaosRemoveRules"
+	Daemon_GenClient_FullMethodName               = "/encore.daemon.Daemon/GenClient"
+	Daemon_GenWrappers_FullMethodName             = "/encore.daemon.Daemon/GenWrappers"
+	Daemon_SecretsRefresh_FullMethodName          = "/encore.daemon.Daemon/SecretsRefresh"
+	Daemon_Version_FullMethodName                 = "/encore.daemon.Daemon/Version"
+	Daemon_CreateNamespace_FullMethodName         = "/encore.daemon.Daemon/CreateNamespace"
+	Daemon_SwitchNamespace_FullMethodName         = "/encore.daemon.Daemon/SwitchNamespace"
+	Daemon_ListNamespaces_FullMethodName          = "/encore.daemon.Daemon/ListNamespaces"
+	Daemon_DeleteNamespace_FullMethodName         = "/encore.daemon.Daemon/DeleteNamespace"
+	Daemon_DumpMeta_FullMethodName                = "/encore.daemon.Daemon/DumpMeta"
+	Daemon_Telemetry_FullMethodName               = "/encore.daemon.Daemon/Telemetry"
+	Daemon_CreateApp_FullMethodName               = "/encore.daemon.Daemon/CreateApp"
 )
 
 // DaemonClient is the client API for Daemon service.
@@ -52,6 +70,8 @@ type DaemonClient interface {
 	Test(ctx context.Context, in *TestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CommandMessage], error)
 	// TestSpec returns the specification for how to run tests.
 	TestSpec(ctx context.Context, in *TestSpecRequest, opts ...grpc.CallOption) (*TestSpecResponse, error)
+	// TestTraces lists the traces recorded by tests.
+	TestTraces(ctx context.Context, in *TestTracesRequest, opts ...grpc.CallOption) (*TestTracesResponse, error)
 	// ExecScript executes a one-off script.
 	ExecScript(ctx context.Context, in *ExecScriptRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CommandMessage], error)
 	// Check checks the app for compilation errors.
@@ -65,6 +85,42 @@ type DaemonClient interface {
 	DBProxy(ctx context.Context, in *DBProxyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CommandMessage], error)
 	// DBReset resets the given databases, recreating them from scratch.
 	DBReset(ctx context.Context, in *DBResetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CommandMessage], error)
+	// DBMigrate reports or changes which migrations are applied to a local database.
+	DBMigrate(ctx context.Context, in *DBMigrateRequest, opts ...grpc.CallOption) (*DBMigrateResponse, error)
+	// DBSnapshot saves, restores or lists snapshots of the local databases.
+	DBSnapshot(ctx context.Context, in *DBSnapshotRequest, opts ...grpc.CallOption) (*DBSnapshotResponse, error)
+	// DBDiff compares the schema of a local database with the schema
+	// produced by its migrations.
+	DBDiff(ctx context.Context, in *DBDiffRequest, opts ...grpc.CallOption) (*DBDiffResponse, error)
+	// CacheFlush removes all keys from the local cache of a namespace.
+	CacheFlush(ctx context.Context, in *CacheFlushRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CacheDump returns a snapshot of the local cache of a namespace.
+	CacheDump(ctx context.Context, in *CacheDumpRequest, opts ...grpc.CallOption) (*CacheDumpResponse, error)
+	// CacheRestore replaces the local cache of a namespace with a snapshot.
+	CacheRestore(ctx context.Context, in *CacheRestoreRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PubSubPublish publishes a message to a topic of a running app.
+	PubSubPublish(ctx context.Context, in *PubSubPublishRequest, opts ...grpc.CallOption) (*PubSubPublishResponse, error)
+	// PubSubStats reports the state of the topics and subscriptions of a running app.
+	PubSubStats(ctx context.Context, in *PubSubStatsRequest, opts ...grpc.CallOption) (*PubSubStatsResponse, error)
+	// PubSubListDeadLetters lists the dead-lettered messages of a running app.
+	PubSubListDeadLetters(ctx context.Context, in *PubSubDeadLetterFilter, opts ...grpc.CallOption) (*PubSubListDeadLettersResponse, error)
+	// PubSubReplayDeadLetters redelivers dead-lettered messages to their subscriptions.
+	PubSubReplayDeadLetters(ctx context.Context, in *PubSubDeadLetterFilter, opts ...grpc.CallOption) (*PubSubDeadLettersCount, error)
+	// PubSubPurgeDeadLetters discards dead-lettered messages.
+	PubSubPurgeDeadLetters(ctx context.Context, in *PubSubDeadLetterFilter, opts ...grpc.CallOption) (*PubSubDeadLettersCount, error)
+	// TrafficRecord records the requests served by the running app into a session,
+	// streaming each recorded request until the call is cancelled.
+	TrafficRecord(ctx context.Context, in *TrafficRecordRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TrafficExchange], error)
+	// TrafficReplay replays a recorded session against the running app.
+	TrafficReplay(ctx context.Context, in *TrafficReplayRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TrafficReplayResult], error)
+	// TrafficSessions lists the recorded traffic sessions of an app.
+	TrafficSessions(ctx context.Context, in *TrafficSessionsRequest, opts ...grpc.CallOption) (*TrafficSessionsResponse, error)
+	// ChaosRules lists the fault injection rules of an app.
+	ChaosRules(ctx context.Context, in *ChaosRulesRequest, opts ...grpc.CallOption) (*ChaosRulesResponse, error)
+	// ChaosAddRule adds a fault injection rule to an app.
+	ChaosAddRule(ctx context.Context, in *ChaosAddRuleRequest, opts ...grpc.CallOption) (*ChaosRule, error)
+	// ChaosRemoveRules removes fault injection rules from an app.
+	ChaosRemoveRules(ctx context.Context, in *ChaosRemoveRulesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// GenClient generates a client based on the app's API.
 	GenClient(ctx context.Context, in *GenClientRequest, opts ...grpc.CallOption) (*GenClientResponse, error)
 	// GenWrappers generates user-facing wrapper code.
@@ -145,6 +201,16 @@ func (c *daemonClient) TestSpec(ctx context.Context, in *TestSpecRequest, opts .
 	return out, nil
 }
 
+func (c *daemonClient) TestTraces(ctx context.Context, in *TestTracesRequest, opts ...grpc.CallOption) (*TestTracesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestTracesResponse)
+	err := c.cc.Invoke(ctx, Daemon_TestTraces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) ExecScript(ctx context.Context, in *ExecScriptRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CommandMessage], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[2], Daemon_ExecScript_FullMethodName, cOpts...)
@@ -250,6 +316,194 @@ func (c *daemonClient) DBReset(ctx context.Context, in *DBResetRequest, opts ...
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Daemon_DBResetClient = grpc.ServerStreamingClient[CommandMessage]
 
+func (c *daemonClient) DBMigrate(ctx context.Context, in *DBMigrateRequest, opts ...grpc.CallOption) (*DBMigrateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DBMigrateResponse)
+	err := c.cc.Invoke(ctx, Daemon_DBMigrate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) DBSnapshot(ctx context.Context, in *DBSnapshotRequest, opts ...grpc.CallOption) (*DBSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DBSnapshotResponse)
+	err := c.cc.Invoke(ctx, Daemon_DBSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) DBDiff(ctx context.Context, in *DBDiffRequest, opts ...grpc.CallOption) (*DBDiffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DBDiffResponse)
+	err := c.cc.Invoke(ctx, Daemon_DBDiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) CacheFlush(ctx context.Context, in *CacheFlushRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Daemon_CacheFlush_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) CacheDump(ctx context.Context, in *CacheDumpRequest, opts ...grpc.CallOption) (*CacheDumpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CacheDumpResponse)
+	err := c.cc.Invoke(ctx, Daemon_CacheDump_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) CacheRestore(ctx context.Context, in *CacheRestoreRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Daemon_CacheRestore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) PubSubPublish(ctx context.Context, in *PubSubPublishRequest, opts ...grpc.CallOption) (*PubSubPublishResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PubSubPublishResponse)
+	err := c.cc.Invoke(ctx, Daemon_PubSubPublish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) PubSubStats(ctx context.Context, in *PubSubStatsRequest, opts ...grpc.CallOption) (*PubSubStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PubSubStatsResponse)
+	err := c.cc.Invoke(ctx, Daemon_PubSubStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) PubSubListDeadLetters(ctx context.Context, in *PubSubDeadLetterFilter, opts ...grpc.CallOption) (*PubSubListDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PubSubListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, Daemon_PubSubListDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) PubSubReplayDeadLetters(ctx context.Context, in *PubSubDeadLetterFilter, opts ...grpc.CallOption) (*PubSubDeadLettersCount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PubSubDeadLettersCount)
+	err := c.cc.Invoke(ctx, Daemon_PubSubReplayDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) PubSubPurgeDeadLetters(ctx context.Context, in *PubSubDeadLetterFilter, opts ...grpc.CallOption) (*PubSubDeadLettersCount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PubSubDeadLettersCount)
+	err := c.cc.Invoke(ctx, Daemon_PubSubPurgeDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) TrafficRecord(ctx context.Context, in *TrafficRecordRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TrafficExchange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[7], Daemon_TrafficRecord_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TrafficRecordRequest, TrafficExchange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Daemon_TrafficRecordClient = grpc.ServerStreamingClient[TrafficExchange]
+
+func (c *daemonClient) TrafficReplay(ctx context.Context, in *TrafficReplayRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TrafficReplayResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[8], Daemon_TrafficReplay_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TrafficReplayRequest, TrafficReplayResult]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Daemon_TrafficReplayClient = grpc.ServerStreamingClient[TrafficReplayResult]
+
+func (c *daemonClient) TrafficSessions(ctx context.Context, in *TrafficSessionsRequest, opts ...grpc.CallOption) (*TrafficSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrafficSessionsResponse)
+	err := c.cc.Invoke(ctx, Daemon_TrafficSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ChaosRules(ctx context.Context, in *ChaosRulesRequest, opts ...grpc.CallOption) (*ChaosRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChaosRulesResponse)
+	err := c.cc.Invoke(ctx, Daemon_ChaosRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ChaosAddRule(ctx context.Context, in *ChaosAddRuleRequest, opts ...grpc.CallOption) (*ChaosRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChaosRule)
+	err := c.cc.Invoke(ctx, Daemon_ChaosAddRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ChaosRemoveRules(ctx context.Context, in *ChaosRemoveRulesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Daemon_ChaosRemoveRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) GenClient(ctx context.Context, in *GenClientRequest, opts ...grpc.CallOption) (*GenClientResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GenClientResponse)
@@ -370,6 +624,8 @@ type DaemonServer interface {
 	Test(*TestRequest, grpc.ServerStreamingServer[CommandMessage]) error
 	// TestSpec returns the specification for how to run tests.
 	TestSpec(context.Context, *TestSpecRequest) (*TestSpecResponse, error)
+	// TestTraces lists the traces recorded by tests.
+	TestTraces(context.Context, *TestTracesRequest) (*TestTracesResponse, error)
 	// ExecScript executes a one-off script.
 	ExecScript(*ExecScriptRequest, grpc.ServerStreamingServer[CommandMessage]) error
 	// Check checks the app for compilation errors.
@@ -383,6 +639,42 @@ type DaemonServer interface {
 	DBProxy(*DBProxyRequest, grpc.ServerStreamingServer[CommandMessage]) error
 	// DBReset resets the given databases, recreating them from scratch.
 	DBReset(*DBResetRequest, grpc.ServerStreamingServer[CommandMessage]) error
+	// DBMigrate reports or changes which migrations are applied to a local database.
+	DBMigrate(context.Context, *DBMigrateRequest) (*DBMigrateResponse, error)
+	// DBSnapshot saves, restores or lists snapshots of the local databases.
+	DBSnapshot(context.Context, *DBSnapshotRequest) (*DBSnapshotResponse, error)
+	// DBDiff compares the schema of a local database with the schema
+	// produced by its migrations.
+	DBDiff(context.Context, *DBDiffRequest) (*DBDiffResponse, error)
+	// CacheFlush removes all keys from the local cache of a namespace.
+	CacheFlush(context.Context, *CacheFlushRequest) (*emptypb.Empty, error)
+	// CacheDump returns a snapshot of the local cache of a namespace.
+	CacheDump(context.Context, *CacheDumpRequest) (*CacheDumpResponse, error)
+	// CacheRestore replaces the local cache of a namespace with a snapshot.
+	CacheRestore(context.Context, *CacheRestoreRequest) (*emptypb.Empty, error)
+	// PubSubPublish publishes a message to a topic of a running app.
+	PubSubPublish(context.Context, *PubSubPublishRequest) (*PubSubPublishResponse, error)
+	// PubSubStats reports the state of the topics and subscriptions of a running app.
+	PubSubStats(context.Context, *PubSubStatsRequest) (*PubSubStatsResponse, error)
+	// PubSubListDeadLetters lists the dead-lettered messages of a running app.
+	PubSubListDeadLetters(context.Context, *PubSubDeadLetterFilter) (*PubSubListDeadLettersResponse, error)
+	// PubSubReplayDeadLetters redelivers dead-lettered messages to their subscriptions.
+	PubSubReplayDeadLetters(context.Context, *PubSubDeadLetterFilter) (*PubSubDeadLettersCount, error)
+	// PubSubPurgeDeadLetters discards dead-lettered messages.
+	PubSubPurgeDeadLetters(context.Context, *PubSubDeadLetterFilter) (*PubSubDeadLettersCount, error)
+	// TrafficRecord records the requests served by the running app into a session,
+	// streaming each recorded request until the call is cancelled.
+	TrafficRecord(*TrafficRecordRequest, grpc.ServerStreamingServer[TrafficExchange]) error
+	// TrafficReplay replays a recorded session against the running app.
+	TrafficReplay(*TrafficReplayRequest, grpc.ServerStreamingServer[TrafficReplayResult]) error
+	// TrafficSessions lists the recorded traffic sessions of an app.
+	TrafficSessions(context.Context, *TrafficSessionsRequest) (*TrafficSessionsResponse, error)
+	// ChaosRules lists the fault injection rules of an app.
+	ChaosRules(context.Context, *ChaosRulesRequest) (*ChaosRulesResponse, error)
+	// ChaosAddRule adds a fault injection rule to an app.
+	ChaosAddRule(context.Context, *ChaosAddRuleRequest) (*ChaosRule, error)
+	// ChaosRemoveRules removes fault injection rules from an app.
+	ChaosRemoveRules(context.Context, *ChaosRemoveRulesRequest) (*emptypb.Empty, error)
 	// GenClient generates a client based on the app's API.
 	GenClient(context.Context, *GenClientRequest) (*GenClientResponse, error)
 	// GenWrappers generates user-facing wrapper code.
@@ -424,6 +716,9 @@ func (UnimplementedDaemonServer) Test(*TestRequest, grpc.ServerStreamingServer[C
 func (UnimplementedDaemonServer) TestSpec(context.Context, *TestSpecRequest) (*TestSpecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TestSpec not implemented")
 }
+func (UnimplementedDaemonServer) TestTraces(context.Context, *TestTracesRequest) (*TestTracesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestTraces not implemented")
+}
 func (UnimplementedDaemonServer) ExecScript(*ExecScriptRequest, grpc.ServerStreamingServer[CommandMessage]) error {
 	return status.Errorf(codes.Unimplemented, "method ExecScript not implemented")
 }
@@ -442,6 +737,57 @@ func (UnimplementedDaemonServer) DBProxy(*DBProxyRequest, grpc.ServerStreamingSe
 func (UnimplementedDaemonServer) DBReset(*DBResetRequest, grpc.ServerStreamingServer[CommandMessage]) error {
 	return status.Errorf(codes.Unimplemented, "method DBReset not implemented")
 }
+func (UnimplementedDaemonServer) DBMigrate(context.Context, *DBMigrateRequest) (*DBMigrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DBMigrate not implemented")
+}
+func (UnimplementedDaemonServer) DBSnapshot(context.Context, *DBSnapshotRequest) (*DBSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DBSnapshot not implemented")
+}
+func (UnimplementedDaemonServer) DBDiff(context.Context, *DBDiffRequest) (*DBDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DBDiff not implemented")
+}
+func (UnimplementedDaemonServer) CacheFlush(context.Context, *CacheFlushRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CacheFlush not implemented")
+}
+func (UnimplementedDaemonServer) CacheDump(context.Context, *CacheDumpRequest) (*CacheDumpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CacheDump not implemented")
+}
+func (UnimplementedDaemonServer) CacheRestore(context.Context, *CacheRestoreRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CacheRestore not implemented")
+}
+func (UnimplementedDaemonServer) PubSubPublish(context.Context, *PubSubPublishRequest) (*PubSubPublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubSubPublish not implemented")
+}
+func (UnimplementedDaemonServer) PubSubStats(context.Context, *PubSubStatsRequest) (*PubSubStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubSubStats not implemented")
+}
+func (UnimplementedDaemonServer) PubSubListDeadLetters(context.Context, *PubSubDeadLetterFilter) (*PubSubListDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubSubListDeadLetters not implemented")
+}
+func (UnimplementedDaemonServer) PubSubReplayDeadLetters(context.Context, *PubSubDeadLetterFilter) (*PubSubDeadLettersCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubSubReplayDeadLetters not implemented")
+}
+func (UnimplementedDaemonServer) PubSubPurgeDeadLetters(context.Context, *PubSubDeadLetterFilter) (*PubSubDeadLettersCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubSubPurgeDeadLetters not implemented")
+}
+func (UnimplementedDaemonServer) TrafficRecord(*TrafficRecordRequest, grpc.ServerStreamingServer[TrafficExchange]) error {
+	return status.Errorf(codes.Unimplemented, "method TrafficRecord not implemented")
+}
+func (UnimplementedDaemonServer) TrafficReplay(*TrafficReplayRequest, grpc.ServerStreamingServer[TrafficReplayResult]) error {
+	return status.Errorf(codes.Unimplemented, "method TrafficReplay not implemented")
+}
+func (UnimplementedDaemonServer) TrafficSessions(context.Context, *TrafficSessionsRequest) (*TrafficSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrafficSessions not implemented")
+}
+func (UnimplementedDaemonServer) ChaosRules(context.Context, *ChaosRulesRequest) (*ChaosRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChaosRules not implemented")
+}
+func (UnimplementedDaemonServer) ChaosAddRule(context.Context, *ChaosAddRuleRequest) (*ChaosRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChaosAddRule not implemented")
+}
+func (UnimplementedDaemonServer) ChaosRemoveRules(context.Context, *ChaosRemoveRulesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChaosRemoveRules not implemented")
+}
 func (UnimplementedDaemonServer) GenClient(context.Context, *GenClientRequest) (*GenClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenClient not implemented")
 }
@@ -536,6 +882,24 @@ func _Daemon_TestSpec_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_TestTraces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestTracesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).TestTraces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_TestTraces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).TestTraces(ctx, req.(*TestTracesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_ExecScript_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ExecScriptRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -609,6 +973,298 @@ func _Daemon_DBReset_Handler(srv interface{}, stream grpc.ServerStream) error {
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type Daemon_DBResetServer = grpc.ServerStreamingServer[CommandMessage]
 
+func _Daemon_DBMigrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DBMigrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).DBMigrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_DBMigrate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).DBMigrate(ctx, req.(*DBMigrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_DBSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DBSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).DBSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_DBSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).DBSnapshot(ctx, req.(*DBSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_DBDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DBDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).DBDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_DBDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).DBDiff(ctx, req.(*DBDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_CacheFlush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheFlushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CacheFlush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_CacheFlush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CacheFlush(ctx, req.(*CacheFlushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_CacheDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CacheDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_CacheDump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CacheDump(ctx, req.(*CacheDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_CacheRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CacheRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CacheRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_CacheRestore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CacheRestore(ctx, req.(*CacheRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_PubSubPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubSubPublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).PubSubPublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_PubSubPublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).PubSubPublish(ctx, req.(*PubSubPublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_PubSubStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubSubStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).PubSubStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_PubSubStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).PubSubStats(ctx, req.(*PubSubStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_PubSubListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubSubDeadLetterFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).PubSubListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_PubSubListDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).PubSubListDeadLetters(ctx, req.(*PubSubDeadLetterFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_PubSubReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubSubDeadLetterFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).PubSubReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_PubSubReplayDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).PubSubReplayDeadLetters(ctx, req.(*PubSubDeadLetterFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_PubSubPurgeDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubSubDeadLetterFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).PubSubPurgeDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_PubSubPurgeDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).PubSubPurgeDeadLetters(ctx, req.(*PubSubDeadLetterFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_TrafficRecord_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TrafficRecordRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).TrafficRecord(m, &grpc.GenericServerStream[TrafficRecordRequest, TrafficExchange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Daemon_TrafficRecordServer = grpc.ServerStreamingServer[TrafficExchange]
+
+func _Daemon_TrafficReplay_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TrafficReplayRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).TrafficReplay(m, &grpc.GenericServerStream[TrafficReplayRequest, TrafficReplayResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Daemon_TrafficReplayServer = grpc.ServerStreamingServer[TrafficReplayResult]
+
+func _Daemon_TrafficSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrafficSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).TrafficSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_TrafficSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).TrafficSessions(ctx, req.(*TrafficSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ChaosRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaosRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ChaosRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ChaosRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ChaosRules(ctx, req.(*ChaosRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ChaosAddRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaosAddRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ChaosAddRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ChaosAddRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ChaosAddRule(ctx, req.(*ChaosAddRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ChaosRemoveRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaosRemoveRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ChaosRemoveRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ChaosRemoveRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ChaosRemoveRules(ctx, req.(*ChaosRemoveRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_GenClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenClientRequest)
 	if err := dec(in); err != nil {
@@ -818,10 +1474,74 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TestSpec",
 			Handler:    _Daemon_TestSpec_Handler,
 		},
+		{
+			MethodName: "TestTraces",
+			Handler:    _Daemon_TestTraces_Handler,
+		},
 		{
 			MethodName: "DBConnect",
 			Handler:    _Daemon_DBConnect_Handler,
 		},
+		{
+			MethodName: "DBMigrate",
+			Handler:    _Daemon_DBMigrate_Handler,
+		},
+		{
+			MethodName: "DBSnapshot",
+			Handler:    _Daemon_DBSnapshot_Handler,
+		},
+		{
+			MethodName: "DBDiff",
+			Handler:    _Daemon_DBDiff_Handler,
+		},
+		{
+			MethodName: "CacheFlush",
+			Handler:    _Daemon_CacheFlush_Handler,
+		},
+		{
+			MethodName: "CacheDump",
+			Handler:    _Daemon_CacheDump_Handler,
+		},
+		{
+			MethodName: "CacheRestore",
+			Handler:    _Daemon_CacheRestore_Handler,
+		},
+		{
+			MethodName: "PubSubPublish",
+			Handler:    _Daemon_PubSubPublish_Handler,
+		},
+		{
+			MethodName: "PubSubStats",
+			Handler:    _Daemon_PubSubStats_Handler,
+		},
+		{
+			MethodName: "PubSubListDeadLetters",
+			Handler:    _Daemon_PubSubListDeadLetters_Handler,
+		},
+		{
+			MethodName: "PubSubReplayDeadLetters",
+			Handler:    _Daemon_PubSubReplayDeadLetters_Handler,
+		},
+		{
+			MethodName: "PubSubPurgeDeadLetters",
+			Handler:    _Daemon_PubSubPurgeDeadLetters_Handler,
+		},
+		{
+			MethodName: "TrafficSessions",
+			Handler:    _Daemon_TrafficSessions_Handler,
+		},
+		{
+			MethodName: "ChaosRules",
+			Handler:    _Daemon_ChaosRules_Handler,
+		},
+		{
+			MethodName: "ChaosAddRule",
+			Handler:    _Daemon_ChaosAddRule_Handler,
+		},
+		{
+			MethodName: "ChaosRemoveRules",
+			Handler:    _Daemon_ChaosRemoveRules_Handler,
+		},
 		{
 			MethodName: "GenClient",
 			Handler:    _Daemon_GenClient_Handler,
@@ -903,6 +1623,16 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Daemon_DBReset_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TrafficRecord",
+			Handler:       _Daemon_TrafficRecord_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TrafficReplay",
+			Handler:       _Daemon_TrafficReplay_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "encore/daemon/daemon.proto",
 }
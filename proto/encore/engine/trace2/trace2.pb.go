@@ -410,7 +410,7 @@ func (x LogMessage_Level) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LogMessage_Level.Descriptor instead.
 func (LogMessage_Level) EnumDescriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{60, 0}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{65, 0}
 }
 
 // SpanSummary summarizes a span for display purposes.
@@ -1835,6 +1835,11 @@ type SpanEvent struct {
 	//	*SpanEvent_BucketListObjectsEnd
 	//	*SpanEvent_BucketDeleteObjectsStart
 	//	*SpanEvent_BucketDeleteObjectsEnd
+	//	*SpanEvent_FaultInjected
+	//	*SpanEvent_BucketObjectCopyStart
+	//	*SpanEvent_BucketObjectCopyEnd
+	//	*SpanEvent_BucketObjectMoveStart
+	//	*SpanEvent_BucketObjectMoveEnd
 	Data          isSpanEvent_Data `protobuf_oneof:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -2132,6 +2137,51 @@ func (x *SpanEvent) GetBucketDeleteObjectsEnd() *BucketDeleteObjectsEnd {
 	return nil
 }
 
+func (x *SpanEvent) GetFaultInjected() *FaultInjected {
+	if x != nil {
+		if x, ok := x.Data.(*SpanEvent_FaultInjected); ok {
+			return x.FaultInjected
+		}
+	}
+	return nil
+}
+
+func (x *SpanEvent) GetBucketObjectCopyStart() *BucketObjectCopyStart {
+	if x != nil {
+		if x, ok := x.Data.(*SpanEvent_BucketObjectCopyStart); ok {
+			return x.BucketObjectCopyStart
+		}
+	}
+	return nil
+}
+
+func (x *SpanEvent) GetBucketObjectCopyEnd() *BucketObjectCopyEnd {
+	if x != nil {
+		if x, ok := x.Data.(*SpanEvent_BucketObjectCopyEnd); ok {
+			return x.BucketObjectCopyEnd
+		}
+	}
+	return nil
+}
+
+func (x *SpanEvent) GetBucketObjectMoveStart() *BucketObjectMoveStart {
+	if x != nil {
+		if x, ok := x.Data.(*SpanEvent_BucketObjectMoveStart); ok {
+			return x.BucketObjectMoveStart
+		}
+	}
+	return nil
+}
+
+func (x *SpanEvent) GetBucketObjectMoveEnd() *BucketObjectMoveEnd {
+	if x != nil {
+		if x, ok := x.Data.(*SpanEvent_BucketObjectMoveEnd); ok {
+			return x.BucketObjectMoveEnd
+		}
+	}
+	return nil
+}
+
 type isSpanEvent_Data interface {
 	isSpanEvent_Data()
 }
@@ -2240,6 +2290,26 @@ type SpanEvent_BucketDeleteObjectsEnd struct {
 	BucketDeleteObjectsEnd *BucketDeleteObjectsEnd `protobuf:"bytes,35,opt,name=bucket_delete_objects_end,json=bucketDeleteObjectsEnd,proto3,oneof"`
 }
 
+type SpanEvent_FaultInjected struct {
+	FaultInjected *FaultInjected `protobuf:"bytes,36,opt,name=fault_injected,json=faultInjected,proto3,oneof"`
+}
+
+type SpanEvent_BucketObjectCopyStart struct {
+	BucketObjectCopyStart *BucketObjectCopyStart `protobuf:"bytes,37,opt,name=bucket_object_copy_start,json=bucketObjectCopyStart,proto3,oneof"`
+}
+
+type SpanEvent_BucketObjectCopyEnd struct {
+	BucketObjectCopyEnd *BucketObjectCopyEnd `protobuf:"bytes,38,opt,name=bucket_object_copy_end,json=bucketObjectCopyEnd,proto3,oneof"`
+}
+
+type SpanEvent_BucketObjectMoveStart struct {
+	BucketObjectMoveStart *BucketObjectMoveStart `protobuf:"bytes,39,opt,name=bucket_object_move_start,json=bucketObjectMoveStart,proto3,oneof"`
+}
+
+type SpanEvent_BucketObjectMoveEnd struct {
+	BucketObjectMoveEnd *BucketObjectMoveEnd `protobuf:"bytes,40,opt,name=bucket_object_move_end,json=bucketObjectMoveEnd,proto3,oneof"`
+}
+
 func (*SpanEvent_LogMessage) isSpanEvent_Data() {}
 
 func (*SpanEvent_BodyStream) isSpanEvent_Data() {}
@@ -2292,6 +2362,16 @@ func (*SpanEvent_BucketDeleteObjectsStart) isSpanEvent_Data() {}
 
 func (*SpanEvent_BucketDeleteObjectsEnd) isSpanEvent_Data() {}
 
+func (*SpanEvent_FaultInjected) isSpanEvent_Data() {}
+
+func (*SpanEvent_BucketObjectCopyStart) isSpanEvent_Data() {}
+
+func (*SpanEvent_BucketObjectCopyEnd) isSpanEvent_Data() {}
+
+func (*SpanEvent_BucketObjectMoveStart) isSpanEvent_Data() {}
+
+func (*SpanEvent_BucketObjectMoveEnd) isSpanEvent_Data() {}
+
 type RPCCallStart struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	TargetServiceName  string                 `protobuf:"bytes,1,opt,name=target_service_name,json=targetServiceName,proto3" json:"target_service_name,omitempty"`
@@ -2576,6 +2656,8 @@ type DBQueryStart struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	Stack         *StackTrace            `protobuf:"bytes,2,opt,name=stack,proto3" json:"stack,omitempty"`
+	Host          *string                `protobuf:"bytes,3,opt,name=host,proto3,oneof" json:"host,omitempty"`  // the database server that served the query, if known
+	Replica       bool                   `protobuf:"varint,4,opt,name=replica,proto3" json:"replica,omitempty"` // whether the query was served by a read replica
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2624,6 +2706,20 @@ func (x *DBQueryStart) GetStack() *StackTrace {
 	return nil
 }
 
+func (x *DBQueryStart) GetHost() string {
+	if x != nil && x.Host != nil {
+		return *x.Host
+	}
+	return ""
+}
+
+func (x *DBQueryStart) GetReplica() bool {
+	if x != nil {
+		return x.Replica
+	}
+	return false
+}
+
 type DBQueryEnd struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Err           *Error                 `protobuf:"bytes,1,opt,name=err,proto3,oneof" json:"err,omitempty"`
@@ -3632,6 +3728,356 @@ func (x *BucketDeleteObjectsEnd) GetErr() *Error {
 	return nil
 }
 
+type BucketObjectCopyStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Src           string                 `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty"`
+	Dst           string                 `protobuf:"bytes,3,opt,name=dst,proto3" json:"dst,omitempty"`
+	SrcVersion    *string                `protobuf:"bytes,4,opt,name=src_version,json=srcVersion,proto3,oneof" json:"src_version,omitempty"`
+	Stack         *StackTrace            `protobuf:"bytes,5,opt,name=stack,proto3" json:"stack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BucketObjectCopyStart) Reset() {
+	*x = BucketObjectCopyStart{}
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BucketObjectCopyStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BucketObjectCopyStart) ProtoMessage() {}
+
+func (x *BucketObjectCopyStart) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BucketObjectCopyStart.ProtoReflect.Descriptor instead.
+func (*BucketObjectCopyStart) Descriptor() ([]byte, []int) {
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BucketObjectCopyStart) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *BucketObjectCopyStart) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *BucketObjectCopyStart) GetDst() string {
+	if x != nil {
+		return x.Dst
+	}
+	return ""
+}
+
+func (x *BucketObjectCopyStart) GetSrcVersion() string {
+	if x != nil && x.SrcVersion != nil {
+		return *x.SrcVersion
+	}
+	return ""
+}
+
+func (x *BucketObjectCopyStart) GetStack() *StackTrace {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+type BucketObjectCopyEnd struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Err           *Error                  `protobuf:"bytes,1,opt,name=err,proto3,oneof" json:"err,omitempty"`
+	Attrs         *BucketObjectAttributes `protobuf:"bytes,2,opt,name=attrs,proto3,oneof" json:"attrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BucketObjectCopyEnd) Reset() {
+	*x = BucketObjectCopyEnd{}
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BucketObjectCopyEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BucketObjectCopyEnd) ProtoMessage() {}
+
+func (x *BucketObjectCopyEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BucketObjectCopyEnd.ProtoReflect.Descriptor instead.
+func (*BucketObjectCopyEnd) Descriptor() ([]byte, []int) {
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BucketObjectCopyEnd) GetErr() *Error {
+	if x != nil {
+		return x.Err
+	}
+	return nil
+}
+
+func (x *BucketObjectCopyEnd) GetAttrs() *BucketObjectAttributes {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+type BucketObjectMoveStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Src           string                 `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty"`
+	Dst           string                 `protobuf:"bytes,3,opt,name=dst,proto3" json:"dst,omitempty"`
+	SrcVersion    *string                `protobuf:"bytes,4,opt,name=src_version,json=srcVersion,proto3,oneof" json:"src_version,omitempty"`
+	Stack         *StackTrace            `protobuf:"bytes,5,opt,name=stack,proto3" json:"stack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BucketObjectMoveStart) Reset() {
+	*x = BucketObjectMoveStart{}
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BucketObjectMoveStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BucketObjectMoveStart) ProtoMessage() {}
+
+func (x *BucketObjectMoveStart) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BucketObjectMoveStart.ProtoReflect.Descriptor instead.
+func (*BucketObjectMoveStart) Descriptor() ([]byte, []int) {
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BucketObjectMoveStart) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *BucketObjectMoveStart) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *BucketObjectMoveStart) GetDst() string {
+	if x != nil {
+		return x.Dst
+	}
+	return ""
+}
+
+func (x *BucketObjectMoveStart) GetSrcVersion() string {
+	if x != nil && x.SrcVersion != nil {
+		return *x.SrcVersion
+	}
+	return ""
+}
+
+func (x *BucketObjectMoveStart) GetStack() *StackTrace {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+type BucketObjectMoveEnd struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Err           *Error                  `protobuf:"bytes,1,opt,name=err,proto3,oneof" json:"err,omitempty"`
+	Attrs         *BucketObjectAttributes `protobuf:"bytes,2,opt,name=attrs,proto3,oneof" json:"attrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BucketObjectMoveEnd) Reset() {
+	*x = BucketObjectMoveEnd{}
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BucketObjectMoveEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BucketObjectMoveEnd) ProtoMessage() {}
+
+func (x *BucketObjectMoveEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BucketObjectMoveEnd.ProtoReflect.Descriptor instead.
+func (*BucketObjectMoveEnd) Descriptor() ([]byte, []int) {
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BucketObjectMoveEnd) GetErr() *Error {
+	if x != nil {
+		return x.Err
+	}
+	return nil
+}
+
+func (x *BucketObjectMoveEnd) GetAttrs() *BucketObjectAttributes {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+// FaultInjected describes a fault injected by "encore chaos"
+// into an operation of a locally running app.
+type FaultInjected struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // "api", "sqldb", "cache", "bucket" or "pubsub"
+	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Endpoint      string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Resource      string                 `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	LatencyNanos  uint64                 `protobuf:"varint,5,opt,name=latency_nanos,json=latencyNanos,proto3" json:"latency_nanos,omitempty"`
+	Err           *Error                 `protobuf:"bytes,6,opt,name=err,proto3,oneof" json:"err,omitempty"` // set if an error was injected
+	Stack         *StackTrace            `protobuf:"bytes,7,opt,name=stack,proto3" json:"stack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FaultInjected) Reset() {
+	*x = FaultInjected{}
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FaultInjected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultInjected) ProtoMessage() {}
+
+func (x *FaultInjected) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultInjected.ProtoReflect.Descriptor instead.
+func (*FaultInjected) Descriptor() ([]byte, []int) {
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FaultInjected) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FaultInjected) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *FaultInjected) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *FaultInjected) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *FaultInjected) GetLatencyNanos() uint64 {
+	if x != nil {
+		return x.LatencyNanos
+	}
+	return 0
+}
+
+func (x *FaultInjected) GetErr() *Error {
+	if x != nil {
+		return x.Err
+	}
+	return nil
+}
+
+func (x *FaultInjected) GetStack() *StackTrace {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
 type BucketObjectAttributes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Size          *uint64                `protobuf:"varint,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
@@ -3644,7 +4090,7 @@ type BucketObjectAttributes struct {
 
 func (x *BucketObjectAttributes) Reset() {
 	*x = BucketObjectAttributes{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[40]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3656,7 +4102,7 @@ func (x *BucketObjectAttributes) String() string {
 func (*BucketObjectAttributes) ProtoMessage() {}
 
 func (x *BucketObjectAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[40]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3669,7 +4115,7 @@ func (x *BucketObjectAttributes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BucketObjectAttributes.ProtoReflect.Descriptor instead.
 func (*BucketObjectAttributes) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{40}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *BucketObjectAttributes) GetSize() uint64 {
@@ -3711,7 +4157,7 @@ type BodyStream struct {
 
 func (x *BodyStream) Reset() {
 	*x = BodyStream{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[41]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3723,7 +4169,7 @@ func (x *BodyStream) String() string {
 func (*BodyStream) ProtoMessage() {}
 
 func (x *BodyStream) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[41]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3736,7 +4182,7 @@ func (x *BodyStream) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BodyStream.ProtoReflect.Descriptor instead.
 func (*BodyStream) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{41}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *BodyStream) GetIsResponse() bool {
@@ -3769,13 +4215,17 @@ type HTTPCallStart struct {
 	// start_nanotime is used to compute timings based on the
 	// nanotime in the HTTP trace events.
 	StartNanotime int64 `protobuf:"varint,5,opt,name=start_nanotime,json=startNanotime,proto3" json:"start_nanotime,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// external_api and external_operation are set if the call was made
+	// by a client generated with "encore gen external".
+	ExternalApi       string `protobuf:"bytes,6,opt,name=external_api,json=externalApi,proto3" json:"external_api,omitempty"`
+	ExternalOperation string `protobuf:"bytes,7,opt,name=external_operation,json=externalOperation,proto3" json:"external_operation,omitempty"` // the OpenAPI operation id
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *HTTPCallStart) Reset() {
 	*x = HTTPCallStart{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[42]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3787,7 +4237,7 @@ func (x *HTTPCallStart) String() string {
 func (*HTTPCallStart) ProtoMessage() {}
 
 func (x *HTTPCallStart) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[42]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3800,7 +4250,7 @@ func (x *HTTPCallStart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPCallStart.ProtoReflect.Descriptor instead.
 func (*HTTPCallStart) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{42}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *HTTPCallStart) GetCorrelationParentSpanId() uint64 {
@@ -3838,6 +4288,20 @@ func (x *HTTPCallStart) GetStartNanotime() int64 {
 	return 0
 }
 
+func (x *HTTPCallStart) GetExternalApi() string {
+	if x != nil {
+		return x.ExternalApi
+	}
+	return ""
+}
+
+func (x *HTTPCallStart) GetExternalOperation() string {
+	if x != nil {
+		return x.ExternalOperation
+	}
+	return ""
+}
+
 type HTTPCallEnd struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// status_code is set if we got a HTTP response.
@@ -3852,7 +4316,7 @@ type HTTPCallEnd struct {
 
 func (x *HTTPCallEnd) Reset() {
 	*x = HTTPCallEnd{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[43]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3864,7 +4328,7 @@ func (x *HTTPCallEnd) String() string {
 func (*HTTPCallEnd) ProtoMessage() {}
 
 func (x *HTTPCallEnd) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[43]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3877,7 +4341,7 @@ func (x *HTTPCallEnd) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPCallEnd.ProtoReflect.Descriptor instead.
 func (*HTTPCallEnd) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{43}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *HTTPCallEnd) GetStatusCode() uint32 {
@@ -3927,7 +4391,7 @@ type HTTPTraceEvent struct {
 
 func (x *HTTPTraceEvent) Reset() {
 	*x = HTTPTraceEvent{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[44]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3939,7 +4403,7 @@ func (x *HTTPTraceEvent) String() string {
 func (*HTTPTraceEvent) ProtoMessage() {}
 
 func (x *HTTPTraceEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[44]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3952,7 +4416,7 @@ func (x *HTTPTraceEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPTraceEvent.ProtoReflect.Descriptor instead.
 func (*HTTPTraceEvent) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{44}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *HTTPTraceEvent) GetNanotime() int64 {
@@ -4192,7 +4656,7 @@ type HTTPGetConn struct {
 
 func (x *HTTPGetConn) Reset() {
 	*x = HTTPGetConn{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[45]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4204,7 +4668,7 @@ func (x *HTTPGetConn) String() string {
 func (*HTTPGetConn) ProtoMessage() {}
 
 func (x *HTTPGetConn) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[45]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4217,7 +4681,7 @@ func (x *HTTPGetConn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPGetConn.ProtoReflect.Descriptor instead.
 func (*HTTPGetConn) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{45}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *HTTPGetConn) GetHostPort() string {
@@ -4238,7 +4702,7 @@ type HTTPGotConn struct {
 
 func (x *HTTPGotConn) Reset() {
 	*x = HTTPGotConn{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[46]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4250,7 +4714,7 @@ func (x *HTTPGotConn) String() string {
 func (*HTTPGotConn) ProtoMessage() {}
 
 func (x *HTTPGotConn) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[46]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4263,7 +4727,7 @@ func (x *HTTPGotConn) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPGotConn.ProtoReflect.Descriptor instead.
 func (*HTTPGotConn) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{46}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *HTTPGotConn) GetReused() bool {
@@ -4295,7 +4759,7 @@ type HTTPGotFirstResponseByte struct {
 
 func (x *HTTPGotFirstResponseByte) Reset() {
 	*x = HTTPGotFirstResponseByte{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[47]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4307,7 +4771,7 @@ func (x *HTTPGotFirstResponseByte) String() string {
 func (*HTTPGotFirstResponseByte) ProtoMessage() {}
 
 func (x *HTTPGotFirstResponseByte) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[47]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4320,7 +4784,7 @@ func (x *HTTPGotFirstResponseByte) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPGotFirstResponseByte.ProtoReflect.Descriptor instead.
 func (*HTTPGotFirstResponseByte) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{47}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{52}
 }
 
 type HTTPGot1XxResponse struct {
@@ -4332,7 +4796,7 @@ type HTTPGot1XxResponse struct {
 
 func (x *HTTPGot1XxResponse) Reset() {
 	*x = HTTPGot1XxResponse{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[48]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4344,7 +4808,7 @@ func (x *HTTPGot1XxResponse) String() string {
 func (*HTTPGot1XxResponse) ProtoMessage() {}
 
 func (x *HTTPGot1XxResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[48]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4357,7 +4821,7 @@ func (x *HTTPGot1XxResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPGot1XxResponse.ProtoReflect.Descriptor instead.
 func (*HTTPGot1XxResponse) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{48}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *HTTPGot1XxResponse) GetCode() int32 {
@@ -4376,7 +4840,7 @@ type HTTPDNSStart struct {
 
 func (x *HTTPDNSStart) Reset() {
 	*x = HTTPDNSStart{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[49]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4388,7 +4852,7 @@ func (x *HTTPDNSStart) String() string {
 func (*HTTPDNSStart) ProtoMessage() {}
 
 func (x *HTTPDNSStart) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[49]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4401,7 +4865,7 @@ func (x *HTTPDNSStart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPDNSStart.ProtoReflect.Descriptor instead.
 func (*HTTPDNSStart) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{49}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *HTTPDNSStart) GetHost() string {
@@ -4421,7 +4885,7 @@ type HTTPDNSDone struct {
 
 func (x *HTTPDNSDone) Reset() {
 	*x = HTTPDNSDone{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[50]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4433,7 +4897,7 @@ func (x *HTTPDNSDone) String() string {
 func (*HTTPDNSDone) ProtoMessage() {}
 
 func (x *HTTPDNSDone) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[50]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4446,7 +4910,7 @@ func (x *HTTPDNSDone) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPDNSDone.ProtoReflect.Descriptor instead.
 func (*HTTPDNSDone) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{50}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *HTTPDNSDone) GetErr() []byte {
@@ -4472,7 +4936,7 @@ type DNSAddr struct {
 
 func (x *DNSAddr) Reset() {
 	*x = DNSAddr{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[51]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4484,7 +4948,7 @@ func (x *DNSAddr) String() string {
 func (*DNSAddr) ProtoMessage() {}
 
 func (x *DNSAddr) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[51]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4497,7 +4961,7 @@ func (x *DNSAddr) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DNSAddr.ProtoReflect.Descriptor instead.
 func (*DNSAddr) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{51}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *DNSAddr) GetIp() []byte {
@@ -4517,7 +4981,7 @@ type HTTPConnectStart struct {
 
 func (x *HTTPConnectStart) Reset() {
 	*x = HTTPConnectStart{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[52]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4529,7 +4993,7 @@ func (x *HTTPConnectStart) String() string {
 func (*HTTPConnectStart) ProtoMessage() {}
 
 func (x *HTTPConnectStart) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[52]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4542,7 +5006,7 @@ func (x *HTTPConnectStart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPConnectStart.ProtoReflect.Descriptor instead.
 func (*HTTPConnectStart) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{52}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *HTTPConnectStart) GetNetwork() string {
@@ -4570,7 +5034,7 @@ type HTTPConnectDone struct {
 
 func (x *HTTPConnectDone) Reset() {
 	*x = HTTPConnectDone{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[53]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4582,7 +5046,7 @@ func (x *HTTPConnectDone) String() string {
 func (*HTTPConnectDone) ProtoMessage() {}
 
 func (x *HTTPConnectDone) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[53]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4595,7 +5059,7 @@ func (x *HTTPConnectDone) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPConnectDone.ProtoReflect.Descriptor instead.
 func (*HTTPConnectDone) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{53}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *HTTPConnectDone) GetNetwork() string {
@@ -4627,7 +5091,7 @@ type HTTPTLSHandshakeStart struct {
 
 func (x *HTTPTLSHandshakeStart) Reset() {
 	*x = HTTPTLSHandshakeStart{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[54]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4639,7 +5103,7 @@ func (x *HTTPTLSHandshakeStart) String() string {
 func (*HTTPTLSHandshakeStart) ProtoMessage() {}
 
 func (x *HTTPTLSHandshakeStart) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[54]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4652,7 +5116,7 @@ func (x *HTTPTLSHandshakeStart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPTLSHandshakeStart.ProtoReflect.Descriptor instead.
 func (*HTTPTLSHandshakeStart) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{54}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{59}
 }
 
 type HTTPTLSHandshakeDone struct {
@@ -4668,7 +5132,7 @@ type HTTPTLSHandshakeDone struct {
 
 func (x *HTTPTLSHandshakeDone) Reset() {
 	*x = HTTPTLSHandshakeDone{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[55]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4680,7 +5144,7 @@ func (x *HTTPTLSHandshakeDone) String() string {
 func (*HTTPTLSHandshakeDone) ProtoMessage() {}
 
 func (x *HTTPTLSHandshakeDone) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[55]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4693,7 +5157,7 @@ func (x *HTTPTLSHandshakeDone) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPTLSHandshakeDone.ProtoReflect.Descriptor instead.
 func (*HTTPTLSHandshakeDone) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{55}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *HTTPTLSHandshakeDone) GetErr() []byte {
@@ -4739,7 +5203,7 @@ type HTTPWroteHeaders struct {
 
 func (x *HTTPWroteHeaders) Reset() {
 	*x = HTTPWroteHeaders{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[56]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4751,7 +5215,7 @@ func (x *HTTPWroteHeaders) String() string {
 func (*HTTPWroteHeaders) ProtoMessage() {}
 
 func (x *HTTPWroteHeaders) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[56]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4764,7 +5228,7 @@ func (x *HTTPWroteHeaders) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPWroteHeaders.ProtoReflect.Descriptor instead.
 func (*HTTPWroteHeaders) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{56}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{61}
 }
 
 type HTTPWroteRequest struct {
@@ -4776,7 +5240,7 @@ type HTTPWroteRequest struct {
 
 func (x *HTTPWroteRequest) Reset() {
 	*x = HTTPWroteRequest{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[57]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4788,7 +5252,7 @@ func (x *HTTPWroteRequest) String() string {
 func (*HTTPWroteRequest) ProtoMessage() {}
 
 func (x *HTTPWroteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[57]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4801,7 +5265,7 @@ func (x *HTTPWroteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPWroteRequest.ProtoReflect.Descriptor instead.
 func (*HTTPWroteRequest) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{57}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *HTTPWroteRequest) GetErr() []byte {
@@ -4819,7 +5283,7 @@ type HTTPWait100Continue struct {
 
 func (x *HTTPWait100Continue) Reset() {
 	*x = HTTPWait100Continue{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[58]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4831,7 +5295,7 @@ func (x *HTTPWait100Continue) String() string {
 func (*HTTPWait100Continue) ProtoMessage() {}
 
 func (x *HTTPWait100Continue) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[58]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4844,7 +5308,7 @@ func (x *HTTPWait100Continue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPWait100Continue.ProtoReflect.Descriptor instead.
 func (*HTTPWait100Continue) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{58}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{63}
 }
 
 type HTTPClosedBodyData struct {
@@ -4856,7 +5320,7 @@ type HTTPClosedBodyData struct {
 
 func (x *HTTPClosedBodyData) Reset() {
 	*x = HTTPClosedBodyData{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[59]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4868,7 +5332,7 @@ func (x *HTTPClosedBodyData) String() string {
 func (*HTTPClosedBodyData) ProtoMessage() {}
 
 func (x *HTTPClosedBodyData) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[59]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4881,7 +5345,7 @@ func (x *HTTPClosedBodyData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HTTPClosedBodyData.ProtoReflect.Descriptor instead.
 func (*HTTPClosedBodyData) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{59}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *HTTPClosedBodyData) GetErr() []byte {
@@ -4903,7 +5367,7 @@ type LogMessage struct {
 
 func (x *LogMessage) Reset() {
 	*x = LogMessage{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[60]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4915,7 +5379,7 @@ func (x *LogMessage) String() string {
 func (*LogMessage) ProtoMessage() {}
 
 func (x *LogMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[60]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4928,7 +5392,7 @@ func (x *LogMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogMessage.ProtoReflect.Descriptor instead.
 func (*LogMessage) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{60}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *LogMessage) GetLevel() LogMessage_Level {
@@ -4982,7 +5446,7 @@ type LogField struct {
 
 func (x *LogField) Reset() {
 	*x = LogField{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[61]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4994,7 +5458,7 @@ func (x *LogField) String() string {
 func (*LogField) ProtoMessage() {}
 
 func (x *LogField) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[61]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5007,7 +5471,7 @@ func (x *LogField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogField.ProtoReflect.Descriptor instead.
 func (*LogField) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{61}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *LogField) GetKey() string {
@@ -5203,7 +5667,7 @@ type StackTrace struct {
 
 func (x *StackTrace) Reset() {
 	*x = StackTrace{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[62]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5215,7 +5679,7 @@ func (x *StackTrace) String() string {
 func (*StackTrace) ProtoMessage() {}
 
 func (x *StackTrace) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[62]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5228,7 +5692,7 @@ func (x *StackTrace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StackTrace.ProtoReflect.Descriptor instead.
 func (*StackTrace) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{62}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *StackTrace) GetPcs() []int64 {
@@ -5256,7 +5720,7 @@ type StackFrame struct {
 
 func (x *StackFrame) Reset() {
 	*x = StackFrame{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[63]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5268,7 +5732,7 @@ func (x *StackFrame) String() string {
 func (*StackFrame) ProtoMessage() {}
 
 func (x *StackFrame) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[63]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5281,7 +5745,7 @@ func (x *StackFrame) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StackFrame.ProtoReflect.Descriptor instead.
 func (*StackFrame) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{63}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *StackFrame) GetFilename() string {
@@ -5315,7 +5779,7 @@ type Error struct {
 
 func (x *Error) Reset() {
 	*x = Error{}
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[64]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5327,7 +5791,7 @@ func (x *Error) String() string {
 func (*Error) ProtoMessage() {}
 
 func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[64]
+	mi := &file_encore_engine_trace2_trace2_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5340,7 +5804,7 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Error.ProtoReflect.Descriptor instead.
 func (*Error) Descriptor() ([]byte, []int) {
-	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{64}
+	return file_encore_engine_trace2_trace2_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *Error) GetMsg() string {
@@ -5533,7 +5997,7 @@ const file_encore_engine_trace2_trace2_proto_rawDesc = "" +
 	"\x06failed\x18\x03 \x01(\bR\x06failed\x12\x18\n" +
 	"\askipped\x18\x04 \x01(\bR\askipped\x12\x15\n" +
 	"\x03uid\x18\x05 \x01(\tH\x00R\x03uid\x88\x01\x01B\x06\n" +
-	"\x04_uid\"\xe3\x13\n" +
+	"\x04_uid\"\xc5\x17\n" +
 	"\tSpanEvent\x12\x12\n" +
 	"\x04goid\x18\x01 \x01(\rR\x04goid\x12\x1c\n" +
 	"\adef_loc\x18\x02 \x01(\rH\x01R\x06defLoc\x88\x01\x01\x125\n" +
@@ -5568,7 +6032,12 @@ const file_encore_engine_trace2_trace2_proto_rawDesc = "" +
 	"\x19bucket_list_objects_start\x18  \x01(\v2,.encore.engine.trace2.BucketListObjectsStartH\x00R\x16bucketListObjectsStart\x12c\n" +
 	"\x17bucket_list_objects_end\x18! \x01(\v2*.encore.engine.trace2.BucketListObjectsEndH\x00R\x14bucketListObjectsEnd\x12o\n" +
 	"\x1bbucket_delete_objects_start\x18\" \x01(\v2..encore.engine.trace2.BucketDeleteObjectsStartH\x00R\x18bucketDeleteObjectsStart\x12i\n" +
-	"\x19bucket_delete_objects_end\x18# \x01(\v2,.encore.engine.trace2.BucketDeleteObjectsEndH\x00R\x16bucketDeleteObjectsEndB\x06\n" +
+	"\x19bucket_delete_objects_end\x18# \x01(\v2,.encore.engine.trace2.BucketDeleteObjectsEndH\x00R\x16bucketDeleteObjectsEnd\x12L\n" +
+	"\x0efault_injected\x18$ \x01(\v2#.encore.engine.trace2.FaultInjectedH\x00R\rfaultInjected\x12f\n" +
+	"\x18bucket_object_copy_start\x18% \x01(\v2+.encore.engine.trace2.BucketObjectCopyStartH\x00R\x15bucketObjectCopyStart\x12`\n" +
+	"\x16bucket_object_copy_end\x18& \x01(\v2).encore.engine.trace2.BucketObjectCopyEndH\x00R\x13bucketObjectCopyEnd\x12f\n" +
+	"\x18bucket_object_move_start\x18' \x01(\v2+.encore.engine.trace2.BucketObjectMoveStartH\x00R\x15bucketObjectMoveStart\x12`\n" +
+	"\x16bucket_object_move_end\x18( \x01(\v2).encore.engine.trace2.BucketObjectMoveEndH\x00R\x13bucketObjectMoveEndB\x06\n" +
 	"\x04dataB\n" +
 	"\n" +
 	"\b_def_locB\x17\n" +
@@ -5595,10 +6064,13 @@ const file_encore_engine_trace2_trace2_proto_rawDesc = "" +
 	"\bROLLBACK\x10\x00\x12\n" +
 	"\n" +
 	"\x06COMMIT\x10\x01B\x06\n" +
-	"\x04_err\"\\\n" +
+	"\x04_err\"\x98\x01\n" +
 	"\fDBQueryStart\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x126\n" +
-	"\x05stack\x18\x02 \x01(\v2 .encore.engine.trace2.StackTraceR\x05stack\"H\n" +
+	"\x05stack\x18\x02 \x01(\v2 .encore.engine.trace2.StackTraceR\x05stack\x12\x17\n" +
+	"\x04host\x18\x03 \x01(\tH\x00R\x04host\x88\x01\x01\x12\x18\n" +
+	"\areplica\x18\x04 \x01(\bR\areplicaB\a\n" +
+	"\x05_host\"H\n" +
 	"\n" +
 	"DBQueryEnd\x122\n" +
 	"\x03err\x18\x01 \x01(\v2\x1b.encore.engine.trace2.ErrorH\x00R\x03err\x88\x01\x01B\x06\n" +
@@ -5691,6 +6163,41 @@ const file_encore_engine_trace2_trace2_proto_rawDesc = "" +
 	"\b_version\"T\n" +
 	"\x16BucketDeleteObjectsEnd\x122\n" +
 	"\x03err\x18\x01 \x01(\v2\x1b.encore.engine.trace2.ErrorH\x00R\x03err\x88\x01\x01B\x06\n" +
+	"\x04_err\"\xc1\x01\n" +
+	"\x15BucketObjectCopyStart\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03src\x18\x02 \x01(\tR\x03src\x12\x10\n" +
+	"\x03dst\x18\x03 \x01(\tR\x03dst\x12$\n" +
+	"\vsrc_version\x18\x04 \x01(\tH\x00R\n" +
+	"srcVersion\x88\x01\x01\x126\n" +
+	"\x05stack\x18\x05 \x01(\v2 .encore.engine.trace2.StackTraceR\x05stackB\x0e\n" +
+	"\f_src_version\"\xa4\x01\n" +
+	"\x13BucketObjectCopyEnd\x122\n" +
+	"\x03err\x18\x01 \x01(\v2\x1b.encore.engine.trace2.ErrorH\x00R\x03err\x88\x01\x01\x12G\n" +
+	"\x05attrs\x18\x02 \x01(\v2,.encore.engine.trace2.BucketObjectAttributesH\x01R\x05attrs\x88\x01\x01B\x06\n" +
+	"\x04_errB\b\n" +
+	"\x06_attrs\"\xc1\x01\n" +
+	"\x15BucketObjectMoveStart\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03src\x18\x02 \x01(\tR\x03src\x12\x10\n" +
+	"\x03dst\x18\x03 \x01(\tR\x03dst\x12$\n" +
+	"\vsrc_version\x18\x04 \x01(\tH\x00R\n" +
+	"srcVersion\x88\x01\x01\x126\n" +
+	"\x05stack\x18\x05 \x01(\v2 .encore.engine.trace2.StackTraceR\x05stackB\x0e\n" +
+	"\f_src_version\"\xa4\x01\n" +
+	"\x13BucketObjectMoveEnd\x122\n" +
+	"\x03err\x18\x01 \x01(\v2\x1b.encore.engine.trace2.ErrorH\x00R\x03err\x88\x01\x01\x12G\n" +
+	"\x05attrs\x18\x02 \x01(\v2,.encore.engine.trace2.BucketObjectAttributesH\x01R\x05attrs\x88\x01\x01B\x06\n" +
+	"\x04_errB\b\n" +
+	"\x06_attrs\"\x8e\x02\n" +
+	"\rFaultInjected\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x1a\n" +
+	"\bresource\x18\x04 \x01(\tR\bresource\x12#\n" +
+	"\rlatency_nanos\x18\x05 \x01(\x04R\flatencyNanos\x122\n" +
+	"\x03err\x18\x06 \x01(\v2\x1b.encore.engine.trace2.ErrorH\x00R\x03err\x88\x01\x01\x126\n" +
+	"\x05stack\x18\a \x01(\v2 .encore.engine.trace2.StackTraceR\x05stackB\x06\n" +
 	"\x04_err\"\xc0\x01\n" +
 	"\x16BucketObjectAttributes\x12\x17\n" +
 	"\x04size\x18\x01 \x01(\x04H\x00R\x04size\x88\x01\x01\x12\x1d\n" +
@@ -5709,13 +6216,15 @@ const file_encore_engine_trace2_trace2_proto_rawDesc = "" +
 	"\n" +
 	"overflowed\x18\x02 \x01(\bR\n" +
 	"overflowed\x12\x12\n" +
-	"\x04data\x18\x03 \x01(\fR\x04data\"\xd5\x01\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"\xa7\x02\n" +
 	"\rHTTPCallStart\x12;\n" +
 	"\x1acorrelation_parent_span_id\x18\x01 \x01(\x04R\x17correlationParentSpanId\x12\x16\n" +
 	"\x06method\x18\x02 \x01(\tR\x06method\x12\x10\n" +
 	"\x03url\x18\x03 \x01(\tR\x03url\x126\n" +
 	"\x05stack\x18\x04 \x01(\v2 .encore.engine.trace2.StackTraceR\x05stack\x12%\n" +
-	"\x0estart_nanotime\x18\x05 \x01(\x03R\rstartNanotime\"\xc8\x01\n" +
+	"\x0estart_nanotime\x18\x05 \x01(\x03R\rstartNanotime\x12!\n" +
+	"\fexternal_api\x18\x06 \x01(\tR\vexternalApi\x12-\n" +
+	"\x12external_operation\x18\a \x01(\tR\x11externalOperation\"\xc8\x01\n" +
 	"\vHTTPCallEnd\x12$\n" +
 	"\vstatus_code\x18\x01 \x01(\rH\x00R\n" +
 	"statusCode\x88\x01\x01\x122\n" +
@@ -5875,7 +6384,7 @@ func file_encore_engine_trace2_trace2_proto_rawDescGZIP() []byte {
 }
 
 var file_encore_engine_trace2_trace2_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_encore_engine_trace2_trace2_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
+var file_encore_engine_trace2_trace2_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
 var file_encore_engine_trace2_trace2_proto_goTypes = []any{
 	(HTTPTraceEventCode)(0),              // 0: encore.engine.trace2.HTTPTraceEventCode
 	(StatusCode)(0),                      // 1: encore.engine.trace2.StatusCode
@@ -5923,41 +6432,46 @@ var file_encore_engine_trace2_trace2_proto_goTypes = []any{
 	(*BucketDeleteObjectsStart)(nil),     // 43: encore.engine.trace2.BucketDeleteObjectsStart
 	(*BucketDeleteObjectEntry)(nil),      // 44: encore.engine.trace2.BucketDeleteObjectEntry
 	(*BucketDeleteObjectsEnd)(nil),       // 45: encore.engine.trace2.BucketDeleteObjectsEnd
-	(*BucketObjectAttributes)(nil),       // 46: encore.engine.trace2.BucketObjectAttributes
-	(*BodyStream)(nil),                   // 47: encore.engine.trace2.BodyStream
-	(*HTTPCallStart)(nil),                // 48: encore.engine.trace2.HTTPCallStart
-	(*HTTPCallEnd)(nil),                  // 49: encore.engine.trace2.HTTPCallEnd
-	(*HTTPTraceEvent)(nil),               // 50: encore.engine.trace2.HTTPTraceEvent
-	(*HTTPGetConn)(nil),                  // 51: encore.engine.trace2.HTTPGetConn
-	(*HTTPGotConn)(nil),                  // 52: encore.engine.trace2.HTTPGotConn
-	(*HTTPGotFirstResponseByte)(nil),     // 53: encore.engine.trace2.HTTPGotFirstResponseByte
-	(*HTTPGot1XxResponse)(nil),           // 54: encore.engine.trace2.HTTPGot1xxResponse
-	(*HTTPDNSStart)(nil),                 // 55: encore.engine.trace2.HTTPDNSStart
-	(*HTTPDNSDone)(nil),                  // 56: encore.engine.trace2.HTTPDNSDone
-	(*DNSAddr)(nil),                      // 57: encore.engine.trace2.DNSAddr
-	(*HTTPConnectStart)(nil),             // 58: encore.engine.trace2.HTTPConnectStart
-	(*HTTPConnectDone)(nil),              // 59: encore.engine.trace2.HTTPConnectDone
-	(*HTTPTLSHandshakeStart)(nil),        // 60: encore.engine.trace2.HTTPTLSHandshakeStart
-	(*HTTPTLSHandshakeDone)(nil),         // 61: encore.engine.trace2.HTTPTLSHandshakeDone
-	(*HTTPWroteHeaders)(nil),             // 62: encore.engine.trace2.HTTPWroteHeaders
-	(*HTTPWroteRequest)(nil),             // 63: encore.engine.trace2.HTTPWroteRequest
-	(*HTTPWait100Continue)(nil),          // 64: encore.engine.trace2.HTTPWait100Continue
-	(*HTTPClosedBodyData)(nil),           // 65: encore.engine.trace2.HTTPClosedBodyData
-	(*LogMessage)(nil),                   // 66: encore.engine.trace2.LogMessage
-	(*LogField)(nil),                     // 67: encore.engine.trace2.LogField
-	(*StackTrace)(nil),                   // 68: encore.engine.trace2.StackTrace
-	(*StackFrame)(nil),                   // 69: encore.engine.trace2.StackFrame
-	(*Error)(nil),                        // 70: encore.engine.trace2.Error
-	nil,                                  // 71: encore.engine.trace2.RequestSpanStart.RequestHeadersEntry
-	nil,                                  // 72: encore.engine.trace2.RequestSpanEnd.ResponseHeadersEntry
-	(*timestamppb.Timestamp)(nil),        // 73: google.protobuf.Timestamp
+	(*BucketObjectCopyStart)(nil),        // 46: encore.engine.trace2.BucketObjectCopyStart
+	(*BucketObjectCopyEnd)(nil),          // 47: encore.engine.trace2.BucketObjectCopyEnd
+	(*BucketObjectMoveStart)(nil),        // 48: encore.engine.trace2.BucketObjectMoveStart
+	(*BucketObjectMoveEnd)(nil),          // 49: encore.engine.trace2.BucketObjectMoveEnd
+	(*FaultInjected)(nil),                // 50: encore.engine.trace2.FaultInjected
+	(*BucketObjectAttributes)(nil),       // 51: encore.engine.trace2.BucketObjectAttributes
+	(*BodyStream)(nil),                   // 52: encore.engine.trace2.BodyStream
+	(*HTTPCallStart)(nil),                // 53: encore.engine.trace2.HTTPCallStart
+	(*HTTPCallEnd)(nil),                  // 54: encore.engine.trace2.HTTPCallEnd
+	(*HTTPTraceEvent)(nil),               // 55: encore.engine.trace2.HTTPTraceEvent
+	(*HTTPGetConn)(nil),                  // 56: encore.engine.trace2.HTTPGetConn
+	(*HTTPGotConn)(nil),                  // 57: encore.engine.trace2.HTTPGotConn
+	(*HTTPGotFirstResponseByte)(nil),     // 58: encore.engine.trace2.HTTPGotFirstResponseByte
+	(*HTTPGot1XxResponse)(nil),           // 59: encore.engine.trace2.HTTPGot1xxResponse
+	(*HTTPDNSStart)(nil),                 // 60: encore.engine.trace2.HTTPDNSStart
+	(*HTTPDNSDone)(nil),                  // 61: encore.engine.trace2.HTTPDNSDone
+	(*DNSAddr)(nil),                      // 62: encore.engine.trace2.DNSAddr
+	(*HTTPConnectStart)(nil),             // 63: encore.engine.trace2.HTTPConnectStart
+	(*HTTPConnectDone)(nil),              // 64: encore.engine.trace2.HTTPConnectDone
+	(*HTTPTLSHandshakeStart)(nil),        // 65: encore.engine.trace2.HTTPTLSHandshakeStart
+	(*HTTPTLSHandshakeDone)(nil),         // 66: encore.engine.trace2.HTTPTLSHandshakeDone
+	(*HTTPWroteHeaders)(nil),             // 67: encore.engine.trace2.HTTPWroteHeaders
+	(*HTTPWroteRequest)(nil),             // 68: encore.engine.trace2.HTTPWroteRequest
+	(*HTTPWait100Continue)(nil),          // 69: encore.engine.trace2.HTTPWait100Continue
+	(*HTTPClosedBodyData)(nil),           // 70: encore.engine.trace2.HTTPClosedBodyData
+	(*LogMessage)(nil),                   // 71: encore.engine.trace2.LogMessage
+	(*LogField)(nil),                     // 72: encore.engine.trace2.LogField
+	(*StackTrace)(nil),                   // 73: encore.engine.trace2.StackTrace
+	(*StackFrame)(nil),                   // 74: encore.engine.trace2.StackFrame
+	(*Error)(nil),                        // 75: encore.engine.trace2.Error
+	nil,                                  // 76: encore.engine.trace2.RequestSpanStart.RequestHeadersEntry
+	nil,                                  // 77: encore.engine.trace2.RequestSpanEnd.ResponseHeadersEntry
+	(*timestamppb.Timestamp)(nil),        // 78: google.protobuf.Timestamp
 }
 var file_encore_engine_trace2_trace2_proto_depIdxs = []int32{
 	2,   // 0: encore.engine.trace2.SpanSummary.type:type_name -> encore.engine.trace2.SpanSummary.SpanType
-	73,  // 1: encore.engine.trace2.SpanSummary.started_at:type_name -> google.protobuf.Timestamp
+	78,  // 1: encore.engine.trace2.SpanSummary.started_at:type_name -> google.protobuf.Timestamp
 	9,   // 2: encore.engine.trace2.EventList.events:type_name -> encore.engine.trace2.TraceEvent
 	7,   // 3: encore.engine.trace2.TraceEvent.trace_id:type_name -> encore.engine.trace2.TraceID
-	73,  // 4: encore.engine.trace2.TraceEvent.event_time:type_name -> google.protobuf.Timestamp
+	78,  // 4: encore.engine.trace2.TraceEvent.event_time:type_name -> google.protobuf.Timestamp
 	10,  // 5: encore.engine.trace2.TraceEvent.span_start:type_name -> encore.engine.trace2.SpanStart
 	11,  // 6: encore.engine.trace2.TraceEvent.span_end:type_name -> encore.engine.trace2.SpanEnd
 	20,  // 7: encore.engine.trace2.TraceEvent.span_event:type_name -> encore.engine.trace2.SpanEvent
@@ -5966,27 +6480,27 @@ var file_encore_engine_trace2_trace2_proto_depIdxs = []int32{
 	14,  // 10: encore.engine.trace2.SpanStart.auth:type_name -> encore.engine.trace2.AuthSpanStart
 	16,  // 11: encore.engine.trace2.SpanStart.pubsub_message:type_name -> encore.engine.trace2.PubsubMessageSpanStart
 	18,  // 12: encore.engine.trace2.SpanStart.test:type_name -> encore.engine.trace2.TestSpanStart
-	70,  // 13: encore.engine.trace2.SpanEnd.error:type_name -> encore.engine.trace2.Error
-	68,  // 14: encore.engine.trace2.SpanEnd.panic_stack:type_name -> encore.engine.trace2.StackTrace
+	75,  // 13: encore.engine.trace2.SpanEnd.error:type_name -> encore.engine.trace2.Error
+	73,  // 14: encore.engine.trace2.SpanEnd.panic_stack:type_name -> encore.engine.trace2.StackTrace
 	7,   // 15: encore.engine.trace2.SpanEnd.parent_trace_id:type_name -> encore.engine.trace2.TraceID
 	1,   // 16: encore.engine.trace2.SpanEnd.status_code:type_name -> encore.engine.trace2.StatusCode
 	13,  // 17: encore.engine.trace2.SpanEnd.request:type_name -> encore.engine.trace2.RequestSpanEnd
 	15,  // 18: encore.engine.trace2.SpanEnd.auth:type_name -> encore.engine.trace2.AuthSpanEnd
 	17,  // 19: encore.engine.trace2.SpanEnd.pubsub_message:type_name -> encore.engine.trace2.PubsubMessageSpanEnd
 	19,  // 20: encore.engine.trace2.SpanEnd.test:type_name -> encore.engine.trace2.TestSpanEnd
-	71,  // 21: encore.engine.trace2.RequestSpanStart.request_headers:type_name -> encore.engine.trace2.RequestSpanStart.RequestHeadersEntry
-	72,  // 22: encore.engine.trace2.RequestSpanEnd.response_headers:type_name -> encore.engine.trace2.RequestSpanEnd.ResponseHeadersEntry
-	73,  // 23: encore.engine.trace2.PubsubMessageSpanStart.publish_time:type_name -> google.protobuf.Timestamp
-	66,  // 24: encore.engine.trace2.SpanEvent.log_message:type_name -> encore.engine.trace2.LogMessage
-	47,  // 25: encore.engine.trace2.SpanEvent.body_stream:type_name -> encore.engine.trace2.BodyStream
+	76,  // 21: encore.engine.trace2.RequestSpanStart.request_headers:type_name -> encore.engine.trace2.RequestSpanStart.RequestHeadersEntry
+	77,  // 22: encore.engine.trace2.RequestSpanEnd.response_headers:type_name -> encore.engine.trace2.RequestSpanEnd.ResponseHeadersEntry
+	78,  // 23: encore.engine.trace2.PubsubMessageSpanStart.publish_time:type_name -> google.protobuf.Timestamp
+	71,  // 24: encore.engine.trace2.SpanEvent.log_message:type_name -> encore.engine.trace2.LogMessage
+	52,  // 25: encore.engine.trace2.SpanEvent.body_stream:type_name -> encore.engine.trace2.BodyStream
 	21,  // 26: encore.engine.trace2.SpanEvent.rpc_call_start:type_name -> encore.engine.trace2.RPCCallStart
 	22,  // 27: encore.engine.trace2.SpanEvent.rpc_call_end:type_name -> encore.engine.trace2.RPCCallEnd
 	25,  // 28: encore.engine.trace2.SpanEvent.db_transaction_start:type_name -> encore.engine.trace2.DBTransactionStart
 	26,  // 29: encore.engine.trace2.SpanEvent.db_transaction_end:type_name -> encore.engine.trace2.DBTransactionEnd
 	27,  // 30: encore.engine.trace2.SpanEvent.db_query_start:type_name -> encore.engine.trace2.DBQueryStart
 	28,  // 31: encore.engine.trace2.SpanEvent.db_query_end:type_name -> encore.engine.trace2.DBQueryEnd
-	48,  // 32: encore.engine.trace2.SpanEvent.http_call_start:type_name -> encore.engine.trace2.HTTPCallStart
-	49,  // 33: encore.engine.trace2.SpanEvent.http_call_end:type_name -> encore.engine.trace2.HTTPCallEnd
+	53,  // 32: encore.engine.trace2.SpanEvent.http_call_start:type_name -> encore.engine.trace2.HTTPCallStart
+	54,  // 33: encore.engine.trace2.SpanEvent.http_call_end:type_name -> encore.engine.trace2.HTTPCallEnd
 	29,  // 34: encore.engine.trace2.SpanEvent.pubsub_publish_start:type_name -> encore.engine.trace2.PubsubPublishStart
 	30,  // 35: encore.engine.trace2.SpanEvent.pubsub_publish_end:type_name -> encore.engine.trace2.PubsubPublishEnd
 	33,  // 36: encore.engine.trace2.SpanEvent.cache_call_start:type_name -> encore.engine.trace2.CacheCallStart
@@ -6003,63 +6517,76 @@ var file_encore_engine_trace2_trace2_proto_depIdxs = []int32{
 	42,  // 47: encore.engine.trace2.SpanEvent.bucket_list_objects_end:type_name -> encore.engine.trace2.BucketListObjectsEnd
 	43,  // 48: encore.engine.trace2.SpanEvent.bucket_delete_objects_start:type_name -> encore.engine.trace2.BucketDeleteObjectsStart
 	45,  // 49: encore.engine.trace2.SpanEvent.bucket_delete_objects_end:type_name -> encore.engine.trace2.BucketDeleteObjectsEnd
-	68,  // 50: encore.engine.trace2.RPCCallStart.stack:type_name -> encore.engine.trace2.StackTrace
-	70,  // 51: encore.engine.trace2.RPCCallEnd.err:type_name -> encore.engine.trace2.Error
-	68,  // 52: encore.engine.trace2.DBTransactionStart.stack:type_name -> encore.engine.trace2.StackTrace
-	3,   // 53: encore.engine.trace2.DBTransactionEnd.completion:type_name -> encore.engine.trace2.DBTransactionEnd.CompletionType
-	68,  // 54: encore.engine.trace2.DBTransactionEnd.stack:type_name -> encore.engine.trace2.StackTrace
-	70,  // 55: encore.engine.trace2.DBTransactionEnd.err:type_name -> encore.engine.trace2.Error
-	68,  // 56: encore.engine.trace2.DBQueryStart.stack:type_name -> encore.engine.trace2.StackTrace
-	70,  // 57: encore.engine.trace2.DBQueryEnd.err:type_name -> encore.engine.trace2.Error
-	68,  // 58: encore.engine.trace2.PubsubPublishStart.stack:type_name -> encore.engine.trace2.StackTrace
-	70,  // 59: encore.engine.trace2.PubsubPublishEnd.err:type_name -> encore.engine.trace2.Error
-	70,  // 60: encore.engine.trace2.ServiceInitEnd.err:type_name -> encore.engine.trace2.Error
-	68,  // 61: encore.engine.trace2.CacheCallStart.stack:type_name -> encore.engine.trace2.StackTrace
-	4,   // 62: encore.engine.trace2.CacheCallEnd.result:type_name -> encore.engine.trace2.CacheCallEnd.Result
-	70,  // 63: encore.engine.trace2.CacheCallEnd.err:type_name -> encore.engine.trace2.Error
-	46,  // 64: encore.engine.trace2.BucketObjectUploadStart.attrs:type_name -> encore.engine.trace2.BucketObjectAttributes
-	68,  // 65: encore.engine.trace2.BucketObjectUploadStart.stack:type_name -> encore.engine.trace2.StackTrace
-	70,  // 66: encore.engine.trace2.BucketObjectUploadEnd.err:type_name -> encore.engine.trace2.Error
-	68,  // 67: encore.engine.trace2.BucketObjectDownloadStart.stack:type_name -> encore.engine.trace2.StackTrace
-	70,  // 68: encore.engine.trace2.BucketObjectDownloadEnd.err:type_name -> encore.engine.trace2.Error
-	68,  // 69: encore.engine.trace2.BucketObjectGetAttrsStart.stack:type_name -> encore.engine.trace2.StackTrace
-	70,  // 70: encore.engine.trace2.BucketObjectGetAttrsEnd.err:type_name -> encore.engine.trace2.Error
-	46,  // 71: encore.engine.trace2.BucketObjectGetAttrsEnd.attrs:type_name -> encore.engine.trace2.BucketObjectAttributes
-	68,  // 72: encore.engine.trace2.BucketListObjectsStart.stack:type_name -> encore.engine.trace2.StackTrace
-	70,  // 73: encore.engine.trace2.BucketListObjectsEnd.err:type_name -> encore.engine.trace2.Error
-	68,  // 74: encore.engine.trace2.BucketDeleteObjectsStart.stack:type_name -> encore.engine.trace2.StackTrace
-	44,  // 75: encore.engine.trace2.BucketDeleteObjectsStart.entries:type_name -> encore.engine.trace2.BucketDeleteObjectEntry
-	70,  // 76: encore.engine.trace2.BucketDeleteObjectsEnd.err:type_name -> encore.engine.trace2.Error
-	68,  // 77: encore.engine.trace2.HTTPCallStart.stack:type_name -> encore.engine.trace2.StackTrace
-	70,  // 78: encore.engine.trace2.HTTPCallEnd.err:type_name -> encore.engine.trace2.Error
-	50,  // 79: encore.engine.trace2.HTTPCallEnd.trace_events:type_name -> encore.engine.trace2.HTTPTraceEvent
-	51,  // 80: encore.engine.trace2.HTTPTraceEvent.get_conn:type_name -> encore.engine.trace2.HTTPGetConn
-	52,  // 81: encore.engine.trace2.HTTPTraceEvent.got_conn:type_name -> encore.engine.trace2.HTTPGotConn
-	53,  // 82: encore.engine.trace2.HTTPTraceEvent.got_first_response_byte:type_name -> encore.engine.trace2.HTTPGotFirstResponseByte
-	54,  // 83: encore.engine.trace2.HTTPTraceEvent.got_1xx_response:type_name -> encore.engine.trace2.HTTPGot1xxResponse
-	55,  // 84: encore.engine.trace2.HTTPTraceEvent.dns_start:type_name -> encore.engine.trace2.HTTPDNSStart
-	56,  // 85: encore.engine.trace2.HTTPTraceEvent.dns_done:type_name -> encore.engine.trace2.HTTPDNSDone
-	58,  // 86: encore.engine.trace2.HTTPTraceEvent.connect_start:type_name -> encore.engine.trace2.HTTPConnectStart
-	59,  // 87: encore.engine.trace2.HTTPTraceEvent.connect_done:type_name -> encore.engine.trace2.HTTPConnectDone
-	60,  // 88: encore.engine.trace2.HTTPTraceEvent.tls_handshake_start:type_name -> encore.engine.trace2.HTTPTLSHandshakeStart
-	61,  // 89: encore.engine.trace2.HTTPTraceEvent.tls_handshake_done:type_name -> encore.engine.trace2.HTTPTLSHandshakeDone
-	62,  // 90: encore.engine.trace2.HTTPTraceEvent.wrote_headers:type_name -> encore.engine.trace2.HTTPWroteHeaders
-	63,  // 91: encore.engine.trace2.HTTPTraceEvent.wrote_request:type_name -> encore.engine.trace2.HTTPWroteRequest
-	64,  // 92: encore.engine.trace2.HTTPTraceEvent.wait_100_continue:type_name -> encore.engine.trace2.HTTPWait100Continue
-	65,  // 93: encore.engine.trace2.HTTPTraceEvent.closed_body:type_name -> encore.engine.trace2.HTTPClosedBodyData
-	57,  // 94: encore.engine.trace2.HTTPDNSDone.addrs:type_name -> encore.engine.trace2.DNSAddr
-	5,   // 95: encore.engine.trace2.LogMessage.level:type_name -> encore.engine.trace2.LogMessage.Level
-	67,  // 96: encore.engine.trace2.LogMessage.fields:type_name -> encore.engine.trace2.LogField
-	68,  // 97: encore.engine.trace2.LogMessage.stack:type_name -> encore.engine.trace2.StackTrace
-	70,  // 98: encore.engine.trace2.LogField.error:type_name -> encore.engine.trace2.Error
-	73,  // 99: encore.engine.trace2.LogField.time:type_name -> google.protobuf.Timestamp
-	69,  // 100: encore.engine.trace2.StackTrace.frames:type_name -> encore.engine.trace2.StackFrame
-	68,  // 101: encore.engine.trace2.Error.stack:type_name -> encore.engine.trace2.StackTrace
-	102, // [102:102] is the sub-list for method output_type
-	102, // [102:102] is the sub-list for method input_type
-	102, // [102:102] is the sub-list for extension type_name
-	102, // [102:102] is the sub-list for extension extendee
-	0,   // [0:102] is the sub-list for field type_name
+	50,  // 50: encore.engine.trace2.SpanEvent.fault_injected:type_name -> encore.engine.trace2.FaultInjected
+	46,  // 51: encore.engine.trace2.SpanEvent.bucket_object_copy_start:type_name -> encore.engine.trace2.BucketObjectCopyStart
+	47,  // 52: encore.engine.trace2.SpanEvent.bucket_object_copy_end:type_name -> encore.engine.trace2.BucketObjectCopyEnd
+	48,  // 53: encore.engine.trace2.SpanEvent.bucket_object_move_start:type_name -> encore.engine.trace2.BucketObjectMoveStart
+	49,  // 54: encore.engine.trace2.SpanEvent.bucket_object_move_end:type_name -> encore.engine.trace2.BucketObjectMoveEnd
+	73,  // 55: encore.engine.trace2.RPCCallStart.stack:type_name -> encore.engine.trace2.StackTrace
+	75,  // 56: encore.engine.trace2.RPCCallEnd.err:type_name -> encore.engine.trace2.Error
+	73,  // 57: encore.engine.trace2.DBTransactionStart.stack:type_name -> encore.engine.trace2.StackTrace
+	3,   // 58: encore.engine.trace2.DBTransactionEnd.completion:type_name -> encore.engine.trace2.DBTransactionEnd.CompletionType
+	73,  // 59: encore.engine.trace2.DBTransactionEnd.stack:type_name -> encore.engine.trace2.StackTrace
+	75,  // 60: encore.engine.trace2.DBTransactionEnd.err:type_name -> encore.engine.trace2.Error
+	73,  // 61: encore.engine.trace2.DBQueryStart.stack:type_name -> encore.engine.trace2.StackTrace
+	75,  // 62: encore.engine.trace2.DBQueryEnd.err:type_name -> encore.engine.trace2.Error
+	73,  // 63: encore.engine.trace2.PubsubPublishStart.stack:type_name -> encore.engine.trace2.StackTrace
+	75,  // 64: encore.engine.trace2.PubsubPublishEnd.err:type_name -> encore.engine.trace2.Error
+	75,  // 65: encore.engine.trace2.ServiceInitEnd.err:type_name -> encore.engine.trace2.Error
+	73,  // 66: encore.engine.trace2.CacheCallStart.stack:type_name -> encore.engine.trace2.StackTrace
+	4,   // 67: encore.engine.trace2.CacheCallEnd.result:type_name -> encore.engine.trace2.CacheCallEnd.Result
+	75,  // 68: encore.engine.trace2.CacheCallEnd.err:type_name -> encore.engine.trace2.Error
+	51,  // 69: encore.engine.trace2.BucketObjectUploadStart.attrs:type_name -> encore.engine.trace2.BucketObjectAttributes
+	73,  // 70: encore.engine.trace2.BucketObjectUploadStart.stack:type_name -> encore.engine.trace2.StackTrace
+	75,  // 71: encore.engine.trace2.BucketObjectUploadEnd.err:type_name -> encore.engine.trace2.Error
+	73,  // 72: encore.engine.trace2.BucketObjectDownloadStart.stack:type_name -> encore.engine.trace2.StackTrace
+	75,  // 73: encore.engine.trace2.BucketObjectDownloadEnd.err:type_name -> encore.engine.trace2.Error
+	73,  // 74: encore.engine.trace2.BucketObjectGetAttrsStart.stack:type_name -> encore.engine.trace2.StackTrace
+	75,  // 75: encore.engine.trace2.BucketObjectGetAttrsEnd.err:type_name -> encore.engine.trace2.Error
+	51,  // 76: encore.engine.trace2.BucketObjectGetAttrsEnd.attrs:type_name -> encore.engine.trace2.BucketObjectAttributes
+	73,  // 77: encore.engine.trace2.BucketListObjectsStart.stack:type_name -> encore.engine.trace2.StackTrace
+	75,  // 78: encore.engine.trace2.BucketListObjectsEnd.err:type_name -> encore.engine.trace2.Error
+	73,  // 79: encore.engine.trace2.BucketDeleteObjectsStart.stack:type_name -> encore.engine.trace2.StackTrace
+	44,  // 80: encore.engine.trace2.BucketDeleteObjectsStart.entries:type_name -> encore.engine.trace2.BucketDeleteObjectEntry
+	75,  // 81: encore.engine.trace2.BucketDeleteObjectsEnd.err:type_name -> encore.engine.trace2.Error
+	73,  // 82: encore.engine.trace2.BucketObjectCopyStart.stack:type_name -> encore.engine.trace2.StackTrace
+	75,  // 83: encore.engine.trace2.BucketObjectCopyEnd.err:type_name -> encore.engine.trace2.Error
+	51,  // 84: encore.engine.trace2.BucketObjectCopyEnd.attrs:type_name -> encore.engine.trace2.BucketObjectAttributes
+	73,  // 85: encore.engine.trace2.BucketObjectMoveStart.stack:type_name -> encore.engine.trace2.StackTrace
+	75,  // 86: encore.engine.trace2.BucketObjectMoveEnd.err:type_name -> encore.engine.trace2.Error
+	51,  // 87: encore.engine.trace2.BucketObjectMoveEnd.attrs:type_name -> encore.engine.trace2.BucketObjectAttributes
+	75,  // 88: encore.engine.trace2.FaultInjected.err:type_name -> encore.engine.trace2.Error
+	73,  // 89: encore.engine.trace2.FaultInjected.stack:type_name -> encore.engine.trace2.StackTrace
+	73,  // 90: encore.engine.trace2.HTTPCallStart.stack:type_name -> encore.engine.trace2.StackTrace
+	75,  // 91: encore.engine.trace2.HTTPCallEnd.err:type_name -> encore.engine.trace2.Error
+	55,  // 92: encore.engine.trace2.HTTPCallEnd.trace_events:type_name -> encore.engine.trace2.HTTPTraceEvent
+	56,  // 93: encore.engine.trace2.HTTPTraceEvent.get_conn:type_name -> encore.engine.trace2.HTTPGetConn
+	57,  // 94: encore.engine.trace2.HTTPTraceEvent.got_conn:type_name -> encore.engine.trace2.HTTPGotConn
+	58,  // 95: encore.engine.trace2.HTTPTraceEvent.got_first_response_byte:type_name -> encore.engine.trace2.HTTPGotFirstResponseByte
+	59,  // 96: encore.engine.trace2.HTTPTraceEvent.got_1xx_response:type_name -> encore.engine.trace2.HTTPGot1xxResponse
+	60,  // 97: encore.engine.trace2.HTTPTraceEvent.dns_start:type_name -> encore.engine.trace2.HTTPDNSStart
+	61,  // 98: encore.engine.trace2.HTTPTraceEvent.dns_done:type_name -> encore.engine.trace2.HTTPDNSDone
+	63,  // 99: encore.engine.trace2.HTTPTraceEvent.connect_start:type_name -> encore.engine.trace2.HTTPConnectStart
+	64,  // 100: encore.engine.trace2.HTTPTraceEvent.connect_done:type_name -> encore.engine.trace2.HTTPConnectDone
+	65,  // 101: encore.engine.trace2.HTTPTraceEvent.tls_handshake_start:type_name -> encore.engine.trace2.HTTPTLSHandshakeStart
+	66,  // 102: encore.engine.trace2.HTTPTraceEvent.tls_handshake_done:type_name -> encore.engine.trace2.HTTPTLSHandshakeDone
+	67,  // 103: encore.engine.trace2.HTTPTraceEvent.wrote_headers:type_name -> encore.engine.trace2.HTTPWroteHeaders
+	68,  // 104: encore.engine.trace2.HTTPTraceEvent.wrote_request:type_name -> encore.engine.trace2.HTTPWroteRequest
+	69,  // 105: encore.engine.trace2.HTTPTraceEvent.wait_100_continue:type_name -> encore.engine.trace2.HTTPWait100Continue
+	70,  // 106: encore.engine.trace2.HTTPTraceEvent.closed_body:type_name -> encore.engine.trace2.HTTPClosedBodyData
+	62,  // 107: encore.engine.trace2.HTTPDNSDone.addrs:type_name -> encore.engine.trace2.DNSAddr
+	5,   // 108: encore.engine.trace2.LogMessage.level:type_name -> encore.engine.trace2.LogMessage.Level
+	72,  // 109: encore.engine.trace2.LogMessage.fields:type_name -> encore.engine.trace2.LogField
+	73,  // 110: encore.engine.trace2.LogMessage.stack:type_name -> encore.engine.trace2.StackTrace
+	75,  // 111: encore.engine.trace2.LogField.error:type_name -> encore.engine.trace2.Error
+	78,  // 112: encore.engine.trace2.LogField.time:type_name -> google.protobuf.Timestamp
+	74,  // 113: encore.engine.trace2.StackTrace.frames:type_name -> encore.engine.trace2.StackFrame
+	73,  // 114: encore.engine.trace2.Error.stack:type_name -> encore.engine.trace2.StackTrace
+	115, // [115:115] is the sub-list for method output_type
+	115, // [115:115] is the sub-list for method input_type
+	115, // [115:115] is the sub-list for extension type_name
+	115, // [115:115] is the sub-list for extension extendee
+	0,   // [0:115] is the sub-list for field type_name
 }
 
 func init() { file_encore_engine_trace2_trace2_proto_init() }
@@ -6118,9 +6645,15 @@ func file_encore_engine_trace2_trace2_proto_init() {
 		(*SpanEvent_BucketListObjectsEnd)(nil),
 		(*SpanEvent_BucketDeleteObjectsStart)(nil),
 		(*SpanEvent_BucketDeleteObjectsEnd)(nil),
+		(*SpanEvent_FaultInjected)(nil),
+		(*SpanEvent_BucketObjectCopyStart)(nil),
+		(*SpanEvent_BucketObjectCopyEnd)(nil),
+		(*SpanEvent_BucketObjectMoveStart)(nil),
+		(*SpanEvent_BucketObjectMoveEnd)(nil),
 	}
 	file_encore_engine_trace2_trace2_proto_msgTypes[16].OneofWrappers = []any{}
 	file_encore_engine_trace2_trace2_proto_msgTypes[20].OneofWrappers = []any{}
+	file_encore_engine_trace2_trace2_proto_msgTypes[21].OneofWrappers = []any{}
 	file_encore_engine_trace2_trace2_proto_msgTypes[22].OneofWrappers = []any{}
 	file_encore_engine_trace2_trace2_proto_msgTypes[24].OneofWrappers = []any{}
 	file_encore_engine_trace2_trace2_proto_msgTypes[26].OneofWrappers = []any{}
@@ -6135,8 +6668,13 @@ func file_encore_engine_trace2_trace2_proto_init() {
 	file_encore_engine_trace2_trace2_proto_msgTypes[38].OneofWrappers = []any{}
 	file_encore_engine_trace2_trace2_proto_msgTypes[39].OneofWrappers = []any{}
 	file_encore_engine_trace2_trace2_proto_msgTypes[40].OneofWrappers = []any{}
+	file_encore_engine_trace2_trace2_proto_msgTypes[41].OneofWrappers = []any{}
+	file_encore_engine_trace2_trace2_proto_msgTypes[42].OneofWrappers = []any{}
 	file_encore_engine_trace2_trace2_proto_msgTypes[43].OneofWrappers = []any{}
-	file_encore_engine_trace2_trace2_proto_msgTypes[44].OneofWrappers = []any{
+	file_encore_engine_trace2_trace2_proto_msgTypes[44].OneofWrappers = []any{}
+	file_encore_engine_trace2_trace2_proto_msgTypes[45].OneofWrappers = []any{}
+	file_encore_engine_trace2_trace2_proto_msgTypes[48].OneofWrappers = []any{}
+	file_encore_engine_trace2_trace2_proto_msgTypes[49].OneofWrappers = []any{
 		(*HTTPTraceEvent_GetConn)(nil),
 		(*HTTPTraceEvent_GotConn)(nil),
 		(*HTTPTraceEvent_GotFirstResponseByte)(nil),
@@ -6152,11 +6690,11 @@ func file_encore_engine_trace2_trace2_proto_init() {
 		(*HTTPTraceEvent_Wait_100Continue)(nil),
 		(*HTTPTraceEvent_ClosedBody)(nil),
 	}
-	file_encore_engine_trace2_trace2_proto_msgTypes[50].OneofWrappers = []any{}
 	file_encore_engine_trace2_trace2_proto_msgTypes[55].OneofWrappers = []any{}
-	file_encore_engine_trace2_trace2_proto_msgTypes[57].OneofWrappers = []any{}
-	file_encore_engine_trace2_trace2_proto_msgTypes[59].OneofWrappers = []any{}
-	file_encore_engine_trace2_trace2_proto_msgTypes[61].OneofWrappers = []any{
+	file_encore_engine_trace2_trace2_proto_msgTypes[60].OneofWrappers = []any{}
+	file_encore_engine_trace2_trace2_proto_msgTypes[62].OneofWrappers = []any{}
+	file_encore_engine_trace2_trace2_proto_msgTypes[64].OneofWrappers = []any{}
+	file_encore_engine_trace2_trace2_proto_msgTypes[66].OneofWrappers = []any{
 		(*LogField_Error)(nil),
 		(*LogField_Str)(nil),
 		(*LogField_Bool)(nil),
@@ -6169,14 +6707,14 @@ func file_encore_engine_trace2_trace2_proto_init() {
 		(*LogField_Float32)(nil),
 		(*LogField_Float64)(nil),
 	}
-	file_encore_engine_trace2_trace2_proto_msgTypes[64].OneofWrappers = []any{}
+	file_encore_engine_trace2_trace2_proto_msgTypes[69].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_encore_engine_trace2_trace2_proto_rawDesc), len(file_encore_engine_trace2_trace2_proto_rawDesc)),
 			NumEnums:      6,
-			NumMessages:   67,
+			NumMessages:   72,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -563,7 +563,7 @@ func (x PubSubTopic_DeliveryGuarantee) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PubSubTopic_DeliveryGuarantee.Descriptor instead.
 func (PubSubTopic_DeliveryGuarantee) EnumDescriptor() ([]byte, []int) {
-	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{27, 0}
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{29, 0}
 }
 
 type Metric_MetricKind int32
@@ -612,7 +612,7 @@ func (x Metric_MetricKind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Metric_MetricKind.Descriptor instead.
 func (Metric_MetricKind) EnumDescriptor() ([]byte, []int) {
-	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{29, 0}
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{31, 0}
 }
 
 // Data is the metadata associated with an app version.
@@ -635,6 +635,7 @@ type Data struct {
 	Gateways           []*Gateway             `protobuf:"bytes,15,rep,name=gateways,proto3" json:"gateways,omitempty"`
 	Language           Lang                   `protobuf:"varint,16,opt,name=language,proto3,enum=encore.parser.meta.v1.Lang" json:"language,omitempty"`
 	Buckets            []*Bucket              `protobuf:"bytes,17,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	PluginResources    []*PluginResource      `protobuf:"bytes,18,rep,name=plugin_resources,json=pluginResources,proto3" json:"plugin_resources,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -788,6 +789,13 @@ func (x *Data) GetBuckets() []*Bucket {
 	return nil
 }
 
+func (x *Data) GetPluginResources() []*PluginResource {
+	if x != nil {
+		return x.PluginResources
+	}
+	return nil
+}
+
 // QualifiedName is a name of an object in a specific package.
 // It is never an unqualified name, even in circumstances
 // where a package may refer to its own objects.
@@ -2661,8 +2669,14 @@ type SQLDatabase struct {
 	MigrationRelPath             *string        `protobuf:"bytes,3,opt,name=migration_rel_path,json=migrationRelPath,proto3,oneof" json:"migration_rel_path,omitempty"`
 	Migrations                   []*DBMigration `protobuf:"bytes,4,rep,name=migrations,proto3" json:"migrations,omitempty"`
 	AllowNonSequentialMigrations bool           `protobuf:"varint,5,opt,name=allow_non_sequential_migrations,json=allowNonSequentialMigrations,proto3" json:"allow_non_sequential_migrations,omitempty"`
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+	// data_migrations are the migrations written in Go,
+	// numbered together with the migration files.
+	DataMigrations []*DBDataMigration `protobuf:"bytes,6,rep,name=data_migrations,json=dataMigrations,proto3" json:"data_migrations,omitempty"`
+	// seed_rel_path is the slash-separated path to the seed data,
+	// relative to the main module's root directory.
+	SeedRelPath   *string `protobuf:"bytes,7,opt,name=seed_rel_path,json=seedRelPath,proto3,oneof" json:"seed_rel_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SQLDatabase) Reset() {
@@ -2730,6 +2744,20 @@ func (x *SQLDatabase) GetAllowNonSequentialMigrations() bool {
 	return false
 }
 
+func (x *SQLDatabase) GetDataMigrations() []*DBDataMigration {
+	if x != nil {
+		return x.DataMigrations
+	}
+	return nil
+}
+
+func (x *SQLDatabase) GetSeedRelPath() string {
+	if x != nil && x.SeedRelPath != nil {
+		return *x.SeedRelPath
+	}
+	return ""
+}
+
 type DBMigration struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`       // filename
@@ -2790,19 +2818,80 @@ func (x *DBMigration) GetDescription() string {
 	return ""
 }
 
+type DBDataMigration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        uint64                 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`          // migration number
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // descriptive name
+	Pkg           string                 `protobuf:"bytes,3,opt,name=pkg,proto3" json:"pkg,omitempty"`                 // package the migration is declared in, relative to app root
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBDataMigration) Reset() {
+	*x = DBDataMigration{}
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBDataMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBDataMigration) ProtoMessage() {}
+
+func (x *DBDataMigration) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBDataMigration.ProtoReflect.Descriptor instead.
+func (*DBDataMigration) Descriptor() ([]byte, []int) {
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DBDataMigration) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *DBDataMigration) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DBDataMigration) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
 type Bucket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Doc           *string                `protobuf:"bytes,2,opt,name=doc,proto3,oneof" json:"doc,omitempty"`
 	Versioned     bool                   `protobuf:"varint,3,opt,name=versioned,proto3" json:"versioned,omitempty"`
 	Public        bool                   `protobuf:"varint,4,opt,name=public,proto3" json:"public,omitempty"`
+	Notifications []*Bucket_Notification `protobuf:"bytes,5,rep,name=notifications,proto3" json:"notifications,omitempty"` // The pub/sub topics object events are published to
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Bucket) Reset() {
 	*x = Bucket{}
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[26]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2814,7 +2903,7 @@ func (x *Bucket) String() string {
 func (*Bucket) ProtoMessage() {}
 
 func (x *Bucket) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[26]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2827,7 +2916,7 @@ func (x *Bucket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Bucket.ProtoReflect.Descriptor instead.
 func (*Bucket) Descriptor() ([]byte, []int) {
-	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{26}
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Bucket) GetName() string {
@@ -2858,6 +2947,114 @@ func (x *Bucket) GetPublic() bool {
 	return false
 }
 
+func (x *Bucket) GetNotifications() []*Bucket_Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+// PluginResource is a resource defined by an external plugin configured in encore.app.
+type PluginResource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plugin        string                 `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"` // name of the plugin defining the resource
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`     // plugin-specific type of the resource
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Pkg           string                 `protobuf:"bytes,4,opt,name=pkg,proto3" json:"pkg,omitempty"`                                          // package the resource is declared in, relative to app root
+	Func          string                 `protobuf:"bytes,5,opt,name=func,proto3" json:"func,omitempty"`                                        // name of the annotated function, qualified by its receiver type for methods
+	ServiceName   *string                `protobuf:"bytes,6,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"` // service the resource is declared in, if any
+	Doc           *string                `protobuf:"bytes,7,opt,name=doc,proto3,oneof" json:"doc,omitempty"`
+	Data          string                 `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"` // plugin-specific data about the resource, as JSON
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginResource) Reset() {
+	*x = PluginResource{}
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginResource) ProtoMessage() {}
+
+func (x *PluginResource) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginResource.ProtoReflect.Descriptor instead.
+func (*PluginResource) Descriptor() ([]byte, []int) {
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PluginResource) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *PluginResource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PluginResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PluginResource) GetPkg() string {
+	if x != nil {
+		return x.Pkg
+	}
+	return ""
+}
+
+func (x *PluginResource) GetFunc() string {
+	if x != nil {
+		return x.Func
+	}
+	return ""
+}
+
+func (x *PluginResource) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
+	}
+	return ""
+}
+
+func (x *PluginResource) GetDoc() string {
+	if x != nil && x.Doc != nil {
+		return *x.Doc
+	}
+	return ""
+}
+
+func (x *PluginResource) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
 type PubSubTopic struct {
 	state             protoimpl.MessageState        `protogen:"open.v1"`
 	Name              string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                                              // The pub sub topic name (unique per application)
@@ -2873,7 +3070,7 @@ type PubSubTopic struct {
 
 func (x *PubSubTopic) Reset() {
 	*x = PubSubTopic{}
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[27]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2885,7 +3082,7 @@ func (x *PubSubTopic) String() string {
 func (*PubSubTopic) ProtoMessage() {}
 
 func (x *PubSubTopic) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[27]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2898,7 +3095,7 @@ func (x *PubSubTopic) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PubSubTopic.ProtoReflect.Descriptor instead.
 func (*PubSubTopic) Descriptor() ([]byte, []int) {
-	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{27}
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *PubSubTopic) GetName() string {
@@ -2962,7 +3159,7 @@ type CacheCluster struct {
 
 func (x *CacheCluster) Reset() {
 	*x = CacheCluster{}
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[28]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2974,7 +3171,7 @@ func (x *CacheCluster) String() string {
 func (*CacheCluster) ProtoMessage() {}
 
 func (x *CacheCluster) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[28]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2987,7 +3184,7 @@ func (x *CacheCluster) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CacheCluster.ProtoReflect.Descriptor instead.
 func (*CacheCluster) Descriptor() ([]byte, []int) {
-	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{28}
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CacheCluster) GetName() string {
@@ -3032,7 +3229,7 @@ type Metric struct {
 
 func (x *Metric) Reset() {
 	*x = Metric{}
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[29]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3044,7 +3241,7 @@ func (x *Metric) String() string {
 func (*Metric) ProtoMessage() {}
 
 func (x *Metric) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[29]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3057,7 +3254,7 @@ func (x *Metric) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Metric.ProtoReflect.Descriptor instead.
 func (*Metric) Descriptor() ([]byte, []int) {
-	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{29}
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Metric) GetName() string {
@@ -3110,7 +3307,7 @@ type RPC_ExposeOptions struct {
 
 func (x *RPC_ExposeOptions) Reset() {
 	*x = RPC_ExposeOptions{}
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[31]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3122,7 +3319,7 @@ func (x *RPC_ExposeOptions) String() string {
 func (*RPC_ExposeOptions) ProtoMessage() {}
 
 func (x *RPC_ExposeOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[31]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3154,7 +3351,7 @@ type RPC_StaticAssets struct {
 
 func (x *RPC_StaticAssets) Reset() {
 	*x = RPC_StaticAssets{}
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[32]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3166,7 +3363,7 @@ func (x *RPC_StaticAssets) String() string {
 func (*RPC_StaticAssets) ProtoMessage() {}
 
 func (x *RPC_StaticAssets) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[32]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3221,7 +3418,7 @@ type RPC_StaticAssets_HeaderValues struct {
 
 func (x *RPC_StaticAssets_HeaderValues) Reset() {
 	*x = RPC_StaticAssets_HeaderValues{}
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[33]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3233,7 +3430,7 @@ func (x *RPC_StaticAssets_HeaderValues) String() string {
 func (*RPC_StaticAssets_HeaderValues) ProtoMessage() {}
 
 func (x *RPC_StaticAssets_HeaderValues) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[33]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3267,7 +3464,7 @@ type Gateway_Explicit struct {
 
 func (x *Gateway_Explicit) Reset() {
 	*x = Gateway_Explicit{}
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[35]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3279,7 +3476,7 @@ func (x *Gateway_Explicit) String() string {
 func (*Gateway_Explicit) ProtoMessage() {}
 
 func (x *Gateway_Explicit) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[35]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3309,6 +3506,74 @@ func (x *Gateway_Explicit) GetAuthHandler() *AuthHandler {
 	return nil
 }
 
+type Bucket_Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Topic         string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`                                       // The name of the topic the events are published to
+	ObjectCreated bool                   `protobuf:"varint,2,opt,name=object_created,json=objectCreated,proto3" json:"object_created,omitempty"` // Whether to publish an event when an object is created or overwritten
+	ObjectDeleted bool                   `protobuf:"varint,3,opt,name=object_deleted,json=objectDeleted,proto3" json:"object_deleted,omitempty"` // Whether to publish an event when an object is deleted
+	Prefix        string                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`                                     // If set, only publish events for objects with names starting with the prefix
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bucket_Notification) Reset() {
+	*x = Bucket_Notification{}
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bucket_Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bucket_Notification) ProtoMessage() {}
+
+func (x *Bucket_Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bucket_Notification.ProtoReflect.Descriptor instead.
+func (*Bucket_Notification) Descriptor() ([]byte, []int) {
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *Bucket_Notification) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *Bucket_Notification) GetObjectCreated() bool {
+	if x != nil {
+		return x.ObjectCreated
+	}
+	return false
+}
+
+func (x *Bucket_Notification) GetObjectDeleted() bool {
+	if x != nil {
+		return x.ObjectDeleted
+	}
+	return false
+}
+
+func (x *Bucket_Notification) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
 type PubSubTopic_Publisher struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"` // The service the publisher is in
@@ -3318,7 +3583,7 @@ type PubSubTopic_Publisher struct {
 
 func (x *PubSubTopic_Publisher) Reset() {
 	*x = PubSubTopic_Publisher{}
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[36]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3330,7 +3595,7 @@ func (x *PubSubTopic_Publisher) String() string {
 func (*PubSubTopic_Publisher) ProtoMessage() {}
 
 func (x *PubSubTopic_Publisher) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[36]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3343,7 +3608,7 @@ func (x *PubSubTopic_Publisher) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PubSubTopic_Publisher.ProtoReflect.Descriptor instead.
 func (*PubSubTopic_Publisher) Descriptor() ([]byte, []int) {
-	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{27, 0}
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (x *PubSubTopic_Publisher) GetServiceName() string {
@@ -3369,7 +3634,7 @@ type PubSubTopic_Subscription struct {
 
 func (x *PubSubTopic_Subscription) Reset() {
 	*x = PubSubTopic_Subscription{}
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[37]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3381,7 +3646,7 @@ func (x *PubSubTopic_Subscription) String() string {
 func (*PubSubTopic_Subscription) ProtoMessage() {}
 
 func (x *PubSubTopic_Subscription) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[37]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3394,7 +3659,7 @@ func (x *PubSubTopic_Subscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PubSubTopic_Subscription.ProtoReflect.Descriptor instead.
 func (*PubSubTopic_Subscription) Descriptor() ([]byte, []int) {
-	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{27, 1}
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{29, 1}
 }
 
 func (x *PubSubTopic_Subscription) GetName() string {
@@ -3450,7 +3715,7 @@ type PubSubTopic_RetryPolicy struct {
 
 func (x *PubSubTopic_RetryPolicy) Reset() {
 	*x = PubSubTopic_RetryPolicy{}
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[38]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3462,7 +3727,7 @@ func (x *PubSubTopic_RetryPolicy) String() string {
 func (*PubSubTopic_RetryPolicy) ProtoMessage() {}
 
 func (x *PubSubTopic_RetryPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[38]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3475,7 +3740,7 @@ func (x *PubSubTopic_RetryPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PubSubTopic_RetryPolicy.ProtoReflect.Descriptor instead.
 func (*PubSubTopic_RetryPolicy) Descriptor() ([]byte, []int) {
-	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{27, 2}
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{29, 2}
 }
 
 func (x *PubSubTopic_RetryPolicy) GetMinBackoff() int64 {
@@ -3512,7 +3777,7 @@ type CacheCluster_Keyspace struct {
 
 func (x *CacheCluster_Keyspace) Reset() {
 	*x = CacheCluster_Keyspace{}
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[39]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3524,7 +3789,7 @@ func (x *CacheCluster_Keyspace) String() string {
 func (*CacheCluster_Keyspace) ProtoMessage() {}
 
 func (x *CacheCluster_Keyspace) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[39]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3537,7 +3802,7 @@ func (x *CacheCluster_Keyspace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CacheCluster_Keyspace.ProtoReflect.Descriptor instead.
 func (*CacheCluster_Keyspace) Descriptor() ([]byte, []int) {
-	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{28, 0}
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{30, 0}
 }
 
 func (x *CacheCluster_Keyspace) GetKeyType() *v1.Type {
@@ -3586,7 +3851,7 @@ type Metric_Label struct {
 
 func (x *Metric_Label) Reset() {
 	*x = Metric_Label{}
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[40]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3598,7 +3863,7 @@ func (x *Metric_Label) String() string {
 func (*Metric_Label) ProtoMessage() {}
 
 func (x *Metric_Label) ProtoReflect() protoreflect.Message {
-	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[40]
+	mi := &file_encore_parser_meta_v1_meta_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3611,7 +3876,7 @@ func (x *Metric_Label) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Metric_Label.ProtoReflect.Descriptor instead.
 func (*Metric_Label) Descriptor() ([]byte, []int) {
-	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{29, 0}
+	return file_encore_parser_meta_v1_meta_proto_rawDescGZIP(), []int{31, 0}
 }
 
 func (x *Metric_Label) GetKey() string {
@@ -3639,7 +3904,7 @@ var File_encore_parser_meta_v1_meta_proto protoreflect.FileDescriptor
 
 const file_encore_parser_meta_v1_meta_proto_rawDesc = "" +
 	"\n" +
-	" encore/parser/meta/v1/meta.proto\x12\x15encore.parser.meta.v1\x1a$encore/parser/schema/v1/schema.proto\"\xdc\a\n" +
+	" encore/parser/meta/v1/meta.proto\x12\x15encore.parser.meta.v1\x1a$encore/parser/schema/v1/schema.proto\"\xae\b\n" +
 	"\x04Data\x12\x1f\n" +
 	"\vmodule_path\x18\x01 \x01(\tR\n" +
 	"modulePath\x12!\n" +
@@ -3661,7 +3926,8 @@ const file_encore_parser_meta_v1_meta_proto_rawDesc = "" +
 	"\rsql_databases\x18\x0e \x03(\v2\".encore.parser.meta.v1.SQLDatabaseR\fsqlDatabases\x12:\n" +
 	"\bgateways\x18\x0f \x03(\v2\x1e.encore.parser.meta.v1.GatewayR\bgateways\x127\n" +
 	"\blanguage\x18\x10 \x01(\x0e2\x1b.encore.parser.meta.v1.LangR\blanguage\x127\n" +
-	"\abuckets\x18\x11 \x03(\v2\x1d.encore.parser.meta.v1.BucketR\abucketsB\x0f\n" +
+	"\abuckets\x18\x11 \x03(\v2\x1d.encore.parser.meta.v1.BucketR\abuckets\x12P\n" +
+	"\x10plugin_resources\x18\x12 \x03(\v2%.encore.parser.meta.v1.PluginResourceR\x0fpluginResourcesB\x0f\n" +
 	"\r_auth_handler\"5\n" +
 	"\rQualifiedName\x12\x10\n" +
 	"\x03pkg\x18\x01 \x01(\tR\x03pkg\x12\x12\n" +
@@ -3914,7 +4180,7 @@ const file_encore_parser_meta_v1_meta_proto_rawDesc = "" +
 	"\x03doc\x18\x03 \x01(\tH\x00R\x03doc\x88\x01\x01\x12\x1a\n" +
 	"\bschedule\x18\x04 \x01(\tR\bschedule\x12@\n" +
 	"\bendpoint\x18\x05 \x01(\v2$.encore.parser.meta.v1.QualifiedNameR\bendpointB\x06\n" +
-	"\x04_doc\"\x95\x02\n" +
+	"\x04_doc\"\xa1\x03\n" +
 	"\vSQLDatabase\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
 	"\x03doc\x18\x02 \x01(\tH\x00R\x03doc\x88\x01\x01\x121\n" +
@@ -3922,18 +4188,42 @@ const file_encore_parser_meta_v1_meta_proto_rawDesc = "" +
 	"\n" +
 	"migrations\x18\x04 \x03(\v2\".encore.parser.meta.v1.DBMigrationR\n" +
 	"migrations\x12E\n" +
-	"\x1fallow_non_sequential_migrations\x18\x05 \x01(\bR\x1callowNonSequentialMigrationsB\x06\n" +
+	"\x1fallow_non_sequential_migrations\x18\x05 \x01(\bR\x1callowNonSequentialMigrations\x12O\n" +
+	"\x0fdata_migrations\x18\x06 \x03(\v2&.encore.parser.meta.v1.DBDataMigrationR\x0edataMigrations\x12'\n" +
+	"\rseed_rel_path\x18\a \x01(\tH\x02R\vseedRelPath\x88\x01\x01B\x06\n" +
 	"\x04_docB\x15\n" +
-	"\x13_migration_rel_path\"c\n" +
+	"\x13_migration_rel_pathB\x10\n" +
+	"\x0e_seed_rel_path\"c\n" +
 	"\vDBMigration\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x16\n" +
 	"\x06number\x18\x02 \x01(\x04R\x06number\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"q\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"]\n" +
+	"\x0fDBDataMigration\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x04R\x06number\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x10\n" +
+	"\x03pkg\x18\x03 \x01(\tR\x03pkg\"\xd0\x02\n" +
 	"\x06Bucket\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
 	"\x03doc\x18\x02 \x01(\tH\x00R\x03doc\x88\x01\x01\x12\x1c\n" +
 	"\tversioned\x18\x03 \x01(\bR\tversioned\x12\x16\n" +
-	"\x06public\x18\x04 \x01(\bR\x06publicB\x06\n" +
+	"\x06public\x18\x04 \x01(\bR\x06public\x12P\n" +
+	"\rnotifications\x18\x05 \x03(\v2*.encore.parser.meta.v1.Bucket.NotificationR\rnotifications\x1a\x8a\x01\n" +
+	"\fNotification\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12%\n" +
+	"\x0eobject_created\x18\x02 \x01(\bR\robjectCreated\x12%\n" +
+	"\x0eobject_deleted\x18\x03 \x01(\bR\robjectDeleted\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefixB\x06\n" +
+	"\x04_doc\"\xe2\x01\n" +
+	"\x0ePluginResource\x12\x16\n" +
+	"\x06plugin\x18\x01 \x01(\tR\x06plugin\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
+	"\x03pkg\x18\x04 \x01(\tR\x03pkg\x12\x12\n" +
+	"\x04func\x18\x05 \x01(\tR\x04func\x12&\n" +
+	"\fservice_name\x18\x06 \x01(\tH\x00R\vserviceName\x88\x01\x01\x12\x15\n" +
+	"\x03doc\x18\a \x01(\tH\x01R\x03doc\x88\x01\x01\x12\x12\n" +
+	"\x04data\x18\b \x01(\tR\x04dataB\x0f\n" +
+	"\r_service_nameB\x06\n" +
 	"\x04_doc\"\xb8\a\n" +
 	"\vPubSubTopic\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
@@ -4014,7 +4304,7 @@ func file_encore_parser_meta_v1_meta_proto_rawDescGZIP() []byte {
 }
 
 var file_encore_parser_meta_v1_meta_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
-var file_encore_parser_meta_v1_meta_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_encore_parser_meta_v1_meta_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_encore_parser_meta_v1_meta_proto_goTypes = []any{
 	(Lang)(0),                             // 0: encore.parser.meta.v1.Lang
 	(BucketUsage_Operation)(0),            // 1: encore.parser.meta.v1.BucketUsage.Operation
@@ -4053,106 +4343,112 @@ var file_encore_parser_meta_v1_meta_proto_goTypes = []any{
 	(*CronJob)(nil),                       // 34: encore.parser.meta.v1.CronJob
 	(*SQLDatabase)(nil),                   // 35: encore.parser.meta.v1.SQLDatabase
 	(*DBMigration)(nil),                   // 36: encore.parser.meta.v1.DBMigration
-	(*Bucket)(nil),                        // 37: encore.parser.meta.v1.Bucket
-	(*PubSubTopic)(nil),                   // 38: encore.parser.meta.v1.PubSubTopic
-	(*CacheCluster)(nil),                  // 39: encore.parser.meta.v1.CacheCluster
-	(*Metric)(nil),                        // 40: encore.parser.meta.v1.Metric
-	nil,                                   // 41: encore.parser.meta.v1.RPC.ExposeEntry
-	(*RPC_ExposeOptions)(nil),             // 42: encore.parser.meta.v1.RPC.ExposeOptions
-	(*RPC_StaticAssets)(nil),              // 43: encore.parser.meta.v1.RPC.StaticAssets
-	(*RPC_StaticAssets_HeaderValues)(nil), // 44: encore.parser.meta.v1.RPC.StaticAssets.HeaderValues
-	nil,                                   // 45: encore.parser.meta.v1.RPC.StaticAssets.HeadersEntry
-	(*Gateway_Explicit)(nil),              // 46: encore.parser.meta.v1.Gateway.Explicit
-	(*PubSubTopic_Publisher)(nil),         // 47: encore.parser.meta.v1.PubSubTopic.Publisher
-	(*PubSubTopic_Subscription)(nil),      // 48: encore.parser.meta.v1.PubSubTopic.Subscription
-	(*PubSubTopic_RetryPolicy)(nil),       // 49: encore.parser.meta.v1.PubSubTopic.RetryPolicy
-	(*CacheCluster_Keyspace)(nil),         // 50: encore.parser.meta.v1.CacheCluster.Keyspace
-	(*Metric_Label)(nil),                  // 51: encore.parser.meta.v1.Metric.Label
-	(*v1.Decl)(nil),                       // 52: encore.parser.schema.v1.Decl
-	(*v1.Type)(nil),                       // 53: encore.parser.schema.v1.Type
-	(*v1.Loc)(nil),                        // 54: encore.parser.schema.v1.Loc
-	(*v1.ValidationExpr)(nil),             // 55: encore.parser.schema.v1.ValidationExpr
-	(v1.Builtin)(0),                       // 56: encore.parser.schema.v1.Builtin
+	(*DBDataMigration)(nil),               // 37: encore.parser.meta.v1.DBDataMigration
+	(*Bucket)(nil),                        // 38: encore.parser.meta.v1.Bucket
+	(*PluginResource)(nil),                // 39: encore.parser.meta.v1.PluginResource
+	(*PubSubTopic)(nil),                   // 40: encore.parser.meta.v1.PubSubTopic
+	(*CacheCluster)(nil),                  // 41: encore.parser.meta.v1.CacheCluster
+	(*Metric)(nil),                        // 42: encore.parser.meta.v1.Metric
+	nil,                                   // 43: encore.parser.meta.v1.RPC.ExposeEntry
+	(*RPC_ExposeOptions)(nil),             // 44: encore.parser.meta.v1.RPC.ExposeOptions
+	(*RPC_StaticAssets)(nil),              // 45: encore.parser.meta.v1.RPC.StaticAssets
+	(*RPC_StaticAssets_HeaderValues)(nil), // 46: encore.parser.meta.v1.RPC.StaticAssets.HeaderValues
+	nil,                                   // 47: encore.parser.meta.v1.RPC.StaticAssets.HeadersEntry
+	(*Gateway_Explicit)(nil),              // 48: encore.parser.meta.v1.Gateway.Explicit
+	(*Bucket_Notification)(nil),           // 49: encore.parser.meta.v1.Bucket.Notification
+	(*PubSubTopic_Publisher)(nil),         // 50: encore.parser.meta.v1.PubSubTopic.Publisher
+	(*PubSubTopic_Subscription)(nil),      // 51: encore.parser.meta.v1.PubSubTopic.Subscription
+	(*PubSubTopic_RetryPolicy)(nil),       // 52: encore.parser.meta.v1.PubSubTopic.RetryPolicy
+	(*CacheCluster_Keyspace)(nil),         // 53: encore.parser.meta.v1.CacheCluster.Keyspace
+	(*Metric_Label)(nil),                  // 54: encore.parser.meta.v1.Metric.Label
+	(*v1.Decl)(nil),                       // 55: encore.parser.schema.v1.Decl
+	(*v1.Type)(nil),                       // 56: encore.parser.schema.v1.Type
+	(*v1.Loc)(nil),                        // 57: encore.parser.schema.v1.Loc
+	(*v1.ValidationExpr)(nil),             // 58: encore.parser.schema.v1.ValidationExpr
+	(v1.Builtin)(0),                       // 59: encore.parser.schema.v1.Builtin
 }
 var file_encore_parser_meta_v1_meta_proto_depIdxs = []int32{
-	52, // 0: encore.parser.meta.v1.Data.decls:type_name -> encore.parser.schema.v1.Decl
+	55, // 0: encore.parser.meta.v1.Data.decls:type_name -> encore.parser.schema.v1.Decl
 	13, // 1: encore.parser.meta.v1.Data.pkgs:type_name -> encore.parser.meta.v1.Package
 	14, // 2: encore.parser.meta.v1.Data.svcs:type_name -> encore.parser.meta.v1.Service
 	18, // 3: encore.parser.meta.v1.Data.auth_handler:type_name -> encore.parser.meta.v1.AuthHandler
 	34, // 4: encore.parser.meta.v1.Data.cron_jobs:type_name -> encore.parser.meta.v1.CronJob
-	38, // 5: encore.parser.meta.v1.Data.pubsub_topics:type_name -> encore.parser.meta.v1.PubSubTopic
+	40, // 5: encore.parser.meta.v1.Data.pubsub_topics:type_name -> encore.parser.meta.v1.PubSubTopic
 	19, // 6: encore.parser.meta.v1.Data.middleware:type_name -> encore.parser.meta.v1.Middleware
-	39, // 7: encore.parser.meta.v1.Data.cache_clusters:type_name -> encore.parser.meta.v1.CacheCluster
-	40, // 8: encore.parser.meta.v1.Data.metrics:type_name -> encore.parser.meta.v1.Metric
+	41, // 7: encore.parser.meta.v1.Data.cache_clusters:type_name -> encore.parser.meta.v1.CacheCluster
+	42, // 8: encore.parser.meta.v1.Data.metrics:type_name -> encore.parser.meta.v1.Metric
 	35, // 9: encore.parser.meta.v1.Data.sql_databases:type_name -> encore.parser.meta.v1.SQLDatabase
 	33, // 10: encore.parser.meta.v1.Data.gateways:type_name -> encore.parser.meta.v1.Gateway
 	0,  // 11: encore.parser.meta.v1.Data.language:type_name -> encore.parser.meta.v1.Lang
-	37, // 12: encore.parser.meta.v1.Data.buckets:type_name -> encore.parser.meta.v1.Bucket
-	12, // 13: encore.parser.meta.v1.Package.rpc_calls:type_name -> encore.parser.meta.v1.QualifiedName
-	20, // 14: encore.parser.meta.v1.Package.trace_nodes:type_name -> encore.parser.meta.v1.TraceNode
-	17, // 15: encore.parser.meta.v1.Service.rpcs:type_name -> encore.parser.meta.v1.RPC
-	36, // 16: encore.parser.meta.v1.Service.migrations:type_name -> encore.parser.meta.v1.DBMigration
-	15, // 17: encore.parser.meta.v1.Service.buckets:type_name -> encore.parser.meta.v1.BucketUsage
-	1,  // 18: encore.parser.meta.v1.BucketUsage.operations:type_name -> encore.parser.meta.v1.BucketUsage.Operation
-	2,  // 19: encore.parser.meta.v1.Selector.type:type_name -> encore.parser.meta.v1.Selector.Type
-	3,  // 20: encore.parser.meta.v1.RPC.access_type:type_name -> encore.parser.meta.v1.RPC.AccessType
-	53, // 21: encore.parser.meta.v1.RPC.request_schema:type_name -> encore.parser.schema.v1.Type
-	53, // 22: encore.parser.meta.v1.RPC.response_schema:type_name -> encore.parser.schema.v1.Type
-	4,  // 23: encore.parser.meta.v1.RPC.proto:type_name -> encore.parser.meta.v1.RPC.Protocol
-	54, // 24: encore.parser.meta.v1.RPC.loc:type_name -> encore.parser.schema.v1.Loc
-	31, // 25: encore.parser.meta.v1.RPC.path:type_name -> encore.parser.meta.v1.Path
-	16, // 26: encore.parser.meta.v1.RPC.tags:type_name -> encore.parser.meta.v1.Selector
-	41, // 27: encore.parser.meta.v1.RPC.expose:type_name -> encore.parser.meta.v1.RPC.ExposeEntry
-	53, // 28: encore.parser.meta.v1.RPC.handshake_schema:type_name -> encore.parser.schema.v1.Type
-	43, // 29: encore.parser.meta.v1.RPC.static_assets:type_name -> encore.parser.meta.v1.RPC.StaticAssets
-	54, // 30: encore.parser.meta.v1.AuthHandler.loc:type_name -> encore.parser.schema.v1.Loc
-	53, // 31: encore.parser.meta.v1.AuthHandler.auth_data:type_name -> encore.parser.schema.v1.Type
-	53, // 32: encore.parser.meta.v1.AuthHandler.params:type_name -> encore.parser.schema.v1.Type
-	12, // 33: encore.parser.meta.v1.Middleware.name:type_name -> encore.parser.meta.v1.QualifiedName
-	54, // 34: encore.parser.meta.v1.Middleware.loc:type_name -> encore.parser.schema.v1.Loc
-	16, // 35: encore.parser.meta.v1.Middleware.target:type_name -> encore.parser.meta.v1.Selector
-	21, // 36: encore.parser.meta.v1.TraceNode.rpc_def:type_name -> encore.parser.meta.v1.RPCDefNode
-	22, // 37: encore.parser.meta.v1.TraceNode.rpc_call:type_name -> encore.parser.meta.v1.RPCCallNode
-	23, // 38: encore.parser.meta.v1.TraceNode.static_call:type_name -> encore.parser.meta.v1.StaticCallNode
-	24, // 39: encore.parser.meta.v1.TraceNode.auth_handler_def:type_name -> encore.parser.meta.v1.AuthHandlerDefNode
-	25, // 40: encore.parser.meta.v1.TraceNode.pubsub_topic_def:type_name -> encore.parser.meta.v1.PubSubTopicDefNode
-	26, // 41: encore.parser.meta.v1.TraceNode.pubsub_publish:type_name -> encore.parser.meta.v1.PubSubPublishNode
-	27, // 42: encore.parser.meta.v1.TraceNode.pubsub_subscriber:type_name -> encore.parser.meta.v1.PubSubSubscriberNode
-	28, // 43: encore.parser.meta.v1.TraceNode.service_init:type_name -> encore.parser.meta.v1.ServiceInitNode
-	29, // 44: encore.parser.meta.v1.TraceNode.middleware_def:type_name -> encore.parser.meta.v1.MiddlewareDefNode
-	30, // 45: encore.parser.meta.v1.TraceNode.cache_keyspace:type_name -> encore.parser.meta.v1.CacheKeyspaceDefNode
-	5,  // 46: encore.parser.meta.v1.StaticCallNode.package:type_name -> encore.parser.meta.v1.StaticCallNode.Package
-	16, // 47: encore.parser.meta.v1.MiddlewareDefNode.target:type_name -> encore.parser.meta.v1.Selector
-	32, // 48: encore.parser.meta.v1.Path.segments:type_name -> encore.parser.meta.v1.PathSegment
-	6,  // 49: encore.parser.meta.v1.Path.type:type_name -> encore.parser.meta.v1.Path.Type
-	7,  // 50: encore.parser.meta.v1.PathSegment.type:type_name -> encore.parser.meta.v1.PathSegment.SegmentType
-	8,  // 51: encore.parser.meta.v1.PathSegment.value_type:type_name -> encore.parser.meta.v1.PathSegment.ParamType
-	55, // 52: encore.parser.meta.v1.PathSegment.validation:type_name -> encore.parser.schema.v1.ValidationExpr
-	46, // 53: encore.parser.meta.v1.Gateway.explicit:type_name -> encore.parser.meta.v1.Gateway.Explicit
-	12, // 54: encore.parser.meta.v1.CronJob.endpoint:type_name -> encore.parser.meta.v1.QualifiedName
-	36, // 55: encore.parser.meta.v1.SQLDatabase.migrations:type_name -> encore.parser.meta.v1.DBMigration
-	53, // 56: encore.parser.meta.v1.PubSubTopic.message_type:type_name -> encore.parser.schema.v1.Type
-	9,  // 57: encore.parser.meta.v1.PubSubTopic.delivery_guarantee:type_name -> encore.parser.meta.v1.PubSubTopic.DeliveryGuarantee
-	47, // 58: encore.parser.meta.v1.PubSubTopic.publishers:type_name -> encore.parser.meta.v1.PubSubTopic.Publisher
-	48, // 59: encore.parser.meta.v1.PubSubTopic.subscriptions:type_name -> encore.parser.meta.v1.PubSubTopic.Subscription
-	50, // 60: encore.parser.meta.v1.CacheCluster.keyspaces:type_name -> encore.parser.meta.v1.CacheCluster.Keyspace
-	56, // 61: encore.parser.meta.v1.Metric.value_type:type_name -> encore.parser.schema.v1.Builtin
-	10, // 62: encore.parser.meta.v1.Metric.kind:type_name -> encore.parser.meta.v1.Metric.MetricKind
-	51, // 63: encore.parser.meta.v1.Metric.labels:type_name -> encore.parser.meta.v1.Metric.Label
-	42, // 64: encore.parser.meta.v1.RPC.ExposeEntry.value:type_name -> encore.parser.meta.v1.RPC.ExposeOptions
-	45, // 65: encore.parser.meta.v1.RPC.StaticAssets.headers:type_name -> encore.parser.meta.v1.RPC.StaticAssets.HeadersEntry
-	44, // 66: encore.parser.meta.v1.RPC.StaticAssets.HeadersEntry.value:type_name -> encore.parser.meta.v1.RPC.StaticAssets.HeaderValues
-	18, // 67: encore.parser.meta.v1.Gateway.Explicit.auth_handler:type_name -> encore.parser.meta.v1.AuthHandler
-	49, // 68: encore.parser.meta.v1.PubSubTopic.Subscription.retry_policy:type_name -> encore.parser.meta.v1.PubSubTopic.RetryPolicy
-	53, // 69: encore.parser.meta.v1.CacheCluster.Keyspace.key_type:type_name -> encore.parser.schema.v1.Type
-	53, // 70: encore.parser.meta.v1.CacheCluster.Keyspace.value_type:type_name -> encore.parser.schema.v1.Type
-	31, // 71: encore.parser.meta.v1.CacheCluster.Keyspace.path_pattern:type_name -> encore.parser.meta.v1.Path
-	56, // 72: encore.parser.meta.v1.Metric.Label.type:type_name -> encore.parser.schema.v1.Builtin
-	73, // [73:73] is the sub-list for method output_type
-	73, // [73:73] is the sub-list for method input_type
-	73, // [73:73] is the sub-list for extension type_name
-	73, // [73:73] is the sub-list for extension extendee
-	0,  // [0:73] is the sub-list for field type_name
+	38, // 12: encore.parser.meta.v1.Data.buckets:type_name -> encore.parser.meta.v1.Bucket
+	39, // 13: encore.parser.meta.v1.Data.plugin_resources:type_name -> encore.parser.meta.v1.PluginResource
+	12, // 14: encore.parser.meta.v1.Package.rpc_calls:type_name -> encore.parser.meta.v1.QualifiedName
+	20, // 15: encore.parser.meta.v1.Package.trace_nodes:type_name -> encore.parser.meta.v1.TraceNode
+	17, // 16: encore.parser.meta.v1.Service.rpcs:type_name -> encore.parser.meta.v1.RPC
+	36, // 17: encore.parser.meta.v1.Service.migrations:type_name -> encore.parser.meta.v1.DBMigration
+	15, // 18: encore.parser.meta.v1.Service.buckets:type_name -> encore.parser.meta.v1.BucketUsage
+	1,  // 19: encore.parser.meta.v1.BucketUsage.operations:type_name -> encore.parser.meta.v1.BucketUsage.Operation
+	2,  // 20: encore.parser.meta.v1.Selector.type:type_name -> encore.parser.meta.v1.Selector.Type
+	3,  // 21: encore.parser.meta.v1.RPC.access_type:type_name -> encore.parser.meta.v1.RPC.AccessType
+	56, // 22: encore.parser.meta.v1.RPC.request_schema:type_name -> encore.parser.schema.v1.Type
+	56, // 23: encore.parser.meta.v1.RPC.response_schema:type_name -> encore.parser.schema.v1.Type
+	4,  // 24: encore.parser.meta.v1.RPC.proto:type_name -> encore.parser.meta.v1.RPC.Protocol
+	57, // 25: encore.parser.meta.v1.RPC.loc:type_name -> encore.parser.schema.v1.Loc
+	31, // 26: encore.parser.meta.v1.RPC.path:type_name -> encore.parser.meta.v1.Path
+	16, // 27: encore.parser.meta.v1.RPC.tags:type_name -> encore.parser.meta.v1.Selector
+	43, // 28: encore.parser.meta.v1.RPC.expose:type_name -> encore.parser.meta.v1.RPC.ExposeEntry
+	56, // 29: encore.parser.meta.v1.RPC.handshake_schema:type_name -> encore.parser.schema.v1.Type
+	45, // 30: encore.parser.meta.v1.RPC.static_assets:type_name -> encore.parser.meta.v1.RPC.StaticAssets
+	57, // 31: encore.parser.meta.v1.AuthHandler.loc:type_name -> encore.parser.schema.v1.Loc
+	56, // 32: encore.parser.meta.v1.AuthHandler.auth_data:type_name -> encore.parser.schema.v1.Type
+	56, // 33: encore.parser.meta.v1.AuthHandler.params:type_name -> encore.parser.schema.v1.Type
+	12, // 34: encore.parser.meta.v1.Middleware.name:type_name -> encore.parser.meta.v1.QualifiedName
+	57, // 35: encore.parser.meta.v1.Middleware.loc:type_name -> encore.parser.schema.v1.Loc
+	16, // 36: encore.parser.meta.v1.Middleware.target:type_name -> encore.parser.meta.v1.Selector
+	21, // 37: encore.parser.meta.v1.TraceNode.rpc_def:type_name -> encore.parser.meta.v1.RPCDefNode
+	22, // 38: encore.parser.meta.v1.TraceNode.rpc_call:type_name -> encore.parser.meta.v1.RPCCallNode
+	23, // 39: encore.parser.meta.v1.TraceNode.static_call:type_name -> encore.parser.meta.v1.StaticCallNode
+	24, // 40: encore.parser.meta.v1.TraceNode.auth_handler_def:type_name -> encore.parser.meta.v1.AuthHandlerDefNode
+	25, // 41: encore.parser.meta.v1.TraceNode.pubsub_topic_def:type_name -> encore.parser.meta.v1.PubSubTopicDefNode
+	26, // 42: encore.parser.meta.v1.TraceNode.pubsub_publish:type_name -> encore.parser.meta.v1.PubSubPublishNode
+	27, // 43: encore.parser.meta.v1.TraceNode.pubsub_subscriber:type_name -> encore.parser.meta.v1.PubSubSubscriberNode
+	28, // 44: encore.parser.meta.v1.TraceNode.service_init:type_name -> encore.parser.meta.v1.ServiceInitNode
+	29, // 45: encore.parser.meta.v1.TraceNode.middleware_def:type_name -> encore.parser.meta.v1.MiddlewareDefNode
+	30, // 46: encore.parser.meta.v1.TraceNode.cache_keyspace:type_name -> encore.parser.meta.v1.CacheKeyspaceDefNode
+	5,  // 47: encore.parser.meta.v1.StaticCallNode.package:type_name -> encore.parser.meta.v1.StaticCallNode.Package
+	16, // 48: encore.parser.meta.v1.MiddlewareDefNode.target:type_name -> encore.parser.meta.v1.Selector
+	32, // 49: encore.parser.meta.v1.Path.segments:type_name -> encore.parser.meta.v1.PathSegment
+	6,  // 50: encore.parser.meta.v1.Path.type:type_name -> encore.parser.meta.v1.Path.Type
+	7,  // 51: encore.parser.meta.v1.PathSegment.type:type_name -> encore.parser.meta.v1.PathSegment.SegmentType
+	8,  // 52: encore.parser.meta.v1.PathSegment.value_type:type_name -> encore.parser.meta.v1.PathSegment.ParamType
+	58, // 53: encore.parser.meta.v1.PathSegment.validation:type_name -> encore.parser.schema.v1.ValidationExpr
+	48, // 54: encore.parser.meta.v1.Gateway.explicit:type_name -> encore.parser.meta.v1.Gateway.Explicit
+	12, // 55: encore.parser.meta.v1.CronJob.endpoint:type_name -> encore.parser.meta.v1.QualifiedName
+	36, // 56: encore.parser.meta.v1.SQLDatabase.migrations:type_name -> encore.parser.meta.v1.DBMigration
+	37, // 57: encore.parser.meta.v1.SQLDatabase.data_migrations:type_name -> encore.parser.meta.v1.DBDataMigration
+	49, // 58: encore.parser.meta.v1.Bucket.notifications:type_name -> encore.parser.meta.v1.Bucket.Notification
+	56, // 59: encore.parser.meta.v1.PubSubTopic.message_type:type_name -> encore.parser.schema.v1.Type
+	9,  // 60: encore.parser.meta.v1.PubSubTopic.delivery_guarantee:type_name -> encore.parser.meta.v1.PubSubTopic.DeliveryGuarantee
+	50, // 61: encore.parser.meta.v1.PubSubTopic.publishers:type_name -> encore.parser.meta.v1.PubSubTopic.Publisher
+	51, // 62: encore.parser.meta.v1.PubSubTopic.subscriptions:type_name -> encore.parser.meta.v1.PubSubTopic.Subscription
+	53, // 63: encore.parser.meta.v1.CacheCluster.keyspaces:type_name -> encore.parser.meta.v1.CacheCluster.Keyspace
+	59, // 64: encore.parser.meta.v1.Metric.value_type:type_name -> encore.parser.schema.v1.Builtin
+	10, // 65: encore.parser.meta.v1.Metric.kind:type_name -> encore.parser.meta.v1.Metric.MetricKind
+	54, // 66: encore.parser.meta.v1.Metric.labels:type_name -> encore.parser.meta.v1.Metric.Label
+	44, // 67: encore.parser.meta.v1.RPC.ExposeEntry.value:type_name -> encore.parser.meta.v1.RPC.ExposeOptions
+	47, // 68: encore.parser.meta.v1.RPC.StaticAssets.headers:type_name -> encore.parser.meta.v1.RPC.StaticAssets.HeadersEntry
+	46, // 69: encore.parser.meta.v1.RPC.StaticAssets.HeadersEntry.value:type_name -> encore.parser.meta.v1.RPC.StaticAssets.HeaderValues
+	18, // 70: encore.parser.meta.v1.Gateway.Explicit.auth_handler:type_name -> encore.parser.meta.v1.AuthHandler
+	52, // 71: encore.parser.meta.v1.PubSubTopic.Subscription.retry_policy:type_name -> encore.parser.meta.v1.PubSubTopic.RetryPolicy
+	56, // 72: encore.parser.meta.v1.CacheCluster.Keyspace.key_type:type_name -> encore.parser.schema.v1.Type
+	56, // 73: encore.parser.meta.v1.CacheCluster.Keyspace.value_type:type_name -> encore.parser.schema.v1.Type
+	31, // 74: encore.parser.meta.v1.CacheCluster.Keyspace.path_pattern:type_name -> encore.parser.meta.v1.Path
+	59, // 75: encore.parser.meta.v1.Metric.Label.type:type_name -> encore.parser.schema.v1.Builtin
+	76, // [76:76] is the sub-list for method output_type
+	76, // [76:76] is the sub-list for method input_type
+	76, // [76:76] is the sub-list for extension type_name
+	76, // [76:76] is the sub-list for extension extendee
+	0,  // [0:76] is the sub-list for field type_name
 }
 
 func init() { file_encore_parser_meta_v1_meta_proto_init() }
@@ -4180,19 +4476,20 @@ func file_encore_parser_meta_v1_meta_proto_init() {
 	file_encore_parser_meta_v1_meta_proto_msgTypes[22].OneofWrappers = []any{}
 	file_encore_parser_meta_v1_meta_proto_msgTypes[23].OneofWrappers = []any{}
 	file_encore_parser_meta_v1_meta_proto_msgTypes[24].OneofWrappers = []any{}
-	file_encore_parser_meta_v1_meta_proto_msgTypes[26].OneofWrappers = []any{}
 	file_encore_parser_meta_v1_meta_proto_msgTypes[27].OneofWrappers = []any{}
+	file_encore_parser_meta_v1_meta_proto_msgTypes[28].OneofWrappers = []any{}
 	file_encore_parser_meta_v1_meta_proto_msgTypes[29].OneofWrappers = []any{}
-	file_encore_parser_meta_v1_meta_proto_msgTypes[32].OneofWrappers = []any{}
-	file_encore_parser_meta_v1_meta_proto_msgTypes[35].OneofWrappers = []any{}
+	file_encore_parser_meta_v1_meta_proto_msgTypes[31].OneofWrappers = []any{}
+	file_encore_parser_meta_v1_meta_proto_msgTypes[34].OneofWrappers = []any{}
 	file_encore_parser_meta_v1_meta_proto_msgTypes[37].OneofWrappers = []any{}
+	file_encore_parser_meta_v1_meta_proto_msgTypes[40].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_encore_parser_meta_v1_meta_proto_rawDesc), len(file_encore_parser_meta_v1_meta_proto_rawDesc)),
 			NumEnums:      11,
-			NumMessages:   41,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1390,6 +1390,10 @@ type PubSubSubscription struct {
 	// If true the application will not actively subscribe but wait
 	// for incoming messages to be pushed to it.
 	PushOnly bool `protobuf:"varint,6,opt,name=push_only,json=pushOnly,proto3" json:"push_only,omitempty"`
+	// The cloud name of the topic (or queue) that messages are forwarded to
+	// once they have exhausted their retries. If unset, the provider's
+	// default behavior applies.
+	DeadLetterCloudName *string `protobuf:"bytes,7,opt,name=dead_letter_cloud_name,json=deadLetterCloudName,proto3,oneof" json:"dead_letter_cloud_name,omitempty"`
 	// Subscription-specific provider configuration.
 	// Not all providers require this, but it must always be set
 	// for the providers that are present.
@@ -1474,6 +1478,13 @@ func (x *PubSubSubscription) GetPushOnly() bool {
 	return false
 }
 
+func (x *PubSubSubscription) GetDeadLetterCloudName() string {
+	if x != nil && x.DeadLetterCloudName != nil {
+		return *x.DeadLetterCloudName
+	}
+	return ""
+}
+
 func (x *PubSubSubscription) GetProviderConfig() isPubSubSubscription_ProviderConfig {
 	if x != nil {
 		return x.ProviderConfig
@@ -2816,14 +2827,15 @@ const file_encore_runtime_v1_infra_proto_rawDesc = "" +
 	" DELIVERY_GUARANTEE_AT_LEAST_ONCE\x10\x01\x12#\n" +
 	"\x1fDELIVERY_GUARANTEE_EXACTLY_ONCE\x10\x02B\x11\n" +
 	"\x0fprovider_configB\x10\n" +
-	"\x0e_ordering_attr\"\xb4\x04\n" +
+	"\x0e_ordering_attr\"\x89\x05\n" +
 	"\x12PubSubSubscription\x12\x10\n" +
 	"\x03rid\x18\x01 \x01(\tR\x03rid\x12*\n" +
 	"\x11topic_encore_name\x18\x02 \x01(\tR\x0ftopicEncoreName\x128\n" +
 	"\x18subscription_encore_name\x18\x03 \x01(\tR\x16subscriptionEncoreName\x12(\n" +
 	"\x10topic_cloud_name\x18\x04 \x01(\tR\x0etopicCloudName\x126\n" +
 	"\x17subscription_cloud_name\x18\x05 \x01(\tR\x15subscriptionCloudName\x12\x1b\n" +
-	"\tpush_only\x18\x06 \x01(\bR\bpushOnly\x12P\n" +
+	"\tpush_only\x18\x06 \x01(\bR\bpushOnly\x128\n" +
+	"\x16dead_letter_cloud_name\x18\a \x01(\tH\x01R\x13deadLetterCloudName\x88\x01\x01\x12P\n" +
 	"\n" +
 	"gcp_config\x18\n" +
 	" \x01(\v2/.encore.runtime.v1.PubSubSubscription.GCPConfigH\x00R\tgcpConfig\x1a\xc1\x01\n" +
@@ -2834,7 +2846,8 @@ const file_encore_runtime_v1_infra_proto_rawDesc = "" +
 	"\x11push_jwt_audience\x18\x03 \x01(\tH\x01R\x0fpushJwtAudience\x88\x01\x01B\x17\n" +
 	"\x15_push_service_accountB\x14\n" +
 	"\x12_push_jwt_audienceB\x11\n" +
-	"\x0fprovider_config\"\xec\x05\n" +
+	"\x0fprovider_configB\x19\n" +
+	"\x17_dead_letter_cloud_name\"\xec\x05\n" +
 	"\rBucketCluster\x12\x10\n" +
 	"\x03rid\x18\x01 \x01(\tR\x03rid\x123\n" +
 	"\abuckets\x18\x02 \x03(\v2\x19.encore.runtime.v1.BucketR\abuckets\x125\n" +
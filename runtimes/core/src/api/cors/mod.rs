@@ -15,12 +15,14 @@ pub mod cors_headers_config;
 mod tests;
 
 /// The default set of allowed headers.
+/// Traceparent is set by the generated clients to propagate the trace context.
 #[allow(clippy::declare_interior_mutable_const)]
-const ALWAYS_ALLOWED_HEADERS: [HeaderName; 8] = [
+const ALWAYS_ALLOWED_HEADERS: [HeaderName; 9] = [
     HeaderName::from_static("accept"),
     HeaderName::from_static("authorization"),
     HeaderName::from_static("content-type"),
     HeaderName::from_static("origin"),
+    HeaderName::from_static("traceparent"),
     HeaderName::from_static("user-agent"),
     HeaderName::from_static("x-correlation-id"),
     HeaderName::from_static("x-request-id"),
@@ -489,3 +489,25 @@ fn test_wildcard_without_creds() {
         bad_headers: &[],
     });
 }
+
+#[test]
+fn test_trace_context_headers() {
+    // The generated clients set the traceparent header on every request.
+    run_test_case(TestCase {
+        cors_cfg: pb::gateway::Cors {
+            debug: false,
+            disable_credentials: false,
+            allowed_origins_with_credentials: None,
+            allowed_origins_without_credentials: None,
+            extra_allowed_headers: vec![],
+            extra_exposed_headers: vec![],
+            allow_private_network_access: false,
+        },
+        creds_good_origins: &[],
+        creds_bad_origins: &[],
+        nocreds_good_origins: &[],
+        nocreds_bad_origins: &[],
+        good_headers: &[CONTENT_TYPE, HeaderName::from_static("traceparent")],
+        bad_headers: &[],
+    });
+}
@@ -180,6 +180,8 @@ pub struct SQLServer {
     pub host: String,
     pub tls_config: Option<TLSConfig>,
     pub databases: HashMap<String, SQLDatabase>,
+    #[serde(default)]
+    pub read_replicas: Vec<String>,
 }
 
 #[derive(Debug, Serialize, Deserialize)]
@@ -271,6 +273,8 @@ pub struct GCPSub {
     pub project_id: Option<String>,
 
     pub push_config: Option<PushConfig>,
+
+    pub dead_letter_topic: Option<String>,
 }
 
 #[derive(Debug, Serialize, Deserialize)]
@@ -295,6 +299,7 @@ pub struct AWSTopic {
 #[derive(Debug, Serialize, Deserialize)]
 pub struct AWSSub {
     pub url: String,
+    pub dead_letter_queue_url: Option<String>,
 }
 
 #[derive(Debug, Serialize, Deserialize)]
@@ -313,6 +318,7 @@ pub struct NSQTopic {
 #[derive(Debug, Serialize, Deserialize)]
 pub struct NSQSub {
     pub name: String,
+    pub dead_letter_topic: Option<String>,
 }
 
 pub fn map_infra_to_runtime(infra: InfraConfig) -> RuntimeConfig {
@@ -683,25 +689,35 @@ pub fn map_infra_to_runtime(infra: InfraConfig) -> RuntimeConfig {
                     })
                     .collect();
 
+                let tls_config = server.tls_config.map_or_else(
+                    || Some(TlsConfig::default()),
+                    |tls| match tls.disabled {
+                        true => None,
+                        false => Some(TlsConfig {
+                            server_ca_cert: tls.ca,
+                            disable_tls_hostname_verification: tls
+                                .disable_tls_hostname_verification,
+                            disable_ca_validation: tls.disable_ca_validation,
+                        }),
+                    },
+                );
+
+                let mut servers = vec![SqlServer {
+                    rid: get_next_rid(),
+                    host: server.host,
+                    kind: pbruntime::ServerKind::Primary as i32,
+                    tls_config: tls_config.clone(),
+                }];
+                servers.extend(server.read_replicas.into_iter().map(|host| SqlServer {
+                    rid: get_next_rid(),
+                    host,
+                    kind: pbruntime::ServerKind::ReadReplica as i32,
+                    tls_config: tls_config.clone(),
+                }));
+
                 SqlCluster {
                     rid: get_next_rid(),
-                    servers: vec![SqlServer {
-                        rid: get_next_rid(),
-                        host: server.host,
-                        kind: pbruntime::ServerKind::Primary as i32,
-                        tls_config: server.tls_config.map_or_else(
-                            || Some(TlsConfig::default()),
-                            |tls| match tls.disabled {
-                                true => None,
-                                false => Some(TlsConfig {
-                                    server_ca_cert: tls.ca,
-                                    disable_tls_hostname_verification: tls
-                                        .disable_tls_hostname_verification,
-                                    disable_ca_validation: tls.disable_ca_validation,
-                                }),
-                            },
-                        ),
-                    }],
+                    servers,
                     databases,
                 }
             })
@@ -827,6 +843,7 @@ pub fn map_infra_to_runtime(infra: InfraConfig) -> RuntimeConfig {
                                         topic_cloud_name: topic.name.clone(),
                                         subscription_cloud_name: sub.name.clone(),
                                         push_only: sub.push_config.is_some(),
+                                        dead_letter_cloud_name: sub.dead_letter_topic.clone(),
                                         provider_config: Some(
                                             pub_sub_subscription::ProviderConfig::GcpConfig(
                                                 pub_sub_subscription::GcpConfig {
@@ -881,6 +898,7 @@ pub fn map_infra_to_runtime(infra: InfraConfig) -> RuntimeConfig {
                                         topic_cloud_name: topic.arn.clone(),
                                         subscription_cloud_name: sub.url.clone(),
                                         push_only: false, // AWS SQS doesn't typically use push config
+                                        dead_letter_cloud_name: sub.dead_letter_queue_url.clone(),
                                         provider_config: None, // AWS doesn't need additional provider config
                                     }
                                 })
@@ -919,6 +937,7 @@ pub fn map_infra_to_runtime(infra: InfraConfig) -> RuntimeConfig {
                                         topic_cloud_name: topic.name.clone(), // Using topic name for simplicity
                                         subscription_cloud_name: sub.name.clone(),
                                         push_only: false, // NSQ is pull-based, no push config
+                                        dead_letter_cloud_name: sub.dead_letter_topic.clone(),
                                         provider_config: None, // No additional provider config for NSQ
                                     }
                                 })
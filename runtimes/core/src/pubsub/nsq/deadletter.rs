@@ -0,0 +1,132 @@
+use std::collections::HashMap;
+
+use anyhow::{Context, Result};
+use serde::Serialize;
+use tokio::sync::{mpsc, oneshot};
+use tokio_nsq::{NSQEvent, NSQProducerConfig, NSQTopic};
+
+use crate::pubsub::nsq::topic::EncodedMessage;
+
+/// A record published to the dead letter topic
+/// for a message that has exhausted its retries.
+/// It must be synchronized with cli/daemon/pubsub/deadletter.go.
+#[derive(Debug, Serialize)]
+struct DeadLetter<'a> {
+    topic: &'a str,
+    subscription: &'a str,
+    message_id: String,
+    #[serde(skip_serializing_if = "HashMap::is_empty")]
+    attributes: HashMap<String, String>,
+    data: serde_json::Value,
+    publish_time: Option<chrono::DateTime<chrono::Utc>>,
+    attempts: u16,
+    #[serde(skip_serializing_if = "Option::is_none")]
+    last_error: Option<String>,
+    dead_lettered: chrono::DateTime<chrono::Utc>,
+}
+
+struct PublishRequest {
+    record: Vec<u8>,
+    resp: oneshot::Sender<Result<()>>,
+}
+
+/// Forwards the messages of a subscription that have exhausted their retries
+/// to its dead letter topic.
+#[derive(Debug)]
+pub(super) struct DeadLetterTopic {
+    topic_encore_name: String,
+    subscription_encore_name: String,
+    tx: mpsc::Sender<PublishRequest>,
+}
+
+impl DeadLetterTopic {
+    pub(super) fn new(
+        addr: String,
+        cloud_name: String,
+        topic_encore_name: String,
+        subscription_encore_name: String,
+    ) -> Self {
+        let (tx, mut rx) = mpsc::channel::<PublishRequest>(32);
+        tokio::spawn(async move {
+            let topic = NSQTopic::new(&cloud_name)
+                .expect("dead_letter_cloud_name should be valid NSQ topic name");
+            let mut producer = NSQProducerConfig::new(addr).build();
+
+            // Wait for the producer to send a Ready event.
+            loop {
+                if let Some(NSQEvent::Healthy()) = producer.consume().await {
+                    break;
+                }
+            }
+
+            loop {
+                tokio::select! {
+                    req = rx.recv() => {
+                        let Some(req) = req else {
+                            break;
+                        };
+                        let result = producer
+                            .publish(&topic, req.record)
+                            .await
+                            .context("failed to publish dead letter");
+
+                        // Ignore error.
+                        _ = req.resp.send(result);
+                    }
+                    _ = producer.consume() => {}
+                }
+            }
+        });
+
+        Self {
+            topic_encore_name,
+            subscription_encore_name,
+            tx,
+        }
+    }
+
+    /// Publishes the message with the given NSQ body to the dead letter topic.
+    pub(super) async fn publish(
+        &self,
+        body: &[u8],
+        publish_time: Option<chrono::DateTime<chrono::Utc>>,
+        attempts: u16,
+        last_error: Option<String>,
+    ) -> Result<()> {
+        let (message_id, attributes, data) = match serde_json::from_slice::<EncodedMessage>(body) {
+            Ok(encoded) => (encoded.id, encoded.attrs, encoded.body.unwrap_or_default()),
+            // The message could not be decoded; keep the raw message.
+            Err(_) => (
+                String::new(),
+                HashMap::new(),
+                serde_json::Value::String(String::from_utf8_lossy(body).into_owned()),
+            ),
+        };
+
+        let record = serde_json::to_vec(&DeadLetter {
+            topic: &self.topic_encore_name,
+            subscription: &self.subscription_encore_name,
+            message_id,
+            attributes,
+            data,
+            publish_time,
+            attempts,
+            last_error,
+            dead_lettered: chrono::Utc::now(),
+        })
+        .context("failed to serialize dead letter")?;
+
+        let (resp_tx, resp_rx) = oneshot::channel::<Result<()>>();
+        let req = PublishRequest {
+            record,
+            resp: resp_tx,
+        };
+        self.tx
+            .send(req)
+            .await
+            .context("failed to send dead letter")?;
+        resp_rx
+            .await
+            .context("failed to receive dead letter response")?
+    }
+}
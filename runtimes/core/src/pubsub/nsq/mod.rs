@@ -6,6 +6,7 @@ use crate::pubsub;
 use crate::pubsub::nsq::sub::NsqSubscription;
 use crate::pubsub::nsq::topic::NsqTopic;
 
+mod deadletter;
 mod sub;
 mod topic;
 
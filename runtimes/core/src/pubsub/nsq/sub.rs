@@ -14,6 +14,7 @@ use crate::encore::parser::meta::v1 as meta;
 use crate::encore::runtime::v1 as pb;
 use crate::pubsub;
 use crate::pubsub::manager::SubHandler;
+use crate::pubsub::nsq::deadletter::DeadLetterTopic;
 use crate::pubsub::nsq::topic::EncodedMessage;
 use crate::pubsub::Subscription;
 
@@ -21,6 +22,7 @@ pub struct NsqSubscription {
     addr: String,
     config: NSQConsumerConfig,
     max_retries: i64,
+    dead_letter: Option<Arc<DeadLetterTopic>>,
 }
 
 impl Debug for NsqSubscription {
@@ -69,10 +71,20 @@ impl NsqSubscription {
             max_retries = retry.max_retries;
         }
 
+        let dead_letter = cfg.dead_letter_cloud_name.as_ref().map(|cloud_name| {
+            Arc::new(DeadLetterTopic::new(
+                addr.clone(),
+                cloud_name.clone(),
+                cfg.topic_encore_name.clone(),
+                cfg.subscription_encore_name.clone(),
+            ))
+        });
+
         NsqSubscription {
             addr,
             config,
             max_retries,
+            dead_letter,
         }
     }
 }
@@ -84,31 +96,46 @@ impl Subscription for NsqSubscription {
     ) -> Pin<Box<dyn Future<Output = APIResult<()>> + Send + 'static>> {
         let mut consumer = self.config.clone().build();
         let max_retries = self.max_retries;
+        let dead_letter = self.dead_letter.clone();
 
         Box::pin(async move {
             loop {
-                let Some(msg) = consumer.consume_filtered().await else {
+                let Some(mut msg) = consumer.consume_filtered().await else {
                     continue;
                 };
 
-                // If the attempt exceeds the max retries, drop it.
+                // If the attempt exceeds the max retries, dead letter or drop it.
                 // Attempt starts at 1 for the first delivery, which means
                 // the retry count is (attempt-1).
                 let retry = msg.attempt as i64 - 1;
                 if retry > max_retries {
-                    msg.finish().await;
+                    match dead_letter.clone() {
+                        Some(dl) => {
+                            let body: Vec<u8> = msg.body.drain(..).collect();
+                            tokio::spawn(async move {
+                                forward_dead_letter(msg, &body, &dl, None).await
+                            });
+                        }
+                        None => msg.finish().await,
+                    }
                     continue;
                 }
 
                 // Process the message asynchronously.
                 let h = handler.clone();
-                tokio::spawn(async move { process_message(msg, h).await });
+                let dl = dead_letter.clone();
+                tokio::spawn(async move { process_message(msg, h, max_retries, dl).await });
             }
         })
     }
 }
 
-async fn process_message(mut msg: NSQMessage, handler: Arc<SubHandler>) {
+async fn process_message(
+    mut msg: NSQMessage,
+    handler: Arc<SubHandler>,
+    max_retries: i64,
+    dead_letter: Option<Arc<DeadLetterTopic>>,
+) {
     let body: Vec<u8> = msg.body.drain(..).collect();
     let timestamp = msg.timestamp;
     let attempt = msg.attempt;
@@ -138,7 +165,7 @@ async fn process_message(mut msg: NSQMessage, handler: Arc<SubHandler>) {
         msg
     });
 
-    let result = handle_message(body, timestamp, attempt, handler).await;
+    let result = handle_message(&body, timestamp, attempt, handler).await;
 
     // Signal the touch task to stop and return the message
     let _ = stop_tx.send(());
@@ -158,21 +185,54 @@ async fn process_message(mut msg: NSQMessage, handler: Arc<SubHandler>) {
 
     match result {
         Ok(()) => msg.finish().await,
+        Err(err) => match dead_letter {
+            // This was the last attempt; forward the message to the dead letter topic.
+            Some(dl) if attempt as i64 - 1 >= max_retries => {
+                forward_dead_letter(msg, &body, &dl, Some(format!("{:#}", err))).await
+            }
+            _ => {
+                log::info!("message handler failed, requeueing message: {:?}", err);
+                msg.requeue(NSQRequeueDelay::DefaultDelay).await;
+            }
+        },
+    }
+}
+
+/// Forwards a message that has exhausted its retries to the dead letter topic.
+/// If that fails the message is requeued, to try again on the next delivery.
+async fn forward_dead_letter(
+    msg: NSQMessage,
+    body: &[u8],
+    dead_letter: &DeadLetterTopic,
+    last_error: Option<String>,
+) {
+    let publish_time = nano_timestamp(msg.timestamp);
+    match dead_letter
+        .publish(body, publish_time, msg.attempt, last_error)
+        .await
+    {
+        Ok(()) => {
+            log::warn!("depleted message retries, forwarded message to the dead letter topic");
+            msg.finish().await;
+        }
         Err(err) => {
-            log::info!("message handler failed, requeueing message: {:?}", err);
+            log::error!(
+                "failed to forward message to the dead letter topic, retrying: {:?}",
+                err
+            );
             msg.requeue(NSQRequeueDelay::DefaultDelay).await;
         }
     }
 }
 
 async fn handle_message(
-    body: Vec<u8>,
+    body: &[u8],
     timestamp: u64,
     attempt: u16,
     handler: Arc<SubHandler>,
 ) -> Result<()> {
     let encoded =
-        serde_json::from_slice::<EncodedMessage>(&body).context("failed to decode message")?;
+        serde_json::from_slice::<EncodedMessage>(body).context("failed to decode message")?;
 
     let publish_time = nano_timestamp(timestamp);
     let raw_body = serde_json::to_vec_pretty(&encoded.body).unwrap_or_default();
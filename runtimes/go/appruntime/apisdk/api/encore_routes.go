@@ -13,6 +13,26 @@ func (s *Server) registerEncoreRoutes() {
 	s.encore.HandlerFunc(wildcardMethod, "/healthz", s.handleHealthz)
 	s.encore.Handle("POST", "/pubsub/push/:subscription_id", s.handlePubsubPush)
 	s.encore.Handle("POST", "/authhandler", s.handleRemoteAuthCall)
+	s.encore.Handle(wildcardMethod, "/objects/*path", s.handleRegisteredRoute("objects"))
+	s.encore.Handle(wildcardMethod, "/sqldb/*path", s.handleRegisteredRoute("sqldb"))
+}
+
+// handleRegisteredRoute returns a handler that routes requests
+// to the handler registered for the given prefix in the route registry.
+func (s *Server) handleRegisteredRoute(prefix string) httprouter.Handle {
+	return func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+		h, ok := s.routes.Handler(prefix)
+		if !ok {
+			errs.HTTPError(w, errs.B().Code(errs.NotFound).Msg("endpoint not found").Err())
+			return
+		}
+
+		// Make the path relative to the prefix.
+		req2 := req.Clone(req.Context())
+		req2.URL.Path = ps.ByName("path")
+		req2.URL.RawPath = ""
+		h.ServeHTTP(w, req2)
+	}
 }
 
 // handleHealthz returns the current health and deployment details of the running Encore application
@@ -45,6 +65,17 @@ func (s *Server) handleHealthz(w http.ResponseWriter, req *http.Request) {
 		})
 	}
 
+	// The app isn't ready to serve traffic until its startup tasks have completed.
+	if s.starting.Load() {
+		statusStr = "starting"
+		statusCode = http.StatusServiceUnavailable
+		checkResults = append(checkResults, checkResult{
+			Name:   "startup",
+			Passed: false,
+			Error:  "the startup tasks have not completed",
+		})
+	}
+
 	w.WriteHeader(statusCode)
 	bytes, _ := jsonapi.Default.Marshal(struct {
 		Code    string `json:"code"`
@@ -640,6 +640,10 @@ func (d *Desc[Req, Resp]) runCall(c CallContext, req Req, mocked bool, executor
 		respErr = errs.Convert(err)
 		return
 	}
+	if err := c.server.injectFault(c.ctx, call); err != nil {
+		respErr = err
+		return
+	}
 
 	// Run the request in a different goroutine
 	done := make(chan struct{})
@@ -806,6 +810,10 @@ func (d *Desc[Req, Resp]) externalCall(c CallContext, service config.Service, re
 		respErr = errs.Convert(err)
 		return
 	}
+	if err := c.server.injectFault(c.ctx, call); err != nil {
+		respErr = err
+		return
+	}
 
 	if err := meta.AddToRequest(c.server, service, reqTransport); err != nil {
 		c.server.rootLogger.Err(err).Msg("unable to add metadata to request")
@@ -3,6 +3,7 @@ package api_test
 import (
 	"context"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -23,6 +24,7 @@ import (
 	"encore.dev/appruntime/exported/config"
 	"encore.dev/appruntime/exported/model"
 	"encore.dev/appruntime/exported/trace2"
+	"encore.dev/appruntime/shared/encoreroutes"
 	"encore.dev/appruntime/shared/health"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/appruntime/shared/testsupport"
@@ -407,6 +409,45 @@ func TestRawEndpointOverflow(t *testing.T) {
 	}
 }
 
+func TestServer_Starting(t *testing.T) {
+	model.EnableTestMode(t)
+	server, _, _ := testServer(t, clock.NewMock(), false)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { _ = server.Serve(ln) }()
+	t.Cleanup(func() { _ = ln.Close() })
+
+	get := func(path string) int {
+		t.Helper()
+		resp, err := http.Get("http://" + ln.Addr().String() + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// While starting the app is not ready and doesn't serve traffic.
+	server.SetStarting(true)
+	if got := get("/__encore/healthz"); got != http.StatusServiceUnavailable {
+		t.Errorf("healthz while starting: got status %d, want %d", got, http.StatusServiceUnavailable)
+	}
+	if got := get("/path/hello"); got != http.StatusServiceUnavailable {
+		t.Errorf("request while starting: got status %d, want %d", got, http.StatusServiceUnavailable)
+	}
+
+	server.SetStarting(false)
+	if got := get("/__encore/healthz"); got != http.StatusOK {
+		t.Errorf("healthz once started: got status %d, want %d", got, http.StatusOK)
+	}
+	if got := get("/path/hello"); got != http.StatusNotFound {
+		t.Errorf("request once started: got status %d, want %d", got, http.StatusNotFound)
+	}
+}
+
 func testServer(t *testing.T, klock clock.Clock, mockTraces bool) (*api.Server, *mock_trace.MockLogger, *usermetrics.Registry) {
 	ctrl := gomock.NewController(t)
 
@@ -427,10 +468,10 @@ func testServer(t *testing.T, klock clock.Clock, mockTraces bool) (*api.Server,
 	json := jsoniter.ConfigCompatibleWithStandardLibrary
 	encoreMgr := encore.NewManager(static, runtime, rt)
 	tsMgr := testsupport.NewManager(static, rt, logger)
-	pubsubMgr := pubsub.NewManager(static, runtime, rt, tsMgr, logger, json)
+	pubsubMgr := pubsub.NewManager(static, runtime, rt, tsMgr, nil, logger, json)
 	healthMgr := health.NewCheckRegistry()
 	testingMgr := testsupport.NewManager(static, rt, logger)
-	server := api.NewServer(static, runtime, rt, nil, encoreMgr, pubsubMgr, logger, metricsRegistry, healthMgr, testingMgr, json, klock)
+	server := api.NewServer(static, runtime, rt, nil, encoreMgr, pubsubMgr, logger, metricsRegistry, healthMgr, encoreroutes.NewRegistry(), testingMgr, nil, json, klock)
 	return server, traceMock, metricsRegistry
 }
 
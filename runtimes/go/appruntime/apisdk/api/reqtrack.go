@@ -10,6 +10,7 @@ import (
 	"encore.dev/appruntime/exported/model"
 	"encore.dev/appruntime/exported/stack"
 	"encore.dev/appruntime/exported/trace2"
+	"encore.dev/appruntime/infrasdk/chaos"
 	"encore.dev/beta/errs"
 )
 
@@ -377,6 +378,20 @@ func (s *Server) beginCall(ctx context.Context, serviceName, endpointName string
 	return call, meta, nil
 }
 
+// injectFault injects the faults configured with "encore chaos" into the call,
+// finishing the call if an error was injected.
+func (s *Server) injectFault(ctx context.Context, call *model.APICall) error {
+	err := s.chaosMgr.Inject(ctx, chaos.Target{
+		Kind:     chaos.API,
+		Service:  call.TargetServiceName,
+		Endpoint: call.TargetEndpointName,
+	})
+	if err != nil {
+		s.finishCall(call, err)
+	}
+	return err
+}
+
 func (s *Server) finishCall(call *model.APICall, err error) {
 	if curr := s.rt.Current(); curr.Trace != nil && call.StartEventID != 0 {
 		curr.Trace.RPCCallEnd(call, curr.Goctr, err)
@@ -11,6 +11,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/benbjohnson/clock"
 	jsoniter "github.com/json-iterator/go"
@@ -26,8 +27,10 @@ import (
 	"encore.dev/appruntime/exported/config"
 	"encore.dev/appruntime/exported/experiments"
 	"encore.dev/appruntime/exported/model"
+	"encore.dev/appruntime/infrasdk/chaos"
 	"encore.dev/appruntime/shared/cfgutil"
 	"encore.dev/appruntime/shared/cloudtrace"
+	"encore.dev/appruntime/shared/encoreroutes"
 	"encore.dev/appruntime/shared/health"
 	"encore.dev/appruntime/shared/platform"
 	"encore.dev/appruntime/shared/reqtrack"
@@ -133,10 +136,17 @@ type Server struct {
 
 	pubsubSubscriptions map[string]func(r *http.Request) error
 	healthMgr           *health.CheckRegistry
+	routes              *encoreroutes.Registry
 	testingMgr          *testsupport.Manager
+	chaosMgr            *chaos.Manager
+
+	// starting is set while the app runs its startup tasks, such as data migrations.
+	// Until they've completed the server reports that it's not ready, and only serves
+	// calls made by the app itself and by the Encore platform.
+	starting atomic.Bool
 }
 
-func NewServer(static *config.Static, runtime *config.Runtime, rt *reqtrack.RequestTracker, pc *platform.Client, encoreMgr *encore.Manager, pubsubMgr *pubsub.Manager, rootLogger zerolog.Logger, reg *metrics.Registry, healthMgr *health.CheckRegistry, testingMgr *testsupport.Manager, json jsoniter.API, clock clock.Clock) *Server {
+func NewServer(static *config.Static, runtime *config.Runtime, rt *reqtrack.RequestTracker, pc *platform.Client, encoreMgr *encore.Manager, pubsubMgr *pubsub.Manager, rootLogger zerolog.Logger, reg *metrics.Registry, healthMgr *health.CheckRegistry, routes *encoreroutes.Registry, testingMgr *testsupport.Manager, chaosMgr *chaos.Manager, json jsoniter.API, clock clock.Clock) *Server {
 	requestsTotal := metrics.NewCounterGroupInternal[requestsTotalLabels, uint64](reg, "e_requests_total", metrics.CounterConfig{
 		EncoreInternal_LabelMapper: func(labels requestsTotalLabels) []metrics.KeyValue {
 			return []metrics.KeyValue{
@@ -167,7 +177,9 @@ func NewServer(static *config.Static, runtime *config.Runtime, rt *reqtrack.Requ
 		encoreMgr:           encoreMgr,
 		pubsubMgr:           pubsubMgr,
 		healthMgr:           healthMgr,
+		routes:              routes,
 		testingMgr:          testingMgr,
+		chaosMgr:            chaosMgr,
 		requestsTotal:       requestsTotal,
 		httpClient:          &http.Client{},
 		clock:               clock,
@@ -404,6 +416,11 @@ func (s *Server) getGlobalMiddleware(ids []string) []*Middleware {
 	return result
 }
 
+// SetStarting sets whether the app is running its startup tasks.
+func (s *Server) SetStarting(starting bool) {
+	s.starting.Store(starting)
+}
+
 func (s *Server) Serve(ln net.Listener) error {
 	if s.runtime.EnvCloud != "local" || s.IsGateway() {
 		s.rootLogger.Trace().Msg("listening for incoming HTTP requests")
@@ -463,9 +480,16 @@ func (s *Server) handler(w http.ResponseWriter, req *http.Request) {
 	}
 
 	path := determineRequestPath(req.URL)
+	const internalPrefix = "/__encore"
+
+	// Don't serve traffic until the startup tasks have completed,
+	// apart from calls made by the app itself, such as from data migrations.
+	if s.starting.Load() && !strings.HasPrefix(path, internalPrefix+"/") && !servedWhileStarting(req, internalCaller) {
+		errs.HTTPError(w, errs.B().Code(errs.Unavailable).Msg("the app is starting").Err())
+		return
+	}
 
 	// Switch to the Encore internal router if we are on the Encore internal path
-	const internalPrefix = "/__encore"
 	if strings.HasPrefix(path, internalPrefix+"/") {
 		router, fallbackRouter = s.encore, nil
 		path = path[len(internalPrefix):] // keep leading slash
@@ -510,6 +534,21 @@ func (s *Server) handler(w http.ResponseWriter, req *http.Request) {
 	errs.HTTPError(w, errs.B().Code(errs.NotFound).Msg("endpoint not found").Err())
 }
 
+// servedWhileStarting reports whether a request is served while the app
+// runs its startup tasks: calls from the app's services and the Encore platform are,
+// while requests coming through the gateway are not.
+func servedWhileStarting(req *http.Request, internalCaller Caller) bool {
+	if platformauth.IsEncorePlatformRequest(req.Context()) {
+		return true
+	}
+	switch internalCaller.(type) {
+	case nil, GatewayCaller:
+		return false
+	default:
+		return true
+	}
+}
+
 func (s *Server) extractCallMeta(w http.ResponseWriter, req *http.Request) (updatedReq *http.Request, internalCaller Caller, ok bool) {
 	// Extract the metadata from the request so we can allow access to the private router.
 	// If the metadata is not present, then we assume this is a public request.
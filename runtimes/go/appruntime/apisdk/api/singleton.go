@@ -6,7 +6,9 @@ import (
 	"github.com/benbjohnson/clock"
 
 	encore "encore.dev"
+	"encore.dev/appruntime/infrasdk/chaos"
 	"encore.dev/appruntime/shared/appconf"
+	"encore.dev/appruntime/shared/encoreroutes"
 	"encore.dev/appruntime/shared/health"
 	"encore.dev/appruntime/shared/jsonapi"
 	"encore.dev/appruntime/shared/logging"
@@ -20,6 +22,6 @@ import (
 var Singleton = NewServer(
 	appconf.Static, appconf.Runtime, reqtrack.Singleton, platform.Singleton,
 	encore.Singleton, pubsub.Singleton, logging.RootLogger, metrics.Singleton,
-	health.Singleton, testsupport.Singleton,
-	jsonapi.Default, clock.New(),
+	health.Singleton, encoreroutes.Singleton, testsupport.Singleton,
+	chaos.Singleton, jsonapi.Default, clock.New(),
 )
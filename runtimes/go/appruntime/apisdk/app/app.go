@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+
 	"github.com/rs/zerolog"
 	"go.uber.org/automaxprocs/maxprocs"
 
@@ -8,6 +10,7 @@ import (
 	"encore.dev/appruntime/apisdk/service"
 	"encore.dev/appruntime/exported/config"
 	"encore.dev/appruntime/shared/shutdown"
+	"encore.dev/appruntime/shared/startup"
 
 	// Initialize the metric subsystem
 	_ "encore.dev/appruntime/infrasdk/metrics"
@@ -18,15 +21,17 @@ type App struct {
 	service  *service.Manager
 	api      *api.Server
 	shutdown *shutdown.Tracker
+	startup  *startup.Registry
 	logger   zerolog.Logger
 }
 
-func New(runtime *config.Runtime, service *service.Manager, api *api.Server, shutdown *shutdown.Tracker, logger zerolog.Logger) *App {
+func New(runtime *config.Runtime, service *service.Manager, api *api.Server, shutdown *shutdown.Tracker, startup *startup.Registry, logger zerolog.Logger) *App {
 	app := &App{
 		runtime:  runtime,
 		service:  service,
 		api:      api,
 		shutdown: shutdown,
+		startup:  startup,
 		logger:   logger,
 	}
 
@@ -61,6 +66,9 @@ func (app *App) Run() error {
 
 	app.Start()
 
+	// Don't serve traffic until the startup tasks have completed.
+	app.api.SetStarting(true)
+
 	// Begin serving requests.
 	serveCh := make(chan error, 1)
 	go func() {
@@ -72,6 +80,15 @@ func (app *App) Run() error {
 		return err
 	}
 
+	// Run the startup tasks, such as data migrations, once the services have been
+	// initialized so they can call the app's APIs. Until they complete, the app
+	// reports that it's not ready and only serves calls made by the app itself.
+	if err := app.startup.RunAll(context.Background()); err != nil {
+		app.shutdown.Shutdown(nil, err)
+		return err
+	}
+	app.api.SetStarting(false)
+
 	// Wait for the Serve to return before triggering shutdown.
 	serveErr := <-serveCh
 
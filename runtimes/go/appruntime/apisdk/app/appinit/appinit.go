@@ -11,11 +11,12 @@ import (
 	"encore.dev/appruntime/shared/appconf"
 	"encore.dev/appruntime/shared/logging"
 	"encore.dev/appruntime/shared/shutdown"
+	"encore.dev/appruntime/shared/startup"
 )
 
 // AppMain is the entrypoint to the Encore Application.
 func AppMain() {
-	inst := app.New(appconf.Runtime, service.Singleton, api.Singleton, shutdown.Singleton, logging.RootLogger)
+	inst := app.New(appconf.Runtime, service.Singleton, api.Singleton, shutdown.Singleton, startup.Singleton, logging.RootLogger)
 	if err := inst.Run(); err != nil && err != io.EOF {
 		logging.RootLogger.Fatal().Err(err).Msg("could not run")
 	}
@@ -36,9 +36,11 @@ func Options(cfg *config.CORS, staticAllowedHeaders, staticExposedHeaders []stri
 	hasUnsafeWildcardOriginWithCreds := sortedSliceContains(originsCreds, config.UnsafeAllOriginWithCredentials)
 
 	// allowedHeaders are the headers allowed through CORS.
+	// Traceparent is set by the generated clients to propagate the trace context.
 	allowedHeaders := []string{
 		"Authorization",
 		"Content-Type",
+		"Traceparent",
 		"User-Agent",
 		"X-Request-ID",
 		"X-Correlation-ID",
@@ -116,6 +116,12 @@ func TestOptions(t *testing.T) {
 			goodHeaders: []string{"Not-Authorization", "Content-Type", "Origin", "X-Forwarded-For", "X-Real-Ip", "X-Requested-With", "X-Evil-Header"},
 			badHeaders:  []string{"Authorization"},
 		},
+		{
+			// The generated clients set the traceparent header on every request.
+			name:        "trace_context_headers",
+			cfg:         config.CORS{},
+			goodHeaders: []string{"Content-Type", "Traceparent"},
+		},
 		{
 			name:        "static_headers",
 			cfg:         config.CORS{},
@@ -0,0 +1,134 @@
+// Package chaos defines the fault injection rules configured
+// with "encore chaos", which are shared between the CLI and the app runtime.
+package chaos
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// EnvName is the environment variable holding the path to the rules file
+// of a locally running app.
+const EnvName = "ENCORE_CHAOS_CONFIG"
+
+// Kind is a kind of operation faults can be injected into.
+type Kind string
+
+const (
+	API    Kind = "api"    // service-to-service API calls
+	SQLDB  Kind = "sqldb"  // SQL database queries and transactions
+	Cache  Kind = "cache"  // cache operations
+	Bucket Kind = "bucket" // object storage operations
+	PubSub Kind = "pubsub" // pub/sub publishes
+)
+
+// Kinds are all the valid kinds.
+var Kinds = []Kind{API, SQLDB, Cache, Bucket, PubSub}
+
+// Valid reports whether k is a known kind.
+func (k Kind) Valid() bool {
+	for _, kind := range Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Config is the set of fault injection rules of an app.
+type Config struct {
+	Rules []*Rule `json:"rules"`
+}
+
+// Rule injects latency and errors into the operations it matches.
+//
+// Empty matchers match anything, so a rule with only a Kind
+// applies to all operations of that kind.
+type Rule struct {
+	ID   string `json:"id"`
+	Kind Kind   `json:"kind,omitempty"`
+
+	// Service is the service being called for API calls,
+	// and the service performing the operation otherwise.
+	Service string `json:"service,omitempty"`
+
+	// Endpoint is the endpoint being called. It only applies to API calls.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Resource is the name of the database, cache cluster, bucket or topic.
+	Resource string `json:"resource,omitempty"`
+
+	// Latency is added before the operation runs.
+	Latency time.Duration `json:"latency,omitempty"`
+
+	// ErrorRate is the fraction of operations, between 0 and 1,
+	// that fail with an injected error instead of running.
+	ErrorRate float64 `json:"error_rate,omitempty"`
+}
+
+// Target describes an operation faults may be injected into.
+type Target struct {
+	Kind     Kind
+	Service  string
+	Endpoint string
+	Resource string
+}
+
+// Validate reports whether the rule is well-formed.
+func (r *Rule) Validate() error {
+	switch {
+	case r.Kind != "" && !r.Kind.Valid():
+		return fmt.Errorf("unknown kind %q", r.Kind)
+	case r.Endpoint != "" && r.Kind != API:
+		return errors.New("endpoint can only be set for api rules")
+	case r.Resource != "" && r.Kind == API:
+		return errors.New("resource cannot be set for api rules")
+	case r.Latency < 0:
+		return errors.New("latency must not be negative")
+	case r.ErrorRate < 0 || r.ErrorRate > 1:
+		return errors.New("error rate must be between 0 and 1")
+	case r.Latency == 0 && r.ErrorRate == 0:
+		return errors.New("rule must inject latency, errors, or both")
+	}
+	return nil
+}
+
+// Matches reports whether the rule applies to t.
+func (r *Rule) Matches(t Target) bool {
+	return (r.Kind == "" || r.Kind == t.Kind) &&
+		(r.Service == "" || r.Service == t.Service) &&
+		(r.Endpoint == "" || r.Endpoint == t.Endpoint) &&
+		(r.Resource == "" || r.Resource == t.Resource)
+}
+
+// Match returns the first rule that applies to t, or nil if none do.
+func (c *Config) Match(t Target) *Rule {
+	if c == nil {
+		return nil
+	}
+	for _, r := range c.Rules {
+		if r.Matches(t) {
+			return r
+		}
+	}
+	return nil
+}
+
+// Load reads the rules from the file at path.
+// A missing file is treated as an empty set of rules.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Config{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parse chaos config: %w", err)
+	}
+	return &cfg, nil
+}
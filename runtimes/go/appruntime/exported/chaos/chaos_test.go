@@ -0,0 +1,32 @@
+package chaos
+
+import (
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestRuleValidate(t *testing.T) {
+	c := qt.New(t)
+	tests := []struct {
+		rule Rule
+		err  string
+	}{
+		{Rule{Kind: API, Service: "svc", Endpoint: "Foo", Latency: time.Second}, ""},
+		{Rule{ErrorRate: 0.5}, ""},
+		{Rule{Kind: "queue", ErrorRate: 1}, `unknown kind "queue"`},
+		{Rule{Kind: SQLDB, Endpoint: "Foo", ErrorRate: 1}, "endpoint can only be set for api rules"},
+		{Rule{Kind: API, Resource: "db", ErrorRate: 1}, "resource cannot be set for api rules"},
+		{Rule{ErrorRate: 1.5}, "error rate must be between 0 and 1"},
+		{Rule{Kind: Cache}, "rule must inject latency, errors, or both"},
+	}
+	for _, test := range tests {
+		err := test.rule.Validate()
+		if test.err == "" {
+			c.Check(err, qt.IsNil)
+		} else {
+			c.Check(err, qt.ErrorMatches, test.err)
+		}
+	}
+}
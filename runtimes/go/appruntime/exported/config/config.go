@@ -297,6 +297,11 @@ type PubsubSubscription struct {
 	ProviderName string `json:"provider_name"` // the name for the pubsub subscription as defined by the provider
 	PushOnly     bool   `json:"push_only"`     // if true the application will not actively subscribe to the pub, but instead will rely on HTTP push messages
 
+	// DeadLetter is the provider name of the topic (or queue, for AWS) that messages
+	// are forwarded to once they have exhausted their retries.
+	// If empty, the provider's own behavior applies; NSQ drops such messages.
+	DeadLetter string `json:"dead_letter,omitempty"`
+
 	// GCP contains GCP-specific configuration.
 	// It is set if the subscription exists in GCP.
 	GCP *PubsubSubscriptionGCPData `json:"gcp,omitempty"`
@@ -340,6 +345,10 @@ type SQLServer struct {
 	ClientCert string `json:"client_cert,omitempty"`
 	// ClientKey is the PEM-encoded client key, or "" if not required.
 	ClientKey string `json:"client_key,omitempty"`
+
+	// ReadReplicas are the hosts of read replicas of the server,
+	// in the same formats as Host. They share the server's TLS config and credentials.
+	ReadReplicas []string `json:"read_replicas,omitempty"`
 }
 
 type SQLDatabase struct {
@@ -405,8 +414,10 @@ type RedisDatabase struct {
 }
 
 type BucketProvider struct {
-	S3  *S3BucketProvider  `json:"s3,omitempty"`  // set if the provider is S3
-	GCS *GCSBucketProvider `json:"gcs,omitempty"` // set if the provider is GCS
+	S3         *S3BucketProvider         `json:"s3,omitempty"`         // set if the provider is S3
+	GCS        *GCSBucketProvider        `json:"gcs,omitempty"`        // set if the provider is GCS
+	Azure      *AzureBlobBucketProvider  `json:"azure,omitempty"`      // set if the provider is Azure Blob Storage
+	Filesystem *FilesystemBucketProvider `json:"filesystem,omitempty"` // set if the provider is the local filesystem
 }
 
 type S3BucketProvider struct {
@@ -429,6 +440,31 @@ type GCSBucketProvider struct {
 	LocalSign *GCSLocalSignOptions `json:"local_sign,omitempty"`
 }
 
+type AzureBlobBucketProvider struct {
+	// The name of the storage account the containers belong to.
+	StorageAccount string `json:"storage_account"`
+
+	// The endpoint to use. If nil, the default endpoint for the storage account is used.
+	Endpoint *string `json:"endpoint"`
+
+	// The shared key to authenticate with.
+	// If nil, the default Azure credentials are used.
+	AccountKey *string `json:"account_key"`
+}
+
+type FilesystemBucketProvider struct {
+	// The directory objects are stored in.
+	// Each bucket is stored in a subdirectory named after the bucket's cloud name.
+	BaseDir string `json:"base_dir"`
+
+	// The base URL signed URLs are generated for, typically the
+	// public URL of the app. If empty, the app's API base URL is used.
+	SignedURLBaseURL string `json:"signed_url_base_url"`
+
+	// The key used to sign URLs.
+	SigningKey string `json:"signing_key"`
+}
+
 type GCSLocalSignOptions struct {
 	BaseURL    string `json:"base_url"`
 	AccessID   string `json:"access_id"`
@@ -444,6 +480,15 @@ type Bucket struct {
 	// The public base url for the bucket.
 	// Only set if the bucket is public.
 	PublicBaseURL string `json:"public_base_url"`
+
+	// Whether the bucket keeps old versions of objects.
+	// Only used by providers that implement versioning
+	// themselves, such as the filesystem provider.
+	Versioned bool `json:"versioned,omitempty"`
+
+	// EventTopics are the Encore names of the pub/sub topics the provider
+	// is configured to deliver the bucket's object events to, at startup.
+	EventTopics []string `json:"event_topics,omitempty"`
 }
 
 type Metrics struct {
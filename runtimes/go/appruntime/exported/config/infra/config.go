@@ -38,9 +38,11 @@ type InfraConfig struct {
 }
 
 type ObjectStorage struct {
-	Type string `json:"type"`
-	GCS  *GCS   `json:"gcs,omitempty"`
-	S3   *S3    `json:"s3,omitempty"`
+	Type       string      `json:"type"`
+	GCS        *GCS        `json:"gcs,omitempty"`
+	S3         *S3         `json:"s3,omitempty"`
+	Azure      *AzureBlob  `json:"azure,omitempty"`
+	Filesystem *Filesystem `json:"filesystem,omitempty"`
 }
 
 func (o *ObjectStorage) GetBuckets() map[string]*Bucket {
@@ -49,6 +51,10 @@ func (o *ObjectStorage) GetBuckets() map[string]*Bucket {
 		return o.GCS.Buckets
 	case "s3":
 		return o.S3.Buckets
+	case "azure":
+		return o.Azure.Buckets
+	case "filesystem":
+		return o.Filesystem.Buckets
 	default:
 		panic("unsupported object storage type")
 	}
@@ -60,6 +66,10 @@ func (o *ObjectStorage) DeleteBucket(name string) {
 		delete(o.GCS.Buckets, name)
 	case "s3":
 		delete(o.S3.Buckets, name)
+	case "azure":
+		delete(o.Azure.Buckets, name)
+	case "filesystem":
+		delete(o.Filesystem.Buckets, name)
 	default:
 		panic("unsupported object storage type")
 	}
@@ -67,12 +77,16 @@ func (o *ObjectStorage) DeleteBucket(name string) {
 }
 
 func (a *ObjectStorage) Validate(v *validator) {
-	v.ValidateField("Type", OneOf(a.Type, "gcs", "s3"))
+	v.ValidateField("Type", OneOf(a.Type, "gcs", "s3", "azure", "filesystem"))
 	switch a.Type {
 	case "gcs":
 		a.GCS.Validate(v)
 	case "s3":
 		a.S3.Validate(v)
+	case "azure":
+		a.Azure.Validate(v)
+	case "filesystem":
+		a.Filesystem.Validate(v)
 	default:
 		v.ValidateField("type", Err("unsupported object storage type"))
 	}
@@ -99,6 +113,18 @@ func (p *ObjectStorage) MarshalJSON() ([]byte, error) {
 				m[k] = v
 			}
 		}
+	case "azure":
+		if p.Azure != nil {
+			for k, v := range structToMap(p.Azure) {
+				m[k] = v
+			}
+		}
+	case "filesystem":
+		if p.Filesystem != nil {
+			for k, v := range structToMap(p.Filesystem) {
+				m[k] = v
+			}
+		}
 	default:
 		return nil, errors.New("unsupported object storage type")
 	}
@@ -133,6 +159,18 @@ func (p *ObjectStorage) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		p.S3 = &a
+	case "azure":
+		var a AzureBlob
+		if err := json.Unmarshal(data, &a); err != nil {
+			return err
+		}
+		p.Azure = &a
+	case "filesystem":
+		var f Filesystem
+		if err := json.Unmarshal(data, &f); err != nil {
+			return err
+		}
+		p.Filesystem = &f
 	default:
 		return errors.New("unsupported object storage type")
 	}
@@ -167,14 +205,73 @@ func (a *GCS) Validate(v *validator) {
 	ValidateChildMap(v, "buckets", a.Buckets)
 }
 
+type AzureBlob struct {
+	StorageAccount string    `json:"storage_account"`
+	Endpoint       string    `json:"endpoint,omitempty"`
+	AccountKey     EnvString `json:"account_key,omitempty"`
+
+	Buckets map[string]*Bucket `json:"buckets,omitempty"`
+}
+
+func (a *AzureBlob) Validate(v *validator) {
+	v.ValidateField("storage_account", NotZero(a.StorageAccount))
+	if a.AccountKey.IsEnvRef() {
+		v.ValidateEnvString("account_key", a.AccountKey, "Azure Storage Account Key", NotZero[string])
+	}
+	ValidateChildMap(v, "buckets", a.Buckets)
+}
+
+type Filesystem struct {
+	BaseDir          string    `json:"base_dir"`
+	SignedURLBaseURL string    `json:"signed_url_base_url,omitempty"`
+	SigningKey       EnvString `json:"signing_key,omitempty"`
+
+	Buckets map[string]*Bucket `json:"buckets,omitempty"`
+}
+
+func (a *Filesystem) Validate(v *validator) {
+	v.ValidateField("base_dir", NotZero(a.BaseDir))
+	v.ValidateField("signed_url_base_url", func() error {
+		if a.SignedURLBaseURL != "" {
+			if _, err := url.Parse(a.SignedURLBaseURL); err != nil {
+				return fmt.Errorf("Not a valid URL: %v", err)
+			}
+		}
+		return nil
+	})
+	if a.SigningKey.IsEnvRef() {
+		v.ValidateEnvString("signing_key", a.SigningKey, "Filesystem Signing Key", NotZero[string])
+	}
+	ValidateChildMap(v, "buckets", a.Buckets)
+}
+
 type Bucket struct {
 	Name          string `json:"name,omitempty"`
 	KeyPrefix     string `json:"key_prefix,omitempty"`
 	PublicBaseURL string `json:"public_base_url,omitempty"`
+
+	// EventTopics are the names of the pub/sub topics to deliver the bucket's
+	// object events to. The application configures the provider to deliver
+	// them at startup, unless it already does.
+	EventTopics []string `json:"event_topics,omitempty"`
+
+	// Versioned is whether the bucket keeps old versions of objects.
+	// It's set automatically from the bucket declaration and only used
+	// by the filesystem provider; other providers configure versioning
+	// on the bucket itself.
+	Versioned bool `json:"versioned,omitempty"`
 }
 
 func (a *Bucket) Validate(v *validator) {
 	v.ValidateField("name", NotZero(a.Name))
+	v.ValidateField("event_topics", func() error {
+		for _, topic := range a.EventTopics {
+			if topic == "" {
+				return fmt.Errorf("Topic names must not be empty")
+			}
+		}
+		return nil
+	})
 
 	v.ValidateField("public_base_url", func() error {
 		if a.PublicBaseURL != "" {
@@ -462,6 +559,10 @@ type SQLServer struct {
 	Host      string                  `json:"host,omitempty"`
 	TLSConfig *TLSConfig              `json:"tls_config,omitempty"`
 	Databases map[string]*SQLDatabase `json:"databases,omitempty"`
+
+	// ReadReplicas are the hosts of read replicas of the server.
+	// They are connected to using the same TLS config and credentials as the server.
+	ReadReplicas []string `json:"read_replicas,omitempty"`
 }
 
 func (s *SQLServer) Validate(v *validator) {
@@ -651,6 +752,9 @@ type GCPSub struct {
 	Name       string      `json:"name,omitempty"`
 	ProjectID  string      `json:"project_id,omitempty"`
 	PushConfig *PushConfig `json:"push_config,omitempty"`
+	// DeadLetterTopic is the name of the topic that messages are forwarded to
+	// once they have exhausted their retries.
+	DeadLetterTopic string `json:"dead_letter_topic,omitempty"`
 }
 
 func (g *GCPSub) Validate(v *validator) {
@@ -713,6 +817,9 @@ func (a *AWSTopic) DeleteSubscription(name string) {
 
 type AWSSub struct {
 	URL string `json:"url,omitempty"`
+	// DeadLetterQueueURL is the URL of the SQS queue that messages are forwarded to
+	// once they have exhausted their retries.
+	DeadLetterQueueURL string `json:"dead_letter_queue_url,omitempty"`
 }
 
 func (a *AWSSub) Validate(v *validator) {
@@ -762,6 +869,9 @@ func (n *NSQTopic) DeleteSubscription(name string) {
 
 type NSQSub struct {
 	Name string `json:"name,omitempty"`
+	// DeadLetterTopic is the name of the topic that messages are forwarded to
+	// once they have exhausted their retries.
+	DeadLetterTopic string `json:"dead_letter_topic,omitempty"`
 }
 
 func (n *NSQSub) Validate(v *validator) {
@@ -230,7 +230,8 @@ func parseInfraConfigEnv(infraCfgPath string) *Runtime {
 	cfg.SQLServers = make([]*SQLServer, len(infraCfg.SQLServers))
 	for i, sqlServer := range infraCfg.SQLServers {
 		cfg.SQLServers[i] = &SQLServer{
-			Host: sqlServer.Host,
+			Host:         sqlServer.Host,
+			ReadReplicas: sqlServer.ReadReplicas,
 		}
 		if sqlServer.TLSConfig != nil {
 			cfg.SQLServers[i].ServerCACert = sqlServer.TLSConfig.CA
@@ -345,6 +346,7 @@ func parseInfraConfigEnv(infraCfgPath string) *Runtime {
 						EncoreName:   subName,
 						ProviderName: subscription.Name,
 						PushOnly:     subscription.PushConfig != nil,
+						DeadLetter:   subscription.DeadLetterTopic,
 						GCP:          &PubsubSubscriptionGCPData{ProjectID: orDefault(subscription.ProjectID, pubsub.GCP.ProjectID)},
 					}
 					if subscription.PushConfig != nil {
@@ -357,12 +359,14 @@ func parseInfraConfigEnv(infraCfgPath string) *Runtime {
 						EncoreName:   subName,
 						ProviderName: subscription.URL,
 						PushOnly:     false,
+						DeadLetter:   subscription.DeadLetterQueueURL,
 					}
 				case *infra.NSQSub:
 					cfg.PubsubTopics[topicName].Subscriptions[subName] = &PubsubSubscription{
 						EncoreName:   subName,
 						ProviderName: subscription.Name,
 						PushOnly:     false,
+						DeadLetter:   subscription.DeadLetterTopic,
 					}
 				}
 			}
@@ -399,8 +403,26 @@ func parseInfraConfigEnv(infraCfgPath string) *Runtime {
 					SecretAccessKey: nilOr(storage.S3.SecretAccessKey.Value()),
 				},
 			}
+		case "azure":
+			cfg.BucketProviders[i] = &BucketProvider{
+				Azure: &AzureBlobBucketProvider{
+					StorageAccount: storage.Azure.StorageAccount,
+					Endpoint:       nilOr(storage.Azure.Endpoint),
+					AccountKey:     nilOr(storage.Azure.AccountKey.Value()),
+				},
+			}
+		case "filesystem":
+			cfg.BucketProviders[i] = &BucketProvider{
+				Filesystem: &FilesystemBucketProvider{
+					BaseDir:          storage.Filesystem.BaseDir,
+					SignedURLBaseURL: storage.Filesystem.SignedURLBaseURL,
+					SigningKey:       storage.Filesystem.SigningKey.Value(),
+				},
+			}
+		}
+		if cfg.Buckets == nil {
+			cfg.Buckets = map[string]*Bucket{}
 		}
-		cfg.Buckets = map[string]*Bucket{}
 		for bucketName, bucket := range storage.GetBuckets() {
 			cfg.Buckets[bucketName] = &Bucket{
 				ProviderID:    i,
@@ -408,6 +430,8 @@ func parseInfraConfigEnv(infraCfgPath string) *Runtime {
 				CloudName:     bucket.Name,
 				KeyPrefix:     bucket.KeyPrefix,
 				PublicBaseURL: bucket.PublicBaseURL,
+				Versioned:     bucket.Versioned,
+				EventTopics:   bucket.EventTopics,
 			}
 		}
 	}
@@ -191,6 +191,7 @@ type TestConfig struct {
 
 	ServiceMocks     map[string]ServiceMock
 	APIMocks         map[string]map[string]ApiMock
+	ExternalMocks    map[string]any       // Mocks of external API clients, keyed by API name
 	IsolatedServices *bool                // Whether to isolate services for this test
 	EndCallbacks     []func(t *testing.T) // Callbacks to run when the test ends
 }
@@ -51,6 +51,11 @@ const (
 	BucketListObjectsEnd      EventType = 0x20
 	BucketDeleteObjectsStart  EventType = 0x21
 	BucketDeleteObjectsEnd    EventType = 0x22
+	FaultInjected             EventType = 0x23
+	BucketObjectCopyStart     EventType = 0x24
+	BucketObjectCopyEnd       EventType = 0x25
+	BucketObjectMoveStart     EventType = 0x26
+	BucketObjectMoveEnd       EventType = 0x27
 )
 
 func (te EventType) String() string {
@@ -123,6 +128,16 @@ func (te EventType) String() string {
 		return "BucketDeleteObjectsStart"
 	case BucketDeleteObjectsEnd:
 		return "BucketDeleteObjectsEnd"
+	case FaultInjected:
+		return "FaultInjected"
+	case BucketObjectCopyStart:
+		return "BucketObjectCopyStart"
+	case BucketObjectCopyEnd:
+		return "BucketObjectCopyEnd"
+	case BucketObjectMoveStart:
+		return "BucketObjectMoveStart"
+	case BucketObjectMoveEnd:
+		return "BucketObjectMoveEnd"
 
 	default:
 		return fmt.Sprintf("Unknown(%x)", byte(te))
@@ -489,6 +504,9 @@ type DBQueryStartParams struct {
 	TxStartID EventID // zero if not in a transaction
 	Stack     stack.Stack
 	Query     string
+
+	Host    string // the database server the query is sent to, or "" if unknown
+	Replica bool   // whether the query is sent to a read replica
 }
 
 func (l *Log) DBQueryStart(p DBQueryStartParams) EventID {
@@ -500,6 +518,8 @@ func (l *Log) DBQueryStart(p DBQueryStartParams) EventID {
 
 	tb.String(p.Query)
 	tb.Stack(p.Stack)
+	tb.String(p.Host)
+	tb.Bool(p.Replica)
 
 	return l.Add(Event{
 		Type:    DBQueryStart,
@@ -1049,6 +1069,123 @@ func (l *Log) BucketDeleteObjectsEnd(p BucketDeleteObjectsEndParams) {
 	})
 }
 
+type BucketObjectCopyStartParams struct {
+	EventParams
+	Bucket     string
+	Src        string
+	Dst        string
+	SrcVersion *string
+	Stack      stack.Stack
+}
+
+func (l *Log) BucketObjectCopyStart(p BucketObjectCopyStartParams) EventID {
+	return l.bucketObjectCopyStart(BucketObjectCopyStart, p)
+}
+
+type BucketObjectCopyEndParams struct {
+	EventParams
+	StartID EventID
+
+	Err error
+	// Set iff err == nil
+	Attrs *BucketObjectAttributes
+}
+
+func (l *Log) BucketObjectCopyEnd(p BucketObjectCopyEndParams) {
+	l.bucketObjectCopyEnd(BucketObjectCopyEnd, p)
+}
+
+// BucketObjectMoveStartParams are the parameters of a move,
+// which are the same as those of a copy.
+type BucketObjectMoveStartParams = BucketObjectCopyStartParams
+
+func (l *Log) BucketObjectMoveStart(p BucketObjectMoveStartParams) EventID {
+	return l.bucketObjectCopyStart(BucketObjectMoveStart, p)
+}
+
+type BucketObjectMoveEndParams = BucketObjectCopyEndParams
+
+func (l *Log) BucketObjectMoveEnd(p BucketObjectMoveEndParams) {
+	l.bucketObjectCopyEnd(BucketObjectMoveEnd, p)
+}
+
+func (l *Log) bucketObjectCopyStart(typ EventType, p BucketObjectCopyStartParams) EventID {
+	tb := l.newEvent(eventData{
+		Common:     p.EventParams,
+		ExtraSpace: len(p.Bucket) + len(p.Src) + len(p.Dst) + 64,
+	})
+
+	tb.String(p.Bucket)
+	tb.String(p.Src)
+	tb.String(p.Dst)
+	tb.OptString(p.SrcVersion)
+	tb.Stack(p.Stack)
+
+	return l.Add(Event{
+		Type:    typ,
+		TraceID: p.TraceID,
+		SpanID:  p.SpanID,
+		Data:    tb,
+	})
+}
+
+func (l *Log) bucketObjectCopyEnd(typ EventType, p BucketObjectCopyEndParams) {
+	tb := l.newEvent(eventData{
+		Common:             p.EventParams,
+		CorrelationEventID: p.StartID,
+		ExtraSpace:         4 + 4 + 8,
+	})
+
+	tb.ErrWithStack(p.Err)
+	if p.Err == nil {
+		tb.bucketObjectAttrs(p.Attrs)
+	}
+
+	l.Add(Event{
+		Type:    typ,
+		TraceID: p.TraceID,
+		SpanID:  p.SpanID,
+		Data:    tb,
+	})
+}
+
+type FaultInjectedParams struct {
+	EventParams
+
+	// Kind is the kind of operation the fault was injected into,
+	// such as "api" or "sqldb".
+	Kind     string
+	Service  string
+	Endpoint string
+	Resource string
+
+	Latency time.Duration
+	Err     error // the injected error, if any
+	Stack   stack.Stack
+}
+
+func (l *Log) FaultInjected(p FaultInjectedParams) {
+	tb := l.newEvent(eventData{
+		Common:     p.EventParams,
+		ExtraSpace: len(p.Kind) + len(p.Service) + len(p.Endpoint) + len(p.Resource) + 64,
+	})
+
+	tb.String(p.Kind)
+	tb.String(p.Service)
+	tb.String(p.Endpoint)
+	tb.String(p.Resource)
+	tb.Duration(p.Latency)
+	tb.ErrWithStack(p.Err)
+	tb.Stack(p.Stack)
+
+	l.Add(Event{
+		Type:    FaultInjected,
+		TraceID: p.TraceID,
+		SpanID:  p.SpanID,
+		Data:    tb,
+	})
+}
+
 func (l *Log) logHeaders(tb *EventBuffer, headers http.Header, scrubHeaders map[string]bool) {
 	tb.UVarint(uint64(len(headers)))
 	for k, v := range headers {
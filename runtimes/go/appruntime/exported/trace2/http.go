@@ -25,10 +25,11 @@ func (l *Log) HTTPBeginRoundTrip(httpReq *http.Request, req *model.Request, goid
 	}
 
 	requestURL := httpReq.URL.String()
+	op, _ := httpReq.Context().Value(externalOpKey).(ExternalOperation)
 
 	tb := l.newEvent(eventData{
 		Common:     EventParams{Goid: goid},
-		ExtraSpace: 8 + len(httpReq.Method) + len(requestURL) + 4,
+		ExtraSpace: 8 + len(httpReq.Method) + len(requestURL) + len(op.API) + len(op.Operation) + 4,
 	})
 
 	tb.Bytes(callCorrelationParentSpanID[:])
@@ -36,6 +37,8 @@ func (l *Log) HTTPBeginRoundTrip(httpReq *http.Request, req *model.Request, goid
 	tb.String(requestURL)
 	tb.Stack(stack.Build(4))
 	tb.Int64(nanotime())
+	tb.String(op.API)
+	tb.String(op.Operation)
 
 	eventID := l.Add(Event{
 		Type:    HTTPCallStart,
@@ -103,6 +106,19 @@ func (l *Log) HTTPCompleteRoundTrip(req *http.Request, resp *http.Response, goid
 	}
 }
 
+// ExternalOperation identifies an operation of an external API,
+// called using a client generated by "encore gen external".
+type ExternalOperation struct {
+	API       string // the name of the external API
+	Operation string // the OpenAPI operation id
+}
+
+// WithExternalOperation returns a copy of ctx that causes HTTP calls
+// made with it to be traced as calls to the given external operation.
+func WithExternalOperation(ctx context.Context, op ExternalOperation) context.Context {
+	return context.WithValue(ctx, externalOpKey, op)
+}
+
 type httpRoundTrip struct {
 	TraceID                 model.TraceID
 	SpanID                  model.SpanID
@@ -369,4 +385,5 @@ type contextKey int
 
 const (
 	rtKey contextKey = iota
+	externalOpKey
 )
@@ -55,4 +55,10 @@ type Logger interface {
 	BucketListObjectsEnd(BucketListObjectsEndParams)
 	BucketDeleteObjectsStart(BucketDeleteObjectsStartParams) EventID
 	BucketDeleteObjectsEnd(BucketDeleteObjectsEndParams)
+	BucketObjectCopyStart(BucketObjectCopyStartParams) EventID
+	BucketObjectCopyEnd(BucketObjectCopyEndParams)
+	BucketObjectMoveStart(BucketObjectMoveStartParams) EventID
+	BucketObjectMoveEnd(BucketObjectMoveEndParams)
+
+	FaultInjected(FaultInjectedParams)
 }
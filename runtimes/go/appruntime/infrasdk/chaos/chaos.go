@@ -0,0 +1,150 @@
+// Package chaos injects the faults configured with "encore chaos"
+// into the operations of a locally running app.
+package chaos
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"encore.dev/appruntime/exported/chaos"
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/exported/stack"
+	"encore.dev/appruntime/exported/trace2"
+	"encore.dev/appruntime/shared/cloud"
+	"encore.dev/appruntime/shared/encoreenv"
+	"encore.dev/appruntime/shared/reqtrack"
+	"encore.dev/beta/errs"
+)
+
+// Target describes an operation faults may be injected into.
+type Target = chaos.Target
+
+// The kinds of operations faults can be injected into.
+const (
+	API    = chaos.API
+	SQLDB  = chaos.SQLDB
+	Cache  = chaos.Cache
+	Bucket = chaos.Bucket
+	PubSub = chaos.PubSub
+)
+
+// reloadInterval is how often the rules file is checked for changes.
+const reloadInterval = 250 * time.Millisecond
+
+// Manager injects faults according to the rules file
+// the app was started with.
+//
+// A nil *Manager never injects any faults.
+type Manager struct {
+	path       string
+	rt         *reqtrack.RequestTracker
+	rootLogger zerolog.Logger
+
+	mu      sync.Mutex
+	cfg     *chaos.Config
+	modTime time.Time
+	checked time.Time
+}
+
+// NewManager returns a new Manager.
+//
+// Faults are only injected when running locally or in tests,
+// and only if the app was started with a rules file.
+func NewManager(runtime *config.Runtime, rt *reqtrack.RequestTracker, rootLogger zerolog.Logger) *Manager {
+	mgr := &Manager{rt: rt, rootLogger: rootLogger}
+	if runtime.EnvCloud == string(cloud.Local) || runtime.EnvType == "test" {
+		mgr.path = encoreenv.Get(chaos.EnvName)
+	}
+	return mgr
+}
+
+// Inject injects the faults configured for the operation described by t,
+// returning the injected error, if any.
+//
+// For operations other than API calls, t.Service defaults to
+// the service of the current request.
+func (mgr *Manager) Inject(ctx context.Context, t Target) error {
+	if mgr == nil || mgr.path == "" {
+		return nil
+	}
+
+	curr := mgr.rt.Current()
+	if t.Kind != API && t.Service == "" && curr.Req != nil {
+		t.Service = curr.Req.Service()
+	}
+	rule := mgr.config().Match(t)
+	if rule == nil {
+		return nil
+	}
+
+	var err error
+	if rule.Latency > 0 {
+		timer := time.NewTimer(rule.Latency)
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+	if err == nil && rule.ErrorRate > 0 && rand.Float64() < rule.ErrorRate {
+		err = errs.B().Code(errs.Unavailable).Meta("chaos_rule", rule.ID).
+			Msgf("fault injected by chaos rule %s", rule.ID).Err()
+	}
+
+	if curr.Trace != nil && curr.Req != nil {
+		curr.Trace.FaultInjected(trace2.FaultInjectedParams{
+			EventParams: trace2.EventParams{
+				TraceID: curr.Req.TraceID,
+				SpanID:  curr.Req.SpanID,
+				Goid:    curr.Goctr,
+			},
+			Kind:     string(t.Kind),
+			Service:  t.Service,
+			Endpoint: t.Endpoint,
+			Resource: t.Resource,
+			Latency:  rule.Latency,
+			Err:      err,
+			Stack:    stack.Build(3),
+		})
+	}
+	return err
+}
+
+// config returns the current rules, reloading them if the file has changed.
+func (mgr *Manager) config() *chaos.Config {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	now := time.Now()
+	if mgr.cfg != nil && now.Sub(mgr.checked) < reloadInterval {
+		return mgr.cfg
+	}
+	mgr.checked = now
+
+	var modTime time.Time
+	if fi, err := os.Stat(mgr.path); err == nil {
+		modTime = fi.ModTime()
+	}
+	if mgr.cfg != nil && modTime.Equal(mgr.modTime) {
+		return mgr.cfg
+	}
+
+	mgr.modTime = modTime
+	cfg, err := chaos.Load(mgr.path)
+	if err != nil {
+		// Keep using the previous rules until the file is valid again.
+		mgr.rootLogger.Error().Err(err).Msg("unable to load chaos rules")
+		if mgr.cfg == nil {
+			mgr.cfg = &chaos.Config{}
+		}
+		return mgr.cfg
+	}
+	mgr.cfg = cfg
+	return cfg
+}
@@ -0,0 +1,62 @@
+package chaos
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/rs/zerolog"
+
+	"encore.dev/appruntime/exported/chaos"
+	"encore.dev/appruntime/shared/reqtrack"
+	"encore.dev/beta/errs"
+)
+
+func TestInject(t *testing.T) {
+	c := qt.New(t)
+	path := filepath.Join(c.TempDir(), "chaos.json")
+	writeRules := func(rules ...*chaos.Rule) {
+		data, err := json.Marshal(chaos.Config{Rules: rules})
+		c.Assert(err, qt.IsNil)
+		c.Assert(os.WriteFile(path, data, 0644), qt.IsNil)
+		// Make sure the change is picked up.
+		future := time.Now().Add(time.Duration(len(rules)) * time.Second)
+		c.Assert(os.Chtimes(path, future, future), qt.IsNil)
+	}
+
+	mgr := &Manager{path: path, rt: reqtrack.New(zerolog.Logger{}, nil, nil)}
+	ctx := context.Background()
+	users := Target{Kind: API, Service: "users", Endpoint: "Get"}
+	db := Target{Kind: SQLDB, Service: "users", Resource: "users"}
+
+	// Nothing is injected without rules.
+	c.Assert(mgr.Inject(ctx, users), qt.IsNil)
+
+	writeRules(&chaos.Rule{ID: "1", Kind: chaos.API, Service: "users", ErrorRate: 1})
+	mgr.checked = time.Time{}
+	err := mgr.Inject(ctx, users)
+	c.Assert(errs.Code(err), qt.Equals, errs.Unavailable)
+	c.Assert(mgr.Inject(ctx, db), qt.IsNil)
+
+	writeRules(
+		&chaos.Rule{ID: "1", Kind: chaos.SQLDB, Resource: "users", Latency: 20 * time.Millisecond},
+		&chaos.Rule{ID: "2", Kind: chaos.SQLDB, ErrorRate: 1},
+	)
+	mgr.checked = time.Time{}
+	c.Assert(mgr.Inject(ctx, users), qt.IsNil)
+	start := time.Now()
+	c.Assert(mgr.Inject(ctx, db), qt.IsNil)
+	c.Assert(time.Since(start) >= 20*time.Millisecond, qt.IsTrue)
+
+	// The first matching rule applies.
+	err = mgr.Inject(ctx, Target{Kind: SQLDB, Resource: "orders"})
+	c.Assert(errs.Code(err), qt.Equals, errs.Unavailable)
+
+	// A nil manager never injects faults.
+	var nilMgr *Manager
+	c.Assert(nilMgr.Inject(ctx, db), qt.IsNil)
+}
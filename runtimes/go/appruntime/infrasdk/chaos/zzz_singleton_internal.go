@@ -0,0 +1,12 @@
+//go:build encore_app
+
+package chaos
+
+import (
+	"encore.dev/appruntime/shared/appconf"
+	"encore.dev/appruntime/shared/logging"
+	"encore.dev/appruntime/shared/reqtrack"
+)
+
+//publicapigen:drop
+var Singleton = NewManager(appconf.Runtime, reqtrack.Singleton, logging.RootLogger)
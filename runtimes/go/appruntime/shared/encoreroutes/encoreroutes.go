@@ -0,0 +1,39 @@
+// Package encoreroutes provides a registry of HTTP handlers from the
+// Infra SDKs that are served by the runtime under the /__encore prefix.
+package encoreroutes
+
+import (
+	"net/http"
+	"sync"
+)
+
+// Registry is a registry of HTTP handlers, keyed by the path prefix they handle.
+type Registry struct {
+	m        sync.RWMutex
+	handlers map[string]http.Handler
+}
+
+// NewRegistry creates a new Registry.
+//
+// If running in an app there is a [Singleton]
+func NewRegistry() *Registry {
+	return &Registry{handlers: make(map[string]http.Handler)}
+}
+
+// Register registers h to handle the requests to /__encore/<prefix>/.
+// The handler is called with the request path relative to the prefix.
+//
+// Registering a handler for a prefix that already has one replaces it.
+func (r *Registry) Register(prefix string, h http.Handler) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.handlers[prefix] = h
+}
+
+// Handler returns the handler registered for the given prefix, if any.
+func (r *Registry) Handler(prefix string) (http.Handler, bool) {
+	r.m.RLock()
+	defer r.m.RUnlock()
+	h, ok := r.handlers[prefix]
+	return h, ok
+}
@@ -0,0 +1,7 @@
+//go:build encore_app
+
+package encoreroutes
+
+// Singleton is the singleton instance of the route registry
+// for a running Encore application.
+var Singleton = NewRegistry()
@@ -0,0 +1,7 @@
+//go:build encore_app
+
+package startup
+
+// Singleton is the singleton instance of the startup task registry
+// for a running Encore application.
+var Singleton = NewRegistry()
@@ -0,0 +1,53 @@
+package startup
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Registry is a registry of tasks from the API and Infra SDKs
+// that must complete before the application serves traffic.
+type Registry struct {
+	m     sync.Mutex
+	tasks []task
+}
+
+type task struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// NewRegistry creates a new Registry.
+//
+// If running in an app there is a [Singleton]
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register registers a new startup task.
+//
+// Tasks are run in the order they were registered, once the services
+// have been initialized, so they can call the application's APIs.
+// Until they've completed the application reports that it's not ready,
+// and only serves calls made by the application itself.
+// If a task fails the application fails to start.
+func (r *Registry) Register(name string, run func(ctx context.Context) error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.tasks = append(r.tasks, task{name: name, run: run})
+}
+
+// RunAll runs all the startup tasks, stopping at the first one that fails.
+func (r *Registry) RunAll(ctx context.Context) error {
+	r.m.Lock()
+	tasks := r.tasks
+	r.m.Unlock()
+
+	for _, t := range tasks {
+		if err := t.run(ctx); err != nil {
+			return fmt.Errorf("%s: %w", t.name, err)
+		}
+	}
+	return nil
+}
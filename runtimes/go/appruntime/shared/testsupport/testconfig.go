@@ -14,9 +14,10 @@ var (
 
 func newTestConfig(parent *model.TestConfig) *model.TestConfig {
 	return &model.TestConfig{
-		Parent:       parent,
-		ServiceMocks: make(map[string]model.ServiceMock),
-		APIMocks:     make(map[string]map[string]model.ApiMock),
+		Parent:        parent,
+		ServiceMocks:  make(map[string]model.ServiceMock),
+		APIMocks:      make(map[string]map[string]model.ApiMock),
+		ExternalMocks: make(map[string]any),
 	}
 }
 
@@ -338,6 +338,27 @@ func (mgr *Manager) GetAPIMock(service string, api string) (model.ApiMock, bool)
 	})
 }
 
+// SetExternalMock allows us to set a mock for an external API client for the current test
+func (mgr *Manager) SetExternalMock(api string, mock any) {
+	api = strings.TrimSpace(strings.ToLower(api))
+
+	cfg := mgr.currentConfig()
+	cfg.Mu.Lock()
+	defer cfg.Mu.Unlock()
+	cfg.ExternalMocks[api] = mock
+}
+
+// GetExternalMock allows us to get a mock for an external API client for the current test
+// or any parent tests - returning the lowest level mock available.
+func (mgr *Manager) GetExternalMock(api string) (any, bool) {
+	api = strings.TrimSpace(strings.ToLower(api))
+
+	return walkConfig(mgr.currentConfig(), func(cfg *TestConfig) (value any, found bool) {
+		value, found = cfg.ExternalMocks[api]
+		return
+	})
+}
+
 func (mgr *Manager) AddEndCallback(fn func(t *testing.T)) {
 	cfg := mgr.currentConfig()
 	cfg.Mu.Lock()
@@ -141,6 +141,32 @@ func (mr *MockLoggerMockRecorder) BucketListObjectsStart(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketListObjectsStart", reflect.TypeOf((*MockLogger)(nil).BucketListObjectsStart), arg0)
 }
 
+// BucketObjectCopyEnd mocks base method.
+func (m *MockLogger) BucketObjectCopyEnd(arg0 trace2.BucketObjectCopyEndParams) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BucketObjectCopyEnd", arg0)
+}
+
+// BucketObjectCopyEnd indicates an expected call of BucketObjectCopyEnd.
+func (mr *MockLoggerMockRecorder) BucketObjectCopyEnd(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketObjectCopyEnd", reflect.TypeOf((*MockLogger)(nil).BucketObjectCopyEnd), arg0)
+}
+
+// BucketObjectCopyStart mocks base method.
+func (m *MockLogger) BucketObjectCopyStart(arg0 trace2.BucketObjectCopyStartParams) trace2.EventID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BucketObjectCopyStart", arg0)
+	ret0, _ := ret[0].(trace2.EventID)
+	return ret0
+}
+
+// BucketObjectCopyStart indicates an expected call of BucketObjectCopyStart.
+func (mr *MockLoggerMockRecorder) BucketObjectCopyStart(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketObjectCopyStart", reflect.TypeOf((*MockLogger)(nil).BucketObjectCopyStart), arg0)
+}
+
 // BucketObjectDownloadEnd mocks base method.
 func (m *MockLogger) BucketObjectDownloadEnd(arg0 trace2.BucketObjectDownloadEndParams) {
 	m.ctrl.T.Helper()
@@ -193,6 +219,32 @@ func (mr *MockLoggerMockRecorder) BucketObjectGetAttrsStart(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketObjectGetAttrsStart", reflect.TypeOf((*MockLogger)(nil).BucketObjectGetAttrsStart), arg0)
 }
 
+// BucketObjectMoveEnd mocks base method.
+func (m *MockLogger) BucketObjectMoveEnd(arg0 trace2.BucketObjectMoveEndParams) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BucketObjectMoveEnd", arg0)
+}
+
+// BucketObjectMoveEnd indicates an expected call of BucketObjectMoveEnd.
+func (mr *MockLoggerMockRecorder) BucketObjectMoveEnd(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketObjectMoveEnd", reflect.TypeOf((*MockLogger)(nil).BucketObjectMoveEnd), arg0)
+}
+
+// BucketObjectMoveStart mocks base method.
+func (m *MockLogger) BucketObjectMoveStart(arg0 trace2.BucketObjectMoveStartParams) trace2.EventID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BucketObjectMoveStart", arg0)
+	ret0, _ := ret[0].(trace2.EventID)
+	return ret0
+}
+
+// BucketObjectMoveStart indicates an expected call of BucketObjectMoveStart.
+func (mr *MockLoggerMockRecorder) BucketObjectMoveStart(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketObjectMoveStart", reflect.TypeOf((*MockLogger)(nil).BucketObjectMoveStart), arg0)
+}
+
 // BucketObjectUploadEnd mocks base method.
 func (m *MockLogger) BucketObjectUploadEnd(arg0 trace2.BucketObjectUploadEndParams) {
 	m.ctrl.T.Helper()
@@ -297,6 +349,18 @@ func (mr *MockLoggerMockRecorder) DBTransactionStart(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DBTransactionStart", reflect.TypeOf((*MockLogger)(nil).DBTransactionStart), arg0, arg1)
 }
 
+// FaultInjected mocks base method.
+func (m *MockLogger) FaultInjected(arg0 trace2.FaultInjectedParams) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "FaultInjected", arg0)
+}
+
+// FaultInjected indicates an expected call of FaultInjected.
+func (mr *MockLoggerMockRecorder) FaultInjected(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FaultInjected", reflect.TypeOf((*MockLogger)(nil).FaultInjected), arg0)
+}
+
 // GetAndClear mocks base method.
 func (m *MockLogger) GetAndClear() ([]byte, bool) {
 	m.ctrl.T.Helper()
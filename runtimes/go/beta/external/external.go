@@ -0,0 +1,41 @@
+// Package external provides the runtime support for the external API clients
+// generated from OpenAPI specifications by `encore gen external`.
+//
+// It is not intended to be used directly by applications.
+package external
+
+import (
+	"context"
+
+	"encore.dev/appruntime/exported/trace2"
+	"encore.dev/appruntime/shared/testsupport"
+)
+
+//publicapigen:drop
+type Manager struct {
+	testMgr *testsupport.Manager
+}
+
+//publicapigen:drop
+func NewManager(testMgr *testsupport.Manager) *Manager {
+	return &Manager{testMgr: testMgr}
+}
+
+// Mock returns the mock set for the given external API using et.MockExternal
+// in the current test, if any.
+func (m *Manager) Mock(api string) (any, bool) {
+	mock, found := m.testMgr.GetExternalMock(api)
+	if !found || mock == nil {
+		return nil, false
+	}
+	return mock, true
+}
+
+// WithOperation returns a copy of ctx which causes HTTP requests made with it
+// to be traced as calls to the given operation of the external API.
+func WithOperation(ctx context.Context, api, operationID string) context.Context {
+	return trace2.WithExternalOperation(ctx, trace2.ExternalOperation{
+		API:       api,
+		Operation: operationID,
+	})
+}
@@ -0,0 +1,30 @@
+//go:build encore_app
+
+package external
+
+import (
+	"fmt"
+	"reflect"
+
+	"encore.dev/appruntime/shared/testsupport"
+)
+
+//publicapigen:drop
+var Singleton = NewManager(testsupport.Singleton)
+
+// Mock returns the mock set for the given external API using et.MockExternal
+// in the current test, and reports whether there is one.
+//
+// It panics if the mock does not implement T.
+func Mock[T any](api string) (T, bool) {
+	var zero T
+	mock, ok := Singleton.Mock(api)
+	if !ok {
+		return zero, false
+	}
+	impl, ok := mock.(T)
+	if !ok {
+		panic(fmt.Sprintf("external: the mock for %s is of type %T, which does not implement %s", api, mock, reflect.TypeFor[T]()))
+	}
+	return impl, true
+}
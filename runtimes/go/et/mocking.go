@@ -113,3 +113,28 @@ func MockService[T any](serviceName string, mock T, opts ...MockOption) {
 
 	Singleton.testMgr.SetServiceMock(serviceName, mock, options.runMiddleware)
 }
+
+// MockExternal allows you to mock out an external API client generated by `encore gen external`
+// in your tests; Any calls made using the client during this test or any of its sub-tests
+// will be routed to the mock you provide instead of being sent to the external API.
+//
+// Your mock must implement the Interface type generated in the client package,
+// which contains a method for each operation of the external API. For example:
+//
+//	func TestCharge(t *testing.T) {
+//		et.MockExternal[stripe.Interface](stripe.Name, &myMockType{})
+//		SomeFuncInThisPackageWhichUltimatelyCallsStripe()
+//	}
+//
+// Setting the mock to nil will remove the mock.
+func MockExternal[T any](api string, mock T) {
+	if Singleton.runtime.EnvType != "test" {
+		panic("et: cannot mock external API in non-test environment")
+	}
+
+	var mockAsAny any
+	if reflect.ValueOf(mock).IsValid() && !reflect.ValueOf(mock).IsNil() {
+		mockAsAny = mock
+	}
+	Singleton.testMgr.SetExternalMock(api, mockAsAny)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -77,6 +78,11 @@ func (t *topic) Subscribe(logger *zerolog.Logger, maxConcurrency int, ackDeadlin
 		maxConcurrency = 1 // FIXME(domblack): This retains the old behaviour, but allows user customisation - in a future release we should remove this
 	}
 
+	// Let SQS move messages that have exhausted their retries to the dead letter queue.
+	if implCfg.DeadLetter != "" {
+		t.configureRedrivePolicy(logger, implCfg, retryPolicy)
+	}
+
 	go func() {
 		defer func() {
 			if r := recover(); r != nil {
@@ -193,6 +199,75 @@ func (t *topic) Subscribe(logger *zerolog.Logger, maxConcurrency int, ackDeadlin
 	}()
 }
 
+// configureRedrivePolicy sets the redrive policy of the subscription's queue
+// so that SQS moves messages that have exhausted the retry policy
+// to the dead letter queue configured in the infra config.
+//
+// Failures are logged rather than fatal, as updating queue attributes
+// requires more permissions than consuming from the queue.
+func (t *topic) configureRedrivePolicy(logger *zerolog.Logger, implCfg *config.PubsubSubscription, retryPolicy *types.RetryPolicy) {
+	// SQS allows between 1 and 1000 receives before a message is moved.
+	maxReceiveCount, ok := utils.DeadLetterDeliveryAttempts(retryPolicy.MaxRetries, 1, 1000)
+	if !ok {
+		logger.Warn().Str("dead_letter_queue", implCfg.DeadLetter).Msg("subscription retries messages indefinitely, not configuring its redrive policy")
+		return
+	}
+	ctx := t.ctxs.Connection
+
+	// The redrive policy refers to the dead letter queue by ARN.
+	dlqARN := implCfg.DeadLetter
+	if !strings.HasPrefix(dlqARN, "arn:") {
+		resp, err := t.sqsClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+			QueueUrl:       aws.String(implCfg.DeadLetter),
+			AttributeNames: []sqsTypes.QueueAttributeName{sqsTypes.QueueAttributeNameQueueArn},
+		})
+		if err != nil {
+			logger.Error().Err(err).Str("dead_letter_queue", implCfg.DeadLetter).Msg("unable to get the dead letter queue's ARN to configure the redrive policy")
+			return
+		}
+		dlqARN = resp.Attributes[string(sqsTypes.QueueAttributeNameQueueArn)]
+	}
+
+	policy, err := json.Marshal(redrivePolicy{DeadLetterTargetARN: dlqARN, MaxReceiveCount: maxReceiveCount})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to marshal redrive policy")
+		return
+	}
+
+	resp, err := t.sqsClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(implCfg.ProviderName),
+		AttributeNames: []sqsTypes.QueueAttributeName{sqsTypes.QueueAttributeNameRedrivePolicy},
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to get the queue's redrive policy")
+		return
+	}
+	var cur redrivePolicy
+	if existing := resp.Attributes[string(sqsTypes.QueueAttributeNameRedrivePolicy)]; existing != "" {
+		if json.Unmarshal([]byte(existing), &cur) == nil && cur.DeadLetterTargetARN == dlqARN && cur.MaxReceiveCount == maxReceiveCount {
+			return
+		}
+	}
+
+	_, err = t.sqsClient.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
+		QueueUrl: aws.String(implCfg.ProviderName),
+		Attributes: map[string]string{
+			string(sqsTypes.QueueAttributeNameRedrivePolicy): string(policy),
+		},
+	})
+	if err != nil {
+		logger.Error().Err(err).Str("dead_letter_queue", dlqARN).Msg("unable to configure the queue's redrive policy")
+		return
+	}
+	logger.Info().Str("dead_letter_queue", dlqARN).Int("max_receive_count", maxReceiveCount).Msg("configured the queue's redrive policy")
+}
+
+// redrivePolicy is the JSON representation of an SQS redrive policy.
+type redrivePolicy struct {
+	DeadLetterTargetARN string `json:"deadLetterTargetArn"`
+	MaxReceiveCount     int    `json:"maxReceiveCount"`
+}
+
 func parseInt(m map[string]string, key string) (int64, error) {
 	value, ok := m[key]
 	if !ok {
@@ -3,6 +3,7 @@ package gcp
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -93,6 +94,12 @@ func (t *topic) Subscribe(logger *zerolog.Logger, maxConcurrency int, ackDeadlin
 		}
 	}
 
+	// Let GCP forward messages that have exhausted their retries to the dead letter topic.
+	// This applies to both push and pull subscriptions.
+	if subCfg.DeadLetter != "" {
+		t.configureDeadLetterPolicy(logger, gcpCfg.ProjectID, subCfg, retryPolicy)
+	}
+
 	// If we're not push only, then also set up the subscription
 	if !subCfg.PushOnly {
 		// Create the subscription object (and then check it exists on GCP's side)
@@ -165,3 +172,44 @@ func (t *topic) Subscribe(logger *zerolog.Logger, maxConcurrency int, ackDeadlin
 		}()
 	}
 }
+
+// configureDeadLetterPolicy sets the dead letter policy of the subscription
+// so that GCP forwards messages that have exhausted the retry policy
+// to the dead letter topic configured in the infra config.
+//
+// Failures are logged rather than fatal, as updating subscriptions
+// requires more permissions than consuming from them.
+func (t *topic) configureDeadLetterPolicy(logger *zerolog.Logger, projectID string, subCfg *config.PubsubSubscription, retryPolicy *types.RetryPolicy) {
+	// GCP only allows between 5 and 100 delivery attempts.
+	maxAttempts, ok := utils.DeadLetterDeliveryAttempts(retryPolicy.MaxRetries, 5, 100)
+	if !ok {
+		logger.Warn().Str("dead_letter_topic", subCfg.DeadLetter).Msg("subscription retries messages indefinitely, not configuring its dead letter policy")
+		return
+	}
+
+	topicName := subCfg.DeadLetter
+	if !strings.HasPrefix(topicName, "projects/") {
+		topicName = fmt.Sprintf("projects/%s/topics/%s", projectID, topicName)
+	}
+	want := &pubsub.DeadLetterPolicy{
+		DeadLetterTopic:     topicName,
+		MaxDeliveryAttempts: maxAttempts,
+	}
+
+	subscription := t.mgr.getClientForProject(projectID).Subscription(subCfg.ProviderName)
+	cfg, err := subscription.Config(t.mgr.ctxs.Connection)
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to get subscription config to configure its dead letter policy")
+		return
+	}
+	if cur := cfg.DeadLetterPolicy; cur != nil && *cur == *want {
+		return
+	}
+
+	_, err = subscription.Update(t.mgr.ctxs.Connection, pubsub.SubscriptionConfigToUpdate{DeadLetterPolicy: want})
+	if err != nil {
+		logger.Error().Err(err).Str("dead_letter_topic", topicName).Msg("unable to configure the subscription's dead letter policy")
+		return
+	}
+	logger.Info().Str("dead_letter_topic", topicName).Int("max_delivery_attempts", maxAttempts).Msg("configured the subscription's dead letter policy")
+}
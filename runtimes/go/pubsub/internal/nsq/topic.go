@@ -94,7 +94,7 @@ func (l *topic) Subscribe(logger *zerolog.Logger, maxConcurrency int, ackDeadlin
 	consumer.SetLogger(&LogAdapter{Logger: logger}, nsq.LogLevelWarning)
 
 	// create a dedicated handler which forwards messages to the encore subscription
-	consumer.AddConcurrentHandlers(nsq.HandlerFunc(func(m *nsq.Message) error {
+	consumer.AddConcurrentHandlers(nsq.HandlerFunc(func(m *nsq.Message) (err error) {
 		// create a message to unmarshal the raw nsq body into
 		msg := &messageWrapper{}
 
@@ -102,9 +102,15 @@ func (l *topic) Subscribe(logger *zerolog.Logger, maxConcurrency int, ackDeadlin
 			if !m.HasResponded() {
 				retry, delay := utils.GetDelay(retryPolicy.MaxRetries, retryPolicy.MinBackoff, retryPolicy.MaxBackoff, m.Attempts)
 				if !retry {
-
-					logger.Error().Str("msg_id", msg.ID).Int("retry", int(m.Attempts)-1).Msg("depleted message retries. Dropping message")
-					// TODO; offload this to the dead letter queue
+					if implCfg.DeadLetter == "" {
+						logger.Error().Str("msg_id", msg.ID).Int("retry", int(m.Attempts)-1).Msg("depleted message retries. Dropping message")
+					} else if dlErr := l.deadLetter(implCfg, m, msg, err); dlErr != nil {
+						logger.Error().Err(dlErr).Str("msg_id", msg.ID).Msg("failed to forward message to the dead letter topic, retrying")
+						m.RequeueWithoutBackoff(delay)
+						return
+					} else {
+						logger.Warn().Str("msg_id", msg.ID).Int("retry", int(m.Attempts)-1).Msg("depleted message retries. Forwarded message to the dead letter topic")
+					}
 					m.Finish()
 					return
 				}
@@ -152,21 +158,9 @@ func (l *topic) Subscribe(logger *zerolog.Logger, maxConcurrency int, ackDeadlin
 
 // PublishMessage publishes a message to an nsq Topic
 func (l *topic) PublishMessage(ctx context.Context, orderingKey string, attrs map[string]string, data []byte) (id string, err error) {
-	// instantiate a Producer if there isn;t one already
-	if l.producer == nil {
-		l.m.Lock()
-		defer l.m.Unlock()
-		if l.producer == nil {
-			cfg := nsq.NewConfig()
-			producer, err := nsq.NewProducer(l.addr, cfg)
-			if err != nil {
-				return "", errs.B().Cause(err).Code(errs.Internal).Msg("failed to connect to NSQD").Err()
-			}
-			// only log warnings and above from the NSQ library
-			log := l.mgr.rt.Logger().With().Str("topic", l.name).Logger()
-			producer.SetLogger(&LogAdapter{Logger: &log}, nsq.LogLevelWarning)
-			l.producer = producer
-		}
+	producer, err := l.getProducer()
+	if err != nil {
+		return "", err
 	}
 
 	// generate a new message ID
@@ -177,13 +171,78 @@ func (l *topic) PublishMessage(ctx context.Context, orderingKey string, attrs ma
 	if err != nil {
 		return "", errs.B().Cause(err).Code(errs.Internal).Msg("failed to marshal message").Err()
 	}
-	err = l.producer.Publish(l.name, data)
+	err = producer.Publish(l.name, data)
 	if err != nil {
 		return "", errs.B().Cause(err).Code(errs.Internal).Msg("failed to connect to NSQD").Err()
 	}
 	return msgID, nil
 }
 
+// getProducer returns the topic's producer, creating it if necessary.
+func (l *topic) getProducer() (*nsq.Producer, error) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if l.producer == nil {
+		cfg := nsq.NewConfig()
+		producer, err := nsq.NewProducer(l.addr, cfg)
+		if err != nil {
+			return nil, errs.B().Cause(err).Code(errs.Internal).Msg("failed to connect to NSQD").Err()
+		}
+		// only log warnings and above from the NSQ library
+		log := l.mgr.rt.Logger().With().Str("topic", l.name).Logger()
+		producer.SetLogger(&LogAdapter{Logger: &log}, nsq.LogLevelWarning)
+		l.producer = producer
+	}
+	return l.producer, nil
+}
+
+// deadLetter is the record published to the dead letter topic
+// for a message that has exhausted its retries.
+// It must be synchronized with cli/daemon/pubsub/deadletter.go.
+type deadLetter struct {
+	Topic        string            `json:"topic"`
+	Subscription string            `json:"subscription"`
+	MessageID    string            `json:"message_id"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+	Data         json.RawMessage   `json:"data"`
+	PublishTime  time.Time         `json:"publish_time"`
+	Attempts     int               `json:"attempts"`
+	LastError    string            `json:"last_error,omitempty"`
+	TraceID      string            `json:"trace_id,omitempty"`
+	DeadLettered time.Time         `json:"dead_lettered"`
+}
+
+// deadLetter publishes a message that has exhausted its retries to the subscription's dead letter topic.
+func (l *topic) deadLetter(implCfg *config.PubsubSubscription, m *nsq.Message, msg *messageWrapper, handlerErr error) error {
+	producer, err := l.getProducer()
+	if err != nil {
+		return err
+	}
+
+	data := msg.Data
+	if msg.ID == "" {
+		// The message wrapper could not be parsed; keep the raw message.
+		data, _ = json.Marshal(string(m.Body))
+	}
+	lastErr, traceID := utils.DeadLetterError(handlerErr)
+	record, err := json.Marshal(&deadLetter{
+		Topic:        l.name,
+		Subscription: implCfg.EncoreName,
+		MessageID:    msg.ID,
+		Attributes:   msg.Attributes,
+		Data:         data,
+		PublishTime:  time.Unix(0, m.Timestamp),
+		Attempts:     int(m.Attempts),
+		LastError:    lastErr,
+		TraceID:      traceID,
+		DeadLettered: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	return producer.Publish(implCfg.DeadLetter, record)
+}
+
 func getConsumerConfig(maxConcurrency int, ackDeadline time.Duration, retryPolicy *types.RetryPolicy) *nsq.Config {
 	conCfg := nsq.NewConfig()
 	conCfg.MsgTimeout = utils.Clamp(ackDeadline, 0, 15*time.Minute)
@@ -17,3 +17,16 @@ type TopicImplementation interface {
 	PublishMessage(ctx context.Context, orderingKey string, attrs map[string]string, data []byte) (id string, err error)
 	Subscribe(logger *zerolog.Logger, maxConcurrency int, ackDeadline time.Duration, retryPolicy *RetryPolicy, implCfg *config.PubsubSubscription, f RawSubscriptionCallback)
 }
+
+// HandlerError is returned by a RawSubscriptionCallback when
+// the subscription handler failed to process a message.
+type HandlerError struct {
+	Err error
+
+	// TraceID is the id of the trace of the failed attempt.
+	// It's empty if the attempt was not traced.
+	TraceID string
+}
+
+func (e *HandlerError) Error() string { return e.Err.Error() }
+func (e *HandlerError) Unwrap() error { return e.Err }
@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"errors"
+
+	"encore.dev/pubsub/internal/types"
+)
+
+// DeadLetterError returns the error message and trace id of the last failed
+// attempt to process a message, given the error returned by the subscription callback.
+func DeadLetterError(err error) (msg, traceID string) {
+	if err == nil {
+		return "", ""
+	}
+	var handlerErr *types.HandlerError
+	if errors.As(err, &handlerErr) {
+		traceID = handlerErr.TraceID
+	}
+	return err.Error(), traceID
+}
+
+// DeadLetterDeliveryAttempts returns the number of delivery attempts after which
+// a provider's native dead letter queue should receive a message, given the
+// subscription's max retries and the range of attempts the provider allows.
+// It reports false if messages are retried indefinitely and never dead-lettered.
+func DeadLetterDeliveryAttempts(maxRetries, min, max int) (attempts int, ok bool) {
+	switch maxRetries {
+	case types.InfiniteRetries:
+		return 0, false
+	case types.NoRetries:
+		return min, true
+	}
+	return Clamp(maxRetries+1, min, max), true
+}
@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"testing"
 	"time"
+
+	"encore.dev/pubsub/internal/types"
 )
 
 type EmbedStruct struct {
@@ -206,3 +208,31 @@ func TestGetDelay(t *testing.T) {
 
 	}
 }
+
+func TestDeadLetterError(t *testing.T) {
+	err := fmt.Errorf("handler failed: %w", &types.HandlerError{Err: fmt.Errorf("boom"), TraceID: "trace-id"})
+	msg, traceID := DeadLetterError(err)
+	if msg != "handler failed: boom" || traceID != "trace-id" {
+		t.Errorf("DeadLetterError() = %q, %q, want %q, %q", msg, traceID, "handler failed: boom", "trace-id")
+	}
+}
+
+func TestDeadLetterDeliveryAttempts(t *testing.T) {
+	tests := []struct {
+		maxRetries   int
+		wantAttempts int
+		wantOK       bool
+	}{
+		{maxRetries: types.InfiniteRetries, wantOK: false},
+		{maxRetries: types.NoRetries, wantAttempts: 5, wantOK: true},
+		{maxRetries: 1, wantAttempts: 5, wantOK: true},
+		{maxRetries: 10, wantAttempts: 11, wantOK: true},
+		{maxRetries: 1000, wantAttempts: 100, wantOK: true},
+	}
+	for _, tt := range tests {
+		attempts, ok := DeadLetterDeliveryAttempts(tt.maxRetries, 5, 100)
+		if attempts != tt.wantAttempts || ok != tt.wantOK {
+			t.Errorf("DeadLetterDeliveryAttempts(%d) = %d, %v, want %d, %v", tt.maxRetries, attempts, ok, tt.wantAttempts, tt.wantOK)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/infrasdk/chaos"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/appruntime/shared/shutdown"
 	"encore.dev/appruntime/shared/testsupport"
@@ -23,6 +24,7 @@ type Manager struct {
 	runtime    *config.Runtime
 	rt         *reqtrack.RequestTracker
 	ts         *testsupport.Manager
+	chaos      *chaos.Manager
 	rootLogger zerolog.Logger
 	json       jsoniter.API
 	providers  []provider
@@ -34,13 +36,14 @@ type Manager struct {
 }
 
 func NewManager(static *config.Static, runtime *config.Runtime, rt *reqtrack.RequestTracker,
-	ts *testsupport.Manager, rootLogger zerolog.Logger, json jsoniter.API) *Manager {
+	ts *testsupport.Manager, chaos *chaos.Manager, rootLogger zerolog.Logger, json jsoniter.API) *Manager {
 	mgr := &Manager{
 		ctxs:         utils.NewContexts(context.Background()),
 		static:       static,
 		runtime:      runtime,
 		rt:           rt,
 		ts:           ts,
+		chaos:        chaos,
 		rootLogger:   rootLogger,
 		json:         json,
 		pushHandlers: make(map[types.SubscriptionID]http.HandlerFunc),
@@ -14,6 +14,7 @@ import (
 	"encore.dev/appruntime/shared/cfgutil"
 	"encore.dev/beta/errs"
 	"encore.dev/pubsub/internal/noop"
+	"encore.dev/pubsub/internal/types"
 	"encore.dev/pubsub/internal/utils"
 )
 
@@ -244,6 +245,10 @@ func NewSubscription[T any](topic *Topic[T], name string, cfg SubscriptionConfig
 		}
 		mgr.rt.FinishRequest(false)
 
+		if err != nil && req.Traced {
+			// Record the failed attempt's trace, so it can be linked to if the message is dead-lettered.
+			err = &types.HandlerError{Err: err, TraceID: traceID.String()}
+		}
 		return err
 	})
 
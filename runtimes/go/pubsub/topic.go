@@ -9,6 +9,7 @@ import (
 	"encore.dev/appruntime/exported/model"
 	"encore.dev/appruntime/exported/stack"
 	"encore.dev/appruntime/exported/trace2"
+	"encore.dev/appruntime/infrasdk/chaos"
 	"encore.dev/beta/errs"
 	"encore.dev/internal/limiter"
 	"encore.dev/pubsub/internal/noop"
@@ -180,10 +181,13 @@ func (t *Topic[T]) Publish(ctx context.Context, msg T) (id string, err error) {
 		})
 	}
 
-	// Publish once the rate limiter allows it
-	if err = t.publishLimiter.Wait(ctx); err == nil {
-		// Publish to the clouds topic
-		id, err = t.topic.PublishMessage(ctx, orderingKey, attrs, data)
+	// Publish once the rate limiter allows it, unless "encore chaos" injects a fault
+	err = t.mgr.chaos.Inject(ctx, chaos.Target{Kind: chaos.PubSub, Resource: t.runtimeCfg.EncoreName})
+	if err == nil {
+		if err = t.publishLimiter.Wait(ctx); err == nil {
+			// Publish to the clouds topic
+			id, err = t.topic.PublishMessage(ctx, orderingKey, attrs, data)
+		}
 	}
 
 	// End the trace span
@@ -118,7 +118,7 @@ func (k *StringKeyspace[K]) With(opts ...WriteOption) *StringKeyspace[K] {
 func (s *StringKeyspace[K]) Append(ctx context.Context, key K, val string) (newLen int64, err error) {
 	const op = "append"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, true, k)
+	endTrace := s.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -146,7 +146,7 @@ func (s *StringKeyspace[K]) Append(ctx context.Context, key K, val string) (newL
 func (s *StringKeyspace[K]) GetRange(ctx context.Context, key K, from, to int64) (val string, err error) {
 	const op = "get range"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, false, k)
+	endTrace := s.doTrace(ctx, &err, op, false, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return "", err
@@ -170,7 +170,7 @@ func (s *StringKeyspace[K]) GetRange(ctx context.Context, key K, from, to int64)
 func (s *StringKeyspace[K]) SetRange(ctx context.Context, key K, offset int64, val string) (newLen int64, err error) {
 	const op = "set range"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, true, k)
+	endTrace := s.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -191,7 +191,7 @@ func (s *StringKeyspace[K]) SetRange(ctx context.Context, key K, offset int64, v
 func (s *StringKeyspace[K]) Len(ctx context.Context, key K) (length int64, err error) {
 	const op = "len"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, false, k)
+	endTrace := s.doTrace(ctx, &err, op, false, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -313,7 +313,7 @@ func (s *IntKeyspace[K]) Delete(ctx context.Context, keys ...K) (deleted int, er
 func (s *IntKeyspace[K]) Increment(ctx context.Context, key K, delta int64) (newVal int64, err error) {
 	const op = "increment"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, true, k)
+	endTrace := s.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -339,7 +339,7 @@ func (s *IntKeyspace[K]) Increment(ctx context.Context, key K, delta int64) (new
 func (s *IntKeyspace[K]) Decrement(ctx context.Context, key K, delta int64) (newVal int64, err error) {
 	const op = "decrement"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, true, k)
+	endTrace := s.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -464,7 +464,7 @@ func (s *FloatKeyspace[K]) Delete(ctx context.Context, keys ...K) (deleted int,
 func (s *FloatKeyspace[K]) Increment(ctx context.Context, key K, delta float64) (newVal float64, err error) {
 	const op = "increment"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, true, k)
+	endTrace := s.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -490,7 +490,7 @@ func (s *FloatKeyspace[K]) Increment(ctx context.Context, key K, delta float64)
 func (s *FloatKeyspace[K]) Decrement(ctx context.Context, key K, delta float64) (newVal float64, err error) {
 	const op = "decrement"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, true, k)
+	endTrace := s.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -514,7 +514,7 @@ func (s *basicKeyspace[K, V]) with(opts []WriteOption) *basicKeyspace[K, V] {
 func (s *basicKeyspace[K, V]) Get(ctx context.Context, key K) (val V, err error) {
 	const op = "get"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, false, k)
+	endTrace := s.doTrace(ctx, &err, op, false, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return val, err
@@ -531,7 +531,7 @@ func (s *basicKeyspace[K, V]) Get(ctx context.Context, key K) (val V, err error)
 func (s *basicKeyspace[K, V]) MultiGet(ctx context.Context, keys ...K) ([]Result[V], error) {
 	const op = "multi get"
 	ks, err := s.keys(keys, op)
-	endTrace := s.doTrace(op, false, ks...)
+	endTrace := s.doTrace(ctx, &err, op, false, ks...)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return nil, err
@@ -594,7 +594,7 @@ func (s *basicKeyspace[K, V]) GetAndSet(ctx context.Context, key K, val V) (prev
 func (s *basicKeyspace[K, V]) GetAndDelete(ctx context.Context, key K) (val V, err error) {
 	const op = "get and delete"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, true, k)
+	endTrace := s.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return val, err
@@ -612,7 +612,7 @@ func (s *basicKeyspace[K, V]) GetAndDelete(ctx context.Context, key K) (val V, e
 func (s *client[K, V]) Delete(ctx context.Context, keys ...K) (deleted int, err error) {
 	const op = "delete"
 	ks, err := s.keys(keys, op)
-	endTrace := s.doTrace(op, true, ks...)
+	endTrace := s.doTrace(ctx, &err, op, true, ks...)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -643,8 +643,11 @@ func (s *basicKeyspace[K, V]) set(ctx context.Context, key K, val V, flag setFla
 		return "", "", err
 	}
 
-	endTrace := s.doTrace(op, true, k)
+	endTrace := s.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
+	if err != nil {
+		return "", k, err
+	}
 
 	get := (flag & setGet) == setGet
 	nx := (flag & setNX) == setNX
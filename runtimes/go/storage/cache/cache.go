@@ -70,9 +70,10 @@ type constStr string
 
 // Cluster represents a Redis cache cluster.
 type Cluster struct {
-	cfg ClusterConfig
-	mgr *Manager
-	cl  *redis.Client
+	name string
+	cfg  ClusterConfig
+	mgr  *Manager
+	cl   *redis.Client
 }
 
 // KeyspaceConfig specifies the configuration options for a cache keyspace.
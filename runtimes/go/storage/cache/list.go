@@ -73,7 +73,7 @@ func (s *ListKeyspace[K, V]) Delete(ctx context.Context, keys ...K) (deleted int
 func (l *ListKeyspace[K, V]) PushLeft(ctx context.Context, key K, values ...V) (newLen int64, err error) {
 	const op = "push left"
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, true, k)
+	endTrace := l.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -103,7 +103,7 @@ func (l *ListKeyspace[K, V]) PushLeft(ctx context.Context, key K, values ...V) (
 func (l *ListKeyspace[K, V]) PushRight(ctx context.Context, key K, values ...V) (newLen int64, err error) {
 	const op = "push right"
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, true, k)
+	endTrace := l.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -125,7 +125,7 @@ func (l *ListKeyspace[K, V]) PushRight(ctx context.Context, key K, values ...V)
 func (l *ListKeyspace[K, V]) PopLeft(ctx context.Context, key K) (val V, err error) {
 	const op = "pop left"
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, true, k)
+	endTrace := l.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return val, err
@@ -149,7 +149,7 @@ func (l *ListKeyspace[K, V]) PopLeft(ctx context.Context, key K) (val V, err err
 func (l *ListKeyspace[K, V]) PopRight(ctx context.Context, key K) (val V, err error) {
 	const op = "pop right"
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, true, k)
+	endTrace := l.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return val, err
@@ -173,7 +173,7 @@ func (l *ListKeyspace[K, V]) PopRight(ctx context.Context, key K) (val V, err er
 func (l *ListKeyspace[K, V]) Len(ctx context.Context, key K) (length int64, err error) {
 	const op = "list len"
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, false, k)
+	endTrace := l.doTrace(ctx, &err, op, false, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -197,7 +197,7 @@ func (l *ListKeyspace[K, V]) Len(ctx context.Context, key K) (length int64, err
 func (l *ListKeyspace[K, V]) Trim(ctx context.Context, key K, start, stop int64) error {
 	const op = "list trim"
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, true, k)
+	endTrace := l.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return err
@@ -219,7 +219,7 @@ func (l *ListKeyspace[K, V]) Trim(ctx context.Context, key K, start, stop int64)
 func (l *ListKeyspace[K, V]) Set(ctx context.Context, key K, idx int64, val V) (err error) {
 	const op = "list set"
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, true, k)
+	endTrace := l.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return err
@@ -242,7 +242,7 @@ func (l *ListKeyspace[K, V]) Set(ctx context.Context, key K, idx int64, val V) (
 func (l *ListKeyspace[K, V]) Get(ctx context.Context, key K, idx int64) (val V, err error) {
 	const op = "list get"
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, false, k)
+	endTrace := l.doTrace(ctx, &err, op, false, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return val, err
@@ -279,7 +279,7 @@ func (l *ListKeyspace[K, V]) GetRange(ctx context.Context, key K, start, stop in
 
 func (l *ListKeyspace[K, V]) getRange(ctx context.Context, key K, from, to int64, op string) (vals []V, err error) {
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, false, k)
+	endTrace := l.doTrace(ctx, &err, op, false, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return nil, err
@@ -311,7 +311,7 @@ func (l *ListKeyspace[K, V]) getRange(ctx context.Context, key K, from, to int64
 func (l *ListKeyspace[K, V]) InsertBefore(ctx context.Context, key K, needle, newVal V) (newLen int64, err error) {
 	const op = "insert before"
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, true, k)
+	endTrace := l.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -338,7 +338,7 @@ func (l *ListKeyspace[K, V]) InsertBefore(ctx context.Context, key K, needle, ne
 func (l *ListKeyspace[K, V]) InsertAfter(ctx context.Context, key K, needle, newVal V) (newLen int64, err error) {
 	const op = "insert after"
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, true, k)
+	endTrace := l.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -364,7 +364,7 @@ func (l *ListKeyspace[K, V]) InsertAfter(ctx context.Context, key K, needle, new
 func (l *ListKeyspace[K, V]) RemoveAll(ctx context.Context, key K, needle V) (removed int64, err error) {
 	const op = "remove all"
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, true, k)
+	endTrace := l.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -387,7 +387,7 @@ func (l *ListKeyspace[K, V]) RemoveAll(ctx context.Context, key K, needle V) (re
 func (l *ListKeyspace[K, V]) RemoveFirst(ctx context.Context, key K, count int64, needle V) (removed int64, err error) {
 	const op = "remove first"
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, true, k)
+	endTrace := l.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -417,7 +417,7 @@ func (l *ListKeyspace[K, V]) RemoveFirst(ctx context.Context, key K, count int64
 func (l *ListKeyspace[K, V]) RemoveLast(ctx context.Context, key K, count int64, needle V) (removed int64, err error) {
 	const op = "remove last"
 	k, err := l.key(key, op)
-	endTrace := l.doTrace(op, true, k)
+	endTrace := l.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -456,7 +456,7 @@ const (
 func (l *ListKeyspace[K, V]) Move(ctx context.Context, src, dst K, fromPos, toPos ListPos) (moved V, err error) {
 	const op = "list move"
 	ks, err := l.keys([]K{src, dst}, op)
-	endTrace := l.doTrace(op, true, ks...)
+	endTrace := l.doTrace(ctx, &err, op, true, ks...)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return moved, err
@@ -20,6 +20,7 @@ import (
 	"encore.dev/appruntime/exported/model"
 	"encore.dev/appruntime/exported/stack"
 	"encore.dev/appruntime/exported/trace2"
+	"encore.dev/appruntime/infrasdk/chaos"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/appruntime/shared/shutdown"
 	"encore.dev/appruntime/shared/syncutil"
@@ -32,6 +33,7 @@ type Manager struct {
 	runtime *config.Runtime
 	rt      *reqtrack.RequestTracker
 	ts      *testsupport.Manager
+	chaos   *chaos.Manager
 	json    jsoniter.API
 
 	initTestSrv syncutil.Once
@@ -41,12 +43,13 @@ type Manager struct {
 	clients  map[string]*redis.Client
 }
 
-func NewManager(static *config.Static, runtime *config.Runtime, rt *reqtrack.RequestTracker, ts *testsupport.Manager, json jsoniter.API) *Manager {
+func NewManager(static *config.Static, runtime *config.Runtime, rt *reqtrack.RequestTracker, ts *testsupport.Manager, chaos *chaos.Manager, json jsoniter.API) *Manager {
 	return &Manager{
 		static:  static,
 		runtime: runtime,
 		rt:      rt,
 		ts:      ts,
+		chaos:   chaos,
 		json:    json,
 		clients: make(map[string]*redis.Client),
 	}
@@ -208,6 +211,8 @@ func newClient[K, V any](cluster *Cluster, cfg KeyspaceConfig,
 
 	return &client[K, V]{
 		rt:        cluster.mgr.rt,
+		chaos:     cluster.mgr.chaos,
+		cluster:   cluster.name,
 		redis:     cluster.cl,
 		cfg:       cfg,
 		expiry:    defaultExpiry,
@@ -219,6 +224,8 @@ func newClient[K, V any](cluster *Cluster, cfg KeyspaceConfig,
 
 type client[K, V any] struct {
 	rt        *reqtrack.RequestTracker
+	chaos     *chaos.Manager
+	cluster   string // the name of the cache cluster
 	redis     *redis.Client
 	cfg       KeyspaceConfig
 	expiry    ExpiryFunc
@@ -322,8 +329,14 @@ func (s *client[K, V]) expiryDur() time.Duration {
 	return exp
 }
 
-func (c *client[K, V]) doTrace(op string, write bool, keys ...string) func(error) {
+// doTrace traces the start of an operation, returning a function to trace its end.
+// If *errp is nil it also injects the faults configured with "encore chaos"
+// into the operation, setting *errp to the injected error, if any.
+func (c *client[K, V]) doTrace(ctx context.Context, errp *error, op string, write bool, keys ...string) func(error) {
 	eventID := c.traceStart(op, write, keys...)
+	if *errp == nil {
+		*errp = c.chaos.Inject(ctx, chaos.Target{Kind: chaos.Cache, Resource: c.cluster})
+	}
 	return func(err error) {
 		c.traceEnd(eventID, err)
 	}
@@ -60,7 +60,7 @@ func (s *SetKeyspace[K, V]) Delete(ctx context.Context, keys ...K) (deleted int,
 func (s *SetKeyspace[K, V]) Add(ctx context.Context, key K, values ...V) (added int, err error) {
 	const op = "set add"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, true, k)
+	endTrace := s.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -86,7 +86,7 @@ func (s *SetKeyspace[K, V]) Add(ctx context.Context, key K, values ...V) (added
 func (s *SetKeyspace[K, V]) Remove(ctx context.Context, key K, values ...V) (removed int, err error) {
 	const op = "set remove"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, true, k)
+	endTrace := s.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -109,7 +109,7 @@ func (s *SetKeyspace[K, V]) Remove(ctx context.Context, key K, values ...V) (rem
 func (s *SetKeyspace[K, V]) PopOne(ctx context.Context, key K) (val V, err error) {
 	const op = "set pop one"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, true, k)
+	endTrace := s.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return val, err
@@ -135,7 +135,7 @@ func (s *SetKeyspace[K, V]) PopOne(ctx context.Context, key K) (val V, err error
 func (s *SetKeyspace[K, V]) Pop(ctx context.Context, key K, count int) (values []V, err error) {
 	const op = "set pop"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, true, k)
+	endTrace := s.doTrace(ctx, &err, op, true, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return nil, err
@@ -160,7 +160,7 @@ func (s *SetKeyspace[K, V]) Pop(ctx context.Context, key K, count int) (values [
 func (s *SetKeyspace[K, V]) Contains(ctx context.Context, key K, val V) (contains bool, err error) {
 	const op = "set contains"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, false, k)
+	endTrace := s.doTrace(ctx, &err, op, false, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return false, err
@@ -179,7 +179,7 @@ func (s *SetKeyspace[K, V]) Contains(ctx context.Context, key K, val V) (contain
 func (s *SetKeyspace[K, V]) Len(ctx context.Context, key K) (length int64, err error) {
 	const op = "set len"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, false, k)
+	endTrace := s.doTrace(ctx, &err, op, false, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -198,7 +198,7 @@ func (s *SetKeyspace[K, V]) Len(ctx context.Context, key K) (length int64, err e
 func (s *SetKeyspace[K, V]) Items(ctx context.Context, key K) (values []V, err error) {
 	const op = "set items"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, false, k)
+	endTrace := s.doTrace(ctx, &err, op, false, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return nil, err
@@ -218,7 +218,7 @@ func (s *SetKeyspace[K, V]) Items(ctx context.Context, key K) (values []V, err e
 func (s *SetKeyspace[K, V]) ItemsMap(ctx context.Context, key K) (values map[V]struct{}, err error) {
 	const op = "set items"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, false, k)
+	endTrace := s.doTrace(ctx, &err, op, false, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return nil, err
@@ -270,7 +270,7 @@ func (s *SetKeyspace[K, V]) DiffMap(ctx context.Context, keys ...K) (map[V]struc
 
 func (s *SetKeyspace[K, V]) diff(ctx context.Context, op string, keys []K) (vals []string, firstKey string, err error) {
 	ks, err := s.keys(keys, op)
-	endTrace := s.doTrace(op, false, ks...)
+	endTrace := s.doTrace(ctx, &err, op, false, ks...)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return nil, "", err
@@ -291,7 +291,7 @@ func (s *SetKeyspace[K, V]) diff(ctx context.Context, op string, keys []K) (vals
 func (s *SetKeyspace[K, V]) DiffStore(ctx context.Context, destination K, keys ...K) (size int64, err error) {
 	const op = "store set diff"
 	dst, err := s.key(destination, op)
-	endTrace := s.doTrace(op, true, dst)
+	endTrace := s.doTrace(ctx, &err, op, true, dst)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -343,7 +343,7 @@ func (s *SetKeyspace[K, V]) IntersectMap(ctx context.Context, keys ...K) (map[V]
 
 func (s *SetKeyspace[K, V]) intersect(ctx context.Context, op string, keys []K) (vals []string, firstKey string, err error) {
 	ks, err := s.keys(keys, op)
-	endTrace := s.doTrace(op, false, ks...)
+	endTrace := s.doTrace(ctx, &err, op, false, ks...)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return nil, "", err
@@ -365,7 +365,7 @@ func (s *SetKeyspace[K, V]) intersect(ctx context.Context, op string, keys []K)
 func (s *SetKeyspace[K, V]) IntersectStore(ctx context.Context, destination K, keys ...K) (size int64, err error) {
 	const op = "store set intersect"
 	dst, err := s.key(destination, op)
-	endTrace := s.doTrace(op, true, dst)
+	endTrace := s.doTrace(ctx, &err, op, true, dst)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -414,7 +414,7 @@ func (s *SetKeyspace[K, V]) UnionMap(ctx context.Context, keys ...K) (map[V]stru
 
 func (s *SetKeyspace[K, V]) union(ctx context.Context, op string, keys []K) (vals []string, firstKey string, err error) {
 	ks, err := s.keys(keys, op)
-	endTrace := s.doTrace(op, false, ks...)
+	endTrace := s.doTrace(ctx, &err, op, false, ks...)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return nil, "", err
@@ -437,7 +437,7 @@ func (s *SetKeyspace[K, V]) union(ctx context.Context, op string, keys []K) (val
 func (s *SetKeyspace[K, V]) UnionStore(ctx context.Context, destination K, keys ...K) (size int64, err error) {
 	const op = "store set union"
 	dst, err := s.key(destination, op)
-	endTrace := s.doTrace(op, true, dst)
+	endTrace := s.doTrace(ctx, &err, op, true, dst)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return 0, err
@@ -461,7 +461,7 @@ func (s *SetKeyspace[K, V]) UnionStore(ctx context.Context, destination K, keys
 func (s *SetKeyspace[K, V]) SampleOne(ctx context.Context, key K) (val V, err error) {
 	const op = "set sample one"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, false, k)
+	endTrace := s.doTrace(ctx, &err, op, false, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return val, err
@@ -484,7 +484,7 @@ func (s *SetKeyspace[K, V]) SampleOne(ctx context.Context, key K) (val V, err er
 func (s *SetKeyspace[K, V]) Sample(ctx context.Context, key K, count int) (values []V, err error) {
 	const op = "set sample one"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, false, k)
+	endTrace := s.doTrace(ctx, &err, op, false, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return nil, err
@@ -514,7 +514,7 @@ func (s *SetKeyspace[K, V]) Sample(ctx context.Context, key K, count int) (value
 func (s *SetKeyspace[K, V]) SampleWithReplacement(ctx context.Context, key K, count int) (values []V, err error) {
 	const op = "set sample with replacement"
 	k, err := s.key(key, op)
-	endTrace := s.doTrace(op, false, k)
+	endTrace := s.doTrace(ctx, &err, op, false, k)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return nil, err
@@ -545,7 +545,7 @@ func (s *SetKeyspace[K, V]) SampleWithReplacement(ctx context.Context, key K, co
 func (s *SetKeyspace[K, V]) Move(ctx context.Context, src, dst K, val V) (moved bool, err error) {
 	const op = "move"
 	ks, err := s.keys([]K{src, dst}, op)
-	endTrace := s.doTrace(op, true, ks...)
+	endTrace := s.doTrace(ctx, &err, op, true, ks...)
 	defer func() { endTrace(err) }()
 	if err != nil {
 		return false, err
@@ -11,6 +11,7 @@ import (
 	"encore.dev/appruntime/exported/config"
 	"encore.dev/appruntime/exported/stack"
 	"encore.dev/appruntime/exported/trace2"
+	"encore.dev/appruntime/infrasdk/chaos"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/storage/objects/internal/providers/noop"
 	"encore.dev/storage/objects/internal/types"
@@ -209,6 +210,10 @@ func (w *Writer) Close() error {
 
 func (w *Writer) initUpload() types.Uploader {
 	if w.u == nil {
+		if err := w.bkt.injectFault(w.ctx); err != nil {
+			w.u = &errUploader{err: err}
+			return w.u
+		}
 		u, err := w.bkt.impl.Upload(types.UploadData{
 			Ctx:    w.ctx,
 			Object: w.bkt.toCloudObject(w.obj),
@@ -268,10 +273,14 @@ func (b *Bucket) Download(ctx context.Context, object string, options ...Downloa
 		})
 	}
 
+	if err := b.injectFault(ctx); err != nil {
+		return &Reader{err: err, curr: curr, startEventID: startEventID}
+	}
 	r, err := b.impl.Download(types.DownloadData{
 		Ctx:     ctx,
 		Object:  b.toCloudObject(object),
 		Version: opt.version,
+		Range:   opt.rng,
 	})
 	return &Reader{r: r, err: err, curr: curr, startEventID: startEventID}
 }
@@ -366,6 +375,15 @@ type ObjectAttrs struct {
 	// The content type of the object, if set during upload.
 	ContentType string
 
+	// The Cache-Control header of the object, if set during upload.
+	CacheControl string
+
+	// The Content-Disposition header of the object, if set during upload.
+	ContentDisposition string
+
+	// The user-defined metadata of the object, if set during upload.
+	Metadata map[string]string
+
 	// The size of the object, in bytes.
 	Size int64
 
@@ -375,11 +393,14 @@ type ObjectAttrs struct {
 
 func (b *Bucket) mapAttrs(attrs *types.ObjectAttrs) *ObjectAttrs {
 	return &ObjectAttrs{
-		Name:        b.fromCloudObject(attrs.Object),
-		Version:     attrs.Version,
-		ContentType: attrs.ContentType,
-		Size:        attrs.Size,
-		ETag:        attrs.ETag,
+		Name:               b.fromCloudObject(attrs.Object),
+		Version:            attrs.Version,
+		ContentType:        attrs.ContentType,
+		CacheControl:       attrs.CacheControl,
+		ContentDisposition: attrs.ContentDisposition,
+		Metadata:           attrs.Metadata,
+		Size:               attrs.Size,
+		ETag:               attrs.ETag,
 	}
 }
 
@@ -447,6 +468,12 @@ func (b *Bucket) List(ctx context.Context, query *Query, options ...ListOption)
 			})
 		}
 
+		if err := b.injectFault(ctx); err != nil {
+			listErr = err
+			yield(nil, err)
+			return
+		}
+
 		iter := b.impl.List(b.mapQuery(ctx, query))
 		for entry, err := range iter {
 			if err != nil {
@@ -503,6 +530,9 @@ func (b *Bucket) Remove(ctx context.Context, object string, options ...RemoveOpt
 		})
 	}
 
+	if removeErr = b.injectFault(ctx); removeErr != nil {
+		return removeErr
+	}
 	removeErr = b.impl.Remove(types.RemoveData{
 		Ctx:     ctx,
 		Object:  b.toCloudObject(object),
@@ -553,14 +583,139 @@ func (b *Bucket) Attrs(ctx context.Context, object string, options ...AttrsOptio
 		})
 
 		defer func() {
-			params := trace2.BucketObjectGetAttrsEndParams{
+			curr.Trace.BucketObjectGetAttrsEnd(trace2.BucketObjectGetAttrsEndParams{
+				StartID: startEventID,
+				EventParams: trace2.EventParams{
+					TraceID: curr.Req.TraceID,
+					SpanID:  curr.Req.SpanID,
+					Goid:    curr.Goctr,
+				},
+				Err:   attrsErr,
+				Attrs: traceAttrs(attrs),
+			})
+		}()
+	}
+
+	if attrsErr = b.injectFault(ctx); attrsErr != nil {
+		return nil, attrsErr
+	}
+	attrs, attrsErr = b.impl.Attrs(types.AttrsData{
+		Ctx:     ctx,
+		Object:  b.toCloudObject(object),
+		Version: opt.version,
+	})
+	if attrsErr != nil {
+		return nil, attrsErr
+	}
+
+	return b.mapAttrs(attrs), nil
+}
+
+// Copy copies an object to a new name in the bucket, server-side,
+// including its attributes and metadata. It returns the attributes of the copy.
+//
+// The source version can be specified with WithVersion, and preconditions
+// on the destination with WithPreconditions.
+// If the source object does not exist, it returns ErrObjectNotFound.
+func (b *Bucket) Copy(ctx context.Context, src, dst string, options ...CopyOption) (*ObjectAttrs, error) {
+	var opt copyOptions
+	for _, o := range options {
+		o.applyCopy(&opt)
+	}
+
+	var (
+		attrs   *types.ObjectAttrs
+		copyErr error
+	)
+
+	curr := b.mgr.rt.Current()
+	if curr.Req != nil && curr.Trace != nil {
+		startEventID := curr.Trace.BucketObjectCopyStart(trace2.BucketObjectCopyStartParams{
+			EventParams: trace2.EventParams{
+				TraceID: curr.Req.TraceID,
+				SpanID:  curr.Req.SpanID,
+				Goid:    curr.Goctr,
+			},
+			Bucket:     b.name,
+			Src:        src,
+			Dst:        dst,
+			SrcVersion: ptrOrNil(opt.srcVersion),
+			Stack:      stack.Build(1),
+		})
+
+		defer func() {
+			curr.Trace.BucketObjectCopyEnd(trace2.BucketObjectCopyEndParams{
+				StartID: startEventID,
+				EventParams: trace2.EventParams{
+					TraceID: curr.Req.TraceID,
+					SpanID:  curr.Req.SpanID,
+					Goid:    curr.Goctr,
+				},
+				Err:   copyErr,
+				Attrs: traceAttrs(attrs),
+			})
+		}()
+	}
+
+	if copyErr = b.injectFault(ctx); copyErr != nil {
+		return nil, copyErr
+	}
+	attrs, copyErr = b.impl.Copy(types.CopyData{
+		Ctx:        ctx,
+		Src:        b.toCloudObject(src),
+		Dst:        b.toCloudObject(dst),
+		SrcVersion: opt.srcVersion,
+		Pre: types.Preconditions{
+			NotExists: opt.pre.NotExists,
+		},
+	})
+	if copyErr != nil {
+		return nil, copyErr
+	}
+	return b.mapAttrs(attrs), nil
+}
+
+// Move moves an object to a new name in the bucket, by copying it
+// and then removing the source object. It returns the attributes of
+// the moved object.
+//
+// It accepts the same options as Copy. If removing the source object fails,
+// the copy is left in place and the error is returned.
+func (b *Bucket) Move(ctx context.Context, src, dst string, options ...CopyOption) (*ObjectAttrs, error) {
+	var opt copyOptions
+	for _, o := range options {
+		o.applyCopy(&opt)
+	}
+
+	var (
+		attrs   *ObjectAttrs
+		moveErr error
+	)
+
+	curr := b.mgr.rt.Current()
+	if curr.Req != nil && curr.Trace != nil {
+		startEventID := curr.Trace.BucketObjectMoveStart(trace2.BucketObjectMoveStartParams{
+			EventParams: trace2.EventParams{
+				TraceID: curr.Req.TraceID,
+				SpanID:  curr.Req.SpanID,
+				Goid:    curr.Goctr,
+			},
+			Bucket:     b.name,
+			Src:        src,
+			Dst:        dst,
+			SrcVersion: ptrOrNil(opt.srcVersion),
+			Stack:      stack.Build(1),
+		})
+
+		defer func() {
+			params := trace2.BucketObjectMoveEndParams{
 				StartID: startEventID,
 				EventParams: trace2.EventParams{
 					TraceID: curr.Req.TraceID,
 					SpanID:  curr.Req.SpanID,
 					Goid:    curr.Goctr,
 				},
-				Err: attrsErr,
+				Err: moveErr,
 			}
 			if attrs != nil {
 				size := uint64(attrs.Size)
@@ -571,20 +726,24 @@ func (b *Bucket) Attrs(ctx context.Context, object string, options ...AttrsOptio
 					ContentType: ptrOrNil(attrs.ContentType),
 				}
 			}
-			curr.Trace.BucketObjectGetAttrsEnd(params)
+			curr.Trace.BucketObjectMoveEnd(params)
 		}()
 	}
 
-	attrs, attrsErr = b.impl.Attrs(types.AttrsData{
-		Ctx:     ctx,
-		Object:  b.toCloudObject(object),
-		Version: opt.version,
-	})
-	if attrsErr != nil {
-		return nil, attrsErr
+	// The copy and the removal are traced as operations of their own.
+	attrs, moveErr = b.Copy(ctx, src, dst, options...)
+	if moveErr != nil {
+		return nil, moveErr
 	}
 
-	return b.mapAttrs(attrs), nil
+	var removeOpts []RemoveOption
+	if opt.srcVersion != "" {
+		removeOpts = append(removeOpts, WithVersion(opt.srcVersion))
+	}
+	if moveErr = b.Remove(ctx, src, removeOpts...); moveErr != nil {
+		return nil, moveErr
+	}
+	return attrs, nil
 }
 
 // Generates an external URL to allow uploading an object to the bucket.
@@ -666,28 +825,22 @@ func (b *Bucket) Exists(ctx context.Context, object string, options ...ExistsOpt
 		})
 
 		defer func() {
-			params := trace2.BucketObjectGetAttrsEndParams{
+			curr.Trace.BucketObjectGetAttrsEnd(trace2.BucketObjectGetAttrsEndParams{
 				StartID: startEventID,
 				EventParams: trace2.EventParams{
 					TraceID: curr.Req.TraceID,
 					SpanID:  curr.Req.SpanID,
 					Goid:    curr.Goctr,
 				},
-				Err: attrsErr,
-			}
-			if attrs != nil {
-				size := uint64(attrs.Size)
-				params.Attrs = &trace2.BucketObjectAttributes{
-					Size:        &size,
-					Version:     ptrOrNil(attrs.Version),
-					ETag:        ptrOrNil(attrs.ETag),
-					ContentType: ptrOrNil(attrs.ContentType),
-				}
-			}
-			curr.Trace.BucketObjectGetAttrsEnd(params)
+				Err:   attrsErr,
+				Attrs: traceAttrs(attrs),
+			})
 		}()
 	}
 
+	if attrsErr = b.injectFault(ctx); attrsErr != nil {
+		return false, attrsErr
+	}
 	attrs, attrsErr = b.impl.Attrs(types.AttrsData{
 		Ctx:     ctx,
 		Object:  b.toCloudObject(object),
@@ -701,6 +854,12 @@ func (b *Bucket) Exists(ctx context.Context, object string, options ...ExistsOpt
 	return true, nil
 }
 
+// injectFault injects the faults configured with "encore chaos"
+// into an operation on the bucket.
+func (b *Bucket) injectFault(ctx context.Context) error {
+	return b.mgr.chaos.Inject(ctx, chaos.Target{Kind: chaos.Bucket, Resource: b.name})
+}
+
 func (b *Bucket) toCloudObject(object string) types.CloudObject {
 	return types.CloudObject(b.cloudPrefix() + object)
 }
@@ -725,6 +884,21 @@ func (b *Bucket) fromCloudObject(object types.CloudObject) string {
 	return strings.TrimPrefix(string(object), b.cloudPrefix())
 }
 
+// traceAttrs returns the object attributes to include in a trace event,
+// or nil if attrs is nil.
+func traceAttrs(attrs *types.ObjectAttrs) *trace2.BucketObjectAttributes {
+	if attrs == nil {
+		return nil
+	}
+	size := uint64(attrs.Size)
+	return &trace2.BucketObjectAttributes{
+		Size:        &size,
+		Version:     ptrOrNil(attrs.Version),
+		ETag:        ptrOrNil(attrs.ETag),
+		ContentType: ptrOrNil(attrs.ContentType),
+	}
+}
+
 func ptrOrNil[V comparable](val V) *V {
 	var zero V
 	if val != zero {
@@ -0,0 +1,217 @@
+package objects
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"encore.dev/storage/objects/internal/types"
+)
+
+// EventType describes the kind of change to an object.
+//
+// The values match the event types of Google Cloud Storage notifications.
+type EventType string
+
+const (
+	// ObjectCreated is the event type for an object being created or overwritten.
+	ObjectCreated EventType = "OBJECT_FINALIZE"
+
+	// ObjectDeleted is the event type for an object being deleted.
+	ObjectDeleted EventType = "OBJECT_DELETE"
+)
+
+// Event describes a change to an object in a bucket.
+//
+// Events are delivered to the pub/sub topics connected to a bucket
+// using NewBucketNotification. The topic must have Event as its message type:
+//
+//	var Uploads = pubsub.NewTopic[objects.Event]("uploads", pubsub.TopicConfig{
+//		DeliveryGuarantee: pubsub.AtLeastOnce,
+//	})
+type Event struct {
+	// Type is the type of change.
+	//
+	// Providers may deliver events of other types, such as test events
+	// when the notification is first configured, which should be ignored.
+	Type EventType `pubsub-attr:"eventType"`
+
+	// Bucket is the cloud name of the bucket.
+	Bucket string
+
+	// Name is the name of the object.
+	Name string
+
+	// Version is the version of the object, if bucket versioning is enabled.
+	Version string
+
+	// Size is the size of the object, in bytes.
+	Size int64
+
+	// ETag is the computed ETag of the object.
+	ETag string
+
+	// ContentType is the content type of the object, if known.
+	ContentType string
+
+	// Time is when the change happened.
+	Time time.Time
+}
+
+// UnmarshalJSON unmarshals an event, either in the format it's published
+// in by Encore or as delivered by S3 or Google Cloud Storage notifications.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		Kind    string `json:"kind"`
+		Records []json.RawMessage
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+
+	switch {
+	case len(probe.Records) > 0:
+		return e.unmarshalS3(probe.Records[0])
+	case probe.Kind == "storage#object":
+		return e.unmarshalGCS(data)
+	default:
+		type plain Event
+		return json.Unmarshal(data, (*plain)(e))
+	}
+}
+
+// unmarshalS3 unmarshals an S3 event notification record.
+func (e *Event) unmarshalS3(data []byte) error {
+	var rec struct {
+		EventName string    `json:"eventName"`
+		EventTime time.Time `json:"eventTime"`
+		S3        struct {
+			Bucket struct {
+				Name string `json:"name"`
+			} `json:"bucket"`
+			Object struct {
+				Key       string `json:"key"`
+				Size      int64  `json:"size"`
+				ETag      string `json:"eTag"`
+				VersionID string `json:"versionId"`
+			} `json:"object"`
+		} `json:"s3"`
+	}
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return err
+	}
+
+	// S3 URL-encodes object keys in event notifications.
+	name, err := url.QueryUnescape(rec.S3.Object.Key)
+	if err != nil {
+		name = rec.S3.Object.Key
+	}
+
+	*e = Event{
+		Type:    EventType(rec.EventName),
+		Bucket:  rec.S3.Bucket.Name,
+		Name:    name,
+		Version: rec.S3.Object.VersionID,
+		Size:    rec.S3.Object.Size,
+		ETag:    rec.S3.Object.ETag,
+		Time:    rec.EventTime,
+	}
+	switch {
+	case strings.HasPrefix(rec.EventName, "ObjectCreated:"):
+		e.Type = ObjectCreated
+	case strings.HasPrefix(rec.EventName, "ObjectRemoved:"):
+		e.Type = ObjectDeleted
+	}
+	return nil
+}
+
+// unmarshalGCS unmarshals the object resource delivered by Google Cloud Storage
+// notifications. The event type is delivered as a message attribute.
+func (e *Event) unmarshalGCS(data []byte) error {
+	var obj struct {
+		Bucket      string    `json:"bucket"`
+		Name        string    `json:"name"`
+		Generation  string    `json:"generation"`
+		Size        string    `json:"size"`
+		ETag        string    `json:"etag"`
+		ContentType string    `json:"contentType"`
+		Updated     time.Time `json:"updated"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+
+	size, _ := strconv.ParseInt(obj.Size, 10, 64)
+	*e = Event{
+		Type:        e.Type,
+		Bucket:      obj.Bucket,
+		Name:        obj.Name,
+		Version:     obj.Generation,
+		Size:        size,
+		ETag:        obj.ETag,
+		ContentType: obj.ContentType,
+		Time:        obj.Updated,
+	}
+	return nil
+}
+
+// NotificationConfig is the configuration for a bucket notification.
+type NotificationConfig struct {
+	// ObjectCreated specifies whether to deliver an event
+	// whenever an object is created or overwritten.
+	ObjectCreated bool
+
+	// ObjectDeleted specifies whether to deliver an event
+	// whenever an object is deleted.
+	ObjectDeleted bool
+
+	// Prefix, if set, limits the events to objects
+	// whose name starts with the given prefix.
+	Prefix string
+}
+
+// BucketNotification connects the object events of a bucket to a pub/sub topic.
+// It is declared using NewBucketNotification.
+type BucketNotification struct {
+	bucket *Bucket
+	topic  string // the Encore name of the topic
+	cfg    NotificationConfig
+}
+
+// configure configures the provider to deliver the notification's events to the topic,
+// if the bucket's infrastructure configuration lists the topic as one of its event topics.
+func (n *BucketNotification) configure(ctx context.Context) error {
+	b := n.bucket
+	if !slices.Contains(b.runtimeCfg.EventTopics, n.topic) {
+		return nil
+	}
+
+	topicCfg, ok := b.mgr.runtime.PubsubTopics[n.topic]
+	if !ok {
+		return fmt.Errorf("topic %s is not configured", n.topic)
+	}
+	impl, ok := b.impl.(types.NotificationConfigurer)
+	if !ok {
+		return fmt.Errorf("the provider of bucket %s does not support event notifications", b.name)
+	}
+
+	data := types.NotificationData{
+		Ctx:           ctx,
+		Topic:         topicCfg.ProviderName,
+		ObjectCreated: n.cfg.ObjectCreated,
+		ObjectDeleted: n.cfg.ObjectDeleted,
+		Prefix:        types.CloudObject(b.baseCloudPrefix + n.cfg.Prefix),
+	}
+	if topicCfg.GCP != nil {
+		data.TopicProjectID = topicCfg.GCP.ProjectID
+	}
+	if err := impl.ConfigureNotification(data); err != nil {
+		return fmt.Errorf("configure events of bucket %s for topic %s: %w", b.name, n.topic, err)
+	}
+	return nil
+}
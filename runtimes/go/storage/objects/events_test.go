@@ -0,0 +1,45 @@
+package objects
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestEvent_UnmarshalJSON(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		data string
+		want Event
+	}{
+		{
+			name: "encore",
+			data: `{"Type":"OBJECT_DELETE","Bucket":"images","Name":"a.png","Size":3,"Time":"2024-05-01T12:00:00Z"}`,
+			want: Event{Type: ObjectDeleted, Bucket: "images", Name: "a.png", Size: 3, Time: ts},
+		},
+		{
+			name: "s3",
+			data: `{"Records":[{"eventName":"ObjectCreated:Put","eventTime":"2024-05-01T12:00:00Z",
+				"s3":{"bucket":{"name":"images"},"object":{"key":"my+file%3F.png","size":3,"eTag":"abc","versionId":"v1"}}}]}`,
+			want: Event{Type: ObjectCreated, Bucket: "images", Name: "my file?.png", Version: "v1", Size: 3, ETag: "abc", Time: ts},
+		},
+		{
+			name: "gcs",
+			data: `{"kind":"storage#object","bucket":"images","name":"a.png","generation":"17","size":"3",
+				"etag":"abc","contentType":"image/png","updated":"2024-05-01T12:00:00Z"}`,
+			want: Event{Bucket: "images", Name: "a.png", Version: "17", Size: 3, ETag: "abc", ContentType: "image/png", Time: ts},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := qt.New(t)
+			var got Event
+			c.Assert(json.Unmarshal([]byte(tt.data), &got), qt.IsNil)
+			c.Assert(got, qt.DeepEquals, tt.want)
+		})
+	}
+}
@@ -0,0 +1,429 @@
+package azure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/service"
+
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/storage/objects/internal/types"
+)
+
+// copyPollInterval is how often the status of a pending copy is checked.
+const copyPollInterval = 500 * time.Millisecond
+
+type Manager struct {
+	ctx     context.Context
+	runtime *config.Runtime
+
+	mu      sync.Mutex
+	clients map[*config.BucketProvider]*service.Client
+}
+
+func NewManager(ctx context.Context, runtime *config.Runtime) *Manager {
+	return &Manager{ctx: ctx, runtime: runtime, clients: make(map[*config.BucketProvider]*service.Client)}
+}
+
+type bucket struct {
+	client    *container.Client
+	svc       *service.Client
+	sharedKey bool
+	cfg       *config.Bucket
+}
+
+func (mgr *Manager) ProviderName() string { return "azure" }
+
+func (mgr *Manager) Matches(cfg *config.BucketProvider) bool {
+	return cfg.Azure != nil
+}
+
+func (mgr *Manager) NewBucket(provider *config.BucketProvider, runtimeCfg *config.Bucket) types.BucketImpl {
+	svc := mgr.clientForProvider(provider)
+	return &bucket{
+		client:    svc.NewContainerClient(runtimeCfg.CloudName),
+		svc:       svc,
+		sharedKey: provider.Azure.AccountKey != nil,
+		cfg:       runtimeCfg,
+	}
+}
+
+func (b *bucket) blobClient(obj types.CloudObject, version string) (*blob.Client, error) {
+	client := b.client.NewBlobClient(obj.String())
+	if version != "" {
+		return client.WithVersionID(version)
+	}
+	return client, nil
+}
+
+func (b *bucket) Download(data types.DownloadData) (types.Downloader, error) {
+	client, err := b.blobClient(data.Object, data.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts blob.DownloadStreamOptions
+	if rng := data.Range; rng != nil {
+		if rng.Length == 0 {
+			return io.NopCloser(strings.NewReader("")), nil
+		} else if rng.Length > 0 {
+			opts.Range = blob.HTTPRange{Offset: rng.Offset, Count: rng.Length}
+		} else {
+			// A zero count means until the end of the blob.
+			opts.Range = blob.HTTPRange{Offset: rng.Offset}
+		}
+	}
+
+	resp, err := client.DownloadStream(data.Ctx, &opts)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	return resp.Body, nil
+}
+
+func (b *bucket) Upload(data types.UploadData) (types.Uploader, error) {
+	client := b.client.NewBlockBlobClient(data.Object.String())
+
+	opts := &blockblob.UploadStreamOptions{
+		HTTPHeaders: &blob.HTTPHeaders{
+			BlobContentType:        ptrOrNil(data.Attrs.ContentType),
+			BlobCacheControl:       ptrOrNil(data.Attrs.CacheControl),
+			BlobContentDisposition: ptrOrNil(data.Attrs.ContentDisposition),
+		},
+		Metadata: toAzureMetadata(data.Attrs.Metadata),
+	}
+	if data.Pre.NotExists {
+		opts.AccessConditions = &blob.AccessConditions{
+			ModifiedAccessConditions: &blob.ModifiedAccessConditions{IfNoneMatch: ptr(azcore.ETagAny)},
+		}
+	}
+
+	pr, pw := io.Pipe()
+	u := &uploader{pw: pw, done: make(chan struct{})}
+	go func() {
+		defer close(u.done)
+		resp, err := client.UploadStream(data.Ctx, pr, opts)
+		if err != nil {
+			err = mapErr(err)
+			_ = pr.CloseWithError(err)
+			u.err = err
+			return
+		}
+		u.attrs = &types.ObjectAttrs{
+			Object:             data.Object,
+			Version:            valOrZero(resp.VersionID),
+			ContentType:        data.Attrs.ContentType,
+			CacheControl:       data.Attrs.CacheControl,
+			ContentDisposition: data.Attrs.ContentDisposition,
+			Metadata:           data.Attrs.Metadata,
+			ETag:               etag(resp.ETag),
+		}
+	}()
+	return u, nil
+}
+
+type uploader struct {
+	pw   *io.PipeWriter
+	size int64
+
+	done  chan struct{}
+	attrs *types.ObjectAttrs
+	err   error
+}
+
+func (u *uploader) Write(p []byte) (int, error) {
+	n, err := u.pw.Write(p)
+	u.size += int64(n)
+	return n, err
+}
+
+func (u *uploader) Abort(err error) {
+	if err == nil {
+		err = errors.New("upload aborted")
+	}
+	_ = u.pw.CloseWithError(err)
+	<-u.done
+}
+
+func (u *uploader) Complete() (*types.ObjectAttrs, error) {
+	_ = u.pw.Close()
+	<-u.done
+	if u.err != nil {
+		return nil, u.err
+	}
+	u.attrs.Size = u.size
+	return u.attrs, nil
+}
+
+func (b *bucket) List(data types.ListData) iter.Seq2[*types.ListEntry, error] {
+	return func(yield func(*types.ListEntry, error) bool) {
+		pager := b.client.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{
+			Prefix: ptrOrNil(data.Prefix),
+		})
+
+		var n int64
+		for pager.More() {
+			resp, err := pager.NextPage(data.Ctx)
+			if err != nil {
+				yield(nil, mapErr(err))
+				return
+			}
+
+			for _, item := range resp.Segment.BlobItems {
+				if data.Limit != nil && n >= *data.Limit {
+					return
+				}
+				entry := &types.ListEntry{Object: types.CloudObject(valOrZero(item.Name))}
+				if props := item.Properties; props != nil {
+					entry.Size = valOrZero(props.ContentLength)
+					entry.ETag = etag(props.ETag)
+				}
+				if !yield(entry, nil) {
+					return
+				}
+				n++
+			}
+		}
+	}
+}
+
+func (b *bucket) Remove(data types.RemoveData) error {
+	client, err := b.blobClient(data.Object, data.Version)
+	if err != nil {
+		return err
+	}
+	_, err = client.Delete(data.Ctx, nil)
+	return mapErr(err)
+}
+
+func (b *bucket) Attrs(data types.AttrsData) (*types.ObjectAttrs, error) {
+	client, err := b.blobClient(data.Object, data.Version)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetProperties(data.Ctx, nil)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	version := valOrZero(resp.VersionID)
+	if version == "" {
+		version = data.Version
+	}
+	return &types.ObjectAttrs{
+		Object:             data.Object,
+		Version:            version,
+		ContentType:        valOrZero(resp.ContentType),
+		CacheControl:       valOrZero(resp.CacheControl),
+		ContentDisposition: valOrZero(resp.ContentDisposition),
+		Metadata:           fromAzureMetadata(resp.Metadata),
+		Size:               valOrZero(resp.ContentLength),
+		ETag:               etag(resp.ETag),
+	}, nil
+}
+
+func (b *bucket) Copy(data types.CopyData) (*types.ObjectAttrs, error) {
+	src, err := b.blobClient(data.Src, data.SrcVersion)
+	if err != nil {
+		return nil, err
+	}
+	dst := b.client.NewBlobClient(data.Dst.String())
+
+	var opts blob.StartCopyFromURLOptions
+	if data.Pre.NotExists {
+		opts.AccessConditions = &blob.AccessConditions{
+			ModifiedAccessConditions: &blob.ModifiedAccessConditions{IfNoneMatch: ptr(azcore.ETagAny)},
+		}
+	}
+
+	// Copies within a storage account are authorized by the request itself.
+	resp, err := dst.StartCopyFromURL(data.Ctx, src.URL(), &opts)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	// Copies are asynchronous; wait for it to complete.
+	status := valOrZero(resp.CopyStatus)
+	for status == blob.CopyStatusTypePending {
+		select {
+		case <-data.Ctx.Done():
+			return nil, data.Ctx.Err()
+		case <-time.After(copyPollInterval):
+		}
+		props, err := dst.GetProperties(data.Ctx, nil)
+		if err != nil {
+			return nil, mapErr(err)
+		}
+		status = valOrZero(props.CopyStatus)
+	}
+	if status != blob.CopyStatusTypeSuccess {
+		return nil, fmt.Errorf("copy failed with status %q", status)
+	}
+
+	return b.Attrs(types.AttrsData{Ctx: data.Ctx, Object: data.Dst, Version: valOrZero(resp.VersionID)})
+}
+
+func (b *bucket) SignedUploadURL(data types.UploadURLData) (string, error) {
+	return b.signedURL(data.Ctx, data.Object, sas.BlobPermissions{Create: true, Write: true}, data.TTL)
+}
+
+func (b *bucket) SignedDownloadURL(data types.DownloadURLData) (string, error) {
+	return b.signedURL(data.Ctx, data.Object, sas.BlobPermissions{Read: true}, data.TTL)
+}
+
+// signedURL returns a SAS URL for the object with the given permissions.
+//
+// With a shared key the URL is signed with the key. Otherwise it's signed
+// using a user delegation key, which requires the credentials to be allowed
+// to generate user delegation keys for the storage account.
+func (b *bucket) signedURL(ctx context.Context, obj types.CloudObject, perms sas.BlobPermissions, ttl time.Duration) (string, error) {
+	client := b.client.NewBlobClient(obj.String())
+	expiry := time.Now().Add(ttl)
+	if b.sharedKey {
+		u, err := client.GetSASURL(perms, expiry, nil)
+		return u, mapErr(err)
+	}
+
+	// Allow for some clock skew.
+	start := time.Now().Add(-5 * time.Minute).UTC()
+	cred, err := b.svc.GetUserDelegationCredential(ctx, service.KeyInfo{
+		Start:  ptr(start.Format(sas.TimeFormat)),
+		Expiry: ptr(expiry.UTC().Format(sas.TimeFormat)),
+	}, nil)
+	if err != nil {
+		return "", mapErr(err)
+	}
+
+	params, err := sas.BlobSignatureValues{
+		Protocol:      sas.ProtocolHTTPS,
+		StartTime:     start,
+		ExpiryTime:    expiry.UTC(),
+		Permissions:   perms.String(),
+		ContainerName: b.cfg.CloudName,
+		BlobName:      obj.String(),
+	}.SignWithUserDelegation(cred)
+	if err != nil {
+		return "", err
+	}
+	return client.URL() + "?" + params.Encode(), nil
+}
+
+func (mgr *Manager) clientForProvider(prov *config.BucketProvider) *service.Client {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	if client, ok := mgr.clients[prov]; ok {
+		return client
+	}
+
+	cfg := prov.Azure
+	serviceURL := fmt.Sprintf("https://%s.blob.core.windows.net/", cfg.StorageAccount)
+	if cfg.Endpoint != nil {
+		serviceURL = *cfg.Endpoint
+	}
+
+	var (
+		client *service.Client
+		err    error
+	)
+	if cfg.AccountKey != nil {
+		var cred *service.SharedKeyCredential
+		cred, err = service.NewSharedKeyCredential(cfg.StorageAccount, *cfg.AccountKey)
+		if err != nil {
+			panic(fmt.Sprintf("invalid azure storage account key: %v", err))
+		}
+		client, err = service.NewClientWithSharedKeyCredential(serviceURL, cred, nil)
+	} else {
+		var cred *azidentity.DefaultAzureCredential
+		cred, err = azidentity.NewDefaultAzureCredential(nil)
+		if err != nil {
+			panic(fmt.Sprintf("unable to load azure credentials: %v", err))
+		}
+		client, err = service.NewClient(serviceURL, cred, nil)
+	}
+	if err != nil {
+		panic(fmt.Sprintf("unable to create azure blob storage client: %v", err))
+	}
+
+	mgr.clients[prov] = client
+	return client
+}
+
+func mapErr(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.CannotVerifyCopySource):
+		return types.ErrObjectNotExist
+	case bloberror.HasCode(err, bloberror.ConditionNotMet, bloberror.BlobAlreadyExists):
+		return types.ErrPreconditionFailed
+	default:
+		return err
+	}
+}
+
+func toAzureMetadata(md map[string]string) map[string]*string {
+	if md == nil {
+		return nil
+	}
+	res := make(map[string]*string, len(md))
+	for k, v := range md {
+		res[k] = ptr(v)
+	}
+	return res
+}
+
+// fromAzureMetadata converts blob metadata from the SDK.
+//
+// The SDK reads metadata from the response headers, whose names are canonicalized
+// by net/http. Azure metadata names are case-insensitive, so they're lowercased
+// to match the names the metadata was written with.
+func fromAzureMetadata(md map[string]*string) map[string]string {
+	if md == nil {
+		return nil
+	}
+	res := make(map[string]string, len(md))
+	for k, v := range md {
+		res[strings.ToLower(k)] = valOrZero(v)
+	}
+	return res
+}
+
+func etag(tag *azcore.ETag) string {
+	if tag == nil {
+		return ""
+	}
+	return string(*tag)
+}
+
+func ptrOrNil[T comparable](val T) *T {
+	var zero T
+	if val != zero {
+		return &val
+	}
+	return nil
+}
+
+func valOrZero[T comparable](val *T) T {
+	if val != nil {
+		return *val
+	}
+	var zero T
+	return zero
+}
+
+func ptr[T any](val T) *T {
+	return &val
+}
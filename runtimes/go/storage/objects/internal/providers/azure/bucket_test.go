@@ -0,0 +1,407 @@
+package azure
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/storage/objects/internal/types"
+)
+
+const testAccount = "devstoreaccount1"
+
+// fakeBlob is a blob stored by fakeBlobService.
+type fakeBlob struct {
+	data               []byte
+	contentType        string
+	cacheControl       string
+	contentDisposition string
+	metadata           map[string]string
+	etag               string
+}
+
+// fakeBlobService implements the parts of the Azure Blob Storage REST API
+// used by the provider, storing the blobs of a single container in memory.
+type fakeBlobService struct {
+	container string
+	pageSize  int
+
+	mu     sync.Mutex
+	blobs  map[string]*fakeBlob
+	blocks map[string][]byte // staged blocks, by blob name and block id
+	etags  int
+}
+
+func newTestBucket(c *qt.C) (*fakeBlobService, *bucket) {
+	svc := &fakeBlobService{
+		container: "files-cloud",
+		pageSize:  2,
+		blobs:     make(map[string]*fakeBlob),
+		blocks:    make(map[string][]byte),
+	}
+	srv := httptest.NewServer(svc)
+	c.Cleanup(srv.Close)
+
+	prov := &config.BucketProvider{Azure: &config.AzureBlobBucketProvider{
+		StorageAccount: testAccount,
+		Endpoint:       ptr(srv.URL + "/" + testAccount + "/"),
+		AccountKey:     ptr(base64.StdEncoding.EncodeToString([]byte("secret"))),
+	}}
+	cfg := &config.Bucket{EncoreName: "files", CloudName: svc.container}
+	mgr := NewManager(context.Background(), &config.Runtime{
+		BucketProviders: []*config.BucketProvider{prov},
+		Buckets:         map[string]*config.Bucket{"files": cfg},
+	})
+	return svc, mgr.NewBucket(prov, cfg).(*bucket)
+}
+
+func (s *fakeBlobService) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path, ok := strings.CutPrefix(req.URL.Path, "/"+testAccount+"/"+s.container)
+	if !ok {
+		writeError(w, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+	name := strings.TrimPrefix(path, "/")
+	query := req.URL.Query()
+
+	switch {
+	case name == "" && req.Method == "GET" && query.Get("comp") == "list":
+		s.list(w, query.Get("prefix"), query.Get("marker"))
+	case req.Method == "PUT" && query.Get("comp") == "block":
+		data, _ := io.ReadAll(req.Body)
+		s.blocks[name+"/"+query.Get("blockid")] = data
+		w.WriteHeader(http.StatusCreated)
+	case req.Method == "PUT" && query.Get("comp") == "blocklist":
+		s.commitBlockList(w, req, name)
+	case req.Method == "PUT" && req.Header.Get("x-ms-copy-source") != "":
+		s.copy(w, req, name)
+	case req.Method == "PUT":
+		data, _ := io.ReadAll(req.Body)
+		s.put(w, req, name, data)
+	case req.Method == "GET":
+		s.download(w, req, name)
+	case req.Method == "HEAD":
+		blob, ok := s.blobs[name]
+		if !ok {
+			writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		writeProperties(w, blob)
+		w.WriteHeader(http.StatusOK)
+	case req.Method == "DELETE":
+		if _, ok := s.blobs[name]; !ok {
+			writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		delete(s.blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		writeError(w, http.StatusBadRequest, "UnsupportedHttpVerb")
+	}
+}
+
+func (s *fakeBlobService) commitBlockList(w http.ResponseWriter, req *http.Request, name string) {
+	var blockList struct {
+		Latest []string `xml:"Latest"`
+	}
+	if err := xml.NewDecoder(req.Body).Decode(&blockList); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidXmlDocument")
+		return
+	}
+	var data []byte
+	for _, id := range blockList.Latest {
+		data = append(data, s.blocks[name+"/"+id]...)
+		delete(s.blocks, name+"/"+id)
+	}
+	s.put(w, req, name, data)
+}
+
+// put stores a blob with the given data and the properties from the request headers.
+func (s *fakeBlobService) put(w http.ResponseWriter, req *http.Request, name string, data []byte) {
+	if _, exists := s.blobs[name]; exists && req.Header.Get("If-None-Match") == "*" {
+		writeError(w, http.StatusConflict, "BlobAlreadyExists")
+		return
+	}
+
+	blob := &fakeBlob{
+		data:               data,
+		contentType:        req.Header.Get("x-ms-blob-content-type"),
+		cacheControl:       req.Header.Get("x-ms-blob-cache-control"),
+		contentDisposition: req.Header.Get("x-ms-blob-content-disposition"),
+		metadata:           make(map[string]string),
+	}
+	for key := range req.Header {
+		if k, ok := strings.CutPrefix(strings.ToLower(key), "x-ms-meta-"); ok {
+			blob.metadata[k] = req.Header.Get(key)
+		}
+	}
+	s.store(name, blob)
+	w.Header().Set("ETag", blob.etag)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *fakeBlobService) copy(w http.ResponseWriter, req *http.Request, name string) {
+	if _, exists := s.blobs[name]; exists && req.Header.Get("If-None-Match") == "*" {
+		writeError(w, http.StatusConflict, "BlobAlreadyExists")
+		return
+	}
+
+	prefix := "/" + testAccount + "/" + s.container + "/"
+	src, ok := s.blobs[strings.TrimPrefix(pathOf(req.Header.Get("x-ms-copy-source")), prefix)]
+	if !ok {
+		writeError(w, http.StatusNotFound, "CannotVerifyCopySource")
+		return
+	}
+	dst := *src
+	s.store(name, &dst)
+	w.Header().Set("ETag", dst.etag)
+	w.Header().Set("x-ms-copy-id", "copy-"+dst.etag)
+	w.Header().Set("x-ms-copy-status", "success")
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *fakeBlobService) download(w http.ResponseWriter, req *http.Request, name string) {
+	blob, ok := s.blobs[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, "BlobNotFound")
+		return
+	}
+	writeProperties(w, blob)
+
+	rng, ok := strings.CutPrefix(req.Header.Get("x-ms-range"), "bytes=")
+	if !ok {
+		w.Header().Set("Content-Length", strconv.Itoa(len(blob.data)))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(blob.data)
+		return
+	}
+
+	start, end, _ := strings.Cut(rng, "-")
+	from, _ := strconv.Atoi(start)
+	to := len(blob.data) - 1
+	if end != "" {
+		to, _ = strconv.Atoi(end)
+		to = min(to, len(blob.data)-1)
+	}
+	data := blob.data[from : to+1]
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", from, to, len(blob.data)))
+	w.WriteHeader(http.StatusPartialContent)
+	_, _ = w.Write(data)
+}
+
+// list lists the blobs with the given prefix, s.pageSize blobs at a time.
+func (s *fakeBlobService) list(w http.ResponseWriter, prefix, marker string) {
+	var names []string
+	for name := range s.blobs {
+		if strings.HasPrefix(name, prefix) && name >= marker {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var buf bytes.Buffer
+	buf.WriteString(`<?xml version="1.0" encoding="utf-8"?><EnumerationResults><Blobs>`)
+	for i, name := range names {
+		if i == s.pageSize {
+			buf.WriteString(`</Blobs><NextMarker>`)
+			_ = xml.EscapeText(&buf, []byte(name))
+			buf.WriteString(`</NextMarker></EnumerationResults>`)
+			writeXML(w, buf.Bytes())
+			return
+		}
+		blob := s.blobs[name]
+		buf.WriteString(`<Blob><Name>`)
+		_ = xml.EscapeText(&buf, []byte(name))
+		fmt.Fprintf(&buf, `</Name><Properties><Content-Length>%d</Content-Length><Etag>%s</Etag></Properties></Blob>`,
+			len(blob.data), blob.etag)
+	}
+	buf.WriteString(`</Blobs><NextMarker /></EnumerationResults>`)
+	writeXML(w, buf.Bytes())
+}
+
+func (s *fakeBlobService) store(name string, blob *fakeBlob) {
+	s.etags++
+	blob.etag = fmt.Sprintf(`"0x%X"`, s.etags)
+	s.blobs[name] = blob
+}
+
+func writeProperties(w http.ResponseWriter, blob *fakeBlob) {
+	h := w.Header()
+	h.Set("Content-Length", strconv.Itoa(len(blob.data)))
+	h.Set("ETag", blob.etag)
+	if blob.contentType != "" {
+		h.Set("Content-Type", blob.contentType)
+	}
+	if blob.cacheControl != "" {
+		h.Set("Cache-Control", blob.cacheControl)
+	}
+	if blob.contentDisposition != "" {
+		h.Set("Content-Disposition", blob.contentDisposition)
+	}
+	for k, v := range blob.metadata {
+		h.Set("x-ms-meta-"+k, v)
+	}
+}
+
+func writeXML(w http.ResponseWriter, data []byte) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+// pathOf returns the unescaped path of the given URL.
+func pathOf(rawURL string) string {
+	req, err := http.NewRequest("GET", rawURL, nil)
+	if err != nil {
+		return ""
+	}
+	return req.URL.Path
+}
+
+func upload(c *qt.C, b *bucket, obj, content string, attrs types.UploadAttrs, pre types.Preconditions) (*types.ObjectAttrs, error) {
+	u, err := b.Upload(types.UploadData{
+		Ctx:    context.Background(),
+		Object: types.CloudObject(obj),
+		Attrs:  attrs,
+		Pre:    pre,
+	})
+	c.Assert(err, qt.IsNil)
+	_, err = io.WriteString(u, content)
+	c.Assert(err, qt.IsNil)
+	return u.Complete()
+}
+
+func download(c *qt.C, b *bucket, obj string, rng *types.Range) string {
+	r, err := b.Download(types.DownloadData{Ctx: context.Background(), Object: types.CloudObject(obj), Range: rng})
+	c.Assert(err, qt.IsNil)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	c.Assert(err, qt.IsNil)
+	return string(data)
+}
+
+func list(c *qt.C, b *bucket, prefix string, limit *int64) []string {
+	var names []string
+	for e, err := range b.List(types.ListData{Ctx: context.Background(), Prefix: prefix, Limit: limit}) {
+		c.Assert(err, qt.IsNil)
+		names = append(names, string(e.Object))
+	}
+	return names
+}
+
+func TestBucket_RoundTrip(t *testing.T) {
+	c := qt.New(t)
+	_, b := newTestBucket(c)
+	ctx := context.Background()
+
+	attrs, err := upload(c, b, "dir/hello.txt", "hello world", types.UploadAttrs{
+		ContentType:        "text/plain",
+		CacheControl:       "no-cache",
+		ContentDisposition: "inline",
+		Metadata:           map[string]string{"owner": "alice"},
+	}, types.Preconditions{})
+	c.Assert(err, qt.IsNil)
+	c.Assert(attrs.Size, qt.Equals, int64(11))
+	c.Assert(attrs.ETag, qt.Not(qt.Equals), "")
+
+	got, err := b.Attrs(types.AttrsData{Ctx: ctx, Object: "dir/hello.txt"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(got.Size, qt.Equals, int64(11))
+	c.Assert(got.ContentType, qt.Equals, "text/plain")
+	c.Assert(got.CacheControl, qt.Equals, "no-cache")
+	c.Assert(got.ContentDisposition, qt.Equals, "inline")
+	c.Assert(got.Metadata, qt.DeepEquals, map[string]string{"owner": "alice"})
+	c.Assert(got.ETag, qt.Equals, attrs.ETag)
+
+	c.Assert(download(c, b, "dir/hello.txt", nil), qt.Equals, "hello world")
+	c.Assert(download(c, b, "dir/hello.txt", &types.Range{Offset: 6, Length: 3}), qt.Equals, "wor")
+	c.Assert(download(c, b, "dir/hello.txt", &types.Range{Offset: 6, Length: -1}), qt.Equals, "world")
+	c.Assert(download(c, b, "dir/hello.txt", &types.Range{Offset: 6, Length: 0}), qt.Equals, "")
+
+	copied, err := b.Copy(types.CopyData{Ctx: ctx, Src: "dir/hello.txt", Dst: "other.txt"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(copied.Object, qt.Equals, types.CloudObject("other.txt"))
+	c.Assert(copied.Size, qt.Equals, int64(11))
+	c.Assert(copied.ContentType, qt.Equals, "text/plain")
+	c.Assert(download(c, b, "other.txt", nil), qt.Equals, "hello world")
+
+	c.Assert(b.Remove(types.RemoveData{Ctx: ctx, Object: "dir/hello.txt"}), qt.IsNil)
+	c.Assert(download(c, b, "other.txt", nil), qt.Equals, "hello world")
+}
+
+func TestBucket_List(t *testing.T) {
+	c := qt.New(t)
+	_, b := newTestBucket(c)
+
+	for _, name := range []string{"dir/a", "dir/b", "dir/sub/c", "other"} {
+		_, err := upload(c, b, name, name, types.UploadAttrs{}, types.Preconditions{})
+		c.Assert(err, qt.IsNil)
+	}
+
+	// The results span multiple pages.
+	c.Assert(list(c, b, "", nil), qt.DeepEquals, []string{"dir/a", "dir/b", "dir/sub/c", "other"})
+	c.Assert(list(c, b, "dir/", nil), qt.DeepEquals, []string{"dir/a", "dir/b", "dir/sub/c"})
+	c.Assert(list(c, b, "dir/", ptr(int64(1))), qt.DeepEquals, []string{"dir/a"})
+	c.Assert(list(c, b, "missing/", nil), qt.HasLen, 0)
+
+	for e, err := range b.List(types.ListData{Ctx: context.Background(), Prefix: "other"}) {
+		c.Assert(err, qt.IsNil)
+		c.Assert(e.Size, qt.Equals, int64(len("other")))
+		c.Assert(e.ETag, qt.Not(qt.Equals), "")
+	}
+}
+
+func TestBucket_Errors(t *testing.T) {
+	c := qt.New(t)
+	_, b := newTestBucket(c)
+	ctx := context.Background()
+
+	_, err := b.Attrs(types.AttrsData{Ctx: ctx, Object: "missing"})
+	c.Assert(err, qt.Equals, types.ErrObjectNotExist)
+	_, err = b.Download(types.DownloadData{Ctx: ctx, Object: "missing"})
+	c.Assert(err, qt.Equals, types.ErrObjectNotExist)
+	c.Assert(b.Remove(types.RemoveData{Ctx: ctx, Object: "missing"}), qt.Equals, types.ErrObjectNotExist)
+	_, err = b.Copy(types.CopyData{Ctx: ctx, Src: "missing", Dst: "dst"})
+	c.Assert(err, qt.Equals, types.ErrObjectNotExist)
+
+	_, err = upload(c, b, "file", "one", types.UploadAttrs{}, types.Preconditions{NotExists: true})
+	c.Assert(err, qt.IsNil)
+	_, err = upload(c, b, "file", "two", types.UploadAttrs{}, types.Preconditions{NotExists: true})
+	c.Assert(err, qt.Equals, types.ErrPreconditionFailed)
+	c.Assert(download(c, b, "file", nil), qt.Equals, "one")
+
+	_, err = upload(c, b, "dst", "dst", types.UploadAttrs{}, types.Preconditions{})
+	c.Assert(err, qt.IsNil)
+	_, err = b.Copy(types.CopyData{Ctx: ctx, Src: "file", Dst: "dst", Pre: types.Preconditions{NotExists: true}})
+	c.Assert(err, qt.Equals, types.ErrPreconditionFailed)
+	c.Assert(download(c, b, "dst", nil), qt.Equals, "dst")
+
+	// Overwriting without preconditions is allowed.
+	_, err = upload(c, b, "file", "two", types.UploadAttrs{}, types.Preconditions{})
+	c.Assert(err, qt.IsNil)
+	c.Assert(download(c, b, "file", nil), qt.Equals, "two")
+}
@@ -0,0 +1,598 @@
+// Package filesystem implements an object storage provider that stores
+// objects on the local filesystem.
+//
+// It's intended for single-node deployments: objects are only visible
+// to the process (or processes on the same host) reading the directory,
+// and concurrent writers are coordinated within a single process only.
+package filesystem
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"iter"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/shared/encoreroutes"
+	"encore.dev/storage/objects/internal/types"
+)
+
+// liveFile is the name of the file pointing to the
+// current generation of an object.
+const liveFile = "live"
+
+// tmpDir is the name of the directory within a bucket
+// where uploads are written before being committed.
+const tmpDir = ".tmp"
+
+// keyFile is the name of the file holding the object key,
+// for objects whose directory is named by a hash of the key.
+const keyFile = "key"
+
+// maxDirNameLen is the maximum length of an object directory name,
+// within the limit of path components on common filesystems (NAME_MAX).
+const maxDirNameLen = 255
+
+// hashedDirPrefix prefixes the names of object directories named by
+// a hash of the object key. It isn't valid base64, so the names don't
+// collide with directories named by the encoded key.
+const hashedDirPrefix = "sha256."
+
+type Manager struct {
+	ctx     context.Context
+	runtime *config.Runtime
+
+	mu      sync.Mutex
+	buckets map[*config.Bucket]*bucket
+	keys    map[*config.BucketProvider][]byte
+}
+
+func NewManager(ctx context.Context, runtime *config.Runtime) *Manager {
+	return &Manager{
+		ctx:     ctx,
+		runtime: runtime,
+		buckets: make(map[*config.Bucket]*bucket),
+		keys:    make(map[*config.BucketProvider][]byte),
+	}
+}
+
+func (mgr *Manager) ProviderName() string { return "filesystem" }
+
+func (mgr *Manager) Matches(cfg *config.BucketProvider) bool {
+	return cfg.Filesystem != nil
+}
+
+func (mgr *Manager) NewBucket(provider *config.BucketProvider, runtimeCfg *config.Bucket) types.BucketImpl {
+	return mgr.bucketFor(provider, runtimeCfg)
+}
+
+// RegisterRoutes registers the handler serving signed URLs.
+func (mgr *Manager) RegisterRoutes(routes *encoreroutes.Registry) {
+	routes.Register(routePrefix, &signedURLHandler{mgr: mgr})
+}
+
+func (mgr *Manager) bucketFor(provider *config.BucketProvider, runtimeCfg *config.Bucket) *bucket {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	if b, ok := mgr.buckets[runtimeCfg]; ok {
+		return b
+	}
+
+	baseURL := provider.Filesystem.SignedURLBaseURL
+	if baseURL == "" {
+		baseURL = mgr.runtime.APIBaseURL
+	}
+
+	b := &bucket{
+		cfg:     runtimeCfg,
+		dir:     filepath.Join(provider.Filesystem.BaseDir, runtimeCfg.CloudName),
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		key:     mgr.signingKey(provider),
+	}
+	mgr.buckets[runtimeCfg] = b
+	return b
+}
+
+// signingKey returns the key to sign URLs with for the given provider.
+// If none is configured a random key is used, which means signed URLs
+// are only valid for the lifetime of the process.
+//
+// It must be called with mgr.mu held.
+func (mgr *Manager) signingKey(provider *config.BucketProvider) []byte {
+	if key := provider.Filesystem.SigningKey; key != "" {
+		return []byte(key)
+	}
+	if key, ok := mgr.keys[provider]; ok {
+		return key
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(fmt.Sprintf("unable to generate signing key: %v", err))
+	}
+	mgr.keys[provider] = key
+	return key
+}
+
+type bucket struct {
+	cfg     *config.Bucket
+	dir     string
+	baseURL string
+	key     []byte
+
+	// mu guards changes to the objects in the bucket.
+	mu      sync.RWMutex
+	lastGen int64
+}
+
+// attrs are the stored attributes of an object generation.
+type attrs struct {
+	ContentType        string            `json:"content_type,omitempty"`
+	CacheControl       string            `json:"cache_control,omitempty"`
+	ContentDisposition string            `json:"content_disposition,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	Size               int64             `json:"size"`
+	ETag               string            `json:"etag"`
+}
+
+// objectDir returns the directory the generations of an object are stored in.
+func (b *bucket) objectDir(obj types.CloudObject) string {
+	return filepath.Join(b.dir, objectDirName(obj))
+}
+
+// objectDirName returns the name of the directory of an object.
+// It's the encoded object key, or a hash of it if the encoded key is too long.
+func objectDirName(obj types.CloudObject) string {
+	name := base64.RawURLEncoding.EncodeToString([]byte(obj))
+	if len(name) > maxDirNameLen {
+		sum := sha256.Sum256([]byte(obj))
+		name = hashedDirPrefix + hex.EncodeToString(sum[:])
+	}
+	return name
+}
+
+// dirObject returns the object stored in the object directory with the given name.
+// It reports false if the directory doesn't contain an object.
+func (b *bucket) dirObject(name string) (types.CloudObject, bool) {
+	if strings.HasPrefix(name, hashedDirPrefix) {
+		key, err := os.ReadFile(filepath.Join(b.dir, name, keyFile))
+		return types.CloudObject(key), err == nil
+	}
+	key, err := base64.RawURLEncoding.DecodeString(name)
+	return types.CloudObject(key), err == nil
+}
+
+func (b *bucket) Upload(data types.UploadData) (types.Uploader, error) {
+	if data.Object == "" {
+		return nil, types.ErrInvalidArgument
+	}
+	dir := filepath.Join(b.dir, tmpDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	f, err := os.CreateTemp(dir, "upload-*")
+	if err != nil {
+		return nil, err
+	}
+	return &uploader{bkt: b, data: data, f: f, hash: md5.New()}, nil
+}
+
+func (b *bucket) Download(data types.DownloadData) (types.Downloader, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	gen, err := b.resolveGen(data.Object, data.Version)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(b.dataPath(data.Object, gen))
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	if rng := data.Range; rng != nil {
+		if _, err := f.Seek(rng.Offset, io.SeekStart); err != nil {
+			_ = f.Close()
+			return nil, err
+		}
+		if rng.Length >= 0 {
+			return struct {
+				io.Reader
+				io.Closer
+			}{io.LimitReader(f, rng.Length), f}, nil
+		}
+	}
+	return f, nil
+}
+
+func (b *bucket) List(data types.ListData) iter.Seq2[*types.ListEntry, error] {
+	return func(yield func(*types.ListEntry, error) bool) {
+		entries, err := os.ReadDir(b.dir)
+		if errors.Is(err, fs.ErrNotExist) {
+			return
+		} else if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		var objects []types.CloudObject
+		for _, e := range entries {
+			if !e.IsDir() {
+				continue
+			}
+			if obj, ok := b.dirObject(e.Name()); ok && strings.HasPrefix(string(obj), data.Prefix) {
+				objects = append(objects, obj)
+			}
+		}
+		slices.Sort(objects)
+
+		var n int64
+		for _, obj := range objects {
+			if data.Limit != nil && n >= *data.Limit {
+				return
+			}
+			// Abort early if the context is canceled.
+			if err := data.Ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			a, _, err := b.readAttrs(obj, "")
+			if errors.Is(err, types.ErrObjectNotExist) {
+				// Deleted, or a versioned object without a live version.
+				continue
+			} else if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(&types.ListEntry{Object: obj, Size: a.Size, ETag: a.ETag}, nil) {
+				return
+			}
+			n++
+		}
+	}
+}
+
+func (b *bucket) Remove(data types.RemoveData) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	live, err := b.liveGen(data.Object)
+	if err != nil && !errors.Is(err, types.ErrObjectNotExist) {
+		return err
+	}
+
+	dir := b.objectDir(data.Object)
+	switch {
+	case data.Version != "":
+		// Remove a specific version.
+		gen, err := parseGen(data.Version)
+		if err != nil {
+			return err
+		}
+		if err := os.Remove(b.dataPath(data.Object, gen)); err != nil {
+			return mapErr(err)
+		}
+		_ = os.Remove(b.attrsPath(data.Object, gen))
+		if gen == live {
+			_ = os.Remove(filepath.Join(dir, liveFile))
+		}
+		// Remove the object directory if no generations remain.
+		entries, err := os.ReadDir(dir)
+		if err == nil && !slices.ContainsFunc(entries, func(e fs.DirEntry) bool { return e.Name() != keyFile }) {
+			_ = os.RemoveAll(dir)
+		}
+		return nil
+
+	case live == 0:
+		return types.ErrObjectNotExist
+
+	case b.cfg.Versioned:
+		// Keep the noncurrent versions around.
+		return os.Remove(filepath.Join(dir, liveFile))
+
+	default:
+		return os.RemoveAll(dir)
+	}
+}
+
+func (b *bucket) Attrs(data types.AttrsData) (*types.ObjectAttrs, error) {
+	a, gen, err := b.readAttrs(data.Object, data.Version)
+	if err != nil {
+		return nil, err
+	}
+	return b.objectAttrs(data.Object, gen, a), nil
+}
+
+func (b *bucket) Copy(data types.CopyData) (*types.ObjectAttrs, error) {
+	a, _, err := b.readAttrs(data.Src, data.SrcVersion)
+	if err != nil {
+		return nil, err
+	}
+	src, err := b.Download(types.DownloadData{Ctx: data.Ctx, Object: data.Src, Version: data.SrcVersion})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = src.Close() }()
+
+	u, err := b.Upload(types.UploadData{
+		Ctx:    data.Ctx,
+		Object: data.Dst,
+		Attrs: types.UploadAttrs{
+			ContentType:        a.ContentType,
+			CacheControl:       a.CacheControl,
+			ContentDisposition: a.ContentDisposition,
+			Metadata:           a.Metadata,
+		},
+		Pre: data.Pre,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(u, src); err != nil {
+		u.Abort(err)
+		return nil, err
+	}
+	return u.Complete()
+}
+
+func (b *bucket) SignedUploadURL(data types.UploadURLData) (string, error) {
+	return b.signedURL("PUT", data.Object, time.Now().Add(data.TTL))
+}
+
+func (b *bucket) SignedDownloadURL(data types.DownloadURLData) (string, error) {
+	return b.signedURL("GET", data.Object, time.Now().Add(data.TTL))
+}
+
+// commit makes the uploaded file at tmpPath the live generation of the object.
+func (b *bucket) commit(data types.UploadData, tmpPath string, a *attrs) (*types.ObjectAttrs, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	live, err := b.liveGen(data.Object)
+	if err != nil && !errors.Is(err, types.ErrObjectNotExist) {
+		return nil, err
+	} else if data.Pre.NotExists && live != 0 {
+		return nil, types.ErrPreconditionFailed
+	}
+
+	dir := b.objectDir(data.Object)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(filepath.Base(dir), hashedDirPrefix) {
+		if err := writeFileAtomic(filepath.Join(dir, keyFile), []byte(data.Object)); err != nil {
+			return nil, err
+		}
+	}
+
+	gen := time.Now().UnixNano()
+	if gen <= b.lastGen {
+		gen = b.lastGen + 1
+	}
+	b.lastGen = gen
+
+	attrsData, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(b.attrsPath(data.Object, gen), attrsData, 0o644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmpPath, b.dataPath(data.Object, gen)); err != nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(filepath.Join(dir, liveFile), []byte(strconv.FormatInt(gen, 10))); err != nil {
+		return nil, err
+	}
+
+	// Unless the bucket is versioned, the previous generation is no longer needed.
+	if live != 0 && !b.cfg.Versioned {
+		_ = os.Remove(b.dataPath(data.Object, live))
+		_ = os.Remove(b.attrsPath(data.Object, live))
+	}
+
+	return b.objectAttrs(data.Object, gen, a), nil
+}
+
+// readAttrs reads the attributes of the given version of an object,
+// or the live version if version is empty.
+func (b *bucket) readAttrs(obj types.CloudObject, version string) (*attrs, int64, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	gen, err := b.resolveGen(obj, version)
+	if err != nil {
+		return nil, 0, err
+	}
+	a, err := b.readGenAttrs(obj, gen)
+	return a, gen, err
+}
+
+// readGenAttrs reads the attributes of the given generation of an object.
+//
+// It must be called with b.mu held.
+func (b *bucket) readGenAttrs(obj types.CloudObject, gen int64) (*attrs, error) {
+	data, err := os.ReadFile(b.attrsPath(obj, gen))
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	var a attrs
+	if err := json.Unmarshal(data, &a); err != nil {
+		return nil, fmt.Errorf("invalid attributes for object %q: %v", obj, err)
+	}
+	return &a, nil
+}
+
+// openLive opens the live version of an object, returning its data and attributes.
+func (b *bucket) openLive(obj types.CloudObject) (*os.File, *attrs, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	gen, err := b.liveGen(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	a, err := b.readGenAttrs(obj, gen)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := os.Open(b.dataPath(obj, gen))
+	if err != nil {
+		return nil, nil, mapErr(err)
+	}
+	return f, a, nil
+}
+
+// resolveGen returns the generation of the given version of an object,
+// or of the live version if version is empty.
+//
+// It must be called with b.mu held.
+func (b *bucket) resolveGen(obj types.CloudObject, version string) (int64, error) {
+	if version != "" {
+		return parseGen(version)
+	}
+	return b.liveGen(obj)
+}
+
+// liveGen returns the live generation of an object.
+// It reports ErrObjectNotExist if the object has no live generation.
+//
+// It must be called with b.mu held.
+func (b *bucket) liveGen(obj types.CloudObject) (int64, error) {
+	data, err := os.ReadFile(filepath.Join(b.objectDir(obj), liveFile))
+	if err != nil {
+		return 0, mapErr(err)
+	}
+	gen, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid live generation for object %q: %v", obj, err)
+	}
+	return gen, nil
+}
+
+func (b *bucket) dataPath(obj types.CloudObject, gen int64) string {
+	return filepath.Join(b.objectDir(obj), strconv.FormatInt(gen, 10)+".data")
+}
+
+func (b *bucket) attrsPath(obj types.CloudObject, gen int64) string {
+	return filepath.Join(b.objectDir(obj), strconv.FormatInt(gen, 10)+".json")
+}
+
+func (b *bucket) objectAttrs(obj types.CloudObject, gen int64, a *attrs) *types.ObjectAttrs {
+	var version string
+	if b.cfg.Versioned {
+		version = strconv.FormatInt(gen, 10)
+	}
+	return &types.ObjectAttrs{
+		Object:             obj,
+		Version:            version,
+		ContentType:        a.ContentType,
+		CacheControl:       a.CacheControl,
+		ContentDisposition: a.ContentDisposition,
+		Metadata:           a.Metadata,
+		Size:               a.Size,
+		ETag:               a.ETag,
+	}
+}
+
+type uploader struct {
+	bkt  *bucket
+	data types.UploadData
+	f    *os.File
+	hash hash.Hash
+	size int64
+
+	once sync.Once
+}
+
+func (u *uploader) Write(p []byte) (int, error) {
+	if err := u.data.Ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := u.f.Write(p)
+	u.hash.Write(p[:n])
+	u.size += int64(n)
+	return n, err
+}
+
+func (u *uploader) Abort(err error) {
+	u.once.Do(func() {
+		_ = u.f.Close()
+		_ = os.Remove(u.f.Name())
+	})
+}
+
+func (u *uploader) Complete() (objAttrs *types.ObjectAttrs, err error) {
+	defer func() {
+		if err != nil {
+			u.Abort(err)
+		}
+	}()
+
+	if err := u.data.Ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := u.f.Close(); err != nil {
+		return nil, err
+	}
+
+	a := &attrs{
+		ContentType:        u.data.Attrs.ContentType,
+		CacheControl:       u.data.Attrs.CacheControl,
+		ContentDisposition: u.data.Attrs.ContentDisposition,
+		Metadata:           u.data.Attrs.Metadata,
+		Size:               u.size,
+		ETag:               hex.EncodeToString(u.hash.Sum(nil)),
+	}
+	return u.bkt.commit(u.data, u.f.Name(), a)
+}
+
+// writeFileAtomic writes a file by writing it to a temporary
+// file in the same directory and renaming it into place.
+func writeFileAtomic(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func parseGen(version string) (int64, error) {
+	gen, err := strconv.ParseInt(version, 10, 64)
+	if err != nil || gen <= 0 {
+		return 0, types.ErrObjectNotExist
+	}
+	return gen, nil
+}
+
+func mapErr(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return types.ErrObjectNotExist
+	}
+	return err
+}
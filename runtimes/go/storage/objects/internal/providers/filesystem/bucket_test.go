@@ -0,0 +1,197 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/shared/encoreroutes"
+	"encore.dev/storage/objects/internal/types"
+)
+
+func newTestBucket(c *qt.C, versioned bool) (*Manager, *bucket) {
+	prov := &config.BucketProvider{Filesystem: &config.FilesystemBucketProvider{
+		BaseDir:          c.TempDir(),
+		SignedURLBaseURL: "http://example.com",
+		SigningKey:       "secret",
+	}}
+	cfg := &config.Bucket{EncoreName: "files", CloudName: "files-cloud", Versioned: versioned}
+	mgr := NewManager(context.Background(), &config.Runtime{
+		BucketProviders: []*config.BucketProvider{prov},
+		Buckets:         map[string]*config.Bucket{"files": cfg},
+	})
+	return mgr, mgr.NewBucket(prov, cfg).(*bucket)
+}
+
+func upload(c *qt.C, b *bucket, obj, content string, pre types.Preconditions) (*types.ObjectAttrs, error) {
+	u, err := b.Upload(types.UploadData{
+		Ctx:    context.Background(),
+		Object: types.CloudObject(obj),
+		Attrs:  types.UploadAttrs{ContentType: "text/plain"},
+		Pre:    pre,
+	})
+	c.Assert(err, qt.IsNil)
+	_, err = io.WriteString(u, content)
+	c.Assert(err, qt.IsNil)
+	return u.Complete()
+}
+
+func download(c *qt.C, b *bucket, obj, version string, rng *types.Range) string {
+	r, err := b.Download(types.DownloadData{Ctx: context.Background(), Object: types.CloudObject(obj), Version: version, Range: rng})
+	c.Assert(err, qt.IsNil)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	c.Assert(err, qt.IsNil)
+	return string(data)
+}
+
+func TestBucket_RoundTrip(t *testing.T) {
+	c := qt.New(t)
+	_, b := newTestBucket(c, false)
+	ctx := context.Background()
+
+	attrs, err := upload(c, b, "dir/hello.txt", "hello world", types.Preconditions{})
+	c.Assert(err, qt.IsNil)
+	c.Assert(attrs.Size, qt.Equals, int64(11))
+	c.Assert(attrs.ContentType, qt.Equals, "text/plain")
+	c.Assert(attrs.Version, qt.Equals, "")
+
+	c.Assert(download(c, b, "dir/hello.txt", "", nil), qt.Equals, "hello world")
+	c.Assert(download(c, b, "dir/hello.txt", "", &types.Range{Offset: 6, Length: 3}), qt.Equals, "wor")
+	c.Assert(download(c, b, "dir/hello.txt", "", &types.Range{Offset: 6, Length: -1}), qt.Equals, "world")
+
+	_, err = upload(c, b, "dir/hello.txt", "again", types.Preconditions{NotExists: true})
+	c.Assert(err, qt.Equals, types.ErrPreconditionFailed)
+
+	_, err = b.Copy(types.CopyData{Ctx: ctx, Src: "dir/hello.txt", Dst: "other.txt"})
+	c.Assert(err, qt.IsNil)
+	_, err = upload(c, b, "dir/sub/x.txt", "x", types.Preconditions{})
+	c.Assert(err, qt.IsNil)
+
+	var names []string
+	for e, err := range b.List(types.ListData{Ctx: ctx, Prefix: "dir/"}) {
+		c.Assert(err, qt.IsNil)
+		names = append(names, string(e.Object))
+	}
+	c.Assert(names, qt.DeepEquals, []string{"dir/hello.txt", "dir/sub/x.txt"})
+
+	c.Assert(b.Remove(types.RemoveData{Ctx: ctx, Object: "dir/hello.txt"}), qt.IsNil)
+	_, err = b.Attrs(types.AttrsData{Ctx: ctx, Object: "dir/hello.txt"})
+	c.Assert(err, qt.Equals, types.ErrObjectNotExist)
+	c.Assert(b.Remove(types.RemoveData{Ctx: ctx, Object: "dir/hello.txt"}), qt.Equals, types.ErrObjectNotExist)
+	c.Assert(download(c, b, "other.txt", "", nil), qt.Equals, "hello world")
+}
+
+func TestBucket_Versioning(t *testing.T) {
+	c := qt.New(t)
+	_, b := newTestBucket(c, true)
+	ctx := context.Background()
+
+	v1, err := upload(c, b, "file", "one", types.Preconditions{})
+	c.Assert(err, qt.IsNil)
+	v2, err := upload(c, b, "file", "two", types.Preconditions{})
+	c.Assert(err, qt.IsNil)
+	c.Assert(v1.Version, qt.Not(qt.Equals), "")
+	c.Assert(v1.Version, qt.Not(qt.Equals), v2.Version)
+
+	c.Assert(download(c, b, "file", "", nil), qt.Equals, "two")
+	c.Assert(download(c, b, "file", v1.Version, nil), qt.Equals, "one")
+
+	// Removing the object keeps the old versions.
+	c.Assert(b.Remove(types.RemoveData{Ctx: ctx, Object: "file"}), qt.IsNil)
+	_, err = b.Attrs(types.AttrsData{Ctx: ctx, Object: "file"})
+	c.Assert(err, qt.Equals, types.ErrObjectNotExist)
+	c.Assert(download(c, b, "file", v2.Version, nil), qt.Equals, "two")
+
+	c.Assert(b.Remove(types.RemoveData{Ctx: ctx, Object: "file", Version: v1.Version}), qt.IsNil)
+	_, err = b.Attrs(types.AttrsData{Ctx: ctx, Object: "file", Version: v1.Version})
+	c.Assert(err, qt.Equals, types.ErrObjectNotExist)
+}
+
+func TestBucket_LongKey(t *testing.T) {
+	c := qt.New(t)
+	_, b := newTestBucket(c, true)
+	ctx := context.Background()
+
+	// The encoded key is longer than a path component can be.
+	long := "dir/" + strings.Repeat("k", 250)
+	v1, err := upload(c, b, long, "one", types.Preconditions{})
+	c.Assert(err, qt.IsNil)
+	v2, err := upload(c, b, long, "two", types.Preconditions{})
+	c.Assert(err, qt.IsNil)
+	_, err = upload(c, b, "dir/short", "short", types.Preconditions{})
+	c.Assert(err, qt.IsNil)
+
+	c.Assert(download(c, b, long, "", nil), qt.Equals, "two")
+	c.Assert(download(c, b, long, v1.Version, nil), qt.Equals, "one")
+	attrs, err := b.Attrs(types.AttrsData{Ctx: ctx, Object: types.CloudObject(long)})
+	c.Assert(err, qt.IsNil)
+	c.Assert(attrs.Size, qt.Equals, int64(3))
+
+	var names []string
+	for e, err := range b.List(types.ListData{Ctx: ctx, Prefix: "dir/"}) {
+		c.Assert(err, qt.IsNil)
+		names = append(names, string(e.Object))
+	}
+	c.Assert(names, qt.DeepEquals, []string{long, "dir/short"})
+
+	// Removing every version removes the object directory.
+	c.Assert(b.Remove(types.RemoveData{Ctx: ctx, Object: types.CloudObject(long), Version: v1.Version}), qt.IsNil)
+	c.Assert(b.Remove(types.RemoveData{Ctx: ctx, Object: types.CloudObject(long), Version: v2.Version}), qt.IsNil)
+	_, err = os.Stat(b.objectDir(types.CloudObject(long)))
+	c.Assert(errors.Is(err, fs.ErrNotExist), qt.IsTrue)
+}
+
+func TestBucket_SignedURLs(t *testing.T) {
+	c := qt.New(t)
+	mgr, b := newTestBucket(c, false)
+	ctx := context.Background()
+
+	routes := encoreroutes.NewRegistry()
+	mgr.RegisterRoutes(routes)
+	h, ok := routes.Handler("objects")
+	c.Assert(ok, qt.IsTrue)
+
+	// serve serves a request for a signed URL the way the runtime does,
+	// with the path relative to the route prefix.
+	serve := func(method, rawURL, body string) *httptest.ResponseRecorder {
+		u, err := url.Parse(rawURL)
+		c.Assert(err, qt.IsNil)
+		c.Assert(strings.HasPrefix(u.Path, "/__encore/objects/"), qt.IsTrue)
+		req := httptest.NewRequest(method, rawURL, strings.NewReader(body))
+		req.URL.Path = strings.TrimPrefix(u.Path, "/__encore/objects")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w
+	}
+
+	uploadURL, err := b.SignedUploadURL(types.UploadURLData{Ctx: ctx, Object: "a b/c.txt", TTL: time.Minute})
+	c.Assert(err, qt.IsNil)
+	c.Assert(serve("PUT", uploadURL, "signed").Code, qt.Equals, http.StatusOK)
+
+	downloadURL, err := b.SignedDownloadURL(types.DownloadURLData{Ctx: ctx, Object: "a b/c.txt", TTL: time.Minute})
+	c.Assert(err, qt.IsNil)
+	w := serve("GET", downloadURL, "")
+	c.Assert(w.Code, qt.Equals, http.StatusOK)
+	c.Assert(w.Body.String(), qt.Equals, "signed")
+
+	// The signature is tied to the method and object.
+	c.Assert(serve("PUT", downloadURL, "x").Code, qt.Equals, http.StatusForbidden)
+	c.Assert(serve("GET", strings.Replace(downloadURL, "c.txt", "d.txt", 1), "").Code, qt.Equals, http.StatusForbidden)
+
+	// Expired URLs are rejected.
+	expiredURL, err := b.signedURL("GET", "a b/c.txt", time.Now().Add(-time.Second))
+	c.Assert(err, qt.IsNil)
+	c.Assert(serve("GET", expiredURL, "").Code, qt.Equals, http.StatusForbidden)
+}
@@ -0,0 +1,175 @@
+package filesystem
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"encore.dev/storage/objects/internal/types"
+)
+
+// routePrefix is the prefix signed URLs are served under, below /__encore.
+const routePrefix = "objects"
+
+// signedURL returns a URL for performing the given method on an object
+// until the given expiry time, served by signedURLHandler.
+func (b *bucket) signedURL(method string, obj types.CloudObject, expires time.Time) (string, error) {
+	if b.baseURL == "" {
+		return "", errors.New("objects: no base url configured for signed urls")
+	}
+
+	exp := strconv.FormatInt(expires.Unix(), 10)
+	q := url.Values{}
+	q.Set("expires", exp)
+	q.Set("sig", b.sign(method, obj, exp))
+
+	u := b.baseURL + "/__encore/" + routePrefix + "/" + url.PathEscape(b.cfg.CloudName) + "/" + escapeKey(obj)
+	return u + "?" + q.Encode(), nil
+}
+
+// sign computes the signature for performing method on obj until expires.
+func (b *bucket) sign(method string, obj types.CloudObject, expires string) string {
+	mac := hmac.New(sha256.New, b.key)
+	_, _ = io.WriteString(mac, method+"\n"+b.cfg.CloudName+"\n"+string(obj)+"\n"+expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// escapeKey escapes an object key for use in a URL path, keeping slashes intact.
+func escapeKey(obj types.CloudObject) string {
+	parts := strings.Split(string(obj), "/")
+	for i, p := range parts {
+		parts[i] = url.PathEscape(p)
+	}
+	return strings.Join(parts, "/")
+}
+
+// signedURLHandler serves the signed URLs of all buckets using the filesystem provider.
+// It's called with paths of the form /<bucket>/<object>.
+type signedURLHandler struct {
+	mgr *Manager
+}
+
+func (h *signedURLHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	bucketName, key, ok := strings.Cut(strings.TrimPrefix(req.URL.Path, "/"), "/")
+	if !ok || key == "" {
+		http.Error(w, "object not found", http.StatusNotFound)
+		return
+	}
+	b := h.lookupBucket(bucketName)
+	if b == nil {
+		http.Error(w, "bucket not found", http.StatusNotFound)
+		return
+	}
+	obj := types.CloudObject(key)
+
+	var method string
+	switch req.Method {
+	case "GET", "HEAD":
+		method = "GET"
+	case "PUT":
+		method = "PUT"
+	default:
+		w.Header().Set("Allow", "GET, HEAD, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Validate the signature.
+	q := req.URL.Query()
+	exp, err := strconv.ParseInt(q.Get("expires"), 10, 64)
+	if err != nil || time.Now().Unix() > exp {
+		http.Error(w, "signed url expired", http.StatusForbidden)
+		return
+	}
+	want := b.sign(method, obj, q.Get("expires"))
+	if !hmac.Equal([]byte(want), []byte(q.Get("sig"))) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+
+	if method == "PUT" {
+		h.upload(w, req, b, obj)
+	} else {
+		h.download(w, req, b, obj)
+	}
+}
+
+func (h *signedURLHandler) upload(w http.ResponseWriter, req *http.Request, b *bucket, obj types.CloudObject) {
+	u, err := b.Upload(types.UploadData{
+		Ctx:    req.Context(),
+		Object: obj,
+		Attrs: types.UploadAttrs{
+			ContentType:        req.Header.Get("Content-Type"),
+			CacheControl:       req.Header.Get("Cache-Control"),
+			ContentDisposition: req.Header.Get("Content-Disposition"),
+		},
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if _, err := io.Copy(u, req.Body); err != nil {
+		u.Abort(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	attrs, err := u.Complete()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("ETag", strconv.Quote(attrs.ETag))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *signedURLHandler) download(w http.ResponseWriter, req *http.Request, b *bucket, obj types.CloudObject) {
+	f, a, err := b.openLive(obj)
+	if errors.Is(err, types.ErrObjectNotExist) {
+		http.Error(w, "object not found", http.StatusNotFound)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer func() { _ = f.Close() }()
+
+	var modTime time.Time
+	if fi, err := f.Stat(); err == nil {
+		modTime = fi.ModTime()
+	}
+
+	hdr := w.Header()
+	hdr.Set("ETag", strconv.Quote(a.ETag))
+	if a.ContentType != "" {
+		hdr.Set("Content-Type", a.ContentType)
+	}
+	if a.CacheControl != "" {
+		hdr.Set("Cache-Control", a.CacheControl)
+	}
+	if a.ContentDisposition != "" {
+		hdr.Set("Content-Disposition", a.ContentDisposition)
+	}
+	http.ServeContent(w, req, "", modTime, f)
+}
+
+// lookupBucket returns the bucket with the given cloud name,
+// provided it uses the filesystem provider.
+func (h *signedURLHandler) lookupBucket(cloudName string) *bucket {
+	rt := h.mgr.runtime
+	for _, cfg := range rt.Buckets {
+		if cfg.CloudName != cloudName || cfg.ProviderID < 0 || cfg.ProviderID >= len(rt.BucketProviders) {
+			continue
+		}
+		if prov := rt.BucketProviders[cfg.ProviderID]; prov.Filesystem != nil {
+			return h.mgr.bucketFor(prov, cfg)
+		}
+	}
+	return nil
+}
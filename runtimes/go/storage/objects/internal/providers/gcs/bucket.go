@@ -1,12 +1,14 @@
 package gcs
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"iter"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -66,6 +68,10 @@ func (b *bucket) Download(data types.DownloadData) (types.Downloader, error) {
 			obj = obj.Generation(gen)
 		}
 	}
+	if rng := data.Range; rng != nil {
+		r, err := obj.NewRangeReader(data.Ctx, rng.Offset, rng.Length)
+		return r, mapErr(err)
+	}
 	r, err := obj.NewReader(data.Ctx)
 	return r, mapErr(err)
 }
@@ -82,6 +88,9 @@ func (b *bucket) Upload(data types.UploadData) (types.Uploader, error) {
 
 	w := obj.NewWriter(ctx)
 	w.ContentType = data.Attrs.ContentType
+	w.CacheControl = data.Attrs.CacheControl
+	w.ContentDisposition = data.Attrs.ContentDisposition
+	w.Metadata = data.Attrs.Metadata
 
 	u := &uploader{
 		cancel: cancel,
@@ -118,11 +127,14 @@ func mapAttrs(attrs *storage.ObjectAttrs) *types.ObjectAttrs {
 		return nil
 	}
 	return &types.ObjectAttrs{
-		Object:      types.CloudObject(attrs.Name),
-		Version:     strconv.FormatInt(attrs.Generation, 10),
-		ContentType: attrs.ContentType,
-		Size:        attrs.Size,
-		ETag:        attrs.Etag,
+		Object:             types.CloudObject(attrs.Name),
+		Version:            strconv.FormatInt(attrs.Generation, 10),
+		ContentType:        attrs.ContentType,
+		CacheControl:       attrs.CacheControl,
+		ContentDisposition: attrs.ContentDisposition,
+		Metadata:           attrs.Metadata,
+		Size:               attrs.Size,
+		ETag:               attrs.Etag,
 	}
 }
 
@@ -190,6 +202,92 @@ func (b *bucket) Attrs(data types.AttrsData) (*types.ObjectAttrs, error) {
 	return mapAttrs(resp), mapErr(err)
 }
 
+func (b *bucket) Copy(data types.CopyData) (*types.ObjectAttrs, error) {
+	src := b.handle.Object(data.Src.String())
+	if data.SrcVersion != "" {
+		if gen, err := strconv.ParseInt(data.SrcVersion, 10, 64); err == nil {
+			src = src.Generation(gen)
+		}
+	}
+
+	dst := b.handle.Object(data.Dst.String())
+	if data.Pre.NotExists {
+		dst = dst.If(storage.Conditions{
+			DoesNotExist: true,
+		})
+	}
+
+	attrs, err := dst.CopierFrom(src).Run(data.Ctx)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	return mapAttrs(attrs), nil
+}
+
+func (b *bucket) ConfigureNotification(data types.NotificationData) error {
+	want := &storage.Notification{
+		TopicProjectID:   data.TopicProjectID,
+		TopicID:          data.Topic,
+		ObjectNamePrefix: data.Prefix.String(),
+		PayloadFormat:    storage.JSONPayload,
+	}
+	if data.ObjectCreated {
+		want.EventTypes = append(want.EventTypes, storage.ObjectFinalizeEvent)
+	}
+	if data.ObjectDeleted {
+		want.EventTypes = append(want.EventTypes, storage.ObjectDeleteEvent)
+	}
+
+	if ids, err := b.matchingNotifications(data.Ctx, want); err != nil || len(ids) > 0 {
+		return err
+	}
+	added, err := b.handle.AddNotification(data.Ctx, want)
+	if err != nil {
+		return mapErr(err)
+	}
+
+	// Another instance of the application may have added the same notification
+	// concurrently. Keep the one with the lowest ID so the events are delivered once.
+	ids, err := b.matchingNotifications(data.Ctx, want)
+	if err != nil {
+		return err
+	}
+	if len(ids) > 1 && ids[0] != added.ID {
+		return mapErr(b.handle.DeleteNotification(data.Ctx, added.ID))
+	}
+	return nil
+}
+
+// matchingNotifications returns the IDs of the bucket's notifications matching want,
+// in ascending order.
+func (b *bucket) matchingNotifications(ctx context.Context, want *storage.Notification) ([]string, error) {
+	existing, err := b.handle.Notifications(ctx)
+	if err != nil {
+		return nil, mapErr(err)
+	}
+	var ids []string
+	for id, n := range existing {
+		if n.TopicProjectID == want.TopicProjectID && n.TopicID == want.TopicID &&
+			n.ObjectNamePrefix == want.ObjectNamePrefix && sameElements(n.EventTypes, want.EventTypes) {
+			ids = append(ids, id)
+		}
+	}
+	slices.SortFunc(ids, func(a, b string) int {
+		x, _ := strconv.ParseInt(a, 10, 64)
+		y, _ := strconv.ParseInt(b, 10, 64)
+		return cmp.Compare(x, y)
+	})
+	return ids, nil
+}
+
+// sameElements reports whether a and b contain the same elements, in any order.
+func sameElements(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
 func (b *bucket) SignedUploadURL(data types.UploadURLData) (string, error) {
 	opts := &storage.SignedURLOptions{
 		Scheme:  storage.SigningSchemeV4,
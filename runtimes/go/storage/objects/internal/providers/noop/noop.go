@@ -33,6 +33,10 @@ func (b *BucketImpl) Attrs(data types.AttrsData) (*types.ObjectAttrs, error) {
 	return nil, fmt.Errorf("cannot get attributes from noop bucket")
 }
 
+func (b *BucketImpl) Copy(data types.CopyData) (*types.ObjectAttrs, error) {
+	return nil, fmt.Errorf("cannot copy objects in noop bucket")
+}
+
 func (b *BucketImpl) SignedUploadURL(data types.UploadURLData) (string, error) {
 	return "", fmt.Errorf("cannot get upload url from noop bucket")
 }
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"iter"
+	"net/url"
+	"strings"
 	"sync"
 
 	"cloud.google.com/go/storage"
@@ -60,10 +63,21 @@ func (mgr *Manager) NewBucket(provider *config.BucketProvider, runtimeCfg *confi
 
 func (b *bucket) Download(data types.DownloadData) (types.Downloader, error) {
 	object := string(data.Object)
+	var byteRange *string
+	if rng := data.Range; rng != nil {
+		if rng.Length < 0 {
+			byteRange = ptr(fmt.Sprintf("bytes=%d-", rng.Offset))
+		} else if rng.Length == 0 {
+			return io.NopCloser(strings.NewReader("")), nil
+		} else {
+			byteRange = ptr(fmt.Sprintf("bytes=%d-%d", rng.Offset, rng.Offset+rng.Length-1))
+		}
+	}
 	resp, err := b.client.GetObject(data.Ctx, &s3.GetObjectInput{
 		Bucket:    &b.cfg.CloudName,
 		Key:       &object,
 		VersionId: ptrOrNil(data.Version),
+		Range:     byteRange,
 	})
 	if err != nil {
 		return nil, mapErr(err)
@@ -150,14 +164,51 @@ func (b *bucket) Attrs(data types.AttrsData) (*types.ObjectAttrs, error) {
 		return nil, mapErr(err)
 	}
 	return &types.ObjectAttrs{
-		Object:      data.Object,
-		Version:     valOrZero(resp.VersionId),
-		ContentType: valOrZero(resp.ContentType),
-		Size:        valOrZero(resp.ContentLength),
-		ETag:        valOrZero(resp.ETag),
+		Object:             data.Object,
+		Version:            valOrZero(resp.VersionId),
+		ContentType:        valOrZero(resp.ContentType),
+		CacheControl:       valOrZero(resp.CacheControl),
+		ContentDisposition: valOrZero(resp.ContentDisposition),
+		Metadata:           resp.Metadata,
+		Size:               valOrZero(resp.ContentLength),
+		ETag:               valOrZero(resp.ETag),
 	}, nil
 }
 
+func (b *bucket) Copy(data types.CopyData) (*types.ObjectAttrs, error) {
+	// S3 doesn't support preconditions on the destination of a copy,
+	// so check for its existence beforehand.
+	if data.Pre.NotExists {
+		_, err := b.Attrs(types.AttrsData{Ctx: data.Ctx, Object: data.Dst})
+		if err == nil {
+			return nil, types.ErrPreconditionFailed
+		} else if !errors.Is(err, types.ErrObjectNotExist) {
+			return nil, err
+		}
+	}
+
+	copySource := b.cfg.CloudName + "/" + url.PathEscape(data.Src.String())
+	if data.SrcVersion != "" {
+		copySource += "?versionId=" + url.QueryEscape(data.SrcVersion)
+	}
+	dst := data.Dst.String()
+	resp, err := b.client.CopyObject(data.Ctx, &s3.CopyObjectInput{
+		Bucket:     &b.cfg.CloudName,
+		Key:        &dst,
+		CopySource: &copySource,
+	})
+	if err != nil {
+		return nil, mapErr(err)
+	}
+
+	// The copy response doesn't include all the attributes, so look them up.
+	var version string
+	if resp.VersionId != nil {
+		version = *resp.VersionId
+	}
+	return b.Attrs(types.AttrsData{Ctx: data.Ctx, Object: data.Dst, Version: version})
+}
+
 func (b *bucket) SignedUploadURL(data types.UploadURLData) (string, error) {
 	object := string(data.Object)
 	params := s3.PutObjectInput{
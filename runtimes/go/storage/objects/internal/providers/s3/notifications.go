@@ -0,0 +1,114 @@
+package s3
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"encore.dev/storage/objects/internal/types"
+)
+
+// notificationClient is the part of the S3 client used to configure event notifications.
+type notificationClient interface {
+	GetBucketNotificationConfiguration(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error)
+	PutBucketNotificationConfiguration(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error)
+}
+
+func (b *bucket) ConfigureNotification(data types.NotificationData) error {
+	return configureNotification(b.client, b.cfg.CloudName, data)
+}
+
+// configureNotification adds a notification delivering the object events to the SNS topic
+// to the bucket's notification configuration, unless it already has one.
+// The other notifications of the bucket are kept as they are.
+func configureNotification(client notificationClient, bucketName string, data types.NotificationData) error {
+	var events []s3types.Event
+	if data.ObjectCreated {
+		events = append(events, s3types.EventS3ObjectCreated)
+	}
+	if data.ObjectDeleted {
+		events = append(events, s3types.EventS3ObjectRemoved)
+	}
+
+	existing, found, err := getNotification(client, bucketName, data, events)
+	if err != nil || found {
+		return err
+	}
+
+	topicCfg := s3types.TopicConfiguration{
+		TopicArn: ptr(data.Topic),
+		Events:   events,
+	}
+	if data.Prefix != "" {
+		topicCfg.Filter = &s3types.NotificationConfigurationFilter{
+			Key: &s3types.S3KeyFilter{
+				FilterRules: []s3types.FilterRule{{
+					Name:  s3types.FilterRuleNamePrefix,
+					Value: ptr(data.Prefix.String()),
+				}},
+			},
+		}
+	}
+
+	_, err = client.PutBucketNotificationConfiguration(data.Ctx, &s3.PutBucketNotificationConfigurationInput{
+		Bucket: &bucketName,
+		NotificationConfiguration: &s3types.NotificationConfiguration{
+			TopicConfigurations:          append(existing.TopicConfigurations, topicCfg),
+			QueueConfigurations:          existing.QueueConfigurations,
+			LambdaFunctionConfigurations: existing.LambdaFunctionConfigurations,
+			EventBridgeConfiguration:     existing.EventBridgeConfiguration,
+		},
+	})
+	if err != nil {
+		// S3 rejects overlapping notifications, so if another instance of the
+		// application added the same notification concurrently, this one fails.
+		if _, found, getErr := getNotification(client, bucketName, data, events); getErr == nil && found {
+			return nil
+		}
+		return mapErr(err)
+	}
+	return nil
+}
+
+// getNotification gets the bucket's notification configuration,
+// and reports whether it delivers the events to the topic.
+func getNotification(client notificationClient, bucketName string, data types.NotificationData, events []s3types.Event) (*s3.GetBucketNotificationConfigurationOutput, bool, error) {
+	existing, err := client.GetBucketNotificationConfiguration(data.Ctx, &s3.GetBucketNotificationConfigurationInput{
+		Bucket: &bucketName,
+	})
+	if err != nil {
+		return nil, false, mapErr(err)
+	}
+	for _, tc := range existing.TopicConfigurations {
+		if aws.ToString(tc.TopicArn) == data.Topic && keyPrefix(tc.Filter) == data.Prefix.String() && sameEvents(tc.Events, events) {
+			return existing, true, nil
+		}
+	}
+	return existing, false, nil
+}
+
+// keyPrefix returns the object key prefix a notification is limited to, if any.
+func keyPrefix(filter *s3types.NotificationConfigurationFilter) string {
+	if filter == nil || filter.Key == nil {
+		return ""
+	}
+	for _, rule := range filter.Key.FilterRules {
+		// S3 reports the rule name capitalized.
+		if strings.EqualFold(string(rule.Name), string(s3types.FilterRuleNamePrefix)) {
+			return aws.ToString(rule.Value)
+		}
+	}
+	return ""
+}
+
+// sameEvents reports whether a and b contain the same events, in any order.
+func sameEvents(a, b []s3types.Event) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
@@ -0,0 +1,82 @@
+package s3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	qt "github.com/frankban/quicktest"
+
+	"encore.dev/storage/objects/internal/types"
+)
+
+// fakeNotificationClient stores a bucket's notification configuration in memory.
+type fakeNotificationClient struct {
+	cfg  s3types.NotificationConfiguration
+	puts int
+}
+
+func (f *fakeNotificationClient) GetBucketNotificationConfiguration(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+	return &s3.GetBucketNotificationConfigurationOutput{
+		TopicConfigurations:          f.cfg.TopicConfigurations,
+		QueueConfigurations:          f.cfg.QueueConfigurations,
+		LambdaFunctionConfigurations: f.cfg.LambdaFunctionConfigurations,
+		EventBridgeConfiguration:     f.cfg.EventBridgeConfiguration,
+	}, nil
+}
+
+func (f *fakeNotificationClient) PutBucketNotificationConfiguration(ctx context.Context, params *s3.PutBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+	f.cfg = *params.NotificationConfiguration
+	f.puts++
+	return &s3.PutBucketNotificationConfigurationOutput{}, nil
+}
+
+func TestConfigureNotification(t *testing.T) {
+	c := qt.New(t)
+
+	const topicARN = "arn:aws:sns:us-east-1:123456789012:uploads"
+	queueCfg := s3types.QueueConfiguration{
+		QueueArn: ptr("arn:aws:sqs:us-east-1:123456789012:other"),
+		Events:   []s3types.Event{s3types.EventS3ObjectCreated},
+	}
+	client := &fakeNotificationClient{
+		cfg: s3types.NotificationConfiguration{
+			QueueConfigurations: []s3types.QueueConfiguration{queueCfg},
+		},
+	}
+
+	data := types.NotificationData{
+		Ctx:           context.Background(),
+		Topic:         topicARN,
+		ObjectCreated: true,
+		ObjectDeleted: true,
+		Prefix:        "images/",
+	}
+	err := configureNotification(client, "bucket", data)
+	c.Assert(err, qt.IsNil)
+	c.Assert(client.puts, qt.Equals, 1)
+
+	// The existing notifications are kept.
+	c.Assert(client.cfg.QueueConfigurations, qt.HasLen, 1)
+	c.Assert(*client.cfg.QueueConfigurations[0].QueueArn, qt.Equals, *queueCfg.QueueArn)
+	c.Assert(client.cfg.TopicConfigurations, qt.HasLen, 1)
+	topicCfg := client.cfg.TopicConfigurations[0]
+	c.Assert(*topicCfg.TopicArn, qt.Equals, topicARN)
+	c.Assert(topicCfg.Events, qt.DeepEquals, []s3types.Event{s3types.EventS3ObjectCreated, s3types.EventS3ObjectRemoved})
+	c.Assert(keyPrefix(topicCfg.Filter), qt.Equals, "images/")
+
+	// Configuring the same notification again does nothing,
+	// even if S3 reports the rule name capitalized.
+	topicCfg.Filter.Key.FilterRules[0].Name = "Prefix"
+	err = configureNotification(client, "bucket", data)
+	c.Assert(err, qt.IsNil)
+	c.Assert(client.puts, qt.Equals, 1)
+
+	// A notification with different events is added.
+	data.ObjectDeleted = false
+	err = configureNotification(client, "bucket", data)
+	c.Assert(err, qt.IsNil)
+	c.Assert(client.puts, qt.Equals, 2)
+	c.Assert(client.cfg.TopicConfigurations, qt.HasLen, 2)
+}
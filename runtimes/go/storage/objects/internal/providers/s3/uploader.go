@@ -164,33 +164,42 @@ func (u *uploader) singlePartUpload(buf []byte) (*types.ObjectAttrs, error) {
 	}
 
 	resp, err := u.client.PutObject(u.ctx, &s3.PutObjectInput{
-		Bucket:        &u.bucket,
-		Key:           key,
-		Body:          bytes.NewReader(buf),
-		ContentType:   ptrOrNil(u.data.Attrs.ContentType),
-		ContentMD5:    &contentMD5,
-		ContentLength: ptr(int64(len(buf))),
-		IfNoneMatch:   ifNoneMatch,
+		Bucket:             &u.bucket,
+		Key:                key,
+		Body:               bytes.NewReader(buf),
+		ContentType:        ptrOrNil(u.data.Attrs.ContentType),
+		CacheControl:       ptrOrNil(u.data.Attrs.CacheControl),
+		ContentDisposition: ptrOrNil(u.data.Attrs.ContentDisposition),
+		Metadata:           u.data.Attrs.Metadata,
+		ContentMD5:         &contentMD5,
+		ContentLength:      ptr(int64(len(buf))),
+		IfNoneMatch:        ifNoneMatch,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.ObjectAttrs{
-		Object:      u.data.Object,
-		Version:     valOrZero(resp.VersionId),
-		ContentType: u.data.Attrs.ContentType,
-		Size:        int64(len(buf)),
-		ETag:        valOrZero(resp.ETag),
+		Object:             u.data.Object,
+		Version:            valOrZero(resp.VersionId),
+		ContentType:        u.data.Attrs.ContentType,
+		CacheControl:       u.data.Attrs.CacheControl,
+		ContentDisposition: u.data.Attrs.ContentDisposition,
+		Metadata:           u.data.Attrs.Metadata,
+		Size:               int64(len(buf)),
+		ETag:               valOrZero(resp.ETag),
 	}, nil
 }
 
 func (u *uploader) multiPartUpload(initial *buffer) (attrs *types.ObjectAttrs, err error) {
 	key := ptr(u.data.Object.String())
 	resp, err := u.client.CreateMultipartUpload(u.ctx, &s3.CreateMultipartUploadInput{
-		Bucket:      &u.bucket,
-		Key:         key,
-		ContentType: ptrOrNil(u.data.Attrs.ContentType),
+		Bucket:             &u.bucket,
+		Key:                key,
+		ContentType:        ptrOrNil(u.data.Attrs.ContentType),
+		CacheControl:       ptrOrNil(u.data.Attrs.CacheControl),
+		ContentDisposition: ptrOrNil(u.data.Attrs.ContentDisposition),
+		Metadata:           u.data.Attrs.Metadata,
 	})
 	if err != nil {
 		return nil, err
@@ -281,11 +290,14 @@ func (u *uploader) multiPartUpload(initial *buffer) (attrs *types.ObjectAttrs, e
 		return nil, err
 	}
 	return &types.ObjectAttrs{
-		Object:      u.data.Object,
-		Version:     valOrZero(completeResp.VersionId),
-		ContentType: u.data.Attrs.ContentType,
-		Size:        totalSize,
-		ETag:        valOrZero(completeResp.ETag),
+		Object:             u.data.Object,
+		Version:            valOrZero(completeResp.VersionId),
+		ContentType:        u.data.Attrs.ContentType,
+		CacheControl:       u.data.Attrs.CacheControl,
+		ContentDisposition: u.data.Attrs.ContentDisposition,
+		Metadata:           u.data.Attrs.Metadata,
+		Size:               totalSize,
+		ETag:               valOrZero(completeResp.ETag),
 	}, nil
 }
 
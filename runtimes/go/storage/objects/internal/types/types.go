@@ -14,6 +14,7 @@ type BucketImpl interface {
 	List(data ListData) iter.Seq2[*ListEntry, error]
 	Remove(data RemoveData) error
 	Attrs(data AttrsData) (*ObjectAttrs, error)
+	Copy(data CopyData) (*ObjectAttrs, error)
 	SignedUploadURL(data UploadURLData) (string, error)
 	SignedDownloadURL(data DownloadURLData) (string, error)
 }
@@ -37,7 +38,10 @@ type Preconditions struct {
 }
 
 type UploadAttrs struct {
-	ContentType string
+	ContentType        string
+	CacheControl       string
+	ContentDisposition string
+	Metadata           map[string]string
 }
 
 type Uploader interface {
@@ -52,6 +56,15 @@ type DownloadData struct {
 
 	// Non-zero to download a specific version
 	Version string
+
+	// Non-nil to download a byte range of the object.
+	Range *Range
+}
+
+// Range is a byte range of an object.
+type Range struct {
+	Offset int64
+	Length int64 // negative means until the end of the object
 }
 
 type Downloader interface {
@@ -60,11 +73,14 @@ type Downloader interface {
 }
 
 type ObjectAttrs struct {
-	Object      CloudObject
-	Version     string
-	ContentType string
-	Size        int64
-	ETag        string
+	Object             CloudObject
+	Version            string
+	ContentType        string
+	CacheControl       string
+	ContentDisposition string
+	Metadata           map[string]string
+	Size               int64
+	ETag               string
 }
 
 type ListData struct {
@@ -93,6 +109,34 @@ type AttrsData struct {
 	Version string // non-zero means specific version
 }
 
+type CopyData struct {
+	Ctx context.Context
+	Src CloudObject
+	Dst CloudObject
+
+	SrcVersion string // non-zero means specific version
+	Pre        Preconditions
+}
+
+// NotificationConfigurer is implemented by bucket implementations
+// that can configure the provider to deliver object events to a topic.
+type NotificationConfigurer interface {
+	// ConfigureNotification configures the provider to deliver the object events
+	// to the topic, unless it's already configured to.
+	ConfigureNotification(data NotificationData) error
+}
+
+type NotificationData struct {
+	Ctx context.Context
+
+	Topic          string // the provider name of the topic
+	TopicProjectID string // the GCP project of the topic, if any
+
+	ObjectCreated bool
+	ObjectDeleted bool
+	Prefix        CloudObject // non-zero limits the events to objects with the prefix
+}
+
 type UploadURLData struct {
 	Ctx    context.Context
 	Object CloudObject
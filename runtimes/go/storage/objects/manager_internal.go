@@ -6,6 +6,8 @@ import (
 	"github.com/rs/zerolog"
 
 	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/infrasdk/chaos"
+	"encore.dev/appruntime/shared/encoreroutes"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/appruntime/shared/shutdown"
 	"encore.dev/appruntime/shared/testsupport"
@@ -18,12 +20,16 @@ type Manager struct {
 	runtime    *config.Runtime
 	rt         *reqtrack.RequestTracker
 	ts         *testsupport.Manager
+	chaos      *chaos.Manager
 	rootLogger zerolog.Logger
 	providers  []provider
+
+	// notifications are the bucket notifications declared by the application.
+	notifications []*BucketNotification
 }
 
 func NewManager(static *config.Static, runtime *config.Runtime, rt *reqtrack.RequestTracker,
-	ts *testsupport.Manager, rootLogger zerolog.Logger) *Manager {
+	ts *testsupport.Manager, chaos *chaos.Manager, routes *encoreroutes.Registry, rootLogger zerolog.Logger) *Manager {
 	ctx, cancel := context.WithCancel(context.Background())
 	mgr := &Manager{
 		ctx:        ctx,
@@ -32,16 +38,32 @@ func NewManager(static *config.Static, runtime *config.Runtime, rt *reqtrack.Req
 		runtime:    runtime,
 		rt:         rt,
 		ts:         ts,
+		chaos:      chaos,
 		rootLogger: rootLogger,
 	}
 
 	for _, p := range providerRegistry {
-		mgr.providers = append(mgr.providers, p(mgr.ctx, mgr.runtime))
+		prov := p(mgr.ctx, mgr.runtime)
+		if rp, ok := prov.(routeProvider); ok {
+			rp.RegisterRoutes(routes)
+		}
+		mgr.providers = append(mgr.providers, prov)
 	}
 
 	return mgr
 }
 
+// ConfigureNotifications configures the providers to deliver the object events
+// of the buckets to the pub/sub topics listed in their infrastructure configuration.
+func (mgr *Manager) ConfigureNotifications(ctx context.Context) error {
+	for _, n := range mgr.notifications {
+		if err := n.configure(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Shutdown stops the manager from fetching new messages and processing them.
 func (mgr *Manager) Shutdown(p *shutdown.Process) error {
 	// Once it's time to force-close tasks, cancel the base context.
@@ -2,6 +2,8 @@
 
 package objects
 
+import "encore.dev/pubsub"
+
 // NewBucket declares a new object storage bucket.
 //
 // See https://encore.dev/docs/primitives/object-storage for more information.
@@ -20,3 +22,26 @@ type constStr string
 func Named(name constStr) *Bucket {
 	return newBucket(Singleton, string(name))
 }
+
+// NewBucketNotification declares that the object events of the bucket
+// should be published to the given pub/sub topic, which must be declared
+// in the same application.
+//
+// For example:
+//
+//	var Uploads = pubsub.NewTopic[objects.Event]("uploads", pubsub.TopicConfig{
+//		DeliveryGuarantee: pubsub.AtLeastOnce,
+//	})
+//
+//	var _ = objects.NewBucketNotification(Images, Uploads, objects.NotificationConfig{
+//		ObjectCreated: true,
+//	})
+//
+// Encore delivers the events when running locally. When self-hosting, the application
+// configures the bucket to publish its events to the topic at startup, for the topics
+// listed in the bucket's infrastructure configuration.
+func NewBucketNotification(bucket *Bucket, topic *pubsub.Topic[Event], cfg NotificationConfig) *BucketNotification {
+	n := &BucketNotification{bucket: bucket, topic: topic.Meta().Name, cfg: cfg}
+	bucket.mgr.notifications = append(bucket.mgr.notifications, n)
+	return n
+}
@@ -37,6 +37,9 @@ func (o withVersionOption) attrsOption() {}
 //publicapigen:keep
 func (o withVersionOption) existsOption() {}
 
+//publicapigen:keep
+func (o withVersionOption) copyOption() {}
+
 //publicapigen:keep
 func (o withTTLOption) uploadURLOption() {}
 
@@ -47,6 +50,7 @@ func (o withVersionOption) applyDownload(opts *downloadOptions)   { opts.version
 func (o withVersionOption) applyRemove(opts *removeOptions)       { opts.version = o.version }
 func (o withVersionOption) applyAttrs(opts *attrsOptions)         { opts.version = o.version }
 func (o withVersionOption) applyExists(opts *existsOptions)       { opts.version = o.version }
+func (o withVersionOption) applyCopy(opts *copyOptions)           { opts.srcVersion = o.version }
 func (o withTTLOption) applyUploadURL(opts *uploadURLOptions)     { opts.TTL = o.TTL }
 func (o withTTLOption) applyDownloadURL(opts *downloadURLOptions) { opts.TTL = o.TTL }
 
@@ -62,9 +66,29 @@ type withTTLOption struct {
 	TTL time.Duration
 }
 
+// WithRange is a DownloadOption for only downloading the given byte range
+// of the object, starting at offset. A negative length downloads
+// the rest of the object, starting at offset.
+func WithRange(offset, length int64) withRangeOption {
+	return withRangeOption{offset: offset, length: length}
+}
+
+//publicapigen:keep
+type withRangeOption struct {
+	offset, length int64
+}
+
+//publicapigen:keep
+func (o withRangeOption) downloadOption() {}
+
+func (o withRangeOption) applyDownload(opts *downloadOptions) {
+	opts.rng = &types.Range{Offset: o.offset, Length: o.length}
+}
+
 //publicapigen:keep
 type downloadOptions struct {
 	version string
+	rng     *types.Range
 }
 
 // UploadOption describes available options for the Upload operation.
@@ -74,15 +98,16 @@ type UploadOption interface {
 	applyUpload(*uploadOptions)
 }
 
-// WithPreconditions is an UploadOption for only uploading an object
-// if certain preconditions are met.
+// WithPreconditions is an UploadOption and CopyOption for only writing
+// an object if certain preconditions are met.
 func WithPreconditions(pre Preconditions) withPreconditionsOption {
 	return withPreconditionsOption{pre: pre}
 }
 
-// Preconditions are the available preconditions for an upload operation.
+// Preconditions are the available preconditions for an upload or copy operation.
 type Preconditions struct {
-	// NotExists specifies that the object must not exist prior to uploading.
+	// NotExists specifies that the object must not exist prior to uploading,
+	// or for copies, that the destination object must not exist.
 	NotExists bool
 }
 
@@ -94,14 +119,31 @@ type withPreconditionsOption struct {
 //publicapigen:keep
 func (o withPreconditionsOption) uploadOption() {}
 
+//publicapigen:keep
+func (o withPreconditionsOption) copyOption() {}
+
 func (o withPreconditionsOption) applyUpload(opts *uploadOptions) {
 	opts.pre = o.pre
 }
 
+func (o withPreconditionsOption) applyCopy(opts *copyOptions) {
+	opts.pre = o.pre
+}
+
 // UploadAttrs specifies additional object attributes to set during upload.
 type UploadAttrs struct {
 	// ContentType specifies the content type of the object.
 	ContentType string
+
+	// CacheControl specifies the Cache-Control header to serve the object with.
+	CacheControl string
+
+	// ContentDisposition specifies the Content-Disposition header
+	// to serve the object with.
+	ContentDisposition string
+
+	// Metadata is user-defined metadata to store with the object.
+	Metadata map[string]string
 }
 
 // WithUploadAttrs is an UploadOption for specifying additional object attributes
@@ -120,7 +162,10 @@ func (o withUploadAttrsOption) uploadOption() {}
 
 func (o withUploadAttrsOption) applyUpload(opts *uploadOptions) {
 	opts.attrs = types.UploadAttrs{
-		ContentType: o.attrs.ContentType,
+		ContentType:        o.attrs.ContentType,
+		CacheControl:       o.attrs.CacheControl,
+		ContentDisposition: o.attrs.ContentDisposition,
+		Metadata:           o.attrs.Metadata,
 	}
 }
 
@@ -187,6 +232,19 @@ type downloadURLOptions struct {
 	TTL time.Duration
 }
 
+// CopyOption describes available options for the Copy and Move operations.
+type CopyOption interface {
+	//publicapigen:keep
+	copyOption()
+
+	applyCopy(*copyOptions)
+}
+
+type copyOptions struct {
+	srcVersion string
+	pre        Preconditions
+}
+
 // ExistsOption describes available options for the Exists operation.
 type ExistsOption interface {
 	//publicapigen:keep
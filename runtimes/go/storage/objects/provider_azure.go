@@ -0,0 +1,16 @@
+//go:build !encore_no_azure
+
+package objects
+
+import (
+	"context"
+
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/storage/objects/internal/providers/azure"
+)
+
+func init() {
+	registerProvider(func(ctx context.Context, runtimeCfg *config.Runtime) provider {
+		return azure.NewManager(ctx, runtimeCfg)
+	})
+}
@@ -0,0 +1,14 @@
+package objects
+
+import (
+	"context"
+
+	"encore.dev/appruntime/exported/config"
+	"encore.dev/storage/objects/internal/providers/filesystem"
+)
+
+func init() {
+	registerProvider(func(ctx context.Context, runtimeCfg *config.Runtime) provider {
+		return filesystem.NewManager(ctx, runtimeCfg)
+	})
+}
@@ -22,6 +22,8 @@ type ReadWriter interface {
 	Remover
 	Lister
 	Attrser
+	Copier
+	Mover
 }
 
 // Uploader is the interface for uploading objects to a bucket.
@@ -163,6 +165,44 @@ type Attrser interface {
 	perms()
 }
 
+// Copier is the interface for copying objects within a bucket.
+// It can be used in conjunction with [BucketRef] to declare
+// a reference that can copy objects in the bucket.
+//
+// For example:
+//
+//	var MyBucket = objects.NewBucket(...)
+//	var ref = objects.BucketRef[objects.Copier](MyBucket)
+//
+// The ref object can then be used to copy objects and can be
+// passed around freely within the service, without being subject
+// to Encore's static analysis restrictions that apply to MyBucket.
+type Copier interface {
+	// Copy copies an object to a new name in the bucket.
+	Copy(ctx context.Context, src, dst string, options ...CopyOption) (*ObjectAttrs, error)
+
+	perms()
+}
+
+// Mover is the interface for moving objects within a bucket.
+// It can be used in conjunction with [BucketRef] to declare
+// a reference that can move objects in the bucket.
+//
+// For example:
+//
+//	var MyBucket = objects.NewBucket(...)
+//	var ref = objects.BucketRef[objects.Mover](MyBucket)
+//
+// The ref object can then be used to move objects and can be
+// passed around freely within the service, without being subject
+// to Encore's static analysis restrictions that apply to MyBucket.
+type Mover interface {
+	// Move moves an object to a new name in the bucket.
+	Move(ctx context.Context, src, dst string, options ...CopyOption) (*ObjectAttrs, error)
+
+	perms()
+}
+
 // PublicURLer is the interface for resolving the public URL for an object.
 // It can be used in conjunction with [BucketRef] to declare
 // a reference that can resolve an object's public URL.
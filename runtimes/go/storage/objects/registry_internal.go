@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/shared/encoreroutes"
 	"encore.dev/storage/objects/internal/types"
 )
 
@@ -13,6 +14,12 @@ type provider interface {
 	NewBucket(providerCfg *config.BucketProvider, runtimeCfg *config.Bucket) types.BucketImpl
 }
 
+// routeProvider is implemented by providers that serve
+// HTTP requests through the runtime's own HTTP server.
+type routeProvider interface {
+	RegisterRoutes(routes *encoreroutes.Registry)
+}
+
 var providerRegistry []func(context.Context, *config.Runtime) provider
 
 func registerProvider(p func(context.Context, *config.Runtime) provider) {
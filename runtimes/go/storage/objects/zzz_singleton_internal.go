@@ -3,10 +3,13 @@
 package objects
 
 import (
+	"encore.dev/appruntime/infrasdk/chaos"
 	"encore.dev/appruntime/shared/appconf"
+	"encore.dev/appruntime/shared/encoreroutes"
 	"encore.dev/appruntime/shared/logging"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/appruntime/shared/shutdown"
+	"encore.dev/appruntime/shared/startup"
 	"encore.dev/appruntime/shared/testsupport"
 )
 
@@ -20,6 +23,7 @@ var Singleton *Manager
 
 func init() {
 	Singleton = NewManager(appconf.Static, appconf.Runtime, reqtrack.Singleton,
-		testsupport.Singleton, logging.RootLogger)
+		testsupport.Singleton, chaos.Singleton, encoreroutes.Singleton, logging.RootLogger)
 	shutdown.Singleton.RegisterShutdownHandler(Singleton.Shutdown)
+	startup.Singleton.Register("objects bucket notifications", Singleton.ConfigureNotifications)
 }
@@ -0,0 +1,284 @@
+package sqldb
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"encore.dev/beta/errs"
+	"encore.dev/internal/platformauth"
+)
+
+// DataMigrationConfig specifies the configuration for a data migration.
+type DataMigrationConfig struct {
+	// Version is the migration number of the data migration.
+	//
+	// Data migrations are numbered together with the SQL migration files of the database,
+	// and the version must not be used by any of them. A data migration runs after the
+	// SQL migrations with a lower number have been applied, and before those with a higher number.
+	Version uint64
+
+	// Name describes the data migration, like the description
+	// in the filename of a SQL migration.
+	Name string
+
+	// Migrate runs the data migration within the given transaction.
+	// If it returns an error the transaction is rolled back,
+	// and the application fails to start.
+	Migrate func(ctx context.Context, tx *Tx) error
+
+	// EncoreInternal_RequiredSQLVersion is the number of the SQL migration
+	// directly preceding the data migration, or 0 if there is none.
+	//
+	//publicapigen:drop
+	EncoreInternal_RequiredSQLVersion uint64
+
+	// EncoreInternal_NextSQLVersion is the number of the SQL migration
+	// directly following the data migration, or 0 if there is none.
+	//
+	//publicapigen:drop
+	EncoreInternal_NextSQLVersion uint64
+}
+
+// DataMigration is a migration of the data in a database, written in Go.
+// Use NewDataMigration to declare one.
+type DataMigration struct {
+	db  *Database
+	cfg DataMigrationConfig
+}
+
+// NewDataMigration declares a data migration for the given database.
+//
+// Data migrations transform the data in a database using Go code, for changes
+// that are hard to express in SQL. Each data migration runs once, in a transaction,
+// before the application serves traffic. Its services have been initialized by then,
+// so it can call other services.
+//
+// Encore uses static analysis to identify data migrations and their configuration,
+// so the version and name must be constant literals.
+//
+// A call to NewDataMigration can only be made when declaring a package level variable.
+func NewDataMigration(db *Database, cfg DataMigrationConfig) *DataMigration {
+	m := &DataMigration{db: db, cfg: cfg}
+	if db.mgr != nil {
+		db.mgr.registerDataMigration(m)
+	}
+	return m
+}
+
+// dataMigrationsTable is the table tracking which data migrations have been applied.
+const dataMigrationsTable = "encore_data_migrations"
+
+func (mgr *Manager) registerDataMigration(m *DataMigration) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	mgr.dataMigrations = append(mgr.dataMigrations, m)
+}
+
+// RunDataMigrations runs the data migrations that have not yet been applied,
+// in version order.
+//
+// The SQL migrations must have been applied up to the first pending data migration,
+// and any later data migrations need the SQL migrations before them to be applied first.
+// When running locally the Encore daemon interleaves the data and SQL migrations instead,
+// using dataMigrationHandler.
+func (mgr *Manager) RunDataMigrations(ctx context.Context) error {
+	mgr.mu.RLock()
+	migrations := slices.Clone(mgr.dataMigrations)
+	mgr.mu.RUnlock()
+
+	slices.SortStableFunc(migrations, func(a, b *DataMigration) int {
+		if n := cmp.Compare(a.db.origName, b.db.origName); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.cfg.Version, b.cfg.Version)
+	})
+	for _, m := range migrations {
+		if err := m.run(ctx); err != nil {
+			return fmt.Errorf("data migration %d_%s for database %s: %w",
+				m.cfg.Version, m.cfg.Name, m.db.origName, err)
+		}
+	}
+	return nil
+}
+
+// dataMigrationRoutePrefix is the prefix data migrations are run under, below /__encore.
+const dataMigrationRoutePrefix = "sqldb"
+
+// dataMigrationHandler runs a single data migration on behalf of the Encore platform,
+// which orders the data migrations together with the SQL migrations it applies.
+// It's called with paths of the form /data-migrations/<db>/<version>.
+type dataMigrationHandler struct {
+	mgr *Manager
+}
+
+func (h *dataMigrationHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if !platformauth.IsEncorePlatformRequest(req.Context()) {
+		errs.HTTPError(w, errs.B().Code(errs.PermissionDenied).Msg("data migrations can only be run by the Encore platform").Err())
+		return
+	} else if req.Method != http.MethodPost {
+		errs.HTTPError(w, errs.B().Code(errs.InvalidArgument).Msg("method not allowed").Err())
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(req.URL.Path, "/"), "/")
+	if len(parts) != 3 || parts[0] != "data-migrations" {
+		errs.HTTPError(w, errs.B().Code(errs.NotFound).Msg("endpoint not found").Err())
+		return
+	}
+	version, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		errs.HTTPError(w, errs.B().Code(errs.InvalidArgument).Msg("invalid data migration version").Err())
+		return
+	}
+
+	m, ok := h.mgr.findDataMigration(parts[1], version)
+	if !ok {
+		errs.HTTPError(w, errs.B().Code(errs.NotFound).Msgf("data migration %d for database %s not found", version, parts[1]).Err())
+		return
+	}
+	if err := m.run(req.Context()); err != nil {
+		errs.HTTPError(w, errs.B().Code(errs.Internal).Msg(err.Error()).Err())
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// findDataMigration returns the data migration with the given version of the given database.
+func (mgr *Manager) findDataMigration(dbName string, version uint64) (*DataMigration, bool) {
+	mgr.mu.RLock()
+	defer mgr.mu.RUnlock()
+	idx := slices.IndexFunc(mgr.dataMigrations, func(m *DataMigration) bool {
+		return m.db.origName == dbName && m.cfg.Version == version
+	})
+	if idx == -1 {
+		return nil, false
+	}
+	return mgr.dataMigrations[idx], true
+}
+
+// run runs the data migration if it has not yet been applied.
+func (m *DataMigration) run(ctx context.Context) error {
+	db := m.db
+	if db.noopDB {
+		return nil
+	}
+	db.init()
+	if db.noopDB {
+		return nil
+	}
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(context.Background()) }() // no-op if committed
+
+	// Serialize the data migrations of concurrently starting instances of the application.
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", dataMigrationsTable); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS `+dataMigrationsTable+` (
+			version BIGINT PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		)
+	`); err != nil {
+		return err
+	}
+
+	var applied bool
+	if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM "+dataMigrationsTable+" WHERE version = $1)", m.cfg.Version).Scan(&applied); err != nil {
+		return err
+	} else if applied {
+		return nil
+	}
+
+	// Make sure the data migration runs against the schema it was written for.
+	if v := m.cfg.EncoreInternal_RequiredSQLVersion; v > 0 {
+		if ok, err := sqlMigrationApplied(ctx, tx, v); err != nil {
+			return err
+		} else if !ok {
+			return fmt.Errorf("SQL migration %d has not been applied", v)
+		}
+	}
+	if v := m.cfg.EncoreInternal_NextSQLVersion; v > 0 {
+		if ok, err := sqlMigrationApplied(ctx, tx, v); err != nil {
+			return err
+		} else if ok {
+			return fmt.Errorf("SQL migration %d was applied before the data migration, "+
+				"but migrations must be applied in order", v)
+		}
+	}
+
+	logger := db.mgr.rootLogger.With().
+		Str("db", db.origName).
+		Uint64("version", m.cfg.Version).
+		Str("name", m.cfg.Name).
+		Logger()
+	logger.Info().Msg("running data migration")
+	start := time.Now()
+
+	if err := m.cfg.Migrate(ctx, &Tx{mgr: db.mgr, std: tx, host: db.primary.host, dbName: db.origName}); err != nil {
+		logger.Error().Err(err).Msg("data migration failed")
+		return err
+	}
+	if _, err := tx.Exec(ctx, "INSERT INTO "+dataMigrationsTable+" (version, name) VALUES ($1, $2)", m.cfg.Version, m.cfg.Name); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	logger.Info().Dur("duration", time.Since(start)).Msg("data migration completed")
+	return nil
+}
+
+// sqlMigrationApplied reports whether the SQL migration with the given version
+// has been applied, according to the schema_migrations table.
+func sqlMigrationApplied(ctx context.Context, tx pgx.Tx, version uint64) (bool, error) {
+	// Use a savepoint so a missing table doesn't abort the transaction.
+	nested, err := tx.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = nested.Rollback(context.Background()) }()
+
+	rows, err := nested.Query(ctx, "SELECT version, dirty FROM schema_migrations")
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "42P01" { // undefined_table
+			return false, nil
+		}
+		return false, err
+	}
+	type row struct {
+		Version int64
+		Dirty   bool
+	}
+	versions, err := pgx.CollectRows(rows, pgx.RowToStructByPos[row])
+	if err != nil {
+		return false, err
+	}
+
+	for _, r := range versions {
+		if r.Version == int64(version) {
+			return !r.Dirty, nil
+		}
+	}
+	// Sequential migrations only track the latest applied version.
+	if len(versions) == 1 {
+		return versions[0].Version > int64(version) && !versions[0].Dirty, nil
+	}
+	return false, nil
+}
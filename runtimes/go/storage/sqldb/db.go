@@ -11,6 +11,7 @@ import (
 	"net"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -19,6 +20,7 @@ import (
 	"encore.dev/appruntime/exported/model"
 	"encore.dev/appruntime/exported/stack"
 	"encore.dev/appruntime/exported/trace2"
+	"encore.dev/appruntime/infrasdk/chaos"
 	"encore.dev/storage/sqldb/internal/stdlibdriver"
 )
 
@@ -33,6 +35,10 @@ type Database struct {
 	pool     *pgxpool.Pool
 	connStr  string
 
+	primary     *dbNode   // the primary server, using pool
+	replicas    []*dbNode // the read replicas, if any
+	nextReplica atomic.Uint32
+
 	stdlibOnce sync.Once
 	stdlib     *sql.DB
 }
@@ -52,6 +58,12 @@ func (db *Database) init() {
 
 		if !db.noopDB {
 			db.connStr = stdlibdriver.RegisterConnConfig(db.pool.Config().ConnConfig)
+			db.primary = newDBNode(db.pool, false)
+
+			// Test databases are cloned on the primary, so they don't have any replicas.
+			if db.name == db.origName {
+				db.replicas = db.mgr.getReplicaNodes(db.origName)
+			}
 		}
 	})
 }
@@ -97,6 +109,9 @@ func (db *Database) shutdown() {
 	if db.pool != nil {
 		db.pool.Close()
 	}
+	for _, r := range db.replicas {
+		r.pool.Close()
+	}
 	if db.stdlib != nil {
 		_ = db.stdlib.Close()
 	}
@@ -170,6 +185,9 @@ func (db *Database) Exec(ctx context.Context, query string, args ...interface{})
 	}
 
 	db.init()
+	if err := db.injectFault(ctx); err != nil {
+		return nil, err
+	}
 
 	var (
 		startEventID model.TraceEventID
@@ -189,6 +207,7 @@ func (db *Database) Exec(ctx context.Context, query string, args ...interface{})
 			Query:       query,
 			TxStartID:   0,
 			Stack:       stack.Build(4),
+			Host:        db.primary.host,
 		})
 	}
 
@@ -212,6 +231,9 @@ func (db *Database) Query(ctx context.Context, query string, args ...interface{}
 	}
 
 	db.init()
+	if err := db.injectFault(ctx); err != nil {
+		return nil, err
+	}
 
 	var (
 		startEventID model.TraceEventID
@@ -230,6 +252,7 @@ func (db *Database) Query(ctx context.Context, query string, args ...interface{}
 			EventParams: eventParams,
 			Query:       query,
 			Stack:       stack.Build(4),
+			Host:        db.primary.host,
 		})
 	}
 
@@ -255,6 +278,9 @@ func (db *Database) QueryRow(ctx context.Context, query string, args ...interfac
 	}
 
 	db.init()
+	if err := db.injectFault(ctx); err != nil {
+		return &Row{err: err}
+	}
 
 	var (
 		startEventID model.TraceEventID
@@ -273,6 +299,7 @@ func (db *Database) QueryRow(ctx context.Context, query string, args ...interfac
 			EventParams: eventParams,
 			Query:       query,
 			Stack:       stack.Build(4),
+			Host:        db.primary.host,
 		})
 	}
 
@@ -296,6 +323,9 @@ func (db *Database) Begin(ctx context.Context) (*Tx, error) {
 	}
 
 	db.init()
+	if err := db.injectFault(ctx); err != nil {
+		return nil, err
+	}
 	tx, err := db.pool.Begin(markTraced(ctx))
 	err = convertErr(err)
 	if err != nil {
@@ -312,7 +342,13 @@ func (db *Database) Begin(ctx context.Context) (*Tx, error) {
 		}, stack.Build(4))
 	}
 
-	return &Tx{mgr: db.mgr, std: tx, startID: startID}, nil
+	return &Tx{mgr: db.mgr, std: tx, host: db.primary.host, dbName: db.origName, startID: startID}, nil
+}
+
+// injectFault injects the faults configured with "encore chaos"
+// into an operation on the database.
+func (db *Database) injectFault(ctx context.Context) error {
+	return db.mgr.chaos.Inject(ctx, chaos.Target{Kind: chaos.SQLDB, Resource: db.origName})
 }
 
 // Driver returns the underlying database driver for this database connection pool.
@@ -8,6 +8,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"encore.dev/appruntime/exported/config"
+	"encore.dev/appruntime/infrasdk/chaos"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/appruntime/shared/shutdown"
 	"encore.dev/appruntime/shared/testsupport"
@@ -18,17 +19,20 @@ type Manager struct {
 	runtime    *config.Runtime
 	rt         *reqtrack.RequestTracker
 	ts         *testsupport.Manager
+	chaos      *chaos.Manager
 	rootLogger zerolog.Logger
 
-	mu  sync.RWMutex
-	dbs map[string]*Database
+	mu             sync.RWMutex
+	dbs            map[string]*Database
+	dataMigrations []*DataMigration
 }
 
-func NewManager(runtime *config.Runtime, rt *reqtrack.RequestTracker, ts *testsupport.Manager, rootLogger zerolog.Logger) *Manager {
+func NewManager(runtime *config.Runtime, rt *reqtrack.RequestTracker, ts *testsupport.Manager, chaos *chaos.Manager, rootLogger zerolog.Logger) *Manager {
 	return &Manager{
 		runtime:    runtime,
 		rt:         rt,
 		ts:         ts,
+		chaos:      chaos,
 		rootLogger: rootLogger,
 		dbs:        make(map[string]*Database),
 	}
@@ -76,30 +80,55 @@ func (mgr *Manager) GetDB(dbName string) *Database {
 // getPool returns a database connection pool for the given database name.
 // Each time it's called it returns a new pool.
 func (mgr *Manager) getPool(encoreName, dbNameOverride string) (pool *pgxpool.Pool, found bool) {
-	var db *config.SQLDatabase
-	for _, d := range mgr.runtime.SQLDatabases {
-		if d.EncoreName == encoreName {
-			db = d
-			break
-		}
-	}
+	db := mgr.findDB(encoreName)
 	if db == nil {
 		return nil, false
 	}
+	return mgr.newPool(mgr.runtime.SQLServers[db.ServerID], db, dbNameOverride), true
+}
+
+// getReplicaNodes returns the read replicas of the server hosting the given database,
+// each with a new connection pool.
+func (mgr *Manager) getReplicaNodes(encoreName string) []*dbNode {
+	db := mgr.findDB(encoreName)
+	if db == nil {
+		return nil
+	}
 
 	srv := mgr.runtime.SQLServers[db.ServerID]
+	nodes := make([]*dbNode, 0, len(srv.ReadReplicas))
+	for _, host := range srv.ReadReplicas {
+		// Replicas share the primary's TLS config and credentials.
+		replica := *srv
+		replica.Host = host
+		replica.ReadReplicas = nil
+		nodes = append(nodes, newDBNode(mgr.newPool(&replica, db, ""), true))
+	}
+	return nodes
+}
+
+func (mgr *Manager) findDB(encoreName string) *config.SQLDatabase {
+	for _, d := range mgr.runtime.SQLDatabases {
+		if d.EncoreName == encoreName {
+			return d
+		}
+	}
+	return nil
+}
+
+// newPool returns a new connection pool for the given database on the given server.
+func (mgr *Manager) newPool(srv *config.SQLServer, db *config.SQLDatabase, dbNameOverride string) *pgxpool.Pool {
 	cfg, err := dbConf(srv, db, dbNameOverride)
 	if err != nil {
 		panic("sqldb: " + err.Error())
 	}
 
 	cfg.ConnConfig.Tracer = &pgxTracer{mgr: mgr}
-	pool, err = pgxpool.NewWithConfig(context.Background(), cfg)
+	pool, err := pgxpool.NewWithConfig(context.Background(), cfg)
 	if err != nil {
 		panic("sqldb: setup db: " + err.Error())
 	}
-
-	return pool, true
+	return pool
 }
 
 func (mgr *Manager) Shutdown(p *shutdown.Process) error {
@@ -51,6 +51,7 @@ func (t *pgxTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pg
 			EventParams: eventParams,
 			Query:       data.SQL,
 			Stack:       stack.Build(5),
+			Host:        connHost(conn.Config()),
 		})
 		ctx = context.WithValue(ctx, pgxQueryKey, &queryValue{
 			trace:       curr.Trace,
@@ -33,6 +33,17 @@ type DatabaseConfig struct {
 	//
 	// Migrations are an ordered sequence of sql files of the format <number>_<description>.up.sql.
 	Migrations string
+
+	// Seeds is the directory containing seed data for local development,
+	// which is inserted after the migrations when the database is reset
+	// using "encore db reset". It follows the same path rules as Migrations.
+	//
+	// The directory may contain .sql files, which are run in lexical order,
+	// and a Go main package, which is run afterwards with the local
+	// Encore app environment set up, like with "encore exec".
+	//
+	// If empty, the database has no seed data.
+	Seeds string
 }
 
 // Exec executes a query without returning any rows.
@@ -0,0 +1,219 @@
+package sqldb
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"encore.dev/appruntime/exported/model"
+	"encore.dev/appruntime/exported/stack"
+	"encore.dev/appruntime/exported/trace2"
+	"encore.dev/appruntime/shared/reqtrack"
+)
+
+// replicaCooldown is how long a read replica is skipped after it failed to serve a query,
+// before queries are routed to it again.
+const replicaCooldown = 10 * time.Second
+
+// dbNode is a database server queries can be sent to:
+// either the primary server or one of its read replicas.
+type dbNode struct {
+	host    string
+	replica bool
+	pool    *pgxpool.Pool
+
+	// unavailableUntil is the time, in unix nanoseconds, until which
+	// the node is skipped because it failed to serve a query.
+	unavailableUntil atomic.Int64
+}
+
+func newDBNode(pool *pgxpool.Pool, replica bool) *dbNode {
+	return &dbNode{host: connHost(pool.Config().ConnConfig), replica: replica, pool: pool}
+}
+
+func (n *dbNode) available(now time.Time) bool {
+	return now.UnixNano() >= n.unavailableUntil.Load()
+}
+
+func (n *dbNode) markUnavailable(now time.Time) {
+	n.unavailableUntil.Store(now.Add(replicaCooldown).UnixNano())
+}
+
+// connHost returns the host a connection connects to, as reported in traces.
+func connHost(cfg *pgx.ConnConfig) string {
+	if strings.HasPrefix(cfg.Host, "/") {
+		return cfg.Host // unix socket
+	}
+	return net.JoinHostPort(cfg.Host, strconv.Itoa(int(cfg.Port)))
+}
+
+// ReadOnlyDatabase is a handle to a database for read-only queries.
+//
+// Queries are load-balanced across the database's read replicas, if any are configured.
+// If a replica can't be reached the query is retried on another replica, and ultimately
+// on the primary, so reads keep working while replicas are unavailable.
+// Without read replicas all queries are sent to the primary.
+//
+// Read replicas are typically replicated asynchronously, so queries may not observe
+// the most recent writes. Use the Database itself for reads that must.
+type ReadOnlyDatabase struct {
+	db *Database
+}
+
+// ReadOnly returns a handle to the database for read-only queries,
+// which are routed to the database's read replicas.
+//
+// See ReadOnlyDatabase for more information.
+func (db *Database) ReadOnly() *ReadOnlyDatabase {
+	return &ReadOnlyDatabase{db: db}
+}
+
+// Query executes a read-only query that returns rows, typically a SELECT.
+// The args are for any placeholder parameters in the query.
+//
+// See (*database/sql.DB).QueryContext() for additional documentation.
+func (r *ReadOnlyDatabase) Query(ctx context.Context, query string, args ...interface{}) (*Rows, error) {
+	db := r.db
+	if db.noopDB {
+		return nil, errNoopDB
+	}
+
+	db.init()
+	if err := db.injectFault(ctx); err != nil {
+		return nil, err
+	}
+
+	var stk stack.Stack
+	curr := db.mgr.rt.Current()
+	if curr.Req != nil && curr.Trace != nil {
+		stk = stack.Build(4)
+	}
+
+	rows, err := db.queryReplica(ctx, curr, stk, query, args)
+	if err != nil {
+		return nil, err
+	}
+	return &Rows{std: rows}, nil
+}
+
+// QueryRow executes a read-only query that is expected to return at most one row.
+//
+// See (*database/sql.DB).QueryRowContext() for additional documentation.
+func (r *ReadOnlyDatabase) QueryRow(ctx context.Context, query string, args ...interface{}) *Row {
+	db := r.db
+	if db.noopDB {
+		return &Row{err: errNoopDB}
+	}
+
+	db.init()
+	if err := db.injectFault(ctx); err != nil {
+		return &Row{err: err}
+	}
+
+	var stk stack.Stack
+	curr := db.mgr.rt.Current()
+	if curr.Req != nil && curr.Trace != nil {
+		stk = stack.Build(4)
+	}
+
+	rows, err := db.queryReplica(ctx, curr, stk, query, args)
+	return &Row{rows: rows, err: err}
+}
+
+// readNode returns the node to send a read-only query to.
+// Available read replicas are picked in round-robin order,
+// falling back to the primary if none are available.
+func (db *Database) readNode(now time.Time) *dbNode {
+	n := uint32(len(db.replicas))
+	if n == 0 {
+		return db.primary
+	}
+
+	start := db.nextReplica.Add(1)
+	for i := uint32(0); i < n; i++ {
+		if node := db.replicas[(start+i)%n]; node.available(now) {
+			return node
+		}
+	}
+	return db.primary
+}
+
+// queryReplica executes a read-only query on a read replica.
+// If the replica is unavailable it's skipped for a while, and the query is
+// retried on another replica, or on the primary if there are no more replicas to try.
+func (db *Database) queryReplica(ctx context.Context, curr reqtrack.Current, stk stack.Stack, query string, args []any) (pgx.Rows, error) {
+	node := db.readNode(time.Now())
+	for tries := 1; ; tries++ {
+		rows, err := db.queryNode(ctx, curr, stk, node, query, args)
+		if err != nil && node.replica && isUnavailable(ctx, err) {
+			node.markUnavailable(time.Now())
+			if tries < len(db.replicas) {
+				node = db.readNode(time.Now())
+			} else {
+				node = db.primary
+			}
+			continue
+		}
+		return rows, convertErr(err)
+	}
+}
+
+// queryNode executes a query on the given node.
+// It returns the error as reported by pgx.
+func (db *Database) queryNode(ctx context.Context, curr reqtrack.Current, stk stack.Stack, node *dbNode, query string, args []any) (pgx.Rows, error) {
+	var (
+		startEventID model.TraceEventID
+		eventParams  trace2.EventParams
+	)
+
+	if curr.Req != nil && curr.Trace != nil {
+		eventParams = trace2.EventParams{
+			TraceID: curr.Req.TraceID,
+			SpanID:  curr.Req.SpanID,
+			Goid:    curr.Goctr,
+			DefLoc:  0,
+		}
+		startEventID = curr.Trace.DBQueryStart(trace2.DBQueryStartParams{
+			EventParams: eventParams,
+			Query:       query,
+			Stack:       stk,
+			Host:        node.host,
+			Replica:     node.replica,
+		})
+	}
+
+	rows, err := node.pool.Query(markTraced(ctx), query, args...)
+
+	if curr.Req != nil && curr.Trace != nil {
+		curr.Trace.DBQueryEnd(eventParams, startEventID, convertErr(err))
+	}
+	return rows, err
+}
+
+// isUnavailable reports whether err indicates the database server
+// could not be reached or is unable to serve queries, as opposed to
+// the query itself failing.
+func isUnavailable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		// The query was canceled by the caller.
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// Class 08 (connection exception) and 57P (operator intervention),
+		// which includes the server shutting down or still starting up.
+		return strings.HasPrefix(pgErr.Code, "08") || strings.HasPrefix(pgErr.Code, "57P")
+	}
+
+	var netErr net.Error
+	return pgconn.SafeToRetry(err) || errors.As(err, &netErr)
+}
@@ -0,0 +1,71 @@
+package sqldb
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestReadNode(t *testing.T) {
+	primary := &dbNode{host: "primary"}
+	r1 := &dbNode{host: "replica-1", replica: true}
+	r2 := &dbNode{host: "replica-2", replica: true}
+	db := &Database{primary: primary, replicas: []*dbNode{r1, r2}}
+
+	now := time.Now()
+	seen := make(map[string]int)
+	for i := 0; i < 4; i++ {
+		seen[db.readNode(now).host]++
+	}
+	if seen["replica-1"] != 2 || seen["replica-2"] != 2 {
+		t.Errorf("got %v, want queries balanced across replicas", seen)
+	}
+
+	r1.markUnavailable(now)
+	for i := 0; i < 2; i++ {
+		if got := db.readNode(now); got != r2 {
+			t.Errorf("readNode() = %s, want replica-2", got.host)
+		}
+	}
+
+	r2.markUnavailable(now)
+	if got := db.readNode(now); got != primary {
+		t.Errorf("readNode() = %s, want primary", got.host)
+	}
+
+	// Replicas are used again after the cooldown.
+	if got := db.readNode(now.Add(replicaCooldown)); got == primary {
+		t.Errorf("readNode() = primary, want a replica")
+	}
+
+	if got := (&Database{primary: primary}).readNode(now); got != primary {
+		t.Errorf("readNode() without replicas = %s, want primary", got.host)
+	}
+}
+
+func TestIsUnavailable(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		{ctx: context.Background(), err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, want: true},
+		{ctx: context.Background(), err: &pgconn.PgError{Code: "57P03"}, want: true},  // cannot_connect_now
+		{ctx: context.Background(), err: &pgconn.PgError{Code: "08006"}, want: true},  // connection_failure
+		{ctx: context.Background(), err: &pgconn.PgError{Code: "42P01"}, want: false}, // undefined_table
+		{ctx: context.Background(), err: errors.New("some error"), want: false},
+		{ctx: canceled, err: context.Canceled, want: false},
+	}
+	for _, tt := range tests {
+		if got := isUnavailable(tt.ctx, tt.err); got != tt.want {
+			t.Errorf("isUnavailable(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"encore.dev/appruntime/exported/model"
 	"encore.dev/appruntime/exported/stack"
 	"encore.dev/appruntime/exported/trace2"
+	"encore.dev/appruntime/infrasdk/chaos"
 	"encore.dev/beta/errs"
 )
 
@@ -26,12 +27,31 @@ type ExecResult interface {
 	RowsAffected() int64
 }
 
+// Querier is implemented by both *Database and *Tx, so the same queries
+// can be run with or without a transaction.
+//
+// The typed query functions Encore generates from .sql query files
+// take a Querier as their database argument.
+type Querier interface {
+	Exec(ctx context.Context, query string, args ...interface{}) (ExecResult, error)
+	Query(ctx context.Context, query string, args ...interface{}) (*Rows, error)
+	QueryRow(ctx context.Context, query string, args ...interface{}) *Row
+}
+
+var (
+	_ Querier = (*Database)(nil)
+	_ Querier = (*Tx)(nil)
+)
+
 // Tx is a handle to a database transaction.
 //
 // See *database/sql.Tx for additional documentation.
 type Tx struct {
-	mgr *Manager
-	std pgx.Tx
+	mgr  *Manager
+	std  pgx.Tx
+	host string // the host of the database server
+
+	dbName string // the name of the database
 
 	startID model.TraceEventID
 }
@@ -95,6 +115,9 @@ func (tx *Tx) Exec(ctx context.Context, query string, args ...interface{}) (Exec
 }
 
 func (tx *Tx) exec(ctx context.Context, query string, args ...interface{}) (ExecResult, error) {
+	if err := tx.injectFault(ctx); err != nil {
+		return nil, err
+	}
 	curr := tx.mgr.rt.Current()
 
 	var (
@@ -114,6 +137,7 @@ func (tx *Tx) exec(ctx context.Context, query string, args ...interface{}) (Exec
 			TxStartID:   tx.startID,
 			Query:       query,
 			Stack:       stack.Build(4),
+			Host:        tx.host,
 		})
 	}
 
@@ -128,6 +152,9 @@ func (tx *Tx) exec(ctx context.Context, query string, args ...interface{}) (Exec
 }
 
 func (tx *Tx) Query(ctx context.Context, query string, args ...interface{}) (*Rows, error) {
+	if err := tx.injectFault(ctx); err != nil {
+		return nil, err
+	}
 	curr := tx.mgr.rt.Current()
 
 	var (
@@ -147,6 +174,7 @@ func (tx *Tx) Query(ctx context.Context, query string, args ...interface{}) (*Ro
 			Query:       query,
 			TxStartID:   tx.startID,
 			Stack:       stack.Build(4),
+			Host:        tx.host,
 		})
 	}
 
@@ -164,6 +192,9 @@ func (tx *Tx) Query(ctx context.Context, query string, args ...interface{}) (*Ro
 }
 
 func (tx *Tx) QueryRow(ctx context.Context, query string, args ...interface{}) *Row {
+	if err := tx.injectFault(ctx); err != nil {
+		return &Row{err: err}
+	}
 	curr := tx.mgr.rt.Current()
 
 	var (
@@ -183,6 +214,7 @@ func (tx *Tx) QueryRow(ctx context.Context, query string, args ...interface{}) *
 			Query:       query,
 			TxStartID:   tx.startID,
 			Stack:       stack.Build(4),
+			Host:        tx.host,
 		})
 	}
 
@@ -199,6 +231,12 @@ func (tx *Tx) QueryRow(ctx context.Context, query string, args ...interface{}) *
 	return r
 }
 
+// injectFault injects the faults configured with "encore chaos"
+// into a query in the transaction.
+func (tx *Tx) injectFault(ctx context.Context) error {
+	return tx.mgr.chaos.Inject(ctx, chaos.Target{Kind: chaos.SQLDB, Resource: tx.dbName})
+}
+
 // Rows is the result of a query. Its cursor starts before the first row
 // of the result set. Use Next to advance from row to row.
 //
@@ -3,10 +3,13 @@
 package sqldb
 
 import (
+	"encore.dev/appruntime/infrasdk/chaos"
 	"encore.dev/appruntime/shared/appconf"
+	"encore.dev/appruntime/shared/encoreroutes"
 	"encore.dev/appruntime/shared/logging"
 	"encore.dev/appruntime/shared/reqtrack"
 	"encore.dev/appruntime/shared/shutdown"
+	"encore.dev/appruntime/shared/startup"
 	"encore.dev/appruntime/shared/testsupport"
 )
 
@@ -19,6 +22,13 @@ import (
 var Singleton *Manager
 
 func init() {
-	Singleton = NewManager(appconf.Runtime, reqtrack.Singleton, testsupport.Singleton, logging.RootLogger)
+	Singleton = NewManager(appconf.Runtime, reqtrack.Singleton, testsupport.Singleton, chaos.Singleton, logging.RootLogger)
 	shutdown.Singleton.RegisterShutdownHandler(Singleton.Shutdown)
+	encoreroutes.Singleton.Register(dataMigrationRoutePrefix, &dataMigrationHandler{mgr: Singleton})
+
+	// When running locally the daemon runs the data migrations,
+	// in order with the SQL migrations.
+	if appconf.Runtime.EnvCloud != "local" {
+		startup.Singleton.Register("sqldb data migrations", Singleton.RunDataMigrations)
+	}
 }
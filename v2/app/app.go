@@ -10,6 +10,9 @@ import (
 	"encr.dev/v2/parser"
 	"encr.dev/v2/parser/apis/api"
 	"encr.dev/v2/parser/apis/middleware"
+	"encr.dev/v2/parser/infra/objects"
+	"encr.dev/v2/parser/infra/pubsub"
+	"encr.dev/v2/parser/infra/sqldb"
 	"encr.dev/v2/parser/resource"
 	"encr.dev/v2/parser/resource/usage"
 )
@@ -120,3 +123,30 @@ func (d *Desc) ServiceForPath(path paths.FS) (*Service, bool) {
 	}
 	return nil, false
 }
+
+// DataMigrationDB returns the database a data migration belongs to, if it can be found.
+func (d *Desc) DataMigrationDB(m *sqldb.DataMigration) (*sqldb.Database, bool) {
+	if res, ok := d.Parse.ResourceForQN(m.Database).Get(); ok {
+		db, ok := res.(*sqldb.Database)
+		return db, ok
+	}
+	return nil, false
+}
+
+// NotificationBucket returns the bucket a bucket notification belongs to, if it can be found.
+func (d *Desc) NotificationBucket(n *objects.Notification) (*objects.Bucket, bool) {
+	if res, ok := d.Parse.ResourceForQN(n.Bucket).Get(); ok {
+		bkt, ok := res.(*objects.Bucket)
+		return bkt, ok
+	}
+	return nil, false
+}
+
+// NotificationTopic returns the topic a bucket notification publishes to, if it can be found.
+func (d *Desc) NotificationTopic(n *objects.Notification) (*pubsub.Topic, bool) {
+	if res, ok := d.Parse.ResourceForQN(n.Topic).Get(); ok {
+		topic, ok := res.(*pubsub.Topic)
+		return topic, ok
+	}
+	return nil, false
+}
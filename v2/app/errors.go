@@ -6,6 +6,8 @@ import (
 
 const (
 	serviceHelp = "For more information on services and how to define them, see https://encore.dev/docs/primitives/services"
+
+	architectureHelp = "Architecture rules are configured in the \"architecture\" section of encore.app."
 )
 
 var (
@@ -41,4 +43,34 @@ var (
 		"Infrastructure resources can only be referenced within services.",
 		errors.WithDetails("To use infrastructure resources outside services, instead pass a reference to the resource into the library."),
 	)
+
+	errInvalidArchitectureRule = errRange.Newf(
+		"Invalid architecture rule",
+		"%s",
+		errors.WithDetails(architectureHelp),
+	)
+
+	errForbiddenServiceDependency = errRange.Newf(
+		"Forbidden service dependency",
+		"The service %s is not allowed to depend on the service %s.",
+		errors.WithDetails(architectureHelp),
+	)
+
+	errServiceDependencyOnHigherLayer = errRange.Newf(
+		"Forbidden service dependency",
+		"The service %s in the layer %q is not allowed to depend on the service %s in the layer %q above it.",
+		errors.WithDetails(architectureHelp),
+	)
+
+	errServiceDependencyCycle = errRange.Newf(
+		"Service dependency cycle",
+		"The services %s depend on each other in a cycle.",
+		errors.WithDetails(architectureHelp),
+	)
+
+	errForbiddenResourceAccess = errRange.Newf(
+		"Forbidden resource access",
+		"The service %s is not allowed to access the %s %q.",
+		errors.WithDetails(architectureHelp),
+	)
 )
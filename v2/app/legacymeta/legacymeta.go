@@ -30,6 +30,7 @@ import (
 	"encr.dev/v2/parser/infra/pubsub"
 	"encr.dev/v2/parser/infra/secrets"
 	"encr.dev/v2/parser/infra/sqldb"
+	"encr.dev/v2/parser/plugin/external"
 	"encr.dev/v2/parser/resource"
 )
 
@@ -156,8 +157,8 @@ func (b *builder) Build() *meta.Data {
 							Value: "nats-subject:" + sub.Subject,
 						},
 					},
-					Sensitive:      false,
-					Expose:         make(map[string]*meta.RPC_ExposeOptions),
+					Sensitive: false,
+					Expose:    make(map[string]*meta.RPC_ExposeOptions),
 				}
 				rpc.AllowUnauthenticated = true
 				out.Rpcs = append(out.Rpcs, rpc)
@@ -246,6 +247,8 @@ func (b *builder) Build() *meta.Data {
 		topicMap   = make(map[pkginfo.QualifiedName]*meta.PubSubTopic)
 		natsTopics = make(map[string]*meta.PubSubTopic)
 		clusterMap = make(map[pkginfo.QualifiedName]*meta.CacheCluster)
+		dbMap      = make(map[*sqldb.Database]*meta.SQLDatabase)
+		bucketMap  = make(map[*objects.Bucket]*meta.Bucket)
 	)
 
 	selectorLookup := computeSelectorLookup(b.app)
@@ -295,9 +298,11 @@ func (b *builder) Build() *meta.Data {
 				Name:             r.Name,
 				Doc:              zeroNil(r.Doc),
 				MigrationRelPath: zeroNil(r.MigrationDir.String()),
+				SeedRelPath:      zeroNil(r.SeedDir.String()),
 				Migrations:       fns.Map(r.Migrations, transformMigration),
 			}
 			md.SqlDatabases = append(md.SqlDatabases, db)
+			dbMap[r] = db
 
 		case *pubsub.Topic:
 			topic := &meta.PubSubTopic{
@@ -376,6 +381,7 @@ func (b *builder) Build() *meta.Data {
 				Public:    r.Public,
 			}
 			md.Buckets = append(md.Buckets, bkt)
+			bucketMap[r] = bkt
 
 			permsBySvc := make(map[string][]objects.Perm)
 			addPerms := func(svcName string, perms ...objects.Perm) {
@@ -388,6 +394,7 @@ func (b *builder) Build() *meta.Data {
 				case *objects.MethodUsage:
 					if svc, ok := b.app.ServiceForPath(u.DeclaredIn().FSPath); ok {
 						addPerms(svc.Name, u.Perm)
+						addPerms(svc.Name, u.ExtraPerms...)
 					}
 				case *objects.RefUsage:
 					if svc, ok := b.app.ServiceForPath(u.DeclaredIn().FSPath); ok {
@@ -462,6 +469,23 @@ func (b *builder) Build() *meta.Data {
 
 			md.Metrics = append(md.Metrics, m)
 
+		case *external.Resource:
+			var svcName *string
+			if svc, ok := b.app.ServiceForPath(r.File.Pkg.FSPath); ok {
+				svcName = &svc.Name
+			}
+
+			md.PluginResources = append(md.PluginResources, &meta.PluginResource{
+				Plugin:      r.Plugin.Name,
+				Type:        r.Type,
+				Name:        r.Name,
+				Pkg:         b.relPath(r.File.Pkg.ImportPath),
+				Func:        r.FuncName(),
+				ServiceName: svcName,
+				Doc:         zeroNil(r.Doc),
+				Data:        string(r.Data),
+			})
+
 		case *config.Load:
 			if svc, ok := b.app.ServiceForPath(r.File.Pkg.FSPath); ok {
 				if metaSvc, ok := svcByName[svc.Name]; ok {
@@ -504,7 +528,7 @@ func (b *builder) Build() *meta.Data {
 				b.nodes.addServiceStruct(r, svc.Name)
 			}
 
-		case *pubsub.Subscription, *nats.Subscription, *caches.Keyspace:
+		case *pubsub.Subscription, *nats.Subscription, *caches.Keyspace, *sqldb.DataMigration, *objects.Notification:
 			dependent = append(dependent, r)
 		}
 	}
@@ -512,6 +536,46 @@ func (b *builder) Build() *meta.Data {
 	// Make a second pass for resources that depend on other resources.
 	for _, r := range dependent {
 		switch r := r.(type) {
+		case *sqldb.DataMigration:
+			res, ok := b.app.DataMigrationDB(r)
+			db := dbMap[res]
+			if !ok || db == nil {
+				b.errs.Addf(r.ASTExpr().Pos(), "database %q not found",
+					r.Database.NaiveDisplayName())
+				continue
+			}
+
+			db.DataMigrations = append(db.DataMigrations, &meta.DBDataMigration{
+				Number:      r.Version,
+				Description: r.Name,
+				Pkg:         b.relPath(r.File.Pkg.ImportPath),
+			})
+			slices.SortFunc(db.DataMigrations, func(a, b *meta.DBDataMigration) int {
+				return cmp.Compare(a.Number, b.Number)
+			})
+
+		case *objects.Notification:
+			res, ok := b.app.NotificationBucket(r)
+			bkt := bucketMap[res]
+			if !ok || bkt == nil {
+				b.errs.Addf(r.ASTExpr().Pos(), "bucket %q not found",
+					r.Bucket.NaiveDisplayName())
+				continue
+			}
+			topic, ok := topicMap[r.Topic]
+			if !ok {
+				b.errs.Addf(r.ASTExpr().Pos(), "topic %q not found",
+					r.Topic.NaiveDisplayName())
+				continue
+			}
+
+			bkt.Notifications = append(bkt.Notifications, &meta.Bucket_Notification{
+				Topic:         topic.Name,
+				ObjectCreated: r.ObjectCreated,
+				ObjectDeleted: r.ObjectDeleted,
+				Prefix:        r.Prefix,
+			})
+
 		case *pubsub.Subscription:
 			topic, ok := topicMap[r.Topic]
 			if !ok {
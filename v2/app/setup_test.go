@@ -12,7 +12,16 @@ import (
 )
 
 func TestMain(m *testing.M) {
-	os.Exit(testscript.RunMain(m, nil))
+	// Record the plugins trusted by the tests in a temporary config directory.
+	configDir, err := os.MkdirTemp("", "encore-config")
+	if err != nil {
+		panic(err)
+	}
+	os.Setenv("ENCORE_CONFIG_DIR", configDir)
+
+	code := testscript.RunMain(m, nil)
+	_ = os.RemoveAll(configDir)
+	os.Exit(code)
 }
 
 // Parse will take the given archiveContent and parse it into a testutil.Context and parser.Result.
@@ -32,6 +32,9 @@ func (d *Desc) validate(pc *parsectx.Context, result *parser.Result) {
 	d.validatePubSub(pc, result)
 	d.validateObjects(pc, result)
 
+	// Validate the architecture rules
+	d.validateArchitecture(pc, result)
+
 	// Validate all resources are defined within a service
 	for _, b := range result.AllBinds() {
 		r := result.ResourceForBind(b)
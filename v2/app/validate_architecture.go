@@ -0,0 +1,304 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"encr.dev/pkg/appfile"
+	"encr.dev/pkg/errors"
+	"encr.dev/v2/internals/parsectx"
+	"encr.dev/v2/parser"
+	"encr.dev/v2/parser/apis/api"
+	"encr.dev/v2/parser/infra/objects"
+	"encr.dev/v2/parser/infra/sqldb"
+	"encr.dev/v2/parser/resource"
+	"encr.dev/v2/parser/resource/usage"
+)
+
+// serviceDep is a dependency of one service on another,
+// through the API calls made from the first to the second.
+type serviceDep struct {
+	From, To *Service
+	Calls    []usage.Usage // sorted by position
+}
+
+// validateArchitecture checks the app against the architecture rules in encore.app, if any.
+func (d *Desc) validateArchitecture(pc *parsectx.Context, result *parser.Result) {
+	appFilePath := pc.MainModuleDir.Join(appfile.Name).ToIO()
+	data, err := pc.ReadFile(appFilePath)
+	if err != nil {
+		return
+	}
+	f, err := appfile.Parse(data)
+	if err != nil || f.Architecture == nil {
+		// Invalid app files are reported when the app is loaded.
+		return
+	}
+	arch := f.Architecture
+
+	svcByName := make(map[string]*Service, len(d.Services))
+	for _, svc := range d.Services {
+		svcByName[svc.Name] = svc
+	}
+	invalidRule := func(format string, args ...any) {
+		pc.Errs.Add(errInvalidArchitectureRule(fmt.Sprintf(format, args...)).InFile(appfile.Name))
+	}
+	checkServices := func(names []string) {
+		for _, name := range names {
+			if _, ok := svcByName[name]; !ok {
+				invalidRule("The architecture rules refer to the unknown service %q.", name)
+			}
+		}
+	}
+
+	// Validate the rules themselves.
+	layerOf := make(map[string]int)
+	for i, layer := range arch.Layers {
+		checkServices(layer.Services)
+		for _, name := range layer.Services {
+			if prev, ok := layerOf[name]; ok && prev != i {
+				invalidRule("The service %q is part of both the layers %q and %q.", name, arch.Layers[prev].Name, layer.Name)
+			}
+			layerOf[name] = i
+		}
+	}
+	for _, rule := range arch.Dependencies {
+		checkServices([]string{rule.Service})
+		checkServices(rule.Allow)
+		checkServices(rule.Deny)
+	}
+	for _, rule := range arch.Resources {
+		checkServices(rule.Services)
+		if (rule.Database == "") == (rule.Bucket == "") {
+			invalidRule("Resource rules must specify exactly one of \"database\" and \"bucket\".")
+		}
+	}
+
+	deps := d.serviceDeps()
+
+	// Check the dependencies against the dependency rules and layers.
+	for _, dep := range deps {
+		forbidden := false
+		for _, rule := range arch.Dependencies {
+			if rule.Service != dep.From.Name {
+				continue
+			}
+			if len(rule.Allow) > 0 && !slices.Contains(rule.Allow, dep.To.Name) {
+				forbidden = true
+			}
+			if slices.Contains(rule.Deny, dep.To.Name) {
+				forbidden = true
+			}
+		}
+		if forbidden {
+			for _, call := range dep.Calls {
+				pc.Errs.Add(errForbiddenServiceDependency(dep.From.Name, dep.To.Name).
+					AtGoNode(call, errors.AsError("called here")))
+			}
+			continue
+		}
+
+		from, fromOk := layerOf[dep.From.Name]
+		to, toOk := layerOf[dep.To.Name]
+		if fromOk && toOk && to < from {
+			for _, call := range dep.Calls {
+				pc.Errs.Add(errServiceDependencyOnHigherLayer(dep.From.Name, arch.Layers[from].Name, dep.To.Name, arch.Layers[to].Name).
+					AtGoNode(call, errors.AsError("called here")))
+			}
+		}
+	}
+
+	if arch.NoCycles {
+		for _, cycle := range dependencyCycles(deps) {
+			names := make([]string, 0, len(cycle))
+			for _, dep := range cycle {
+				names = append(names, dep.From.Name)
+			}
+			sort.Strings(names)
+			names = slices.Compact(names)
+
+			err := errServiceDependencyCycle(strings.Join(names, ", "))
+			// Locations are prepended, so add them in reverse.
+			for _, dep := range slices.Backward(cycle) {
+				err = err.AtGoNode(dep.Calls[0], errors.AsError(fmt.Sprintf("%s calls %s here", dep.From.Name, dep.To.Name)))
+			}
+			pc.Errs.Add(err)
+		}
+	}
+
+	// Check the resource access rules.
+	for _, rule := range arch.Resources {
+		var (
+			res  resource.Resource
+			kind string
+			name string
+		)
+		switch {
+		case rule.Database != "":
+			kind, name = "database", rule.Database
+			for _, db := range parser.Resources[*sqldb.Database](result) {
+				if db.Name == name {
+					res = db
+				}
+			}
+		case rule.Bucket != "":
+			kind, name = "bucket", rule.Bucket
+			for _, bkt := range parser.Resources[*objects.Bucket](result) {
+				if bkt.Name == name {
+					res = bkt
+				}
+			}
+		default:
+			continue
+		}
+		if res == nil {
+			invalidRule("The architecture rules refer to the unknown %s %q.", kind, name)
+			continue
+		}
+
+		for _, svc := range d.Services {
+			if slices.Contains(rule.Services, svc.Name) {
+				continue
+			}
+			for _, u := range sortedUsages(svc.ResourceUsage[res]) {
+				if !u.DeclaredIn().TestFile {
+					pc.Errs.Add(errForbiddenResourceAccess(svc.Name, kind, name).
+						AtGoNode(u, errors.AsError("accessed here")))
+				}
+			}
+		}
+	}
+}
+
+// serviceDeps computes the dependencies between the services of the app,
+// ordered by the dependent service and then the service depended on.
+func (d *Desc) serviceDeps() []*serviceDep {
+	var deps []*serviceDep
+	for _, svc := range d.Services {
+		byTarget := make(map[*Service]*serviceDep)
+		for res, usages := range svc.ResourceUsage {
+			ep, ok := res.(*api.Endpoint)
+			if !ok {
+				continue
+			}
+			target, ok := d.ServiceForPath(ep.File.Pkg.FSPath)
+			if !ok || target == svc {
+				continue
+			}
+
+			for _, u := range usages {
+				switch u.(type) {
+				case *api.CallUsage, *api.ReferenceUsage:
+				default:
+					continue
+				}
+				if u.DeclaredIn().TestFile {
+					continue
+				}
+
+				dep, ok := byTarget[target]
+				if !ok {
+					dep = &serviceDep{From: svc, To: target}
+					byTarget[target] = dep
+					deps = append(deps, dep)
+				}
+				dep.Calls = append(dep.Calls, u)
+			}
+		}
+	}
+
+	for _, dep := range deps {
+		dep.Calls = sortedUsages(dep.Calls)
+	}
+	sort.Slice(deps, func(i, j int) bool {
+		if deps[i].From.Num != deps[j].From.Num {
+			return deps[i].From.Num < deps[j].From.Num
+		}
+		return deps[i].To.Num < deps[j].To.Num
+	})
+	return deps
+}
+
+// dependencyCycles returns the groups of services that depend on each other
+// in a cycle, as the dependencies between the services of each group.
+func dependencyCycles(deps []*serviceDep) [][]*serviceDep {
+	out := make(map[*Service][]*serviceDep)
+	for _, dep := range deps {
+		out[dep.From] = append(out[dep.From], dep)
+	}
+
+	// Find the strongly connected components using Tarjan's algorithm.
+	var (
+		index   = make(map[*Service]int)
+		lowLink = make(map[*Service]int)
+		onStack = make(map[*Service]bool)
+		stack   []*Service
+		comp    = make(map[*Service]int)
+		numComp int
+	)
+	var visit func(svc *Service)
+	visit = func(svc *Service) {
+		index[svc] = len(index)
+		lowLink[svc] = index[svc]
+		stack = append(stack, svc)
+		onStack[svc] = true
+
+		for _, dep := range out[svc] {
+			if _, seen := index[dep.To]; !seen {
+				visit(dep.To)
+				lowLink[svc] = min(lowLink[svc], lowLink[dep.To])
+			} else if onStack[dep.To] {
+				lowLink[svc] = min(lowLink[svc], index[dep.To])
+			}
+		}
+
+		if lowLink[svc] == index[svc] {
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				comp[top] = numComp
+				if top == svc {
+					break
+				}
+			}
+			numComp++
+		}
+	}
+	for _, dep := range deps {
+		if _, seen := index[dep.From]; !seen {
+			visit(dep.From)
+		}
+	}
+
+	// Group the dependencies within each component, in the order of deps.
+	var (
+		cycles   [][]*serviceDep
+		cycleIdx = make(map[int]int)
+	)
+	for _, dep := range deps {
+		c := comp[dep.From]
+		if comp[dep.To] != c {
+			continue
+		}
+		idx, ok := cycleIdx[c]
+		if !ok {
+			idx = len(cycles)
+			cycleIdx[c] = idx
+			cycles = append(cycles, nil)
+		}
+		cycles[idx] = append(cycles[idx], dep)
+	}
+	return cycles
+}
+
+// sortedUsages returns the usages sorted by their position.
+func sortedUsages(usages []usage.Usage) []usage.Usage {
+	usages = slices.Clone(usages)
+	sort.SliceStable(usages, func(i, j int) bool {
+		return usages[i].Pos() < usages[j].Pos()
+	})
+	return usages
+}
@@ -32,4 +32,29 @@ func (d *Desc) validateDatabases(pc *parsectx.Context, result *parser.Result) {
 			}
 		}
 	}
+
+	// Check that the data migrations are numbered together with their database's migrations.
+	dataMigrations := make(map[*sqldb.Database][]*sqldb.DataMigration)
+	for _, m := range parser.Resources[*sqldb.DataMigration](result) {
+		db, ok := d.DataMigrationDB(m)
+		if !ok {
+			pc.Errs.Add(sqldb.ErrDataMigrationDatabaseNotFound.AtGoNode(m.DBExpr))
+			continue
+		}
+		dataMigrations[db] = append(dataMigrations[db], m)
+	}
+	for _, db := range dbs {
+		if migs := dataMigrations[db]; len(migs) > 0 {
+			sqldb.ValidateDataMigrations(pc.Errs, db, migs)
+		}
+	}
+
+	// Check new migrations for unsafe operations, once per migration directory.
+	linted := make(map[string]bool)
+	for _, db := range dbs {
+		if dir := string(db.MigrationDir); dir != "" && !linted[dir] {
+			linted[dir] = true
+			sqldb.LintNewMigrations(pc, db)
+		}
+	}
 }
@@ -3,12 +3,14 @@ package app
 import (
 	"encr.dev/pkg/errors"
 	"encr.dev/v2/internals/parsectx"
+	"encr.dev/v2/internals/schema/schemautil"
 	"encr.dev/v2/parser"
 	"encr.dev/v2/parser/infra/objects"
 )
 
 func (d *Desc) validateObjects(pc *parsectx.Context, result *parser.Result) {
 	buckets := make(map[string]*objects.Bucket)
+	var notifications []*objects.Notification
 
 	for _, res := range d.Parse.Resources() {
 		switch res := res.(type) {
@@ -51,6 +53,43 @@ func (d *Desc) validateObjects(pc *parsectx.Context, result *parser.Result) {
 					}
 				}
 			}
+
+		case *objects.Notification:
+			notifications = append(notifications, res)
+		}
+	}
+
+	type notificationKey struct {
+		bucket, topic string
+	}
+	seen := make(map[notificationKey]*objects.Notification)
+	for _, n := range notifications {
+		bkt, ok := d.NotificationBucket(n)
+		if !ok {
+			pc.Errs.Add(objects.ErrNotificationBucketNotResource.AtGoNode(n.AST.Args[0]))
+			continue
+		}
+		topic, ok := d.NotificationTopic(n)
+		if !ok {
+			pc.Errs.Add(objects.ErrNotificationTopicNotResource.AtGoNode(n.AST.Args[1]))
+			continue
+		}
+		if !schemautil.IsNamed(topic.MessageType.ToType(), "encore.dev/storage/objects", "Event") {
+			pc.Errs.Add(objects.ErrNotificationInvalidMessageType.
+				AtGoNode(n.AST.Args[1], errors.AsError("used here")).
+				AtGoNode(topic.AST, errors.AsHelp("topic defined here")),
+			)
+			continue
+		}
+
+		key := notificationKey{bucket: bkt.Name, topic: topic.Name}
+		if existing, ok := seen[key]; ok {
+			pc.Errs.Add(objects.ErrDuplicateNotification.
+				AtGoNode(existing.AST, errors.AsHelp("originally defined here")).
+				AtGoNode(n.AST, errors.AsError("duplicated here")),
+			)
+		} else {
+			seen[key] = n
 		}
 	}
 }
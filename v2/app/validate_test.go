@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	goregexp "regexp"
 	"sort"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/pkg/diff"
 	"github.com/rogpeppe/go-internal/testscript"
 
+	"encr.dev/pkg/appfile"
 	"encr.dev/pkg/errinsrc/srcerrors"
 	"encr.dev/pkg/option"
 	"encr.dev/v2/app/apiframework"
@@ -26,8 +28,10 @@ import (
 	"encr.dev/v2/parser/infra/config"
 	"encr.dev/v2/parser/infra/crons"
 	"encr.dev/v2/parser/infra/metrics"
+	"encr.dev/v2/parser/infra/objects"
 	"encr.dev/v2/parser/infra/pubsub"
 	"encr.dev/v2/parser/infra/sqldb"
+	"encr.dev/v2/parser/plugin/external"
 )
 
 var goldenUpdate = flag.Bool("golden-update", os.Getenv("GOLDEN_UPDATE") != "", "update golden files")
@@ -107,6 +111,15 @@ func TestValidation(t *testing.T) {
 				}
 			},
 
+			// The "trustPlugins" command trusts the plugins configured
+			// in the encore.app file, so that the parser runs them.
+			"trustPlugins": func(ts *testscript.TestScript, neg bool, args []string) {
+				wd := ts.Value("wd").(string)
+				f, err := appfile.ParseFile(filepath.Join(wd, appfile.Name))
+				ts.Check(err)
+				ts.Check(external.Trust(wd, f.Plugins))
+			},
+
 			// The "Err" command is a no-op in the v2 parser, as we used expected errors
 			// inside the test files to assert the full error message
 			"err": func(ts *testscript.TestScript, neg bool, args []string) {},
@@ -275,8 +288,18 @@ func parse(ts *testscript.TestScript, neg bool, args []string) {
 				topicsByName[res.Topic].Name, res.Name, svc.Name, res.Cfg.AckDeadline,
 				res.Cfg.MessageRetention, res.Cfg.MaxRetries, res.Cfg.MinRetryBackoff,
 				res.Cfg.MaxRetryBackoff)
+		case *objects.Notification:
+			bkt, _ := desc.NotificationBucket(res)
+			topic, _ := desc.NotificationTopic(res)
+			if bkt == nil || topic == nil {
+				continue
+			}
+			printf("bucketNotification %s %s created=%v deleted=%v prefix=%q",
+				bkt.Name, topic.Name, res.ObjectCreated, res.ObjectDeleted, res.Prefix)
 		case *metrics.Metric:
 			printf("metric %s %s %s %s", res.Name, strings.ToUpper(res.ValueType.String()), strings.ToUpper(res.Type.String()), res.Labels)
+		case *external.Resource:
+			printf("pluginResource %s %s %s func=%s data=%s", res.Plugin.Name, res.Type, res.Name, res.FuncName(), res.Data)
 		}
 	}
 }
@@ -24,6 +24,7 @@ type Generator struct {
 	TypeScrubber     *typescrub.Computer
 	rewrites         map[*pkginfo.File]*rewrite.Rewriter
 	files            map[fileKey]*File
+	rawFiles         map[fileKey]overlay.File
 	addedAppInit     map[paths.Pkg]bool
 	addedTestSupport map[paths.Pkg]bool
 }
@@ -36,6 +37,7 @@ func New(c *parsectx.Context, traceNodes *legacymeta.TraceNodes) *Generator {
 		TypeScrubber:     typescrub.NewComputer(c.Log),
 		rewrites:         make(map[*pkginfo.File]*rewrite.Rewriter),
 		files:            make(map[fileKey]*File),
+		rawFiles:         make(map[fileKey]overlay.File),
 		addedAppInit:     make(map[paths.Pkg]bool),
 		addedTestSupport: make(map[paths.Pkg]bool),
 	}
@@ -76,6 +78,17 @@ func (g *Generator) InjectFile(pkgPath paths.Pkg, pkgName string, pkgDir paths.F
 	return f
 }
 
+// RawFile adds a file with the given contents to pkg, replacing any raw file
+// previously added with the same short name. Unlike File the contents are used
+// as-is, so they must be complete Go source including the package clause.
+func (g *Generator) RawFile(pkg *pkginfo.Package, shortName string, contents []byte) {
+	baseName := "encore_internal__" + shortName + ".go"
+	g.rawFiles[fileKey{pkg.ImportPath, baseName}] = overlay.File{
+		Source:   pkg.FSPath.Join(baseName),
+		Contents: contents,
+	}
+}
+
 func (g *Generator) Overlays() []overlay.File {
 	var of []overlay.File
 
@@ -98,6 +111,10 @@ func (g *Generator) Overlays() []overlay.File {
 		})
 	}
 
+	for _, f := range g.rawFiles {
+		of = append(of, f)
+	}
+
 	for f, rw := range g.rewrites {
 		source := f.Pkg.FSPath.Join(f.Name)
 		of = append(of, overlay.File{
@@ -10,8 +10,10 @@ import (
 	"encr.dev/v2/codegen/infragen/configgen"
 	"encr.dev/v2/codegen/infragen/metricsgen"
 	"encr.dev/v2/codegen/infragen/natsgen"
+	"encr.dev/v2/codegen/infragen/plugingen"
 	"encr.dev/v2/codegen/infragen/pubsubgen"
 	"encr.dev/v2/codegen/infragen/secretsgen"
+	"encr.dev/v2/codegen/infragen/sqldbgen"
 	"encr.dev/v2/internals/pkginfo"
 	"encr.dev/v2/parser/apis/nats"
 	"encr.dev/v2/parser/infra/caches"
@@ -19,6 +21,8 @@ import (
 	"encr.dev/v2/parser/infra/metrics"
 	"encr.dev/v2/parser/infra/pubsub"
 	"encr.dev/v2/parser/infra/secrets"
+	"encr.dev/v2/parser/infra/sqldb"
+	"encr.dev/v2/parser/plugin/external"
 	"encr.dev/v2/parser/resource"
 )
 
@@ -30,6 +34,7 @@ func Process(gg *codegen.Generator, appDesc *app.Desc) {
 
 	groups := make(map[groupKey][]resource.Resource)
 	pkgMap := make(map[paths.Pkg]*pkginfo.Package)
+	var pluginResources []*external.Resource
 	for _, r := range appDesc.Parse.Resources() {
 		// Group by package.
 		var pkg *pkginfo.Package
@@ -53,6 +58,17 @@ func Process(gg *codegen.Generator, appDesc *app.Desc) {
 		case *config.Load:
 			pkg = r.File.Pkg
 			resourceType = "config-load"
+		case *sqldb.DataMigration:
+			pkg = r.File.Pkg
+			resourceType = "sqldb-data-migration"
+		case *sqldb.Database:
+			pkg = r.Pkg
+			resourceType = "sqldb-database"
+		case *external.Resource:
+			// Plugins generate code for all their resources at once,
+			// so they're not grouped by package.
+			pluginResources = append(pluginResources, r)
+			continue
 		default:
 			continue
 		}
@@ -81,6 +97,14 @@ func Process(gg *codegen.Generator, appDesc *app.Desc) {
 			natsgen.Gen(gg, pkg, fns.Map(resources, func(r resource.Resource) *nats.Subscription {
 				return r.(*nats.Subscription)
 			}))
+		case "sqldb-data-migration":
+			sqldbgen.GenDataMigrations(gg, appDesc, fns.Map(resources, func(r resource.Resource) *sqldb.DataMigration {
+				return r.(*sqldb.DataMigration)
+			}))
+		case "sqldb-database":
+			for _, r := range resources {
+				sqldbgen.GenQueries(gg, r.(*sqldb.Database))
+			}
 		case "secrets":
 			svc, _ := appDesc.ServiceForPath(pkg.FSPath)
 			secretsgen.Gen(gg, option.AsOptional(svc), pkg, fns.Map(resources, func(r resource.Resource) *secrets.Secrets {
@@ -98,4 +122,6 @@ func Process(gg *codegen.Generator, appDesc *app.Desc) {
 			}))
 		}
 	}
+
+	plugingen.Gen(gg, appDesc, pluginResources)
 }
@@ -0,0 +1,64 @@
+package plugingen
+
+import (
+	"go/parser"
+	"go/token"
+	"regexp"
+
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/app"
+	"encr.dev/v2/codegen"
+	"encr.dev/v2/parser/plugin/external"
+)
+
+var fileNameRe = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Gen asks the external plugins to generate code for the resources they define,
+// and adds the files they return to the app's packages.
+func Gen(gen *codegen.Generator, appDesc *app.Desc, resources []*external.Resource) {
+	var (
+		plugins  []*external.Plugin
+		byPlugin = make(map[*external.Plugin][]*external.Resource)
+	)
+	for _, r := range resources {
+		if _, seen := byPlugin[r.Plugin]; !seen {
+			plugins = append(plugins, r.Plugin)
+		}
+		byPlugin[r.Plugin] = append(byPlugin[r.Plugin], r)
+	}
+
+	for _, p := range plugins {
+		rs := byPlugin[p]
+		files, err := p.Generate(gen.Ctx, appDesc.MainModule.Path, rs)
+		if err != nil {
+			gen.Errs.Addf(rs[0].Pos(), "plugin %q failed to generate code: %v", p.Name, err)
+			continue
+		}
+
+		for _, f := range files {
+			if !fileNameRe.MatchString(f.Name) {
+				gen.Errs.Addf(rs[0].Pos(), "plugin %q generated a file with the invalid name %q", p.Name, f.Name)
+				continue
+			}
+			pkg, ok := appDesc.Parse.PackageAt(paths.Pkg(f.Package)).Get()
+			if !ok {
+				gen.Errs.Addf(rs[0].Pos(), "plugin %q generated a file for the unknown package %q", p.Name, f.Package)
+				continue
+			}
+
+			// Make sure the file belongs to the package, as the compiler
+			// would otherwise report a confusing error about mixed packages.
+			ast, err := parser.ParseFile(token.NewFileSet(), f.Name+".go", f.Content, parser.PackageClauseOnly)
+			if err != nil {
+				gen.Errs.Addf(rs[0].Pos(), "plugin %q generated invalid code for package %q: %v", p.Name, f.Package, err)
+				continue
+			} else if ast.Name.Name != pkg.Name {
+				gen.Errs.Addf(rs[0].Pos(), "plugin %q generated a file for package %q with the package name %q, expected %q",
+					p.Name, f.Package, ast.Name.Name, pkg.Name)
+				continue
+			}
+
+			gen.RawFile(pkg, "plugin_"+p.Name+"_"+f.Name, []byte(f.Content))
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package plugingen_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"encr.dev/v2/app"
+	"encr.dev/v2/codegen"
+	"encr.dev/v2/codegen/infragen"
+	"encr.dev/v2/codegen/internal/codegentest"
+	"encr.dev/v2/internals/testutil"
+	"encr.dev/v2/parser"
+)
+
+func TestCodegen(t *testing.T) {
+	fn := func(gen *codegen.Generator, desc *app.Desc) {
+		infragen.Process(gen, desc)
+	}
+
+	codegentest.Run(t, fn)
+}
+
+func TestGen_InvalidFiles(t *testing.T) {
+	c := qt.New(t)
+
+	testCases := map[string]struct {
+		response string // the response of the plugin to the "generate" call
+		wantErr  string // a regexp matching the error
+	}{
+		"invalid_file_name": {
+			response: `{"files":[{"package":"example.com/svc","name":"Queues.go","content":"package svc\n"}]}`,
+			wantErr:  `generated a file with the invalid name "Queues.go"`,
+		},
+		"unknown_package": {
+			response: `{"files":[{"package":"example.com/other","name":"queues","content":"package other\n"}]}`,
+			wantErr:  `generated a file for the unknown package "example.com/other"`,
+		},
+		"package_clause_mismatch": {
+			response: `{"files":[{"package":"example.com/svc","name":"queues","content":"package other\n"}]}`,
+			wantErr:  `with the package name "other",\s+expected "svc"`,
+		},
+		"invalid_code": {
+			response: `{"files":[{"package":"example.com/svc","name":"queues","content":"var x = 1\n"}]}`,
+			wantErr:  `generated invalid code for package "example.com/svc"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		c.Run(name, func(c *qt.C) {
+			archive := testutil.ParseTxtar(`
+-- go.mod --
+module example.com
+
+require encore.dev v1.52.0
+-- encore.app --
+{"plugins": [{"name": "queues", "command": ["sh", "plugin.sh"], "directives": ["queue"]}]}
+-- plugin.sh --
+read -r req
+case "$req" in
+  *'"method":"parse"'*) printf '%s\n' '{"jsonrpc":"2.0","id":1,"result":{"resources":[{"decl":0,"type":"queue","name":"emails"}]}}' ;;
+  *) printf '{"jsonrpc":"2.0","id":1,"result":%s}\n' "$(cat generate.json)" ;;
+esac
+-- generate.json --
+` + tc.response + `
+-- svc/svc.go --
+package svc
+
+import "context"
+
+//encore:queue
+func SendEmail(ctx context.Context) error { return nil }
+
+//encore:api public
+func Ping(ctx context.Context) error { return nil }
+`)
+			tctx := testutil.NewContext(c, false, archive)
+			tctx.GoModDownload()
+			tctx.TrustPlugins()
+
+			desc := app.ValidateAndDescribe(tctx.Context, parser.NewParser(tctx.Context).Parse())
+			c.Assert(tctx.Errs.Len(), qt.Equals, 0, qt.Commentf("parse errors: %s", tctx.Errs.FormatErrors()))
+
+			gen := codegen.New(tctx.Context, nil)
+			infragen.Process(gen, desc)
+			tctx.DeferExpectError(tc.wantErr)
+
+			// The invalid files are not added to the app.
+			c.Assert(gen.Overlays(), qt.HasLen, 0)
+		})
+	}
+}
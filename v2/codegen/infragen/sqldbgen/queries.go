@@ -0,0 +1,417 @@
+package sqldbgen
+
+import (
+	"fmt"
+	"go/token"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	. "github.com/dave/jennifer/jen"
+
+	"encr.dev/pkg/option"
+	daemonpb "encr.dev/proto/encore/daemon"
+	"encr.dev/v2/codegen"
+	"encr.dev/v2/parser/infra/sqldb"
+)
+
+// QueriesFile is the name of the file containing the typed query functions.
+const QueriesFile = "queries.gen.go"
+
+// GeneratedHeader is the first line of the generated queries file.
+const GeneratedHeader = "// Code generated by encore. DO NOT EDIT."
+
+const sqldbPkg = "encore.dev/storage/sqldb"
+
+// GenQueries generates typed query functions from the .sql files in the database's
+// query directory, in the package declaring the database.
+//
+// If the database has no queries it returns None.
+func GenQueries(gen *codegen.Generator, db *sqldb.Database) option.Option[*codegen.File] {
+	if db.QueryDir == "" {
+		return option.None[*codegen.File]()
+	}
+	queryDir := gen.MainModuleDir.Join(filepath.FromSlash(string(db.QueryDir)))
+	if files, _ := filepath.Glob(filepath.Join(queryDir.ToIO(), "*.sql")); len(files) == 0 {
+		return option.None[*codegen.File]()
+	}
+
+	migrationDir := gen.MainModuleDir.Join(filepath.FromSlash(string(db.MigrationDir)))
+	req, queryErrs, err := analyzeQueries(migrationDir.ToIO(), queryDir.ToIO())
+	if err != nil {
+		gen.Errs.Add(sqldb.ErrInvalidQuery(err.Error()).InFile(queryDir.ToIO()))
+		return option.None[*codegen.File]()
+	}
+	for _, e := range queryErrs {
+		t := sqldb.ErrInvalidQuery(e.Message)
+		if e.Pos.IsValid() {
+			t = t.AtGoPosition(e.Pos, token.Position{Filename: e.Pos.Filename, Line: e.Pos.Line, Column: e.Pos.Column + 1})
+		} else {
+			t = t.InFile(queryDir.ToIO())
+		}
+		gen.Errs.Add(t)
+	}
+	if len(queryErrs) > 0 || len(req.Queries) == 0 {
+		return option.None[*codegen.File]()
+	}
+
+	numErrs := gen.Errs.Len()
+	f := gen.InjectFile(db.Pkg.ImportPath, db.Pkg.Name, db.Pkg.FSPath, QueriesFile, "queries")
+	f.Jen.HeaderComment(strings.TrimPrefix(GeneratedHeader, "// "))
+	f.Jen.Comment(fmt.Sprintf("These functions are generated from the queries in %s.", db.QueryDir))
+	f.Jen.Comment("They are automatically updated by Encore whenever the queries or migrations change.")
+	f.Jen.Line()
+
+	qg := &queryGen{
+		gen:      gen,
+		queryDir: queryDir.ToIO(),
+		enums:    make(map[string]*enumType),
+	}
+	for _, s := range req.GetCatalog().GetSchemas() {
+		for _, e := range s.Enums {
+			name := e.Name
+			if s.Name != "" && s.Name != req.Catalog.DefaultSchema {
+				name = s.Name + "." + e.Name
+			}
+			qg.enums[name] = &enumType{name: goName(strings.ReplaceAll(name, ".", "_")), vals: e.Vals}
+		}
+	}
+
+	var funcs []Code
+	for _, q := range req.Queries {
+		if code, ok := qg.query(q); ok {
+			funcs = append(funcs, code...)
+		}
+	}
+
+	// Declare the enum types first, so they're easy to find.
+	for _, e := range qg.usedEnums {
+		f.Jen.Type().Id(e.name).String()
+		f.Jen.Line()
+		f.Jen.Const().DefsFunc(func(g *Group) {
+			for i, v := range e.vals {
+				name := e.name + goName(v)
+				if name == e.name {
+					name += strconv.Itoa(i + 1)
+				}
+				g.Id(name).Id(e.name).Op("=").Lit(v)
+			}
+		})
+		f.Jen.Line()
+	}
+	for _, c := range funcs {
+		f.Jen.Add(c)
+		f.Jen.Line()
+	}
+
+	// Only drop the file on errors generating it.
+	if gen.Errs.Len() > numErrs {
+		return option.None[*codegen.File]()
+	}
+	return option.Some(f)
+}
+
+type enumType struct {
+	name string
+	vals []string
+}
+
+type queryGen struct {
+	gen       *codegen.Generator
+	queryDir  string
+	enums     map[string]*enumType
+	usedEnums []*enumType
+}
+
+// field is a struct field or function parameter for a query column.
+type field struct {
+	name string // Go name
+	typ  Code
+}
+
+func (qg *queryGen) query(q *daemonpb.SQLCPlugin_Query) ([]Code, bool) {
+	name := q.Name
+	if !token.IsIdentifier(name) || !token.IsExported(name) {
+		qg.errorf(q, "invalid query name %q: must be an exported Go identifier", name)
+		return nil, false
+	}
+	constName := strings.ToLower(name[:1]) + name[1:]
+
+	var decls []Code
+
+	// The query text, with the name comment so it can be identified in database logs.
+	text := fmt.Sprintf("-- name: %s %s\n%s\n", q.Name, q.Cmd, q.Text)
+	if strings.Contains(text, "`") {
+		decls = append(decls, Const().Id(constName).Op("=").Lit(text))
+	} else {
+		decls = append(decls, Const().Id(constName).Op("=").Op("`"+text+"`"))
+	}
+
+	// Parameters
+	var params []field
+	seen := make(map[string]int)
+	for _, p := range q.Params {
+		pname := p.Column.GetName()
+		if pname == "" {
+			pname = "arg" + strconv.Itoa(int(p.Number))
+		}
+		params = append(params, field{name: dedupe(seen, goName(pname)), typ: qg.goType(p.Column)})
+	}
+
+	// Use a params struct if there's more than one parameter.
+	var (
+		sigParams []Code
+		args      []Code
+	)
+	sigParams = append(sigParams, Id("ctx").Qual("context", "Context"), Id("q").Qual(sqldbPkg, "Querier"))
+	args = append(args, Id("ctx"), Id(constName))
+	if len(params) == 1 {
+		argName := paramName(q.Params[0].Column.GetName(), params[0].name)
+		if token.Lookup(argName).IsKeyword() || reservedNames[argName] {
+			argName += "Arg"
+		}
+		sigParams = append(sigParams, Id(argName).Add(params[0].typ))
+		args = append(args, Id(argName))
+	} else if len(params) > 1 {
+		paramsType := name + "Params"
+		decls = append(decls, Type().Id(paramsType).StructFunc(func(g *Group) {
+			for _, p := range params {
+				g.Id(p.name).Add(p.typ)
+			}
+		}))
+		sigParams = append(sigParams, Id("arg").Id(paramsType))
+		for _, p := range params {
+			args = append(args, Id("arg").Dot(p.name))
+		}
+	}
+
+	// Result columns
+	var (
+		cols    []field
+		rowType Code
+	)
+	seen = make(map[string]int)
+	for i, c := range q.Columns {
+		cname := c.Name
+		if cname == "" || cname == "?column?" {
+			cname = "column_" + strconv.Itoa(i+1)
+		}
+		cols = append(cols, field{name: dedupe(seen, goName(cname)), typ: qg.goType(c)})
+	}
+	returnsRows := q.Cmd == ":one" || q.Cmd == ":many"
+	if returnsRows {
+		switch len(cols) {
+		case 0:
+			qg.errorf(q, "query %s uses %s but doesn't return any columns", name, q.Cmd)
+			return nil, false
+		case 1:
+			rowType = cols[0].typ
+		default:
+			rowName := name + "Row"
+			decls = append(decls, Type().Id(rowName).StructFunc(func(g *Group) {
+				for _, c := range cols {
+					g.Id(c.name).Add(c.typ)
+				}
+			}))
+			rowType = Id(rowName)
+		}
+	}
+	scanArgs := func() []Code {
+		if len(cols) == 1 {
+			return []Code{Op("&").Id("i")}
+		}
+		var dst []Code
+		for _, c := range cols {
+			dst = append(dst, Op("&").Id("i").Dot(c.name))
+		}
+		return dst
+	}
+
+	// Function
+	fn := Null()
+	if len(q.Comments) > 0 {
+		for _, c := range q.Comments {
+			fn.Comment(strings.TrimSpace(c)).Line()
+		}
+	} else {
+		fn.Comment(fmt.Sprintf("%s runs the %s query in %s.", name, name, q.Filename)).Line()
+	}
+
+	fn = fn.Func().Id(name).Params(sigParams...)
+	switch q.Cmd {
+	case ":one":
+		fn = fn.Params(rowType, Error()).Block(
+			Id("row").Op(":=").Id("q").Dot("QueryRow").Call(args...),
+			Var().Id("i").Add(rowType),
+			Id("err").Op(":=").Id("row").Dot("Scan").Call(scanArgs()...),
+			Return(Id("i"), Id("err")),
+		)
+	case ":many":
+		fn = fn.Params(Index().Add(rowType), Error()).Block(
+			List(Id("rows"), Err()).Op(":=").Id("q").Dot("Query").Call(args...),
+			If(Err().Op("!=").Nil()).Block(Return(Nil(), Err())),
+			Defer().Id("rows").Dot("Close").Call(),
+			Var().Id("items").Index().Add(rowType),
+			For(Id("rows").Dot("Next").Call()).Block(
+				Var().Id("i").Add(rowType),
+				If(Err().Op(":=").Id("rows").Dot("Scan").Call(scanArgs()...), Err().Op("!=").Nil()).Block(
+					Return(Nil(), Err()),
+				),
+				Id("items").Op("=").Append(Id("items"), Id("i")),
+			),
+			If(Err().Op(":=").Id("rows").Dot("Err").Call(), Err().Op("!=").Nil()).Block(
+				Return(Nil(), Err()),
+			),
+			Return(Id("items"), Nil()),
+		)
+	case ":exec":
+		fn = fn.Error().Block(
+			List(Id("_"), Err()).Op(":=").Id("q").Dot("Exec").Call(args...),
+			Return(Err()),
+		)
+	case ":execrows":
+		fn = fn.Params(Int64(), Error()).Block(
+			List(Id("res"), Err()).Op(":=").Id("q").Dot("Exec").Call(args...),
+			If(Err().Op("!=").Nil()).Block(Return(Lit(0), Err())),
+			Return(Id("res").Dot("RowsAffected").Call(), Nil()),
+		)
+	case ":execresult":
+		fn = fn.Params(Qual(sqldbPkg, "ExecResult"), Error()).Block(
+			Return(Id("q").Dot("Exec").Call(args...)),
+		)
+	default:
+		qg.errorf(q, "query %s uses unsupported command %s: use :one, :many, :exec, :execrows or :execresult", name, q.Cmd)
+		return nil, false
+	}
+
+	decls = append(decls, fn)
+	var code []Code
+	for _, d := range decls {
+		code = append(code, d, Line())
+	}
+	return code, true
+}
+
+// goType returns the Go type to use for the column.
+// Nullable columns use pointer types, except for types that can represent NULL themselves.
+func (qg *queryGen) goType(col *daemonpb.SQLCPlugin_Column) Code {
+	typ, nullable := qg.baseType(col)
+	if nullable && !col.NotNull && !col.IsArray && col.ArrayDims == 0 {
+		typ = Op("*").Add(typ)
+	}
+	for range max(col.ArrayDims, boolToInt(col.IsArray)) {
+		typ = Index().Add(typ)
+	}
+	return typ
+}
+
+// baseType returns the Go type for the column's type, ignoring arrays,
+// and whether a pointer is needed to represent NULL.
+func (qg *queryGen) baseType(col *daemonpb.SQLCPlugin_Column) (typ *Statement, nullable bool) {
+	name := col.GetType().GetName()
+	if schema := col.GetType().GetSchema(); schema != "" && schema != "pg_catalog" && schema != "public" {
+		name = schema + "." + name
+	}
+	name = strings.TrimPrefix(name, "pg_catalog.")
+
+	switch name {
+	case "smallint", "int2", "smallserial", "serial2":
+		return Int16(), true
+	case "integer", "int", "int4", "serial", "serial4":
+		return Int32(), true
+	case "bigint", "int8", "bigserial", "serial8":
+		return Int64(), true
+	case "real", "float4":
+		return Float32(), true
+	case "float", "double precision", "float8":
+		return Float64(), true
+	case "boolean", "bool":
+		return Bool(), true
+	case "text", "varchar", "bpchar", "char", "character varying", "character", "citext", "name",
+		"numeric", "decimal", "money", "uuid", "time", "timetz", "macaddr", "ltree":
+		return String(), true
+	case "bytea":
+		return Index().Byte(), false
+	case "json", "jsonb":
+		return Qual("encoding/json", "RawMessage"), false
+	case "date", "timestamp", "timestamptz":
+		return Qual("time", "Time"), true
+	case "interval":
+		return Qual("time", "Duration"), true
+	case "inet", "cidr":
+		return Qual("net/netip", "Prefix"), true
+	}
+
+	if e, ok := qg.enums[name]; ok {
+		if !slices.Contains(qg.usedEnums, e) {
+			qg.usedEnums = append(qg.usedEnums, e)
+		}
+		return Id(e.name), true
+	}
+	return Interface(), false
+}
+
+func (qg *queryGen) errorf(q *daemonpb.SQLCPlugin_Query, format string, args ...any) {
+	t := sqldb.ErrInvalidQuery(fmt.Sprintf(format, args...))
+	qg.gen.Errs.Add(t.InFile(filepath.Join(qg.queryDir, q.Filename)))
+}
+
+// reservedNames are the identifiers used by the generated query functions,
+// which parameters can't be named.
+var reservedNames = map[string]bool{
+	"arg": true, "ctx": true, "err": true, "i": true, "items": true,
+	"q": true, "res": true, "row": true, "rows": true,
+}
+
+// initialisms are the name parts that are written in upper case in Go names.
+var initialisms = map[string]bool{
+	"api": true, "http": true, "id": true, "ip": true, "json": true, "sql": true,
+	"uid": true, "uri": true, "url": true, "uuid": true,
+}
+
+// goName converts a SQL name like "author_id" to an exported Go name like "AuthorID".
+func goName(s string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if initialisms[strings.ToLower(part)] {
+			b.WriteString(strings.ToUpper(part))
+		} else {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	name := b.String()
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "X" + name
+	}
+	return name
+}
+
+// paramName returns the unexported Go name for the parameter with the SQL name,
+// given its exported Go name.
+func paramName(sqlName, goName string) string {
+	first, _, _ := strings.Cut(sqlName, "_")
+	if first != "" && initialisms[strings.ToLower(first)] {
+		return strings.ToLower(first) + goName[len(first):]
+	}
+	return strings.ToLower(goName[:1]) + goName[1:]
+}
+
+// dedupe returns name, adding a number suffix if it's already been used.
+func dedupe(seen map[string]int, name string) string {
+	seen[name]++
+	if n := seen[name]; n > 1 {
+		return name + strconv.Itoa(n)
+	}
+	return name
+}
+
+func boolToInt(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,121 @@
+package sqldbgen
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"encr.dev/pkg/sqlcexec"
+	daemonpb "encr.dev/proto/encore/daemon"
+)
+
+// queryError is an error in a query file, as reported by sqlc.
+type queryError struct {
+	Pos     token.Position // the zero value if the position is unknown
+	Message string
+}
+
+// analyzeQueries uses sqlc to analyze the queries in queryDir
+// against the schema defined by the migrations in migrationDir.
+//
+// It reports the analyzed queries, or the errors in the queries.
+func analyzeQueries(migrationDir, queryDir string) (req *daemonpb.SQLCPlugin_GenerateRequest, queryErrs []queryError, err error) {
+	tmpDir, err := os.MkdirTemp("", "encore-sqlc")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	// sqlc requires the paths to be relative to the config file.
+	schemaPath, err := filepath.Rel(tmpDir, migrationDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	queriesPath, err := filepath.Rel(tmpDir, queryDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg := map[string]any{
+		"version": "2",
+		"sql": []map[string]any{{
+			"engine":  "postgresql",
+			"schema":  schemaPath,
+			"queries": queriesPath,
+			"gen": map[string]any{
+				"json": map[string]any{"out": "out", "filename": "request.json"},
+			},
+		}},
+	}
+	cfgData, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	cfgPath := filepath.Join(tmpDir, "sqlc.json")
+	if err := os.WriteFile(cfgPath, cfgData, 0644); err != nil {
+		return nil, nil, err
+	}
+
+	stderr, code, err := sqlcexec.Run(context.Background(), "generate", "--no-remote", "-f", cfgPath)
+	if err != nil {
+		return nil, nil, err
+	} else if code != 0 {
+		queryErrs = parseSQLCErrors(tmpDir, stderr)
+		if len(queryErrs) == 0 {
+			return nil, nil, fmt.Errorf("sqlc exited with code %d: %s", code, bytes.TrimSpace(stderr))
+		}
+		return nil, queryErrs, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, "out", "request.json"))
+	if err != nil {
+		return nil, nil, err
+	}
+	req = &daemonpb.SQLCPlugin_GenerateRequest{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, req); err != nil {
+		return nil, nil, fmt.Errorf("parse sqlc output: %v", err)
+	}
+	return req, nil, nil
+}
+
+var sqlcErrRe = regexp.MustCompile(`^(.+?):(\d+):(\d+): (.+)$`)
+
+// parseSQLCErrors parses the errors sqlc reports, which are of the form
+// "<file>:<line>:<column>: <message>" with the file relative to dir.
+func parseSQLCErrors(dir string, stderr []byte) []queryError {
+	var errs []queryError
+	sc := bufio.NewScanner(bytes.NewReader(stderr))
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.HasPrefix(line, "# package") {
+			continue
+		}
+		m := sqlcErrRe.FindStringSubmatch(line)
+		if m == nil {
+			if line = strings.TrimSpace(line); line != "" {
+				errs = append(errs, queryError{Message: line})
+			}
+			continue
+		}
+		ln, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		file := m[1]
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(dir, file)
+		}
+		errs = append(errs, queryError{
+			Pos:     token.Position{Filename: file, Line: ln, Column: max(col, 1)},
+			Message: m[4],
+		})
+	}
+	return errs
+}
@@ -0,0 +1,33 @@
+package sqldbgen
+
+import (
+	"fmt"
+
+	"encr.dev/v2/app"
+	"encr.dev/v2/codegen"
+	"encr.dev/v2/parser/infra/sqldb"
+)
+
+// GenDataMigrations generates the code for the given data migrations.
+func GenDataMigrations(gen *codegen.Generator, appDesc *app.Desc, migrations []*sqldb.DataMigration) {
+	for _, m := range migrations {
+		db, ok := appDesc.DataMigrationDB(m)
+		if !ok {
+			gen.Errs.Add(sqldb.ErrDataMigrationDatabaseNotFound.AtGoNode(m.DBExpr))
+			continue
+		}
+
+		// Insert the SQL migrations the data migration must run between into the config literal,
+		// so the runtime can check it runs against the schema it was written for.
+		var snippet string
+		if v := m.RequiredSQLVersion(db); v > 0 {
+			snippet += fmt.Sprintf("EncoreInternal_RequiredSQLVersion: %d,", v)
+		}
+		if v := m.NextSQLVersion(db); v > 0 {
+			snippet += fmt.Sprintf("EncoreInternal_NextSQLVersion: %d,", v)
+		}
+		if snippet != "" {
+			gen.Rewrite(m.File).Insert(m.ConfigLiteral.Lbrace+1, []byte(snippet))
+		}
+	}
+}
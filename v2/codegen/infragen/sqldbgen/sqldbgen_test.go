@@ -0,0 +1,33 @@
+package sqldbgen_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sqlc-dev/sqlc/pkg/cli"
+
+	"encr.dev/pkg/sqlcexec"
+	"encr.dev/v2/app"
+	"encr.dev/v2/codegen"
+	"encr.dev/v2/codegen/infragen"
+	"encr.dev/v2/codegen/internal/codegentest"
+)
+
+func TestMain(m *testing.M) {
+	// The test binary stands in for the encore binary's 'sqlc' command.
+	if len(os.Args) > 1 && os.Args[1] == "sqlc" {
+		os.Exit(cli.Run(os.Args[2:]))
+	}
+	if exe, err := os.Executable(); err == nil {
+		sqlcexec.SetEncoreBinary(exe)
+	}
+	os.Exit(m.Run())
+}
+
+func TestCodegen(t *testing.T) {
+	fn := func(gen *codegen.Generator, desc *app.Desc) {
+		infragen.Process(gen, desc)
+	}
+
+	codegentest.Run(t, fn)
+}
@@ -55,6 +55,7 @@ func Run(t *testing.T, fn func(*codegen.Generator, *app.Desc)) {
 
 			tc.GoModTidy()
 			tc.GoModDownload()
+			tc.TrustPlugins()
 
 			p := parser.NewParser(tc.Context)
 			parserResult := p.Parse()
@@ -20,12 +20,14 @@ import (
 	"github.com/rs/zerolog"
 
 	"encr.dev/internal/env"
+	"encr.dev/pkg/appfile"
 	"encr.dev/pkg/errinsrc"
 	"encr.dev/pkg/option"
 	"encr.dev/pkg/paths"
 	"encr.dev/v2/internals/parsectx"
 	"encr.dev/v2/internals/perr"
 	"encr.dev/v2/internals/pkginfo"
+	"encr.dev/v2/parser/plugin/external"
 )
 
 type Context struct {
@@ -178,6 +180,19 @@ func (c *Context) DeferExpectError(matches ...string) {
 	}
 }
 
+// TrustPlugins trusts the plugins configured in the encore.app file
+// of the main module, if any, so that the parser runs them.
+// The trust is recorded in a temporary config directory.
+func (c *Context) TrustPlugins() {
+	f, err := appfile.ParseFile(c.MainModuleDir.Join(appfile.Name).ToIO())
+	c.TestC.Assert(err, qt.IsNil)
+	if len(f.Plugins) == 0 {
+		return
+	}
+	c.TestC.Setenv("ENCORE_CONFIG_DIR", c.TestC.TempDir())
+	c.TestC.Assert(external.Trust(c.MainModuleDir.ToIO(), f.Plugins), qt.IsNil)
+}
+
 // GoModTidy runs "go mod tidy" on the main module.
 func (c *Context) GoModTidy() {
 	// nosemgrep go.lang.security.audit.dangerous-exec-command.dangerous-exec-command
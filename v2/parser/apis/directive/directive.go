@@ -139,6 +139,12 @@ func RegisterDirectiveParser(name string, parser DirectiveParser) {
 	pluginParsers[name] = parser
 }
 
+// HasDirectiveParser reports whether a custom parser is registered for //encore:<name>.
+func HasDirectiveParser(name string) bool {
+	_, ok := pluginParsers[name]
+	return ok
+}
+
 // ----------------------------------------------------------------------
 
 // Parse parses the encore:foo directives in cg.
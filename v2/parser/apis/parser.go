@@ -10,6 +10,7 @@ import (
 	"encr.dev/v2/parser/apis/middleware"
 	"encr.dev/v2/parser/apis/nats"
 	"encr.dev/v2/parser/apis/servicestruct"
+	"encr.dev/v2/parser/plugin/external"
 	_ "encr.dev/v2/parser/plugin/natspubsub"
 	"encr.dev/v2/parser/resource/resourceparser"
 )
@@ -105,7 +106,18 @@ var Parser = &resourceparser.Parser{
 						}
 
 					default:
-						p.Errs.Add(errUnexpectedDirective(dir.Name).AtGoNode(decl))
+						if plugin, ok := external.ForDirective(p.Plugins, dir.Name); ok {
+							// The resource is filled in by the plugin once all packages are parsed.
+							p.RegisterResource(&external.Resource{
+								Plugin: plugin,
+								File:   file,
+								Decl:   decl,
+								Dir:    dir,
+								Doc:    doc,
+							})
+						} else {
+							p.Errs.Add(errUnexpectedDirective(dir.Name).AtGoNode(decl))
+						}
 					}
 
 				case *ast.GenDecl:
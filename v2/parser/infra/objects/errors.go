@@ -7,6 +7,8 @@ import (
 const (
 	objectsNewBucketHelp = "For example `objects.NewBucket(\"my-bucket\", objects.BucketConfig{ Versioned: false })`"
 
+	objectsNewBucketNotificationHelp = "For example `objects.NewBucketNotification(MyBucket, MyTopic, objects.NotificationConfig{ ObjectCreated: true })`"
+
 	objectsBucketUsageHelp = "The bucket can only be referenced by calling methods on it, or by using objects.BucketRef."
 )
 
@@ -47,7 +49,7 @@ var (
 
 	errBucketRefInvalidPerms = errRange.New(
 		"Unrecognized permissions in call to objects.BucketRef",
-		"The supported permissions are objects.{Uploader,Downloader,Attrser,Lister,Remover,PublicURLer,ReadWriter}.",
+		"The supported permissions are objects.{Uploader,Downloader,Attrser,Lister,Remover,Copier,Mover,PublicURLer,ReadWriter}.",
 	)
 
 	ErrBucketRefOutsideService = errRange.New(
@@ -59,4 +61,39 @@ var (
 		"Call to PublicURL for non-public objects.Bucket",
 		"The PublicURL method can only be called on a public bucket.",
 	)
+
+	errNewBucketNotificationArgCount = errRange.Newf(
+		"Invalid objects.NewBucketNotification call",
+		"A call to objects.NewBucketNotification requires 3 arguments; the bucket, the topic and the config object, got %d arguments.",
+		errors.PrependDetails(objectsNewBucketNotificationHelp),
+	)
+
+	ErrNotificationBucketNotResource = errRange.New(
+		"Invalid call to objects.NewBucketNotification",
+		"The bucket must be a package-level variable declared using objects.NewBucket.",
+		errors.PrependDetails(objectsNewBucketNotificationHelp),
+	)
+
+	ErrNotificationTopicNotResource = errRange.New(
+		"Invalid call to objects.NewBucketNotification",
+		"The topic must be a package-level variable declared using pubsub.NewTopic.",
+		errors.PrependDetails(objectsNewBucketNotificationHelp),
+	)
+
+	ErrNotificationInvalidMessageType = errRange.New(
+		"Invalid topic for bucket notification",
+		"The topic of a bucket notification must have objects.Event as its message type.",
+		errors.PrependDetails("For example `pubsub.NewTopic[objects.Event](\"uploads\", pubsub.TopicConfig{ DeliveryGuarantee: pubsub.AtLeastOnce })`"),
+	)
+
+	errNotificationNoEvents = errRange.New(
+		"Invalid objects.NotificationConfig",
+		"At least one of ObjectCreated and ObjectDeleted must be set.",
+		errors.PrependDetails(objectsNewBucketNotificationHelp),
+	)
+
+	ErrDuplicateNotification = errRange.New(
+		"Duplicate bucket notification",
+		"A bucket can only be connected to a given topic once.",
+	)
 )
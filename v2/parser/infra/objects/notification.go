@@ -0,0 +1,112 @@
+package objects
+
+import (
+	"go/ast"
+	"go/token"
+
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/internals/pkginfo"
+	literals "encr.dev/v2/parser/infra/internal/literals"
+	parseutil "encr.dev/v2/parser/infra/internal/parseutil"
+	"encr.dev/v2/parser/resource"
+	"encr.dev/v2/parser/resource/resourceparser"
+)
+
+// Notification connects the object events of a bucket to a pub/sub topic.
+type Notification struct {
+	AST  *ast.CallExpr
+	File *pkginfo.File
+	Doc  string // The documentation on the notification
+
+	Bucket pkginfo.QualifiedName // The bucket the events are for
+	Topic  pkginfo.QualifiedName // The topic the events are published to
+
+	ObjectCreated bool
+	ObjectDeleted bool
+	Prefix        string
+}
+
+func (n *Notification) Kind() resource.Kind       { return resource.BucketNotification }
+func (n *Notification) Package() *pkginfo.Package { return n.File.Pkg }
+func (n *Notification) ASTExpr() ast.Expr         { return n.AST }
+func (n *Notification) Pos() token.Pos            { return n.AST.Pos() }
+func (n *Notification) End() token.Pos            { return n.AST.End() }
+func (n *Notification) SortKey() string {
+	return n.Bucket.PkgPath.String() + "." + n.Bucket.Name + "." + n.Topic.PkgPath.String() + "." + n.Topic.Name
+}
+
+var NotificationParser = &resourceparser.Parser{
+	Name: "Bucket Notification",
+
+	InterestingImports: []paths.Pkg{"encore.dev/storage/objects"},
+	Run: func(p *resourceparser.Pass) {
+		name := pkginfo.QualifiedName{Name: "NewBucketNotification", PkgPath: "encore.dev/storage/objects"}
+
+		spec := &parseutil.ReferenceSpec{
+			MinTypeArgs: 0,
+			MaxTypeArgs: 0,
+			Parse:       parseNotification,
+		}
+
+		parseutil.FindPkgNameRefs(p.Pkg, []pkginfo.QualifiedName{name}, func(file *pkginfo.File, name pkginfo.QualifiedName, stack []ast.Node) {
+			parseutil.ParseReference(p, spec, parseutil.ReferenceData{
+				File:         file,
+				Stack:        stack,
+				ResourceFunc: name,
+			})
+		})
+	},
+}
+
+func parseNotification(d parseutil.ReferenceInfo) {
+	errs := d.Pass.Errs
+
+	if len(d.Call.Args) != 3 {
+		errs.Add(errNewBucketNotificationArgCount(len(d.Call.Args)).AtGoNode(d.Call))
+		return
+	}
+
+	bucketExpr := d.Call.Args[0]
+	bucketObj, ok := d.File.Names().ResolvePkgLevelRef(bucketExpr)
+	if !ok {
+		errs.Add(ErrNotificationBucketNotResource.AtGoNode(bucketExpr))
+		return
+	}
+
+	topicExpr := d.Call.Args[1]
+	topicObj, ok := d.File.Names().ResolvePkgLevelRef(topicExpr)
+	if !ok {
+		errs.Add(ErrNotificationTopicNotResource.AtGoNode(topicExpr))
+		return
+	}
+
+	cfgLit, ok := literals.ParseStruct(d.Pass.Errs, d.File, "objects.NotificationConfig", d.Call.Args[2])
+	if !ok {
+		return // error reported by ParseStruct
+	}
+
+	// Decode the config
+	type decodedConfig struct {
+		ObjectCreated bool   `literal:",optional"`
+		ObjectDeleted bool   `literal:",optional"`
+		Prefix        string `literal:",optional"`
+	}
+	config := literals.Decode[decodedConfig](d.Pass.Errs, cfgLit, nil)
+	if !config.ObjectCreated && !config.ObjectDeleted {
+		errs.Add(errNotificationNoEvents.AtGoNode(d.Call.Args[2]))
+		return
+	}
+
+	n := &Notification{
+		AST:           d.Call,
+		File:          d.File,
+		Doc:           d.Doc,
+		Bucket:        bucketObj,
+		Topic:         topicObj,
+		ObjectCreated: config.ObjectCreated,
+		ObjectDeleted: config.ObjectDeleted,
+		Prefix:        config.Prefix,
+	}
+	d.Pass.RegisterResource(n)
+	d.Pass.AddBind(d.File, d.Ident, n)
+}
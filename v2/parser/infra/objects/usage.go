@@ -17,6 +17,9 @@ type MethodUsage struct {
 	usage.Base
 	Method string
 	Perm   Perm
+
+	// ExtraPerms are additional permissions the method requires, beyond Perm.
+	ExtraPerms []Perm
 }
 
 type RefUsage struct {
@@ -75,7 +78,10 @@ func (p Perm) ToMeta() (meta.BucketUsage_Operation, bool) {
 func ResolveBucketUsage(data usage.ResolveData, bkt *Bucket) usage.Usage {
 	switch expr := data.Expr.(type) {
 	case *usage.MethodCall:
-		var perm Perm
+		var (
+			perm  Perm
+			extra []Perm
+		)
 		switch expr.Method {
 		case "Upload":
 			perm = WriteObject
@@ -93,6 +99,12 @@ func ResolveBucketUsage(data usage.ResolveData, bkt *Bucket) usage.Usage {
 			perm = SignedDownloadURL
 		case "Attrs", "Exists":
 			perm = GetObjectMetadata
+		case "Copy":
+			perm = WriteObject
+			extra = []Perm{ReadObjectContents}
+		case "Move":
+			perm = WriteObject
+			extra = []Perm{ReadObjectContents, DeleteObject}
 		default:
 			return nil
 		}
@@ -103,14 +115,18 @@ func ResolveBucketUsage(data usage.ResolveData, bkt *Bucket) usage.Usage {
 				Bind: expr.Bind,
 				Expr: expr,
 			},
-			Method: expr.Method,
-			Perm:   perm,
+			Method:     expr.Method,
+			Perm:       perm,
+			ExtraPerms: extra,
 		}
 
 	case *usage.FuncArg:
 		switch {
 		case option.Contains(expr.PkgFunc, pkginfo.Q("encore.dev/storage/objects", "BucketRef")):
 			return parseBucketRef(data.Errs, expr)
+		case option.Contains(expr.PkgFunc, pkginfo.Q("encore.dev/storage/objects", "NewBucketNotification")):
+			// Allowed usage
+			return nil
 		}
 	}
 
@@ -146,6 +162,10 @@ func parseBucketRef(errs *perr.List, expr *usage.FuncArg) usage.Usage {
 				perms = append(perms, DeleteObject)
 			case isNamed(typ, "Attrser"):
 				perms = append(perms, GetObjectMetadata)
+			case isNamed(typ, "Copier"):
+				perms = append(perms, ReadObjectContents, WriteObject)
+			case isNamed(typ, "Mover"):
+				perms = append(perms, ReadObjectContents, WriteObject, DeleteObject)
 			case isNamed(typ, "PublicURLer"):
 				perms = append(perms, GetPublicURL)
 			case isNamed(typ, "ReadWriter"):
@@ -66,6 +66,30 @@ func Foo() { bkt.Exists(context.Background(), "key") }
 `,
 			Want: []usage.Usage{&objects.MethodUsage{Method: "Exists", Perm: objects.GetObjectMetadata}},
 		},
+		{
+			Name: "move",
+			Code: `
+var bkt = objects.NewBucket("bucket", objects.BucketConfig{})
+
+func Foo() { bkt.Move(context.Background(), "src", "dst") }
+`,
+			Want: []usage.Usage{&objects.MethodUsage{
+				Method:     "Move",
+				Perm:       objects.WriteObject,
+				ExtraPerms: []objects.Perm{objects.ReadObjectContents, objects.DeleteObject},
+			}},
+		},
+		{
+			Name: "ref_copier",
+			Code: `
+var bkt = objects.NewBucket("bucket", objects.BucketConfig{})
+
+var ref = objects.BucketRef[objects.Copier](bkt)
+`,
+			Want: []usage.Usage{&objects.RefUsage{
+				Perms: []objects.Perm{objects.ReadObjectContents, objects.WriteObject},
+			}},
+		},
 		{
 			Name: "ref",
 			Code: `
@@ -139,6 +163,32 @@ var ref = objects.BucketRef[MyRef](bkt)
 					objects.WriteObject},
 			}},
 		},
+		{
+			Name: "notification",
+			Code: `
+import "encore.dev/pubsub"
+
+var bkt = objects.NewBucket("bucket", objects.BucketConfig{})
+
+var topic = pubsub.NewTopic[objects.Event]("topic", pubsub.TopicConfig{DeliveryGuarantee: pubsub.AtLeastOnce})
+
+var _ = objects.NewBucketNotification(bkt, topic, objects.NotificationConfig{ObjectCreated: true})
+`,
+			Want: []usage.Usage{},
+		},
+		{
+			Name: "notification_no_events",
+			Code: `
+import "encore.dev/pubsub"
+
+var bkt = objects.NewBucket("bucket", objects.BucketConfig{})
+
+var topic = pubsub.NewTopic[objects.Event]("topic", pubsub.TopicConfig{DeliveryGuarantee: pubsub.AtLeastOnce})
+
+var _ = objects.NewBucketNotification(bkt, topic, objects.NotificationConfig{Prefix: "images/"})
+`,
+			WantErrs: []string{"At least one of ObjectCreated and ObjectDeleted must be set"},
+		},
 		{
 			Name: "invalid_ref",
 			Code: `
@@ -56,6 +56,9 @@ func ResolveTopicUsage(data usage.ResolveData, topic *Topic) usage.Usage {
 		case option.Contains(expr.PkgFunc, pkginfo.Q("encore.dev/et", "Topic")):
 			// Allowed usage
 			return nil
+		case option.Contains(expr.PkgFunc, pkginfo.Q("encore.dev/storage/objects", "NewBucketNotification")):
+			// Allowed usage
+			return nil
 		case option.Contains(expr.PkgFunc, pkginfo.Q("encore.dev/pubsub", "TopicRef")):
 			return parseTopicRef(data.Errs, expr)
 		}
@@ -0,0 +1,163 @@
+package sqldb
+
+import (
+	"go/ast"
+	"go/token"
+	"regexp"
+
+	"encr.dev/pkg/errors"
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/internals/perr"
+	"encr.dev/v2/internals/pkginfo"
+	"encr.dev/v2/parser/infra/internal/literals"
+	"encr.dev/v2/parser/infra/internal/parseutil"
+	"encr.dev/v2/parser/resource"
+	"encr.dev/v2/parser/resource/resourceparser"
+)
+
+// DataMigration is a data migration written in Go,
+// declared using sqldb.NewDataMigration.
+type DataMigration struct {
+	AST      *ast.CallExpr
+	File     *pkginfo.File
+	Doc      string
+	Database pkginfo.QualifiedName // The database the migration belongs to
+	DBExpr   ast.Expr
+	Version  uint64
+	Name     string
+
+	// ConfigLiteral is the literal of the migration configuration.
+	ConfigLiteral *ast.CompositeLit
+}
+
+func (m *DataMigration) Kind() resource.Kind       { return resource.SQLDataMigration }
+func (m *DataMigration) Package() *pkginfo.Package { return m.File.Pkg }
+func (m *DataMigration) ASTExpr() ast.Expr         { return m.AST }
+func (m *DataMigration) Pos() token.Pos            { return m.AST.Pos() }
+func (m *DataMigration) End() token.Pos            { return m.AST.End() }
+func (m *DataMigration) SortKey() string {
+	return m.Database.PkgPath.String() + "." + m.Database.Name + "." + m.Name
+}
+
+var DataMigrationParser = &resourceparser.Parser{
+	Name: "SQL Data Migration",
+
+	InterestingImports: []paths.Pkg{"encore.dev/storage/sqldb"},
+	Run: func(p *resourceparser.Pass) {
+		name := pkginfo.QualifiedName{PkgPath: "encore.dev/storage/sqldb", Name: "NewDataMigration"}
+
+		spec := &parseutil.ReferenceSpec{
+			MinTypeArgs: 0,
+			MaxTypeArgs: 0,
+			Parse:       parseDataMigration,
+		}
+
+		parseutil.FindPkgNameRefs(p.Pkg, []pkginfo.QualifiedName{name}, func(file *pkginfo.File, name pkginfo.QualifiedName, stack []ast.Node) {
+			parseutil.ParseReference(p, spec, parseutil.ReferenceData{
+				File:         file,
+				Stack:        stack,
+				ResourceFunc: name,
+			})
+		})
+	},
+}
+
+func parseDataMigration(d parseutil.ReferenceInfo) {
+	errs := d.Pass.Errs
+	if len(d.Call.Args) != 2 {
+		errs.Add(errNewDataMigrationArgCount(len(d.Call.Args)).AtGoNode(d.Call))
+		return
+	}
+
+	dbExpr := d.Call.Args[0]
+	dbRef, ok := d.File.Names().ResolvePkgLevelRef(dbExpr)
+	if !ok {
+		errs.Add(errDataMigrationDatabaseNotResource.AtGoNode(dbExpr))
+		return
+	}
+
+	cfgLit, ok := literals.ParseStruct(errs, d.File, "sqldb.DataMigrationConfig", d.Call.Args[1])
+	if !ok {
+		return // error reported by ParseStruct
+	}
+
+	// Decode the config
+	type decodedConfig struct {
+		Version int64    `literal:",required"`
+		Name    string   `literal:",required"`
+		Migrate ast.Expr `literal:",required,dynamic"`
+	}
+	config := literals.Decode[decodedConfig](errs, cfgLit, nil)
+	if config.Version == 0 {
+		return // error reported by Decode
+	} else if config.Version < 0 {
+		errs.Add(errDataMigrationInvalidVersion.AtGoNode(cfgLit.Expr("Version")))
+		return
+	} else if !dataMigrationNameRe.MatchString(config.Name) {
+		errs.Add(errDataMigrationInvalidName.AtGoNode(cfgLit.Expr("Name")))
+		return
+	}
+
+	mig := &DataMigration{
+		AST:           d.Call,
+		File:          d.File,
+		Doc:           d.Doc,
+		Database:      dbRef,
+		DBExpr:        dbExpr,
+		Version:       uint64(config.Version),
+		Name:          config.Name,
+		ConfigLiteral: cfgLit.Lit(),
+	}
+	d.Pass.RegisterResource(mig)
+	d.Pass.AddBind(d.File, d.Ident, mig)
+}
+
+// dataMigrationNameRe matches valid data migration names,
+// which follow the description format of migration files.
+var dataMigrationNameRe = regexp.MustCompile(`^[^.\s/]+$`)
+
+// ValidateDataMigrations validates that the data migrations of a database
+// are numbered uniquely, together with the database's SQL migrations.
+func ValidateDataMigrations(errs *perr.List, db *Database, migrations []*DataMigration) {
+	sqlVersions := make(map[uint64]string, len(db.Migrations))
+	for _, m := range db.Migrations {
+		sqlVersions[m.Number] = m.Filename
+	}
+
+	seen := make(map[uint64]*DataMigration, len(migrations))
+	for _, m := range migrations {
+		if filename, ok := sqlVersions[m.Version]; ok {
+			errs.Add(errDataMigrationDuplicateVersion(m.Version).
+				AtGoNode(m.ConfigLiteral, errors.AsError("also used by "+filename)))
+		} else if prev, ok := seen[m.Version]; ok {
+			errs.Add(errDataMigrationDuplicateVersion(m.Version).
+				AtGoNode(m.ConfigLiteral).
+				AtGoNode(prev.ConfigLiteral))
+		}
+		seen[m.Version] = m
+	}
+}
+
+// RequiredSQLVersion reports the number of the SQL migration directly
+// preceding the data migration, or 0 if there is none.
+func (m *DataMigration) RequiredSQLVersion(db *Database) uint64 {
+	var version uint64
+	for _, sqlMig := range db.Migrations {
+		if sqlMig.Number < m.Version && sqlMig.Number > version {
+			version = sqlMig.Number
+		}
+	}
+	return version
+}
+
+// NextSQLVersion reports the number of the SQL migration directly
+// following the data migration, or 0 if there is none.
+func (m *DataMigration) NextSQLVersion(db *Database) uint64 {
+	var version uint64
+	for _, sqlMig := range db.Migrations {
+		if sqlMig.Number > m.Version && (version == 0 || sqlMig.Number < version) {
+			version = sqlMig.Number
+		}
+	}
+	return version
+}
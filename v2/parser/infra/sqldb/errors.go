@@ -35,22 +35,26 @@ var (
 		"Invalid sqldb.NewDatabase call",
 		"A call to sqldb.NewDatabase requires 2 arguments: the database name and the config object, got %d arguments.",
 	)
-	errNewDatabaseAbsPath = errRange.New(
+	errNewDatabaseAbsPath = errRange.Newf(
 		"Invalid sqldb.NewDatabase call",
-		"The migration path must be a relative path rooted within the package directory, got an absolute path.",
+		"The %s path must be a relative path rooted within the package directory, got an absolute path.",
 	)
-	errNewDatabaseNonLocalPath = errRange.New(
+	errNewDatabaseNonLocalPath = errRange.Newf(
 		"Invalid sqldb.NewDatabase call",
-		"The migration path must be a relative path rooted within the package directory, got a non-local path.",
+		"The %s path must be a relative path rooted within the package directory, got a non-local path.",
 	)
-	errNewDatabaseMigrationDirNotFound = errRange.New(
+	errNewDatabaseDirNotFound = errRange.Newf(
 		"Invalid sqldb.NewDatabase call",
-		"The migration directory does not exist.",
+		"The %s directory does not exist.",
 	)
 	errMigrationsNotInMainModule = errRange.New(
 		"Invalid database migration directory",
 		"The migration path must be within the application's main module.",
 	)
+	errSeedsNotInMainModule = errRange.New(
+		"Invalid database seed directory",
+		"The seed path must be within the application's main module.",
+	)
 	errInvalidPkgLevelQuery = errRange.Newf(
 		"Invalid use of sqldb package-level function",
 		"The package-level query function sqldb.%s can only be used within Encore services that don't use sqldb.NewDatabase.",
@@ -59,4 +63,37 @@ var (
 		"Unknown sqldb database",
 		"No database named %q was found in the application. Ensure it is created somewhere using sqldb.NewDatabase to be able to reference it.",
 	)
+	errNewDataMigrationArgCount = errRange.Newf(
+		"Invalid sqldb.NewDataMigration call",
+		"A call to sqldb.NewDataMigration requires 2 arguments: the database and the config object, got %d arguments.",
+	)
+	errDataMigrationDatabaseNotResource = errRange.New(
+		"Invalid sqldb.NewDataMigration call",
+		"The database must be a package level variable declared using sqldb.NewDatabase or sqldb.Named.",
+	)
+	errDataMigrationInvalidVersion = errRange.New(
+		"Invalid sqldb.NewDataMigration call",
+		"The data migration version must be a positive integer.",
+	)
+	errDataMigrationInvalidName = errRange.New(
+		"Invalid sqldb.NewDataMigration call",
+		"The data migration name must be non-empty and cannot contain periods, slashes or whitespace.",
+	)
+	errDataMigrationDuplicateVersion = errRange.Newf(
+		"Duplicate migration version",
+		"Multiple migrations of the database use version %d. Data migrations are numbered together with the database's SQL migrations, and each version must be unique.",
+	)
+	ErrDataMigrationDatabaseNotFound = errRange.New(
+		"Unknown sqldb database",
+		"The database passed to sqldb.NewDataMigration could not be found. Ensure it is declared using sqldb.NewDatabase or sqldb.Named.",
+	)
+	errUnsafeMigration = errRange.New(
+		"Unsafe database migration",
+		"",
+	)
+	ErrInvalidQuery = errRange.Newf(
+		"Invalid SQL query",
+		"%s",
+		errors.WithDetails("Typed query functions are generated from the .sql files in the database's queries directory, checked against the schema defined by its migrations. See https://encore.dev/docs/primitives/databases for more information."),
+	)
 )
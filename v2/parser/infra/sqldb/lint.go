@@ -0,0 +1,105 @@
+package sqldb
+
+import (
+	"fmt"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"encr.dev/pkg/errinsrc"
+	"encr.dev/pkg/errors"
+	"encr.dev/pkg/migrationlint"
+	"encr.dev/v2/internals/parsectx"
+)
+
+// LintMigrations lints the migrations in migrationDir that have been added or modified
+// compared to the git revision base, against the schema created by the earlier migrations.
+//
+// It returns the unsafe operations as errors, and the findings worth reviewing as warnings.
+// If the migration directory is not within a git repository nothing is linted.
+func LintMigrations(appRoot, migrationDir string, migrations []MigrationFile, base string) (errs, warnings []errors.Template, err error) {
+	changed, ok, err := migrationlint.ChangedFiles(migrationDir, base)
+	if err != nil || !ok {
+		return nil, nil, err
+	}
+
+	cfg := migrationlint.Config{
+		Dir: migrationDir,
+		Lint: func(m migrationlint.Migration) bool {
+			return changed[m.Filename]
+		},
+	}
+	anyChanged := false
+	for _, m := range migrations {
+		if !strings.HasSuffix(m.Filename, ".up.sql") {
+			continue
+		}
+		cfg.Migrations = append(cfg.Migrations, migrationlint.Migration{Number: m.Number, Filename: m.Filename})
+		anyChanged = anyChanged || changed[m.Filename]
+	}
+	if !anyChanged {
+		return nil, nil, nil
+	}
+
+	cfg.Queries, err = migrationlint.SQLCQueries(appRoot, migrationDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Include the queries used to generate typed query functions.
+	typedQueries, err := filepath.Glob(filepath.Join(filepath.Dir(migrationDir), "queries", "*.sql"))
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg.Queries = append(cfg.Queries, typedQueries...)
+	findings, err := migrationlint.Lint(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, f := range findings {
+		t := errUnsafeMigration
+		t.Title = f.Title
+		t.Summary = f.Message
+		if f.Help != "" {
+			t.Detail = f.Help + "\n\n"
+		}
+		t.Detail += fmt.Sprintf("If this is intended, add the comment '-- encore-lint-ignore: %s' before the statement.", f.Rule)
+
+		if f.Line == 0 {
+			t = t.InFile(f.File)
+		} else {
+			start := token.Position{Filename: f.File, Line: f.Line, Column: f.Column}
+			end := token.Position{Filename: f.File, Line: f.EndLine, Column: f.EndColumn}
+			if f.Severity == migrationlint.Error {
+				t = t.AtGoPosition(start, end, errors.AsError(string(f.Rule)))
+			} else {
+				t = t.AtGoPosition(start, end, errors.AsWarning(string(f.Rule)))
+			}
+		}
+
+		if f.Severity == migrationlint.Error {
+			errs = append(errs, t)
+		} else {
+			warnings = append(warnings, t)
+		}
+	}
+	return errs, warnings, nil
+}
+
+// LintNewMigrations checks the database's migrations that have not yet been
+// committed to version control for unsafe operations.
+//
+// The findings are logged as warnings, so they don't break the build while
+// working on a migration; 'encore check' reports the unsafe operations as errors.
+func LintNewMigrations(pc *parsectx.Context, db *Database) {
+	migrationDir := pc.MainModuleDir.Join(filepath.FromSlash(string(db.MigrationDir)))
+	lintErrs, warnings, err := LintMigrations(pc.MainModuleDir.ToIO(), migrationDir.ToIO(), db.Migrations, "HEAD")
+	if err != nil {
+		// Errors running git are not fatal to parsing; 'encore check' reports them.
+		pc.Log.Debug().Err(err).Str("database", db.Name).Msg("unable to check migrations for unsafe operations")
+		return
+	}
+	for _, t := range append(lintErrs, warnings...) {
+		pc.Log.Warn().Str("database", db.Name).Msg(errinsrc.FromTemplate(t, pc.FS).Error())
+	}
+}
@@ -0,0 +1,57 @@
+package sqldb
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/rs/zerolog"
+
+	"encr.dev/v2/internals/testutil"
+)
+
+func TestLintNewMigrations(t *testing.T) {
+	c := qt.New(t)
+	if _, err := exec.LookPath("git"); err != nil {
+		c.Skip("git not installed")
+	}
+
+	tc := testutil.NewContext(c, false, testutil.ParseTxtar(`
+-- svc/migrations/1_init.up.sql --
+CREATE TABLE users (id BIGINT PRIMARY KEY, name TEXT);
+`))
+	root := tc.MainModuleDir.ToIO()
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = root
+		out, err := cmd.CombinedOutput()
+		c.Assert(err, qt.IsNil, qt.Commentf("git %s: %s", args[0], out))
+	}
+	git("init", "-q")
+	git("add", ".")
+	git("commit", "-q", "-m", "init")
+
+	// Add a migration that hasn't been committed yet.
+	err := os.WriteFile(filepath.Join(root, "svc", "migrations", "2_drop_name.up.sql"), []byte("ALTER TABLE users DROP COLUMN name;\n"), 0644)
+	c.Assert(err, qt.IsNil)
+
+	var logs bytes.Buffer
+	tc.Log = zerolog.New(&logs)
+	LintNewMigrations(tc.Context, &Database{
+		Name:         "svc",
+		MigrationDir: "svc/migrations",
+		Migrations: []MigrationFile{
+			{Filename: "1_init.up.sql", Number: 1, Description: "init"},
+			{Filename: "2_drop_name.up.sql", Number: 2, Description: "drop_name"},
+		},
+	})
+
+	// The unsafe operation is reported as a warning, without failing the parse.
+	c.Assert(tc.Errs.Len(), qt.Equals, 0)
+	c.Assert(logs.String(), qt.Contains, `"level":"warn"`)
+	c.Assert(logs.String(), qt.Contains, "drop-column")
+	c.Assert(logs.String(), qt.Not(qt.Contains), "1_init.up.sql")
+}
@@ -32,6 +32,15 @@ type Database struct {
 	File         option.Option[*pkginfo.File]
 	MigrationDir paths.MainModuleRelSlash
 	Migrations   []MigrationFile
+
+	// SeedDir is the directory containing the database's seed data,
+	// or the empty string if it has none.
+	SeedDir paths.MainModuleRelSlash
+
+	// QueryDir is the "queries" directory next to the migration directory,
+	// containing the .sql files to generate typed query functions from.
+	// It's the empty string if there is none.
+	QueryDir paths.MainModuleRelSlash
 }
 
 func (d *Database) Kind() resource.Kind       { return resource.SQLDatabase }
@@ -93,32 +102,12 @@ func parseDatabase(d parseutil.ReferenceInfo) {
 	// Decode the config
 	type decodedConfig struct {
 		Migrations string `literal:",required"`
+		Seeds      string `literal:",optional"`
 	}
 	config := literals.Decode[decodedConfig](d.Pass.Errs, cfgLit, nil)
 
-	if path.IsAbs(config.Migrations) {
-		errs.Add(errNewDatabaseAbsPath.AtGoNode(cfgLit.Expr("Migrations")))
-		return
-	}
-	migDir := filepath.FromSlash(config.Migrations)
-	if !filepath.IsLocal(migDir) {
-		errs.Add(errNewDatabaseNonLocalPath.AtGoNode(cfgLit.Expr("Migrations")))
-		return
-	}
-
-	migrationDir := d.Pass.Pkg.FSPath.Join(migDir)
-	if fi, err := os.Stat(migrationDir.ToIO()); errors.Is(err, fs.ErrNotExist) || (err == nil && !fi.IsDir()) {
-		errs.Add(errNewDatabaseMigrationDirNotFound.AtGoNode(cfgLit.Expr("Migrations")))
-		return
-	} else if err != nil {
-		errs.AddStd(err)
-		return
-	}
-
-	// Compute the relative path to the migration directory from the main module.
-	relMigrationDir, err := filepath.Rel(d.Pass.MainModuleDir.ToIO(), migrationDir.ToIO())
-	if err != nil || !filepath.IsLocal(relMigrationDir) {
-		errs.Add(errMigrationsNotInMainModule)
+	migrationDir, relMigrationDir, ok := parseDatabaseDir(d.Pass, cfgLit, "Migrations", "migration", config.Migrations)
+	if !ok {
 		return
 	}
 
@@ -133,13 +122,56 @@ func parseDatabase(d parseutil.ReferenceInfo) {
 		Pkg:          d.Pass.Pkg,
 		Name:         databaseName,
 		Doc:          d.Doc,
-		MigrationDir: paths.MainModuleRelSlash(filepath.ToSlash(relMigrationDir)),
+		MigrationDir: relMigrationDir,
 		Migrations:   migrations,
 	}
+	if config.Seeds != "" {
+		if _, db.SeedDir, ok = parseDatabaseDir(d.Pass, cfgLit, "Seeds", "seed", config.Seeds); !ok {
+			return
+		}
+	}
+	db.QueryDir = queryDir(d.Pass.MainModuleDir, relMigrationDir)
 	d.Pass.RegisterResource(db)
 	d.Pass.AddBind(d.File, d.Ident, db)
 }
 
+// parseDatabaseDir parses the directory given by the config field,
+// returning its path and its path relative to the main module.
+// The kind describes the directory in error messages.
+func parseDatabaseDir(p *resourceparser.Pass, cfgLit *literals.Struct, field, kind, dir string) (fsPath paths.FS, rel paths.MainModuleRelSlash, ok bool) {
+	errs := p.Errs
+	if path.IsAbs(dir) {
+		errs.Add(errNewDatabaseAbsPath(kind).AtGoNode(cfgLit.Expr(field)))
+		return "", "", false
+	}
+	localDir := filepath.FromSlash(dir)
+	if !filepath.IsLocal(localDir) {
+		errs.Add(errNewDatabaseNonLocalPath(kind).AtGoNode(cfgLit.Expr(field)))
+		return "", "", false
+	}
+
+	fsPath = p.Pkg.FSPath.Join(localDir)
+	if fi, err := os.Stat(fsPath.ToIO()); errors.Is(err, fs.ErrNotExist) || (err == nil && !fi.IsDir()) {
+		errs.Add(errNewDatabaseDirNotFound(kind).AtGoNode(cfgLit.Expr(field)))
+		return "", "", false
+	} else if err != nil {
+		errs.AddStd(err)
+		return "", "", false
+	}
+
+	// Compute the relative path to the directory from the main module.
+	relDir, err := filepath.Rel(p.MainModuleDir.ToIO(), fsPath.ToIO())
+	if err != nil || !filepath.IsLocal(relDir) {
+		if field == "Seeds" {
+			errs.Add(errSeedsNotInMainModule)
+		} else {
+			errs.Add(errMigrationsNotInMainModule)
+		}
+		return "", "", false
+	}
+	return fsPath, paths.MainModuleRelSlash(filepath.ToSlash(relDir)), true
+}
+
 var MigrationParser = &resourceparser.Parser{
 	Name: "SQL Database",
 
@@ -181,11 +213,29 @@ var MigrationParser = &resourceparser.Parser{
 			MigrationDir: paths.MainModuleRelSlash(filepath.ToSlash(relMigrationDir)),
 			Migrations:   migrations,
 		}
+
+		// Services with implicit databases declare seed data
+		// with a "seeds" directory next to the migrations.
+		seedDir := p.Pkg.FSPath.Join("seeds")
+		if fi, err := os.Stat(seedDir.ToIO()); err == nil && fi.IsDir() {
+			res.SeedDir = paths.MainModuleRelSlash(path.Join(path.Dir(string(res.MigrationDir)), "seeds"))
+		}
+		res.QueryDir = queryDir(p.MainModuleDir, res.MigrationDir)
 		p.RegisterResource(res)
 		p.AddImplicitBind(res)
 	},
 }
 
+// queryDir returns the "queries" directory next to the migration directory,
+// or the empty string if it doesn't exist.
+func queryDir(mainModuleDir paths.FS, migrationDir paths.MainModuleRelSlash) paths.MainModuleRelSlash {
+	dir := path.Join(path.Dir(string(migrationDir)), "queries")
+	if fi, err := os.Stat(mainModuleDir.Join(filepath.FromSlash(dir)).ToIO()); err == nil && fi.IsDir() {
+		return paths.MainModuleRelSlash(dir)
+	}
+	return ""
+}
+
 var migrationRe = regexp.MustCompile(`^(\d+)(_[^.]+)?\.(up|down).sql$`)
 
 func parseMigrations(migrationDir paths.FS) ([]MigrationFile, error) {
@@ -3,6 +3,9 @@ package sqldb
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"encr.dev/v2/internals/pkginfo"
 	"encr.dev/v2/parser/resource/resourcetest"
 )
 
@@ -40,6 +43,51 @@ CREATE TABLE foo (id int);
 				}},
 			},
 		},
+		{
+			Name: "seeds",
+			Code: `
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "migrations",
+	Seeds:      "seeds",
+})
+-- migrations/foo.txt --
+-- seeds/1_users.sql --
+INSERT INTO users (id) VALUES (1);
+`,
+			Want: &Database{
+				Name:         "name",
+				MigrationDir: "migrations",
+				SeedDir:      "seeds",
+			},
+		},
+		{
+			Name: "queries",
+			Code: `
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "migrations",
+})
+-- migrations/foo.txt --
+-- queries/authors.sql --
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = $1;
+`,
+			Want: &Database{
+				Name:         "name",
+				MigrationDir: "migrations",
+				QueryDir:     "queries",
+			},
+		},
+		{
+			Name: "seeds_not_found",
+			Code: `
+var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
+	Migrations: "migrations",
+	Seeds:      "seeds",
+})
+-- migrations/foo.txt --
+`,
+			WantErrs: []string{`.*The seed directory does not exist.*`},
+		},
 		{
 			Name: "abs_path",
 			Code: `
@@ -62,3 +110,80 @@ var x = sqldb.NewDatabase("name", sqldb.DatabaseConfig{
 
 	resourcetest.Run(t, DatabaseParser, tests)
 }
+
+func TestParseDataMigration(t *testing.T) {
+	tests := []resourcetest.Case[*DataMigration]{
+		{
+			Name: "basic",
+			Code: `
+var db = sqldb.Named("name")
+
+var _ = sqldb.NewDataMigration(db, sqldb.DataMigrationConfig{
+	Version: 3,
+	Name:    "backfill_names",
+	Migrate: func(ctx context.Context, tx *sqldb.Tx) error { return nil },
+})
+`,
+			Want: &DataMigration{
+				Database: pkginfo.Q("example.com", "db"),
+				Version:  3,
+				Name:     "backfill_names",
+			},
+		},
+		{
+			Name: "negative_version",
+			Code: `
+var db = sqldb.Named("name")
+
+var _ = sqldb.NewDataMigration(db, sqldb.DataMigrationConfig{
+	Version: -1,
+	Name:    "backfill_names",
+	Migrate: func(ctx context.Context, tx *sqldb.Tx) error { return nil },
+})
+`,
+			WantErrs: []string{`.*The data migration version must be a positive integer.*`},
+		},
+		{
+			Name: "invalid_name",
+			Code: `
+var db = sqldb.Named("name")
+
+var _ = sqldb.NewDataMigration(db, sqldb.DataMigrationConfig{
+	Version: 3,
+	Name:    "backfill.names",
+	Migrate: func(ctx context.Context, tx *sqldb.Tx) error { return nil },
+})
+`,
+			WantErrs: []string{`.*The data migration name must be non-empty.*`},
+		},
+	}
+
+	resourcetest.Run(t, DataMigrationParser, tests, cmpopts.IgnoreFields(DataMigration{}, "ConfigLiteral"))
+}
+
+func TestRequiredSQLVersion(t *testing.T) {
+	db := &Database{Migrations: []MigrationFile{
+		{Filename: "1_init.up.sql", Number: 1},
+		{Filename: "5_add_names.up.sql", Number: 5},
+		{Filename: "8_drop_names.up.sql", Number: 8},
+	}}
+	tests := []struct {
+		version  uint64
+		required uint64
+		next     uint64
+	}{
+		{version: 0, required: 0, next: 1},
+		{version: 3, required: 1, next: 5},
+		{version: 7, required: 5, next: 8},
+		{version: 9, required: 8, next: 0},
+	}
+	for _, tt := range tests {
+		m := &DataMigration{Version: tt.version}
+		if got := m.RequiredSQLVersion(db); got != tt.required {
+			t.Errorf("RequiredSQLVersion(%d) = %d, want %d", tt.version, got, tt.required)
+		}
+		if got := m.NextSQLVersion(db); got != tt.next {
+			t.Errorf("NextSQLVersion(%d) = %d, want %d", tt.version, got, tt.next)
+		}
+	}
+}
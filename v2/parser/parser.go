@@ -22,6 +22,7 @@ import (
 	"encr.dev/v2/parser/infra/pubsub"
 	"encr.dev/v2/parser/infra/secrets"
 	"encr.dev/v2/parser/infra/sqldb"
+	"encr.dev/v2/parser/plugin/external"
 	"encr.dev/v2/parser/resource"
 	"encr.dev/v2/parser/resource/resourceparser"
 	"encr.dev/v2/parser/resource/usage"
@@ -36,6 +37,7 @@ func NewParser(c *parsectx.Context) *Parser {
 		schemaParser:  schemaParser,
 		registry:      resourceparser.NewRegistry(allParsers),
 		usageResolver: newUsageResolver(),
+		plugins:       external.Load(c),
 	}
 }
 
@@ -45,6 +47,7 @@ type Parser struct {
 	schemaParser  *schema.Parser
 	registry      *resourceparser.Registry
 	usageResolver *usage.Resolver
+	plugins       []*external.Plugin
 }
 
 func (p *Parser) MainModule() *pkginfo.Module {
@@ -77,6 +80,7 @@ func (p *Parser) Parse() *Result {
 			Context:      p.c,
 			SchemaParser: p.schemaParser,
 			Pkg:          pkg,
+			Plugins:      p.plugins,
 		}
 
 		interested := p.registry.InterestedParsers(pkg)
@@ -112,6 +116,10 @@ func (p *Parser) Parse() *Result {
 		return cmp.Compare(a.Pos(), b.Pos())
 	})
 
+	// Let the external plugins fill in the resources declared with their directives.
+	// This happens after sorting so the plugins see the declarations in a stable order.
+	resources = external.Resolve(p.c, p.MainModule(), resources)
+
 	// Then sort the binds
 	slices.SortFunc(binds, func(a, b resource.Bind) int {
 		if a.Package() != b.Package() {
@@ -149,7 +157,9 @@ var allParsers = []*resourceparser.Parser{
 	sqldb.DatabaseParser,
 	sqldb.MigrationParser,
 	sqldb.NamedParser,
+	sqldb.DataMigrationParser,
 	objects.BucketParser,
+	objects.NotificationParser,
 }
 
 func newUsageResolver() *usage.Resolver {
@@ -0,0 +1,44 @@
+package external
+
+import (
+	"encr.dev/pkg/errors"
+)
+
+var (
+	errRange = errors.Range(
+		"parser/plugin/external",
+		"For more information on plugins, see https://encore.dev/docs/go/how-to/parser-plugins",
+	)
+
+	errInvalidPlugin = errRange.Newf(
+		"Invalid Plugin",
+		"The plugin configuration in encore.app is invalid: %s",
+	)
+
+	errUntrustedPlugins = errRange.New(
+		"Untrusted Plugins",
+		"The app declares plugins in encore.app, which run commands on this machine when parsing the app. "+
+			"They only run once you've trusted them: review the plugin commands, then run 'encore app trust-plugins'. "+
+			"Plugins need to be trusted again whenever their configuration, or an executable within the app they run, changes.",
+	)
+
+	errPluginFailed = errRange.Newf(
+		"Plugin Failed",
+		"The plugin %q failed to %s.",
+	)
+
+	errInvalidResponse = errRange.Newf(
+		"Invalid Plugin Response",
+		"The plugin %q returned an invalid response: %s",
+	)
+
+	errPluginDiagnostic = errRange.Newf(
+		"Plugin Error",
+		"The plugin %q reported an error: %s",
+	)
+
+	errUnhandledDirective = errRange.Newf(
+		"Unhandled Directive",
+		"The plugin %q did not return a resource for the //encore:%s directive.",
+	)
+)
@@ -0,0 +1,158 @@
+// Package external runs the out-of-process plugins declared in encore.app,
+// which handle custom //encore:<name> directives without changes to the parser.
+//
+// Plugins are executables that speak JSON-RPC 2.0 over stdin and stdout.
+// They are started in the app root once per call, read a single request
+// from stdin and write the response to stdout before exiting.
+// Anything written to stderr is included in the error if the call fails,
+// and calls that don't finish within the plugin's timeout are canceled.
+//
+// Since plugins run commands from encore.app, they only run once the user
+// has trusted the app's plugin configuration (see Trust).
+//
+// The calls made are:
+//
+//   - "parse", with the declarations annotated with the plugin's directives
+//     and the packages they're in. The plugin returns a resource for each
+//     declaration it accepts and diagnostics for the ones it doesn't.
+//   - "generate", with the resources previously returned by the plugin.
+//     The plugin returns Go files to add to the app's packages.
+package external
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"encr.dev/pkg/appfile"
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/internals/parsectx"
+	"encr.dev/v2/parser/apis/directive"
+)
+
+// Plugin is an out-of-process plugin configured in encore.app.
+type Plugin struct {
+	Name       string
+	Command    []string      // the command to run, and its arguments
+	Directives []string      // the names of the directives handled by the plugin
+	AppRoot    paths.FS      // the directory the plugin runs in
+	Timeout    time.Duration // the maximum duration of each call
+
+	// trusted is whether the user has trusted the plugin configuration.
+	// Untrusted plugins are never run.
+	trusted bool
+}
+
+// defaultTimeout is the maximum duration of each call to a plugin,
+// unless configured otherwise in encore.app.
+const defaultTimeout = time.Minute
+
+// builtinDirectives are the directives handled by the parser itself,
+// which plugins may not take over.
+var builtinDirectives = []string{"api", "authhandler", "middleware", "service"}
+
+var (
+	pluginNameRe    = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+	directiveNameRe = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+)
+
+// Load loads the plugins configured in the encore.app file of the app.
+// Invalid plugin configurations are reported to pc.Errs and skipped.
+// If the user hasn't trusted the plugin configuration that's reported too,
+// and the plugins are loaded but never run.
+func Load(pc *parsectx.Context) []*Plugin {
+	data, err := pc.ReadFile(pc.MainModuleDir.Join(appfile.Name).ToIO())
+	if err != nil {
+		return nil
+	}
+	f, err := appfile.Parse(data)
+	if err != nil {
+		// Invalid app files are reported when the app is loaded.
+		return nil
+	}
+
+	invalid := func(format string, args ...any) {
+		pc.Errs.Add(errInvalidPlugin(fmt.Sprintf(format, args...)).InFile(appfile.Name))
+	}
+
+	var (
+		plugins     []*Plugin
+		names       = make(map[string]bool)
+		directiveOf = make(map[string]string)
+	)
+PluginLoop:
+	for _, cfg := range f.Plugins {
+		switch {
+		case !pluginNameRe.MatchString(cfg.Name):
+			invalid("invalid plugin name %q: names must start with a letter and contain only lowercase letters, numbers, hyphens and underscores", cfg.Name)
+			continue
+		case names[cfg.Name]:
+			invalid("the plugin %q is declared more than once", cfg.Name)
+			continue
+		case len(cfg.Command) == 0 || cfg.Command[0] == "":
+			invalid("the plugin %q has no command", cfg.Name)
+			continue
+		case len(cfg.Directives) == 0:
+			invalid("the plugin %q handles no directives", cfg.Name)
+			continue
+		}
+		names[cfg.Name] = true
+
+		timeout := defaultTimeout
+		if cfg.Timeout != "" {
+			d, err := time.ParseDuration(cfg.Timeout)
+			if err != nil || d <= 0 {
+				invalid("the plugin %q has the invalid timeout %q: timeouts must be positive durations, like \"30s\"", cfg.Name, cfg.Timeout)
+				continue
+			}
+			timeout = d
+		}
+
+		for _, name := range cfg.Directives {
+			switch {
+			case !directiveNameRe.MatchString(name):
+				invalid("the plugin %q declares the invalid directive name %q", cfg.Name, name)
+				continue PluginLoop
+			case slices.Contains(builtinDirectives, name) || directive.HasDirectiveParser(name):
+				invalid("the plugin %q cannot handle the built-in directive //encore:%s", cfg.Name, name)
+				continue PluginLoop
+			case directiveOf[name] != "":
+				invalid("the directive //encore:%s is handled by both the plugins %q and %q", name, directiveOf[name], cfg.Name)
+				continue PluginLoop
+			}
+		}
+		for _, name := range cfg.Directives {
+			directiveOf[name] = cfg.Name
+		}
+
+		plugins = append(plugins, &Plugin{
+			Name:       cfg.Name,
+			Command:    cfg.Command,
+			Directives: cfg.Directives,
+			AppRoot:    pc.MainModuleDir,
+			Timeout:    timeout,
+		})
+	}
+
+	if len(plugins) > 0 {
+		if Trusted(pc.MainModuleDir.ToIO(), f.Plugins) {
+			for _, p := range plugins {
+				p.trusted = true
+			}
+		} else {
+			pc.Errs.Add(errUntrustedPlugins.InFile(appfile.Name))
+		}
+	}
+	return plugins
+}
+
+// ForDirective returns the plugin handling the directive with the given name, if any.
+func ForDirective(plugins []*Plugin, name string) (*Plugin, bool) {
+	for _, p := range plugins {
+		if slices.Contains(p.Directives, name) {
+			return p, true
+		}
+	}
+	return nil, false
+}
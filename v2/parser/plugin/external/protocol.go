@@ -0,0 +1,213 @@
+package external
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ParseParams are the parameters of the "parse" call.
+type ParseParams struct {
+	ModulePath string        `json:"modulePath"`
+	Packages   []PackageInfo `json:"packages"`
+	Decls      []DeclInfo    `json:"decls"`
+}
+
+// PackageInfo describes a package containing declarations for a plugin.
+type PackageInfo struct {
+	ImportPath string   `json:"importPath"`
+	RelPath    string   `json:"relPath"` // relative to the app root
+	Name       string   `json:"name"`
+	Doc        string   `json:"doc,omitempty"`
+	Files      []string `json:"files"`   // file names, relative to the package
+	Imports    []string `json:"imports"` // import paths, sorted
+}
+
+// DeclInfo describes a declaration annotated with one of the plugin's directives.
+type DeclInfo struct {
+	ID        int           `json:"id"`
+	Package   string        `json:"package"` // import path
+	File      string        `json:"file"`    // relative to the app root
+	Line      int           `json:"line"`
+	Column    int           `json:"column"`
+	Func      string        `json:"func"` // "Foo", or "Recv.Foo" for methods
+	Doc       string        `json:"doc,omitempty"`
+	Directive DirectiveInfo `json:"directive"`
+}
+
+// DirectiveInfo describes a parsed //encore:<name> directive.
+type DirectiveInfo struct {
+	Name    string            `json:"name"`
+	Options []string          `json:"options,omitempty"`
+	Fields  map[string]string `json:"fields,omitempty"`
+	Tags    []string          `json:"tags,omitempty"`
+}
+
+// ParseResult is the result of the "parse" call.
+type ParseResult struct {
+	Resources   []ResourceInfo `json:"resources"`
+	Diagnostics []Diagnostic   `json:"diagnostics"`
+}
+
+// ResourceInfo describes a resource defined by a plugin.
+type ResourceInfo struct {
+	Decl int             `json:"decl"` // the id of the declaration defining the resource
+	Type string          `json:"type"`
+	Name string          `json:"name"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// Diagnostic is an error or warning reported by a plugin.
+// It's reported at the given declaration, or at the given position if there is no declaration.
+type Diagnostic struct {
+	Severity string `json:"severity"` // "error" or "warning"; defaults to "error"
+	Message  string `json:"message"`
+	Decl     *int   `json:"decl,omitempty"`
+	File     string `json:"file,omitempty"` // relative to the app root
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+}
+
+// GenerateParams are the parameters of the "generate" call.
+type GenerateParams struct {
+	ModulePath string                 `json:"modulePath"`
+	Resources  []GenerateResourceInfo `json:"resources"`
+}
+
+// GenerateResourceInfo describes a resource to generate code for.
+type GenerateResourceInfo struct {
+	Decl DeclInfo        `json:"decl"`
+	Type string          `json:"type"`
+	Name string          `json:"name"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// GenerateResult is the result of the "generate" call.
+type GenerateResult struct {
+	Files []GeneratedFile `json:"files"`
+}
+
+// GeneratedFile is a Go file generated by a plugin.
+type GeneratedFile struct {
+	Package string `json:"package"` // import path of the package to add the file to
+	Name    string `json:"name"`    // short name of the file, e.g. "queues"
+	Content string `json:"content"` // complete Go source, including the package clause
+}
+
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Method  string `json:"method"`
+	Params  any    `json:"params"`
+}
+
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      int             `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *rpcError       `json:"error"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// resolveCommand resolves the executable name of a plugin command.
+// Commands given as relative paths are relative to the app root,
+// while bare names are looked up in PATH.
+// It reports whether the executable is within the app.
+func resolveCommand(appRoot, name string) (path string, inApp bool) {
+	if !filepath.IsAbs(name) && strings.ContainsRune(name, '/') {
+		return filepath.Join(appRoot, name), true
+	}
+	return name, false
+}
+
+// call runs the plugin, makes a single JSON-RPC call to it,
+// and decodes the result into result.
+// The plugin is killed if the call takes longer than the plugin's timeout.
+func (p *Plugin) call(ctx context.Context, method string, params, result any) error {
+	if !p.trusted {
+		return errors.New("the plugin configuration has not been trusted")
+	}
+
+	timeout := cmp.Or(p.Timeout, defaultTimeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := json.Marshal(rpcRequest{JSONRPC: "2.0", ID: 1, Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+
+	name, _ := resolveCommand(p.AppRoot.ToIO(), p.Command[0])
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, p.Command[1:]...)
+	cmd.Dir = p.AppRoot.ToIO()
+	cmd.Stdin = bytes.NewReader(append(req, '\n'))
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Don't wait for the output of processes started by the plugin
+	// that outlive it once it's been killed.
+	cmd.WaitDelay = time.Second
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("timed out after %v", timeout)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%w: %s", err, msg)
+		}
+		return err
+	}
+
+	var resp rpcResponse
+	if err := json.NewDecoder(&stdout).Decode(&resp); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	} else if resp.Error != nil {
+		return resp.Error
+	} else if resp.ID != 1 {
+		return fmt.Errorf("unexpected response id %d", resp.ID)
+	}
+	if err := json.Unmarshal(resp.Result, result); err != nil {
+		return fmt.Errorf("decode result: %w", err)
+	}
+	return nil
+}
+
+// funcName returns the name of fn, qualified by its receiver type for methods.
+func funcName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+	typ := fn.Recv.List[0].Type
+	for {
+		switch t := typ.(type) {
+		case *ast.StarExpr:
+			typ = t.X
+			continue
+		case *ast.IndexExpr:
+			typ = t.X
+			continue
+		case *ast.IndexListExpr:
+			typ = t.X
+			continue
+		case *ast.Ident:
+			return t.Name + "." + fn.Name.Name
+		}
+		return fn.Name.Name
+	}
+}
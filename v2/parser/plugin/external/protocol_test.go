@@ -0,0 +1,95 @@
+package external
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+
+	"encr.dev/pkg/paths"
+)
+
+// TestHelperPlugin is not a real test; it's run as the plugin process by the tests below.
+func TestHelperPlugin(t *testing.T) {
+	mode := os.Getenv("ENCORE_TEST_PLUGIN_MODE")
+	if mode == "" {
+		t.Skip("only run as a plugin process")
+	}
+
+	var req rpcRequest
+	line, _ := bufio.NewReader(os.Stdin).ReadBytes('\n')
+	if err := json.Unmarshal(line, &req); err != nil {
+		fmt.Fprintln(os.Stderr, "bad request:", err)
+		os.Exit(1)
+	}
+
+	switch mode {
+	case "echo":
+		params, _ := json.Marshal(req.Params)
+		fmt.Printf(`{"jsonrpc":"2.0","id":1,"result":{"method":%q,"params":%s}}`+"\n", req.Method, params)
+	case "rpc-error":
+		fmt.Println(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`)
+	case "crash":
+		fmt.Fprintln(os.Stderr, "something went wrong")
+		os.Exit(2)
+	case "hang":
+		time.Sleep(time.Minute)
+	}
+	os.Exit(0)
+}
+
+func testPlugin(t *testing.T, mode string) *Plugin {
+	t.Setenv("ENCORE_TEST_PLUGIN_MODE", mode)
+	return &Plugin{
+		Name:    "test",
+		Command: []string{os.Args[0], "-test.run=^TestHelperPlugin$"},
+		AppRoot: paths.RootedFSPath(t.TempDir(), "."),
+		trusted: true,
+	}
+}
+
+func TestCall(t *testing.T) {
+	c := qt.New(t)
+
+	var result struct {
+		Method string            `json:"method"`
+		Params map[string]string `json:"params"`
+	}
+	err := testPlugin(t, "echo").call(context.Background(), "parse", map[string]string{"foo": "bar"}, &result)
+	c.Assert(err, qt.IsNil)
+	c.Assert(result.Method, qt.Equals, "parse")
+	c.Assert(result.Params, qt.DeepEquals, map[string]string{"foo": "bar"})
+}
+
+func TestCall_RPCError(t *testing.T) {
+	c := qt.New(t)
+	err := testPlugin(t, "rpc-error").call(context.Background(), "parse", nil, &struct{}{})
+	c.Assert(err, qt.ErrorMatches, `method not found \(code -32601\)`)
+}
+
+func TestCall_Crash(t *testing.T) {
+	c := qt.New(t)
+	err := testPlugin(t, "crash").call(context.Background(), "parse", nil, &struct{}{})
+	c.Assert(err, qt.ErrorMatches, `exit status 2: something went wrong`)
+}
+
+func TestCall_Timeout(t *testing.T) {
+	c := qt.New(t)
+	p := testPlugin(t, "hang")
+	p.Timeout = 100 * time.Millisecond
+	err := p.call(context.Background(), "parse", nil, &struct{}{})
+	c.Assert(err, qt.ErrorMatches, `timed out after 100ms`)
+}
+
+func TestCall_Untrusted(t *testing.T) {
+	c := qt.New(t)
+	p := testPlugin(t, "echo")
+	p.trusted = false
+	err := p.call(context.Background(), "parse", nil, &struct{}{})
+	c.Assert(err, qt.ErrorMatches, `the plugin configuration has not been trusted`)
+}
@@ -0,0 +1,203 @@
+package external
+
+import (
+	"context"
+	"fmt"
+	"go/token"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"encr.dev/pkg/errors"
+	"encr.dev/pkg/paths"
+	"encr.dev/v2/internals/parsectx"
+	"encr.dev/v2/internals/pkginfo"
+	"encr.dev/v2/parser/resource"
+)
+
+// Resolve asks the plugins to parse the declarations of the plugin resources
+// among resources, and fills in the resources from the plugins' responses.
+//
+// It returns resources without the plugin resources that were not accepted
+// by their plugins, having reported why to pc.Errs.
+// The resources of untrusted plugins are dropped without running the plugins.
+func Resolve(pc *parsectx.Context, mainModule *pkginfo.Module, resources []resource.Resource) []resource.Resource {
+	var (
+		plugins  []*Plugin
+		byPlugin = make(map[*Plugin][]*Resource)
+	)
+	for _, r := range resources {
+		if r, ok := r.(*Resource); ok {
+			if _, seen := byPlugin[r.Plugin]; !seen {
+				plugins = append(plugins, r.Plugin)
+			}
+			byPlugin[r.Plugin] = append(byPlugin[r.Plugin], r)
+		}
+	}
+	if len(plugins) == 0 {
+		return resources
+	}
+
+	accepted := make(map[*Resource]bool)
+	for _, p := range plugins {
+		if !p.trusted {
+			// Load has already reported that the plugins are untrusted.
+			continue
+		}
+		for _, r := range p.parse(pc, mainModule, byPlugin[p]) {
+			accepted[r] = true
+		}
+	}
+
+	return slices.DeleteFunc(resources, func(r resource.Resource) bool {
+		res, ok := r.(*Resource)
+		return ok && !accepted[res]
+	})
+}
+
+// parse makes the "parse" call to the plugin for the given resources,
+// and returns the resources accepted by the plugin.
+func (p *Plugin) parse(pc *parsectx.Context, mainModule *pkginfo.Module, resources []*Resource) []*Resource {
+	params := ParseParams{ModulePath: string(mainModule.Path)}
+	seenPkgs := make(map[*pkginfo.Package]bool)
+	for i, r := range resources {
+		if pkg := r.File.Pkg; !seenPkgs[pkg] {
+			seenPkgs[pkg] = true
+			params.Packages = append(params.Packages, packageInfo(pc, pkg))
+		}
+		r.decl = declInfo(pc, i, r)
+		params.Decls = append(params.Decls, r.decl)
+	}
+
+	var result ParseResult
+	if err := p.call(pc.Ctx, "parse", params, &result); err != nil {
+		pc.Errs.Add(errPluginFailed(p.Name, "parse the app").Wrapping(err))
+		return nil
+	}
+
+	var accepted []*Resource
+	for _, info := range result.Resources {
+		if info.Decl < 0 || info.Decl >= len(resources) {
+			pc.Errs.Add(errInvalidResponse(p.Name, fmt.Sprintf("unknown declaration id %d", info.Decl)))
+			continue
+		}
+		r := resources[info.Decl]
+		if slices.Contains(accepted, r) {
+			pc.Errs.Add(errInvalidResponse(p.Name, fmt.Sprintf("multiple resources for declaration id %d", info.Decl)).AtGoNode(r.Dir))
+			continue
+		}
+		r.Type, r.Name, r.Data = info.Type, info.Name, info.Data
+		accepted = append(accepted, r)
+	}
+
+	rejected := false
+	for _, diag := range result.Diagnostics {
+		if diag.Severity == "warning" {
+			pc.Log.Warn().Str("plugin", p.Name).Str("file", diag.File).Int("line", diag.Line).Msg(diag.Message)
+			continue
+		}
+		rejected = true
+
+		err := errPluginDiagnostic(p.Name, diag.Message)
+		switch {
+		case diag.Decl != nil && *diag.Decl >= 0 && *diag.Decl < len(resources):
+			err = err.AtGoNode(resources[*diag.Decl].Dir, errors.AsError(diag.Message))
+		case diag.File != "" && diag.Line > 0:
+			pos := token.Position{
+				Filename: pc.MainModuleDir.Join(filepath.FromSlash(diag.File)).ToIO(),
+				Line:     diag.Line,
+				Column:   max(diag.Column, 1),
+			}
+			err = err.AtGoPosition(pos, pos, errors.AsError(diag.Message))
+		}
+		pc.Errs.Add(err)
+	}
+
+	// Declarations the plugin neither accepted nor reported errors for
+	// would otherwise be silently ignored.
+	if !rejected {
+		for _, r := range resources {
+			if !slices.Contains(accepted, r) {
+				pc.Errs.Add(errUnhandledDirective(p.Name, r.Dir.Name).AtGoNode(r.Dir))
+			}
+		}
+	}
+	return accepted
+}
+
+// Generate makes the "generate" call to the plugin for the given resources,
+// and returns the files generated by the plugin.
+func (p *Plugin) Generate(ctx context.Context, modulePath paths.Mod, resources []*Resource) ([]GeneratedFile, error) {
+	params := GenerateParams{ModulePath: string(modulePath)}
+	for _, r := range resources {
+		params.Resources = append(params.Resources, GenerateResourceInfo{
+			Decl: r.decl,
+			Type: r.Type,
+			Name: r.Name,
+			Data: r.Data,
+		})
+	}
+
+	var result GenerateResult
+	if err := p.call(ctx, "generate", params, &result); err != nil {
+		return nil, err
+	}
+	return result.Files, nil
+}
+
+func packageInfo(pc *parsectx.Context, pkg *pkginfo.Package) PackageInfo {
+	info := PackageInfo{
+		ImportPath: pkg.ImportPath.String(),
+		RelPath:    relPath(pc, pkg.FSPath),
+		Name:       pkg.Name,
+		Doc:        pkg.Doc,
+	}
+	for _, f := range pkg.Files {
+		if !f.TestFile {
+			info.Files = append(info.Files, f.Name)
+		}
+	}
+	for imp := range pkg.Imports {
+		info.Imports = append(info.Imports, imp.String())
+	}
+	slices.Sort(info.Imports)
+	return info
+}
+
+func declInfo(pc *parsectx.Context, id int, r *Resource) DeclInfo {
+	pos := pc.FS.Position(r.Decl.Pos())
+	info := DeclInfo{
+		ID:      id,
+		Package: r.File.Pkg.ImportPath.String(),
+		File:    relPath(pc, r.File.FSPath),
+		Line:    pos.Line,
+		Column:  pos.Column,
+		Func:    funcName(r.Decl),
+		Doc:     r.Doc,
+		Directive: DirectiveInfo{
+			Name: r.Dir.Name,
+		},
+	}
+	for _, o := range r.Dir.Options {
+		info.Directive.Options = append(info.Directive.Options, o.Value)
+	}
+	for _, f := range r.Dir.Fields {
+		if info.Directive.Fields == nil {
+			info.Directive.Fields = make(map[string]string)
+		}
+		info.Directive.Fields[f.Key] = f.Value
+	}
+	for _, t := range r.Dir.Tags {
+		info.Directive.Tags = append(info.Directive.Tags, strings.TrimPrefix(t.Value, "tag:"))
+	}
+	return info
+}
+
+// relPath returns the slash-separated path of p relative to the app root.
+func relPath(pc *parsectx.Context, p paths.FS) string {
+	rel, err := filepath.Rel(pc.MainModuleDir.ToIO(), p.ToIO())
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return p.ToIO()
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,43 @@
+package external
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/token"
+
+	"encr.dev/v2/internals/pkginfo"
+	"encr.dev/v2/parser/apis/directive"
+	"encr.dev/v2/parser/resource"
+)
+
+// Resource is a resource defined by a plugin, declared by a function
+// annotated with one of the plugin's directives.
+type Resource struct {
+	Plugin *Plugin
+	File   *pkginfo.File
+	Decl   *ast.FuncDecl
+	Dir    *directive.Directive
+	Doc    string
+
+	// The fields below are set from the plugin's response by Resolve.
+
+	Type string          // plugin-specific type of the resource, e.g. "queue"
+	Name string          // name of the resource
+	Data json.RawMessage // plugin-specific data about the resource
+
+	decl DeclInfo // the declaration as sent to the plugin
+}
+
+func (r *Resource) Kind() resource.Kind       { return resource.PluginResource }
+func (r *Resource) Package() *pkginfo.Package { return r.File.Pkg }
+func (r *Resource) Pos() token.Pos            { return r.Decl.Pos() }
+func (r *Resource) End() token.Pos            { return r.Decl.End() }
+func (r *Resource) SortKey() string {
+	return r.Plugin.Name + ":" + r.File.Pkg.ImportPath.String() + "." + r.FuncName()
+}
+
+// FuncName returns the name of the annotated function,
+// qualified by its receiver type for methods.
+func (r *Resource) FuncName() string {
+	return funcName(r.Decl)
+}
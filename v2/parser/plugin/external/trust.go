@@ -0,0 +1,140 @@
+package external
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"encr.dev/internal/conf"
+	"encr.dev/pkg/appfile"
+	"encr.dev/pkg/xos"
+)
+
+// Plugins run commands from encore.app, which may come from anywhere,
+// like a freshly cloned repository. They therefore only run once the user
+// has trusted the plugin configuration of the app, with "encore app trust-plugins".
+//
+// Trust is recorded for the app root and a hash of the plugin configuration,
+// along with the contents of the executables of commands within the app,
+// so any change to them needs to be trusted again.
+
+// trustFileName is the name of the file in the Encore config directory
+// recording the trusted plugin configurations.
+const trustFileName = "trusted_plugins.json"
+
+// trustMu serializes updates to the trust file within the process.
+var trustMu sync.Mutex
+
+// Trusted reports whether the plugin configuration plugins
+// has been trusted for the app at appRoot.
+func Trusted(appRoot string, plugins []appfile.Plugin) bool {
+	trusted, err := readTrusted()
+	if err != nil {
+		return false
+	}
+	key, err := trustKey(appRoot)
+	if err != nil {
+		return false
+	}
+	hash, err := configHash(appRoot, plugins)
+	if err != nil {
+		return false
+	}
+	return trusted[key] == hash
+}
+
+// Trust records that the plugin configuration plugins is trusted
+// for the app at appRoot, replacing any previously trusted configuration.
+func Trust(appRoot string, plugins []appfile.Plugin) error {
+	trustMu.Lock()
+	defer trustMu.Unlock()
+
+	path, err := trustFilePath()
+	if err != nil {
+		return err
+	}
+	trusted, err := readTrusted()
+	if err != nil {
+		return err
+	}
+	key, err := trustKey(appRoot)
+	if err != nil {
+		return err
+	}
+	hash, err := configHash(appRoot, plugins)
+	if err != nil {
+		return err
+	}
+	trusted[key] = hash
+
+	data, err := json.MarshalIndent(trusted, "", "  ")
+	if err != nil {
+		return err
+	} else if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return xos.WriteFile(path, data, 0600)
+}
+
+// readTrusted reads the trusted plugin configurations,
+// keyed by app root.
+func readTrusted() (map[string]string, error) {
+	path, err := trustFilePath()
+	if err != nil {
+		return nil, err
+	}
+	trusted := make(map[string]string)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return trusted, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &trusted); err != nil {
+		return nil, err
+	}
+	return trusted, nil
+}
+
+func trustFilePath() (string, error) {
+	dir, err := conf.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, trustFileName), nil
+}
+
+func trustKey(appRoot string) (string, error) {
+	return filepath.Abs(appRoot)
+}
+
+// configHash returns a hash of the plugin configuration
+// and of the executables of the commands within the app at appRoot,
+// which can change without the configuration changing.
+func configHash(appRoot string, plugins []appfile.Plugin) (string, error) {
+	h := sha256.New()
+	data, _ := json.Marshal(plugins)
+	h.Write(data)
+	for _, p := range plugins {
+		if len(p.Command) == 0 {
+			continue
+		}
+		path, inApp := resolveCommand(appRoot, p.Command[0])
+		if !inApp {
+			continue
+		}
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("read command of plugin %s: %w", p.Name, err)
+		}
+		sum := sha256.Sum256(contents)
+		h.Write(sum[:])
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
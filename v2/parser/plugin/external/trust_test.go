@@ -0,0 +1,66 @@
+package external
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+
+	"encr.dev/pkg/appfile"
+)
+
+func TestTrust(t *testing.T) {
+	c := qt.New(t)
+	t.Setenv("ENCORE_CONFIG_DIR", t.TempDir())
+
+	appRoot := t.TempDir()
+	writeCommand(c, appRoot, "plugin", "#!/bin/sh\necho v1\n")
+	writeCommand(c, appRoot, "other", "#!/bin/sh\necho other\n")
+	plugins := []appfile.Plugin{{Name: "queues", Command: []string{"./plugin"}, Directives: []string{"queue"}}}
+	c.Assert(Trusted(appRoot, plugins), qt.IsFalse)
+
+	c.Assert(Trust(appRoot, plugins), qt.IsNil)
+	c.Assert(Trusted(appRoot, plugins), qt.IsTrue)
+
+	// Trust is specific to the app and the plugin configuration.
+	c.Assert(Trusted(t.TempDir(), plugins), qt.IsFalse)
+	changed := []appfile.Plugin{{Name: "queues", Command: []string{"./other"}, Directives: []string{"queue"}}}
+	c.Assert(Trusted(appRoot, changed), qt.IsFalse)
+
+	// Trusting a new configuration replaces the previous one.
+	c.Assert(Trust(appRoot, changed), qt.IsNil)
+	c.Assert(Trusted(appRoot, changed), qt.IsTrue)
+	c.Assert(Trusted(appRoot, plugins), qt.IsFalse)
+}
+
+func TestTrust_AppCommandChanged(t *testing.T) {
+	c := qt.New(t)
+	t.Setenv("ENCORE_CONFIG_DIR", t.TempDir())
+
+	appRoot := t.TempDir()
+	writeCommand(c, appRoot, "tools/plugin", "#!/bin/sh\necho v1\n")
+	plugins := []appfile.Plugin{
+		{Name: "queues", Command: []string{"./tools/plugin"}, Directives: []string{"queue"}},
+		{Name: "cron", Command: []string{"sh", "-c", "cat"}, Directives: []string{"cron"}},
+	}
+	c.Assert(Trust(appRoot, plugins), qt.IsNil)
+	c.Assert(Trusted(appRoot, plugins), qt.IsTrue)
+
+	// Changing the executable within the app, like after a pull, revokes the trust.
+	writeCommand(c, appRoot, "tools/plugin", "#!/bin/sh\necho v2\n")
+	c.Assert(Trusted(appRoot, plugins), qt.IsFalse)
+	c.Assert(Trust(appRoot, plugins), qt.IsNil)
+	c.Assert(Trusted(appRoot, plugins), qt.IsTrue)
+
+	// So does removing it.
+	c.Assert(os.Remove(filepath.Join(appRoot, "tools", "plugin")), qt.IsNil)
+	c.Assert(Trusted(appRoot, plugins), qt.IsFalse)
+	c.Assert(Trust(appRoot, plugins), qt.IsNotNil)
+}
+
+func writeCommand(c *qt.C, appRoot, name, contents string) {
+	path := filepath.Join(appRoot, filepath.FromSlash(name))
+	c.Assert(os.MkdirAll(filepath.Dir(path), 0755), qt.IsNil)
+	c.Assert(os.WriteFile(path, []byte(contents), 0755), qt.IsNil)
+}
@@ -22,12 +22,17 @@ const (
 	ConfigLoad
 	Secrets
 	Bucket
+	SQLDataMigration
+	BucketNotification
 
 	// API Framework Resources
 	APIEndpoint
 	AuthHandler
 	Middleware
 	ServiceStruct
+
+	// External Plugin Resources
+	PluginResource
 )
 
 type Resource interface {
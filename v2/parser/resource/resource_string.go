@@ -19,15 +19,18 @@ func _() {
 	_ = x[ConfigLoad-8]
 	_ = x[Secrets-9]
 	_ = x[Bucket-10]
-	_ = x[APIEndpoint-11]
-	_ = x[AuthHandler-12]
-	_ = x[Middleware-13]
-	_ = x[ServiceStruct-14]
+	_ = x[SQLDataMigration-11]
+	_ = x[BucketNotification-12]
+	_ = x[APIEndpoint-13]
+	_ = x[AuthHandler-14]
+	_ = x[Middleware-15]
+	_ = x[ServiceStruct-16]
+	_ = x[PluginResource-17]
 }
 
-const _Kind_name = "UnknownPubSubTopicPubSubSubscriptionSQLDatabaseMetricCronJobCacheClusterCacheKeyspaceConfigLoadSecretsBucketAPIEndpointAuthHandlerMiddlewareServiceStruct"
+const _Kind_name = "UnknownPubSubTopicPubSubSubscriptionSQLDatabaseMetricCronJobCacheClusterCacheKeyspaceConfigLoadSecretsBucketSQLDataMigrationBucketNotificationAPIEndpointAuthHandlerMiddlewareServiceStructPluginResource"
 
-var _Kind_index = [...]uint8{0, 7, 18, 36, 47, 53, 60, 72, 85, 95, 102, 108, 119, 130, 140, 153}
+var _Kind_index = [...]uint8{0, 7, 18, 36, 47, 53, 60, 72, 85, 95, 102, 108, 124, 142, 153, 164, 174, 187, 201}
 
 func (i Kind) String() string {
 	if i < 0 || i >= Kind(len(_Kind_index)-1) {
@@ -8,6 +8,7 @@ import (
 	"encr.dev/v2/internals/parsectx"
 	"encr.dev/v2/internals/pkginfo"
 	"encr.dev/v2/internals/schema"
+	"encr.dev/v2/parser/plugin/external"
 	"encr.dev/v2/parser/resource"
 )
 
@@ -35,6 +36,9 @@ type Pass struct {
 
 	Pkg *pkginfo.Package
 
+	// Plugins are the external plugins configured for the app.
+	Plugins []*external.Plugin
+
 	resources []resource.Resource
 	binds     []resource.Bind
 }
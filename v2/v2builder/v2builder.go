@@ -33,11 +33,13 @@ import (
 	"encr.dev/v2/codegen/apigen/userfacinggen"
 	"encr.dev/v2/codegen/cuegen"
 	"encr.dev/v2/codegen/infragen"
+	"encr.dev/v2/codegen/infragen/sqldbgen"
 	"encr.dev/v2/compiler/build"
 	"encr.dev/v2/internals/parsectx"
 	"encr.dev/v2/internals/perr"
 	"encr.dev/v2/internals/pkginfo"
 	"encr.dev/v2/parser"
+	"encr.dev/v2/parser/infra/sqldb"
 	"encr.dev/v2/parser/resource"
 )
 
@@ -491,6 +493,36 @@ func (i *BuilderImpl) GenUserFacing(ctx context.Context, p builder.GenUserFacing
 			}
 		}
 
+		// Generate the typed query functions.
+		for _, r := range pd.appDesc.Parse.Resources() {
+			db, ok := r.(*sqldb.Database)
+			if !ok {
+				continue
+			}
+
+			buf.Reset()
+			numErrs := errs.Len()
+			if f, ok := sqldbgen.GenQueries(gg, db).Get(); ok {
+				if err := f.Render(&buf); err != nil {
+					errs.Addf(token.NoPos, "unable to render query code: %v", err)
+					continue
+				}
+			} else if errs.Len() > numErrs {
+				// Keep the previously generated code while the queries are invalid.
+				continue
+			}
+
+			dst := db.Pkg.FSPath.Join(sqldbgen.QueriesFile)
+			if buf.Len() == 0 {
+				// Only remove the file if it was generated by Encore.
+				data, err := os.ReadFile(dst.ToIO())
+				if err != nil || !bytes.HasPrefix(data, []byte(sqldbgen.GeneratedHeader)) {
+					continue
+				}
+			}
+			i.writeOrDeleteFile(errs, buf.Bytes(), dst)
+		}
+
 		if errs.Len() > 0 {
 			return errs.AsError()
 		}